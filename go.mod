@@ -7,7 +7,7 @@ require (
 	github.com/go-gl/mathgl v0.0.0-20190416160123-c4601bc793c7
 	github.com/lib/pq v1.3.0
 	github.com/stretchr/testify v1.3.0
-	golang.org/x/image v0.0.0-20191214001246-9130b4cfad52 // indirect
+	golang.org/x/image v0.0.0-20191214001246-9130b4cfad52
 	golang.org/x/text v0.3.2
 )
 
@@ -33,6 +33,7 @@ const (
 	PointTempEnv                 // 10
 	PathTempEnv                  // 11
 	PointLoadEnv                 // 12
+	ServerTestEnv                // 13
 )
 
 const (
@@ -0,0 +1,150 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/go-gl/mathgl/mgl32"
+	"math"
+)
+
+const (
+	// Radians of orbit per pixel of mouse move
+	OrbitPerPixel = 0.01
+	// Eye distance ratio per scroll wheel step
+	ZoomPerStep = 0.9
+	// Field of view in degrees when reset
+	DefaultFovAngle = 30.0
+	// The eye distance when reset, as a ratio of its maximum
+	defaultEyeDistRatio = 0.75
+	// Keep away from the poles where the up vector is parallel to the eye direction
+	maxPitch = math.Pi/2.0 - 0.01
+)
+
+var defaultYaw = math.Pi / 4.0
+var defaultPitch = math.Asin(1.0 / math.Sqrt(3.0))
+
+// The camera of a display world, orbiting around a target point with the Z axis up.
+// By default the eye is on the diagonal (d,d,d) looking at the origin, d being the EyeDist value.
+type Camera struct {
+	Width, Height int
+	// Distance of the top corner of the space from the origin, used for the far plane
+	TopCornerDist float64
+	EyeDist       SizeVar
+	FovAngle      SizeVar
+	// Angles in radians of the eye around the target, yaw around Z from the X axis and pitch above the XY plane
+	Yaw, Pitch float64
+	Target     mgl32.Vec3
+	Projection mgl32.Mat4
+	View       mgl32.Mat4
+}
+
+func MakeCamera(width, height int) Camera {
+	c := Camera{}
+	c.Width = width
+	c.Height = height
+	c.FovAngle = SizeVar{10.0, 75.0, DefaultFovAngle}
+	c.EyeDist = SizeVar{1.0, 2.0, 1.5}
+	c.Projection = mgl32.Ident4()
+	c.View = mgl32.Ident4()
+	c.Reset()
+	return c
+}
+
+/***************************************************************/
+// Camera Functions
+/***************************************************************/
+
+// Adapt the eye distance range to the size of the space
+func (c *Camera) SetMax(max m3point.CInt) {
+	c.TopCornerDist = math.Sqrt(float64(3.0*max*max)) + 1.1
+	c.EyeDist = SizeVar{float64(max), c.TopCornerDist * 2.0, c.TopCornerDist * 1.5}
+	c.SetMatrices()
+}
+
+// Back to the default eye direction, distance and FOV looking at the origin
+func (c *Camera) Reset() {
+	c.EyeDist.Val = c.EyeDist.Max * defaultEyeDistRatio
+	c.FovAngle.Val = DefaultFovAngle
+	c.Yaw = defaultYaw
+	c.Pitch = defaultPitch
+	c.Target = mgl32.Vec3{0, 0, 0}
+	c.SetMatrices()
+}
+
+// Return true if the size changed and the matrices were recalculated
+func (c *Camera) SetSize(width, height int) bool {
+	if c.Width == width && c.Height == height {
+		return false
+	}
+	c.Width = width
+	c.Height = height
+	c.SetMatrices()
+	return true
+}
+
+func (c *Camera) getDistance() float64 {
+	return c.EyeDist.Val * math.Sqrt(3.0)
+}
+
+func (c *Camera) GetEye() mgl32.Vec3 {
+	d := c.getDistance()
+	dir := mgl32.Vec3{
+		float32(math.Cos(c.Pitch) * math.Cos(c.Yaw)),
+		float32(math.Cos(c.Pitch) * math.Sin(c.Yaw)),
+		float32(math.Sin(c.Pitch)),
+	}
+	return c.Target.Add(dir.Mul(float32(d)))
+}
+
+func (c *Camera) SetMatrices() {
+	if c.Width <= 0 || c.Height <= 0 {
+		return
+	}
+	eye := c.GetEye()
+	far := eye.Len() + c.Target.Len() + float32(c.TopCornerDist)
+	c.Projection = mgl32.Perspective(mgl32.DegToRad(float32(c.FovAngle.Val)), float32(c.Width)/float32(c.Height), 1.0, far)
+	c.View = mgl32.LookAtV(eye, c.Target, mgl32.Vec3{0, 0, 1})
+}
+
+// Rotate the eye around the target from a mouse move in pixels
+func (c *Camera) Orbit(dx, dy float64) {
+	c.Yaw = math.Mod(c.Yaw-dx*OrbitPerPixel, 2.0*math.Pi)
+	c.Pitch += dy * OrbitPerPixel
+	if c.Pitch > maxPitch {
+		c.Pitch = maxPitch
+	}
+	if c.Pitch < -maxPitch {
+		c.Pitch = -maxPitch
+	}
+	c.SetMatrices()
+}
+
+// Move the target so the point under the cursor follows a mouse move in pixels
+func (c *Camera) Pan(dx, dy float64) {
+	eye := c.GetEye()
+	forward := c.Target.Sub(eye).Normalize()
+	right := forward.Cross(mgl32.Vec3{0, 0, 1}).Normalize()
+	up := right.Cross(forward)
+	// Space units per pixel at the target distance
+	scale := 2.0 * c.getDistance() * math.Tan(float64(mgl32.DegToRad(float32(c.FovAngle.Val)))/2.0) / float64(c.Height)
+	c.Target = c.Target.Sub(right.Mul(float32(dx * scale))).Add(up.Mul(float32(dy * scale)))
+	c.SetMatrices()
+}
+
+// Move the eye closer for positive scroll steps, in the eye distance range
+func (c *Camera) Zoom(steps float64) {
+	c.EyeDist.Val *= math.Pow(ZoomPerStep, steps)
+	c.EyeDist.check()
+	c.SetMatrices()
+}
+
+// The picking ray under the pixel x,y for objects drawn with this model matrix
+func (c *Camera) GetRay(model mgl32.Mat4, x, y float64) Ray {
+	return MakePickingRay(c.Projection, c.View, model, c.Width, c.Height, x, y)
+}
+
+func (c *Camera) DisplaySettings() {
+	fmt.Println("FOV Angle [Z,X]", c.FovAngle.Val)
+	fmt.Println("Eye Dist [Q,W,Scroll]", c.EyeDist.Val)
+	fmt.Printf("Orbit [Left Drag] yaw %.2f pitch %.2f, Target [Right Drag] %v, Reset View [R]\n", c.Yaw, c.Pitch, c.Target)
+}
@@ -0,0 +1,80 @@
+package m3gl
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func projectOnCamera(c Camera, p mgl32.Vec3) (float64, float64) {
+	win := mgl32.Project(p, c.View, c.Projection, 0, 0, c.Width, c.Height)
+	return float64(win[0]), float64(c.Height) - float64(win[1])
+}
+
+func TestCameraDefault(t *testing.T) {
+	c := MakeCamera(800, 600)
+	c.SetMax(27)
+	d := float32(c.EyeDist.Val)
+	assert.True(t, c.GetEye().ApproxEqualThreshold(mgl32.Vec3{d, d, d}, 1e-3), "wrong eye %v", c.GetEye())
+	expected := mgl32.LookAtV(mgl32.Vec3{d, d, d}, mgl32.Vec3{}, mgl32.Vec3{0, 0, 1})
+	assert.True(t, c.View.ApproxEqualThreshold(expected, 1e-4))
+
+	x, y := projectOnCamera(c, mgl32.Vec3{})
+	assert.InDelta(t, 400.0, x, 1e-2)
+	assert.InDelta(t, 300.0, y, 1e-2)
+
+	assert.False(t, c.SetSize(800, 600))
+	assert.True(t, c.SetSize(400, 400))
+	assert.InDelta(t, 1.0, c.Projection[5]/c.Projection[0], 1e-5)
+}
+
+func TestCameraOrbitPanZoom(t *testing.T) {
+	c := MakeCamera(800, 600)
+	c.SetMax(27)
+	dist := c.GetEye().Len()
+
+	c.Orbit(100, 0)
+	assert.InDelta(t, defaultYaw-1.0, c.Yaw, 1e-9)
+	assert.InDelta(t, dist, c.GetEye().Len(), 1e-3)
+	c.Orbit(0, 1000)
+	assert.InDelta(t, maxPitch, c.Pitch, 1e-9)
+	c.Orbit(0, -5000)
+	assert.InDelta(t, -maxPitch, c.Pitch, 1e-9)
+	// Target still in the middle
+	x, y := projectOnCamera(c, c.Target)
+	assert.InDelta(t, 400.0, x, 1e-2)
+	assert.InDelta(t, 300.0, y, 1e-2)
+
+	// The previous target follows the mouse
+	c.EyeDist.Val = c.EyeDist.Min
+	c.FovAngle.Val = c.FovAngle.Max
+	c.Reset()
+	assert.Equal(t, defaultPitch, c.Pitch)
+	assert.InDelta(t, c.TopCornerDist*1.5, c.EyeDist.Val, 1e-9)
+	assert.Equal(t, DefaultFovAngle, c.FovAngle.Val)
+	assert.InDelta(t, dist, c.GetEye().Len(), 1e-3)
+	previous := c.Target
+	c.Pan(50, -20)
+	assert.True(t, c.Target.Sub(previous).Len() > 0.0)
+	x, y = projectOnCamera(c, previous)
+	assert.InDelta(t, 450.0, x, 0.5)
+	assert.InDelta(t, 280.0, y, 0.5)
+	x, y = projectOnCamera(c, c.Target)
+	assert.InDelta(t, 400.0, x, 1e-2)
+	assert.InDelta(t, 300.0, y, 1e-2)
+
+	eyeDist := c.EyeDist.Val
+	c.Zoom(1)
+	assert.InDelta(t, eyeDist*ZoomPerStep, c.EyeDist.Val, 1e-9)
+	c.Zoom(-1)
+	assert.InDelta(t, eyeDist, c.EyeDist.Val, 1e-9)
+	c.Zoom(100)
+	assert.Equal(t, c.EyeDist.Min, c.EyeDist.Val)
+	c.Zoom(-100)
+	assert.Equal(t, c.EyeDist.Max, c.EyeDist.Val)
+
+	c.Reset()
+	assert.Equal(t, mgl32.Vec3{}, c.Target)
+	assert.InDelta(t, math.Pi/4.0, c.Yaw, 1e-9)
+}
@@ -0,0 +1,213 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"math"
+)
+
+const (
+	ControlWindowWidth = 310
+	EventWindowWidth   = 250
+)
+
+// What the control window asks the player to do, the other changes are already applied to the world
+type ControlActions struct {
+	Quit   bool
+	Export bool
+	// The displayed time changed, the space max may have grown
+	TimeChanged bool
+	// The object sizes changed, the OpenGL buffer needs to be filled again
+	ReFill bool
+}
+
+// The values being edited in the event window
+type EventWindowState struct {
+	GrowthType   m3point.GrowthType
+	GrowthIndex  int
+	GrowthOffset int
+	Point        m3point.Point
+	Color        m3space.EventColor
+	Message      string
+}
+
+func MakeEventWindowState() EventWindowState {
+	return EventWindowState{GrowthType: 8, Color: m3space.RedEvent}
+}
+
+func getEventColorName(k m3space.EventColor) string {
+	switch k {
+	case m3space.RedEvent:
+		return "red"
+	case m3space.GreenEvent:
+		return "green"
+	case m3space.BlueEvent:
+		return "blue"
+	case m3space.YellowEvent:
+		return "yellow"
+	}
+	return fmt.Sprintf("color %d", k)
+}
+
+/***************************************************************/
+// DisplayWorld Control Windows Functions
+/***************************************************************/
+
+// Declare the Control Window with all the settings of the key bindings, the key being shown in the labels
+func (world *DisplayWorld) DrawControlWindow(ui *Ui, x, y float32) ControlActions {
+	res := ControlActions{}
+	ui.BeginWindow("Control", x, y, ControlWindowWidth)
+
+	ui.Label("Time %d of %d, %d active nodes", world.GetDisplayTime(), world.WorldSpace.GetCurrentTime(), world.GetDisplayNbActiveNodes())
+	switch ui.Buttons("Back [Left]", "Forward [Right]") {
+	case 0:
+		res.TimeChanged = world.BackwardTime()
+	case 1:
+		world.ForwardTime()
+		res.TimeChanged = true
+	}
+	switch ui.Stepper("Jump %d ticks [PgDn,PgUp]", JumpTicks) {
+	case -1:
+		world.JumpToTime(world.GetDisplayTime() - JumpTicks)
+		res.TimeChanged = true
+	case 1:
+		world.JumpToTime(world.GetDisplayTime() + JumpTicks)
+		res.TimeChanged = true
+	}
+	// Scrub through all the recorded ticks
+	latest := world.WorldSpace.GetCurrentTime()
+	if latest > 0 {
+		scrub := SizeVar{0.0, float64(latest), float64(world.GetDisplayTime())}
+		if ui.Slider("Scrub Time", &scrub) {
+			world.JumpToTime(m3space.DistAndTime(math.Round(scrub.Val)))
+			res.TimeChanged = true
+		}
+	}
+	switch ui.Buttons("First [Home]", "Latest [End]") {
+	case 0:
+		world.JumpToTime(0)
+		res.TimeChanged = true
+	case 1:
+		world.JumpToLatest()
+		res.TimeChanged = true
+	}
+	ui.Checkbox("Auto Play [Space]", &world.AutoPlay.Enabled)
+	ui.Slider("Play Rate [-,=]", &world.PlayRate)
+	switch ui.Buttons("Export [E]", "Reset View [R]") {
+	case 0:
+		res.Export = true
+	case 1:
+		world.ResetView()
+	}
+	if ui.Button("Quit [Esc]") {
+		res.Quit = true
+	}
+	ui.Checkbox("Rotate [S]", &world.Angle.Enabled)
+
+	if ui.Slider("Line Width [B,T]", &LineWidth) {
+		res.ReFill = true
+	}
+	if ui.Slider("Sphere Radius [P,L]", &SphereRadius) {
+		res.ReFill = true
+	}
+	if ui.Slider("FOV Angle [Z,X]", &world.Camera.FovAngle) {
+		world.Camera.SetMatrices()
+	}
+	if ui.Slider("Eye Dist [Q,W]", &world.Camera.EyeDist) {
+		world.Camera.SetMatrices()
+	}
+
+	ui.Checkbox("Empty Nodes [N]", &world.Filter.DisplayEmptyNodes)
+	ui.Checkbox("Empty Connections [C]", &world.Filter.DisplayEmptyConnections)
+	switch ui.Stepper("Outgrowth Threshold %d [Up,Down]", world.WorldSpace.EventOutgrowthThreshold) {
+	case -1:
+		world.EventOutgrowthThresholdDecrease()
+	case 1:
+		world.EventOutgrowthThresholdIncrease()
+	}
+	switch ui.Stepper("Many Colors Threshold %d [U,I]", world.Filter.EventOutgrowthManyColorsThreshold) {
+	case -1:
+		world.Filter.EventOutgrowthColorsDecrease()
+	case 1:
+		world.Filter.EventOutgrowthColorsIncrease()
+	}
+	for i, c := range m3space.AllColors {
+		shown := world.Filter.EventColorMask&uint8(c) != 0
+		if ui.Checkbox(fmt.Sprintf("Show %s [%d]", getEventColorName(c), i+1), &shown) {
+			world.Filter.ColorMaskSwitch(c)
+		}
+	}
+
+	ui.EndWindow()
+	return res
+}
+
+// Go to the next or previous value of the list, cycling at both ends
+func cycleIndex(current, nbValues, step int) int {
+	return ((current+step)%nbValues + nbValues) % nbValues
+}
+
+// Declare the Event Window, and create the event in the world space when asked. Return true if created.
+func (world *DisplayWorld) DrawEventWindow(ui *Ui, state *EventWindowState, x, y float32) bool {
+	ui.BeginWindow("Event", x, y, EventWindowWidth)
+
+	allTypes := m3point.GetAllContextTypes()
+	typeIdx := 0
+	for i, t := range allTypes {
+		if t == state.GrowthType {
+			typeIdx = i
+		}
+	}
+	if step := ui.Stepper("Growth Type %d", state.GrowthType); step != 0 {
+		state.GrowthType = allTypes[cycleIndex(typeIdx, len(allTypes), step)]
+	}
+	// Keep index and offset valid for the type
+	state.GrowthIndex = cycleIndex(state.GrowthIndex, state.GrowthType.GetNbIndexes(), 0)
+	state.GrowthOffset = cycleIndex(state.GrowthOffset, state.GrowthType.GetMaxOffset(), 0)
+	if step := ui.Stepper("Growth Index %d", state.GrowthIndex); step != 0 {
+		state.GrowthIndex = cycleIndex(state.GrowthIndex, state.GrowthType.GetNbIndexes(), step)
+	}
+	if step := ui.Stepper("Growth Offset %d", state.GrowthOffset); step != 0 {
+		state.GrowthOffset = cycleIndex(state.GrowthOffset, state.GrowthType.GetMaxOffset(), step)
+	}
+	// Events are created on main points
+	for i, name := range [3]string{"X", "Y", "Z"} {
+		if step := ui.Stepper(name+" %d", state.Point[i]); step != 0 {
+			c := state.Point[i] + m3point.CInt(step)*m3point.THREE
+			if c >= -world.WorldSpace.Max && c <= world.WorldSpace.Max {
+				state.Point[i] = c
+			}
+		}
+	}
+	colorIdx := 0
+	for i, c := range m3space.AllColors {
+		if c == state.Color {
+			colorIdx = i
+		}
+	}
+	if step := ui.Stepper("Color %s", getEventColorName(state.Color)); step != 0 {
+		state.Color = m3space.AllColors[cycleIndex(colorIdx, len(m3space.AllColors), step)]
+	}
+
+	created := false
+	if ui.Button("Create") {
+		space := world.WorldSpace
+		if !space.CanCreateEvent() {
+			state.Message = fmt.Sprintf("Maximum number of events %d reached", space.GetNbEvents())
+		} else if other := space.GetEventAt(state.Point); other != nil {
+			state.Message = fmt.Sprintf("Event %d already exists at %v", other.GetId(), state.Point)
+		} else {
+			evt := space.CreateEvent(state.GrowthType, state.GrowthIndex, state.GrowthOffset, state.Point, state.Color)
+			world.CreateDrawingElements()
+			state.Message = fmt.Sprintf("Created event %d at %v", evt.GetId(), state.Point)
+			created = true
+		}
+	}
+	if state.Message != "" {
+		ui.Label("%s", state.Message)
+	}
+
+	ui.EndWindow()
+	return created
+}
@@ -47,6 +47,8 @@ type SpaceDrawingFilter struct {
 	EventOutgrowthManyColorsThreshold uint8
 	// The space the filter apply to
 	Space *m3space.Space
+	// The clipping planes, box and distance range to display
+	Region RegionFilter
 }
 
 func (filter *SpaceDrawingFilter) DisplaySettings() {
@@ -54,6 +56,7 @@ func (filter *SpaceDrawingFilter) DisplaySettings() {
 	fmt.Println("Empty Nodes [N]", filter.DisplayEmptyNodes, ", Empty Connections [C]", filter.DisplayEmptyConnections)
 	fmt.Println("Event Outgrowth Threshold [UP,DOWN]", filter.Space.EventOutgrowthThreshold, ", Event Outgrowth Many Colors Threshold [U,I]", filter.EventOutgrowthManyColorsThreshold)
 	fmt.Println("Event Colors Mask [1,2,3,4]", filter.EventColorMask)
+	filter.Region.DisplaySettings()
 }
 
 func (world *DisplayWorld) EventOutgrowthThresholdIncrease() {
@@ -81,7 +84,7 @@ func (filter *SpaceDrawingFilter) EventOutgrowthColorsDecrease() {
 }
 
 func (filter *SpaceDrawingFilter) ColorMaskSwitch(color m3space.EventColor) {
-	filter.EventColorMask ^= 1 << color
+	filter.EventColorMask ^= uint8(color)
 }
 
 type SpaceDrawingColor struct {
@@ -274,6 +277,18 @@ func MakeConnectionDrawingElement(space *m3space.Space, point m3point.Point, con
 	return &ConnectionDrawingElement{getConnectionObjectType(connId), sdc, &point,}
 }
 
+// The connection drawn by this object type, 0 if not a connection
+func (ot ObjectType) GetConnectionId() m3point.ConnectionId {
+	if !ot.IsConnection() {
+		return 0
+	}
+	delta := m3point.ConnectionId(ot) - m3point.ConnectionId(Connection00)
+	if delta%2 == 0 {
+		return delta / 2
+	}
+	return -(delta - 1) / 2
+}
+
 func getConnectionObjectType(cdId m3point.ConnectionId) ObjectType {
 	if cdId > 0 {
 		return ObjectType(m3point.ConnectionId(Connection00) + cdId*2)
@@ -288,6 +303,9 @@ func (n NodeDrawingElement) Key() ObjectType {
 }
 
 func (n NodeDrawingElement) Display(filter SpaceDrawingFilter) bool {
+	if filter.Region.IsActive() && !filter.Region.Contains(*n.node.GetPoint()) {
+		return false
+	}
 	if n.objectType == NodeActive {
 		if n.node.HasRoot(filter.Space) {
 			return true
@@ -309,12 +327,19 @@ func (n NodeDrawingElement) Pos() *m3point.Point {
 	return n.node.GetPoint()
 }
 
+func (n NodeDrawingElement) GetNode() m3space.Node {
+	return n.node
+}
+
 // ConnectionDrawingElement functions
 func (c ConnectionDrawingElement) Key() ObjectType {
 	return c.objectType
 }
 
 func (c ConnectionDrawingElement) Display(filter SpaceDrawingFilter) bool {
+	if filter.Region.IsActive() && !filter.Region.Contains(*c.pos) {
+		return false
+	}
 	if c.sdc.objColors&filter.EventColorMask != uint8(0) && c.sdc.howManyColors() >= filter.EventOutgrowthManyColorsThreshold {
 		return true
 	}
@@ -121,3 +121,35 @@ func assertSpaceSingleEvent(t *testing.T, world *DisplayWorld, time m3space.Dist
 		assert.Equal(t, uint8(1), nodeDraw.sdc.howManyColors(), "failed at %d", time)
 	}
 }
+
+func TestConnectionObjectType(t *testing.T) {
+	for connId := m3point.ConnectionId(1); connId <= 25; connId++ {
+		for _, cId := range []m3point.ConnectionId{connId, connId.GetNegId()} {
+			ot := getConnectionObjectType(cId)
+			assert.True(t, ot.IsConnection(), "object type %d for %d", ot, cId)
+			assert.Equal(t, cId, ot.GetConnectionId())
+		}
+	}
+	for _, ot := range []ObjectType{AxeX, AxeY, AxeZ, NodeEmpty, NodeActive} {
+		assert.Equal(t, m3point.ConnectionId(0), ot.GetConnectionId())
+	}
+}
+
+func TestColorMaskSwitch(t *testing.T) {
+	filter := SpaceDrawingFilter{EventColorMask: 0xFF}
+	filter.ColorMaskSwitch(m3space.RedEvent)
+	assert.Equal(t, uint8(0xFE), filter.EventColorMask)
+	filter.ColorMaskSwitch(m3space.YellowEvent)
+	assert.Equal(t, uint8(0xF6), filter.EventColorMask)
+	filter.ColorMaskSwitch(m3space.RedEvent)
+	assert.Equal(t, uint8(0xF7), filter.EventColorMask)
+
+	// Each switch only hides the drawing elements of its own color
+	filter.EventColorMask = 0xFF
+	for _, c := range m3space.AllColors {
+		filter.ColorMaskSwitch(c)
+		assert.Equal(t, uint8(0), filter.EventColorMask&uint8(c), "color %d", c)
+		filter.ColorMaskSwitch(c)
+		assert.Equal(t, uint8(c), filter.EventColorMask&uint8(c), "color %d", c)
+	}
+}
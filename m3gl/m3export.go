@@ -0,0 +1,479 @@
+package m3gl
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/freddy33/qsm-go/m3util"
+	"github.com/go-gl/mathgl/mgl32"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	ExportObj  = "obj"
+	ExportPly  = "ply"
+	ExportGltf = "gltf"
+)
+
+var AllExportFormats = [3]string{ExportObj, ExportPly, ExportGltf}
+
+// A displayed drawing element resolved to its position and final color
+type ExportElement struct {
+	Key       ObjectType
+	Pos       m3point.Point
+	ColorMask uint8
+	Color     mgl32.Vec3
+}
+
+// Elements with the same kind of object and the same color end up in one OBJ group or glTF mesh
+type exportGroup struct {
+	name     string
+	kind     int
+	color    mgl32.Vec3
+	elements []ExportElement
+}
+
+// The filtered drawing elements of a space, ready to be written in standard 3D formats
+type ExportScene struct {
+	ppd      *m3point.PointPackData
+	Max      m3point.CInt
+	Elements []ExportElement
+	objects  map[ObjectType]GLObject
+}
+
+// Same colors as the obj_color of the shader program
+func GetColorRGB(color int32) mgl32.Vec3 {
+	switch m3space.EventColor(color) {
+	case 0:
+		return mgl32.Vec3{0.25, 0.25, 0.25}
+	case m3space.RedEvent:
+		return mgl32.Vec3{1.0, 0.0, 0.0}
+	case m3space.GreenEvent:
+		return mgl32.Vec3{0.0, 1.0, 0.0}
+	case m3space.BlueEvent:
+		return mgl32.Vec3{0.0, 0.0, 1.0}
+	case m3space.YellowEvent:
+		return mgl32.Vec3{1.0, 1.0, 0.0}
+	}
+	return mgl32.Vec3{1.0, 1.0, 1.0}
+}
+
+func getColorMaskName(mask uint8) string {
+	if mask == 0 {
+		return "grey"
+	}
+	names := make([]string, 0, 4)
+	for i, name := range []string{"red", "green", "blue", "yellow"} {
+		if mask&uint8(m3space.AllColors[i]) != 0 {
+			names = append(names, name)
+		}
+	}
+	if mask&uint8(HighlightColor) != 0 {
+		names = append(names, "highlight")
+	}
+	return strings.Join(names, "-")
+}
+
+// Instead of blinking, mix the event colors the element takes over one blinker cycle
+func MakeExportElement(e SpaceDrawingElement) ExportElement {
+	res := ExportElement{Key: e.Key(), Pos: *e.Pos()}
+	for blink := 0; blink < 4; blink++ {
+		res.ColorMask |= uint8(e.Color(float64(blink)))
+	}
+	seen := make(map[int32]bool, 4)
+	sum := mgl32.Vec3{}
+	for blink := 0; blink < 4; blink++ {
+		color := e.Color(float64(blink))
+		// Grey is only the blinker filler when some colors are present
+		if seen[color] || (color == 0 && res.ColorMask != 0) {
+			continue
+		}
+		seen[color] = true
+		sum = sum.Add(GetColorRGB(color).Mul(e.Dimmer(float64(blink))))
+	}
+	res.Color = sum.Mul(1.0 / float32(len(seen)))
+	return res
+}
+
+func MakeExportScene(ppd *m3point.PointPackData, elements []SpaceDrawingElement, filter SpaceDrawingFilter, max m3point.CInt) *ExportScene {
+	verifyData()
+	scene := ExportScene{ppd: ppd, Max: max, Elements: make([]ExportElement, 0, len(elements))}
+	scene.objects = make(map[ObjectType]GLObject)
+	for _, e := range elements {
+		if e != nil && e.Display(filter) {
+			scene.Elements = append(scene.Elements, MakeExportElement(e))
+		}
+	}
+	return &scene
+}
+
+func (world *DisplayWorld) MakeExportScene() *ExportScene {
+	return MakeExportScene(world.WorldSpace.GetPointPackData(), world.GetDrawnElements(), world.Filter, world.Max)
+}
+
+/***************************************************************/
+// ExportScene Functions
+/***************************************************************/
+
+// The end of the segment drawn from the element position, for axes and connections
+func (scene *ExportScene) getSegmentVector(ot ObjectType) m3point.Point {
+	if ot.IsAxe() {
+		p := m3point.Point{}
+		p[ot] = scene.Max + AxeExtraLength
+		return p
+	}
+	return scene.ppd.GetConnDetailsById(ot.GetConnectionId()).Vector
+}
+
+func (scene *ExportScene) getObject(ot ObjectType) GLObject {
+	obj, ok := scene.objects[ot]
+	if !ok {
+		if ot.IsNode() {
+			obj = MakeSphere(ot)
+		} else {
+			obj = MakeSegment(m3point.Origin, scene.getSegmentVector(ot), ot)
+		}
+		scene.objects[ot] = obj
+	}
+	return obj
+}
+
+func getKind(ot ObjectType) int {
+	if ot.IsAxe() {
+		return 0
+	}
+	if ot.IsNode() {
+		return 1
+	}
+	return 2
+}
+
+func (scene *ExportScene) getGroups() []*exportGroup {
+	type groupKey struct {
+		kind  int
+		mask  uint8
+		color mgl32.Vec3
+	}
+	groups := make(map[groupKey]*exportGroup)
+	res := make([]*exportGroup, 0)
+	for _, e := range scene.Elements {
+		key := groupKey{getKind(e.Key), e.ColorMask, e.Color}
+		g, ok := groups[key]
+		if !ok {
+			g = &exportGroup{kind: key.kind, color: e.Color}
+			g.name = fmt.Sprintf("%s-%s", []string{"axes", "nodes", "connections"}[key.kind], getColorMaskName(e.ColorMask))
+			groups[key] = g
+			res = append(res, g)
+		}
+		g.elements = append(g.elements, e)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].kind != res[j].kind {
+			return res[i].kind < res[j].kind
+		}
+		return res[i].name < res[j].name
+	})
+	// Same kind and colors but different dimmers
+	names := make(map[string]int, len(res))
+	for _, g := range res {
+		names[g.name]++
+		if names[g.name] > 1 {
+			g.name = fmt.Sprintf("%s-%d", g.name, names[g.name])
+		}
+	}
+	return res
+}
+
+func (scene *ExportScene) getTriangles(e ExportElement) []Triangle {
+	return scene.getObject(e.Key).ExtractTriangles()
+}
+
+func toVec32(v [3]float64) mgl32.Vec3 {
+	return mgl32.Vec3{float32(v[0]), float32(v[1]), float32(v[2])}
+}
+
+func pointToVec32(p m3point.Point) mgl32.Vec3 {
+	return mgl32.Vec3{float32(p.X()), float32(p.Y()), float32(p.Z())}
+}
+
+// Wavefront OBJ with one group per kind and color, the colors are set per vertex after the position
+func (scene *ExportScene) WriteObj(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# QSM space export of %d elements\n", len(scene.Elements))
+	fmt.Fprintln(bw, "o qsm")
+	vIdx := 1
+	for _, g := range scene.getGroups() {
+		fmt.Fprintf(bw, "g %s\n", g.name)
+		for _, e := range g.elements {
+			pos := pointToVec32(e.Pos)
+			for _, tr := range scene.getTriangles(e) {
+				for _, v := range tr.vertices {
+					p := toVec32(v).Add(pos)
+					fmt.Fprintf(bw, "v %g %g %g %.3f %.3f %.3f\n", p[0], p[1], p[2], e.Color[0], e.Color[1], e.Color[2])
+				}
+				n := toVec32(tr.normal)
+				fmt.Fprintf(bw, "vn %g %g %g\n", n[0], n[1], n[2])
+				nIdx := (vIdx + 2) / 3
+				fmt.Fprintf(bw, "f %d//%d %d//%d %d//%d\n", vIdx, nIdx, vIdx+1, nIdx, vIdx+2, nIdx)
+				vIdx += 3
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+func colorToBytes(c mgl32.Vec3) [3]uint8 {
+	res := [3]uint8{}
+	for i := 0; i < 3; i++ {
+		res[i] = uint8(math.Round(float64(mgl32.Clamp(c[i], 0.0, 1.0)) * 255.0))
+	}
+	return res
+}
+
+// ASCII PLY of the nodes as colored vertices and the axes and connections as colored edges
+func (scene *ExportScene) WritePly(w io.Writer) error {
+	type plyVertex struct {
+		p     m3point.Point
+		color [3]uint8
+	}
+	type plyEdge struct {
+		v1, v2 int
+		color  [3]uint8
+	}
+	vertices := make([]plyVertex, 0, len(scene.Elements))
+	indexes := make(map[m3point.Point]int)
+	getIndex := func(p m3point.Point, color [3]uint8) int {
+		idx, ok := indexes[p]
+		if !ok {
+			idx = len(vertices)
+			indexes[p] = idx
+			vertices = append(vertices, plyVertex{p, color})
+		}
+		return idx
+	}
+	// Nodes first so they keep their own color
+	for _, e := range scene.Elements {
+		if e.Key.IsNode() {
+			getIndex(e.Pos, colorToBytes(e.Color))
+		}
+	}
+	edges := make([]plyEdge, 0, len(scene.Elements))
+	for _, e := range scene.Elements {
+		if !e.Key.IsNode() {
+			color := colorToBytes(e.Color)
+			v1 := getIndex(e.Pos, color)
+			v2 := getIndex(e.Pos.Add(scene.getSegmentVector(e.Key)), color)
+			edges = append(edges, plyEdge{v1, v2, color})
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "ply")
+	fmt.Fprintln(bw, "format ascii 1.0")
+	fmt.Fprintln(bw, "comment QSM space export, nodes as vertices and connections as edges")
+	fmt.Fprintf(bw, "element vertex %d\n", len(vertices))
+	fmt.Fprintln(bw, "property float x\nproperty float y\nproperty float z")
+	fmt.Fprintln(bw, "property uchar red\nproperty uchar green\nproperty uchar blue")
+	fmt.Fprintf(bw, "element edge %d\n", len(edges))
+	fmt.Fprintln(bw, "property int vertex1\nproperty int vertex2")
+	fmt.Fprintln(bw, "property uchar red\nproperty uchar green\nproperty uchar blue")
+	fmt.Fprintln(bw, "end_header")
+	for _, v := range vertices {
+		fmt.Fprintf(bw, "%d %d %d %d %d %d\n", v.p.X(), v.p.Y(), v.p.Z(), v.color[0], v.color[1], v.color[2])
+	}
+	for _, e := range edges {
+		fmt.Fprintf(bw, "%d %d %d %d %d\n", e.v1, e.v2, e.color[0], e.color[1], e.color[2])
+	}
+	return bw.Flush()
+}
+
+type gltfAsset struct {
+	Version   string `json:"version"`
+	Generator string `json:"generator"`
+}
+
+type gltfScene struct {
+	Nodes []int `json:"nodes"`
+}
+
+type gltfNode struct {
+	Name string `json:"name"`
+	Mesh int    `json:"mesh"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Material   int            `json:"material"`
+	Mode       int            `json:"mode"`
+}
+
+type gltfMesh struct {
+	Name       string          `json:"name"`
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+type gltfPbr struct {
+	BaseColorFactor [4]float32 `json:"baseColorFactor"`
+	MetallicFactor  float32    `json:"metallicFactor"`
+	RoughnessFactor float32    `json:"roughnessFactor"`
+}
+
+type gltfMaterial struct {
+	Name                 string  `json:"name"`
+	PbrMetallicRoughness gltfPbr `json:"pbrMetallicRoughness"`
+	DoubleSided          bool    `json:"doubleSided"`
+}
+
+type gltfBuffer struct {
+	ByteLength int    `json:"byteLength"`
+	Uri        string `json:"uri"`
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	Target     int `json:"target"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float32 `json:"min,omitempty"`
+	Max           []float32 `json:"max,omitempty"`
+}
+
+type gltfDocument struct {
+	Asset       gltfAsset        `json:"asset"`
+	Scene       int              `json:"scene"`
+	Scenes      []gltfScene      `json:"scenes"`
+	Nodes       []gltfNode       `json:"nodes,omitempty"`
+	Meshes      []gltfMesh       `json:"meshes,omitempty"`
+	Materials   []gltfMaterial   `json:"materials,omitempty"`
+	Buffers     []gltfBuffer     `json:"buffers,omitempty"`
+	BufferViews []gltfBufferView `json:"bufferViews,omitempty"`
+	Accessors   []gltfAccessor   `json:"accessors,omitempty"`
+}
+
+const (
+	gltfFloat         = 5126
+	gltfArrayBuffer   = 34962
+	gltfTrianglesMode = 4
+)
+
+// glTF 2.0 JSON with the binary buffer embedded, one mesh and material per kind and color
+func (scene *ExportScene) WriteGltf(w io.Writer) error {
+	doc := gltfDocument{
+		Asset:  gltfAsset{"2.0", "qsm-go"},
+		Scenes: []gltfScene{{Nodes: make([]int, 0)}},
+	}
+	data := make([]byte, 0)
+	appendVec := func(v mgl32.Vec3) {
+		for i := 0; i < 3; i++ {
+			data = append(data, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint32(data[len(data)-4:], math.Float32bits(v[i]))
+		}
+	}
+	addView := func(start int) int {
+		doc.BufferViews = append(doc.BufferViews, gltfBufferView{0, start, len(data) - start, gltfArrayBuffer})
+		return len(doc.BufferViews) - 1
+	}
+	for i, g := range scene.getGroups() {
+		positions := make([]mgl32.Vec3, 0)
+		normals := make([]mgl32.Vec3, 0)
+		for _, e := range g.elements {
+			pos := pointToVec32(e.Pos)
+			for _, tr := range scene.getTriangles(e) {
+				n := toVec32(tr.normal)
+				for _, v := range tr.vertices {
+					positions = append(positions, toVec32(v).Add(pos))
+					normals = append(normals, n)
+				}
+			}
+		}
+		min := positions[0]
+		max := positions[0]
+		start := len(data)
+		for _, p := range positions {
+			for c := 0; c < 3; c++ {
+				min[c] = float32(math.Min(float64(min[c]), float64(p[c])))
+				max[c] = float32(math.Max(float64(max[c]), float64(p[c])))
+			}
+			appendVec(p)
+		}
+		posAccessor := len(doc.Accessors)
+		doc.Accessors = append(doc.Accessors, gltfAccessor{addView(start), gltfFloat, len(positions), "VEC3", min[:], max[:]})
+		start = len(data)
+		for _, n := range normals {
+			appendVec(n)
+		}
+		doc.Accessors = append(doc.Accessors, gltfAccessor{addView(start), gltfFloat, len(normals), "VEC3", nil, nil})
+
+		doc.Materials = append(doc.Materials, gltfMaterial{g.name, gltfPbr{
+			[4]float32{g.color[0], g.color[1], g.color[2], 1.0}, 0.0, 0.8,
+		}, true})
+		doc.Meshes = append(doc.Meshes, gltfMesh{g.name, []gltfPrimitive{{
+			map[string]int{"POSITION": posAccessor, "NORMAL": posAccessor + 1}, i, gltfTrianglesMode,
+		}}})
+		doc.Nodes = append(doc.Nodes, gltfNode{g.name, i})
+		doc.Scenes[0].Nodes = append(doc.Scenes[0].Nodes, i)
+	}
+	if len(data) > 0 {
+		doc.Buffers = []gltfBuffer{{len(data), "data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(data)}}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", " ")
+	return enc.Encode(doc)
+}
+
+// Write the scene in the format matching the file extension
+func (scene *ExportScene) Write(w io.Writer, format string) error {
+	switch format {
+	case ExportObj:
+		return scene.WriteObj(w)
+	case ExportPly:
+		return scene.WritePly(w)
+	case ExportGltf:
+		return scene.WriteGltf(w)
+	}
+	return fmt.Errorf("export format %q unknown, should be one of %v", format, AllExportFormats)
+}
+
+// Write the scene in all the formats under the export build dir, and return the file paths
+func (scene *ExportScene) WriteAllFormats(baseName string) []string {
+	dir := m3util.GetExportDir()
+	res := make([]string, 0, len(AllExportFormats))
+	for _, format := range AllExportFormats {
+		f := m3util.CreateFile(dir, baseName+"."+format)
+		err := scene.Write(f, format)
+		if err != nil {
+			Log.Errorf("could not export %s due to %v", f.Name(), err)
+		}
+		m3util.CloseFile(f)
+		res = append(res, f.Name())
+	}
+	return res
+}
+
+// Initialize the DB, run the default world for the number of steps and export it in all formats
+func ExportDefaultWorld(env *m3db.QsmEnvironment, nbSteps int) {
+	m3path.InitializeDBEnv(env)
+	world := MakeDefaultWorld(env, 0.0)
+	for i := 0; i < nbSteps; i++ {
+		world.ForwardTime()
+		world.CheckMax()
+	}
+	files := world.MakeExportScene().WriteAllFormats(fmt.Sprintf("space-%d", world.WorldSpace.GetCurrentTime()))
+	Log.Infof("exported %d elements to %v", len(world.Elements), files)
+}
@@ -0,0 +1,183 @@
+package m3gl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type testDrawingElement struct {
+	key     ObjectType
+	pos     m3point.Point
+	colors  [4]int32
+	dimmers [4]float32
+	display bool
+}
+
+func (e testDrawingElement) Key() ObjectType                   { return e.key }
+func (e testDrawingElement) Pos() *m3point.Point               { return &e.pos }
+func (e testDrawingElement) Color(blinkValue float64) int32    { return e.colors[int(blinkValue)] }
+func (e testDrawingElement) Dimmer(blinkValue float64) float32 { return e.dimmers[int(blinkValue)] }
+func (e testDrawingElement) Display(filter SpaceDrawingFilter) bool {
+	return e.display
+}
+
+var fullDimmers = [4]float32{1.0, 1.0, 1.0, 1.0}
+
+func countLinesWithPrefix(s string, prefix string) int {
+	res := 0
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), prefix) {
+			res++
+		}
+	}
+	return res
+}
+
+func TestMakeExportElement(t *testing.T) {
+	red := MakeExportElement(testDrawingElement{NodeActive, m3point.Origin, [4]int32{1, 1, 1, 1}, fullDimmers, true})
+	assert.Equal(t, uint8(1), red.ColorMask)
+	assert.Equal(t, mgl32.Vec3{1, 0, 0}, red.Color)
+
+	redGreen := MakeExportElement(testDrawingElement{NodeActive, m3point.Origin, [4]int32{1, 2, 1, 2}, fullDimmers, true})
+	assert.Equal(t, uint8(3), redGreen.ColorMask)
+	assert.Equal(t, mgl32.Vec3{0.5, 0.5, 0}, redGreen.Color)
+
+	// The grey filler of 3 colors is ignored
+	three := MakeExportElement(testDrawingElement{NodeActive, m3point.Origin, [4]int32{1, 2, 4, 0}, [4]float32{1, 1, 1, defaultGreyDimmer}, true})
+	assert.Equal(t, uint8(7), three.ColorMask)
+	assert.True(t, three.Color.ApproxEqual(mgl32.Vec3{1.0 / 3.0, 1.0 / 3.0, 1.0 / 3.0}))
+
+	grey := MakeExportElement(testDrawingElement{NodeEmpty, m3point.Origin, [4]int32{}, [4]float32{0.7, 0.7, 0.7, 0.7}, true})
+	assert.Equal(t, uint8(0), grey.ColorMask)
+	assert.True(t, grey.Color.ApproxEqual(GetColorRGB(0).Mul(0.7)))
+
+	assert.Equal(t, "grey", getColorMaskName(0))
+	assert.Equal(t, "red-blue-yellow", getColorMaskName(13))
+}
+
+func TestExportSceneFormats(t *testing.T) {
+	max := m3point.CInt(27)
+	elements := []SpaceDrawingElement{
+		&AxeDrawingElement{AxeX, max + AxeExtraLength, false},
+		&AxeDrawingElement{AxeX, max + AxeExtraLength, true},
+		testDrawingElement{NodeActive, m3point.Point{3, 0, 0}, [4]int32{1, 1, 1, 1}, fullDimmers, true},
+		testDrawingElement{NodeEmpty, m3point.Point{0, 3, 0}, [4]int32{}, [4]float32{0.7, 0.7, 0.7, 0.7}, true},
+		testDrawingElement{NodeActive, m3point.Point{0, 0, 3}, [4]int32{2, 2, 2, 2}, fullDimmers, false},
+		nil,
+	}
+	// No connections so no point data needed
+	scene := MakeExportScene(nil, elements, SpaceDrawingFilter{}, max)
+	assert.Equal(t, 4, len(scene.Elements))
+	groups := scene.getGroups()
+	assert.Equal(t, 3, len(groups))
+	assert.Equal(t, "axes-red", groups[0].name)
+	assert.Equal(t, 2, len(groups[0].elements))
+	assert.Equal(t, "nodes-grey", groups[1].name)
+	assert.Equal(t, "nodes-red", groups[2].name)
+
+	nbVertices := 2*trianglesPerLine*pointsPerTriangle + 2*trianglesPerSphere*pointsPerTriangle
+
+	var buf bytes.Buffer
+	assert.Nil(t, scene.Write(&buf, ExportObj))
+	obj := buf.String()
+	assert.Equal(t, nbVertices, countLinesWithPrefix(obj, "v "))
+	assert.Equal(t, nbVertices/3, countLinesWithPrefix(obj, "vn "))
+	assert.Equal(t, nbVertices/3, countLinesWithPrefix(obj, "f "))
+	assert.Equal(t, 3, countLinesWithPrefix(obj, "g "))
+	assert.True(t, strings.Contains(obj, "f 1//1 2//1 3//1\n"))
+
+	buf.Reset()
+	assert.Nil(t, scene.Write(&buf, ExportPly))
+	ply := buf.String()
+	assert.True(t, strings.HasPrefix(ply, "ply\nformat ascii 1.0\n"))
+	// 2 nodes, plus origin and both ends of the X axe
+	assert.True(t, strings.Contains(ply, "element vertex 5\n"))
+	assert.True(t, strings.Contains(ply, "element edge 2\n"))
+	assert.True(t, strings.Contains(ply, "\n3 0 0 255 0 0\n"))
+	assert.True(t, strings.Contains(ply, "\n-30 0 0 255 0 0\n"))
+	body := ply[strings.Index(ply, "end_header\n")+len("end_header\n"):]
+	assert.Equal(t, 7, strings.Count(body, "\n"))
+
+	buf.Reset()
+	assert.Nil(t, scene.Write(&buf, ExportGltf))
+	doc := gltfDocument{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "2.0", doc.Asset.Version)
+	assert.Equal(t, 3, len(doc.Meshes))
+	assert.Equal(t, 3, len(doc.Materials))
+	assert.Equal(t, []int{0, 1, 2}, doc.Scenes[0].Nodes)
+	assert.Equal(t, [4]float32{1, 0, 0, 1}, doc.Materials[2].PbrMetallicRoughness.BaseColorFactor)
+	assert.Equal(t, 6, len(doc.Accessors))
+	totalCount := 0
+	for i, acc := range doc.Accessors {
+		if i%2 == 0 {
+			totalCount += acc.Count
+			assert.Equal(t, 3, len(acc.Min))
+		}
+		view := doc.BufferViews[acc.BufferView]
+		assert.Equal(t, acc.Count*3*4, view.ByteLength)
+	}
+	assert.Equal(t, nbVertices, totalCount)
+	assert.Equal(t, 1, len(doc.Buffers))
+	uri := doc.Buffers[0].Uri
+	assert.True(t, strings.HasPrefix(uri, "data:application/octet-stream;base64,"))
+	data, err := base64.StdEncoding.DecodeString(uri[strings.Index(uri, ",")+1:])
+	assert.Nil(t, err)
+	assert.Equal(t, doc.Buffers[0].ByteLength, len(data))
+	assert.Equal(t, nbVertices*2*3*4, len(data))
+
+	assert.NotNil(t, scene.Write(&buf, "stl"))
+}
+
+func TestExportWorld(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 0, 0, m3point.Origin, m3space.RedEvent)
+	world.WorldSpace.CreateEvent(8, 0, 0, m3point.Point{9, 0, 0}, m3space.GreenEvent)
+	for i := 0; i < 4; i++ {
+		world.ForwardTime()
+	}
+	world.Filter.DisplayEmptyConnections = true
+
+	nbDisplayed := 0
+	nbLines := 0
+	for _, e := range world.Elements {
+		if e.Display(world.Filter) {
+			nbDisplayed++
+			if !e.Key().IsNode() {
+				nbLines++
+			}
+		}
+	}
+	scene := world.MakeExportScene()
+	assert.Equal(t, nbDisplayed, len(scene.Elements))
+
+	var buf bytes.Buffer
+	assert.Nil(t, scene.WritePly(&buf))
+	assert.True(t, strings.Contains(buf.String(), "element edge "+strconv.Itoa(nbLines)+"\n"))
+
+	buf.Reset()
+	assert.Nil(t, scene.WriteGltf(&buf))
+	doc := gltfDocument{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &doc))
+	names := make(map[string]bool)
+	for _, m := range doc.Meshes {
+		assert.False(t, names[m.Name], "mesh name %s not unique", m.Name)
+		names[m.Name] = true
+	}
+	assert.True(t, names["nodes-red"])
+	assert.True(t, names["nodes-green"])
+}
@@ -28,7 +28,7 @@ const (
 	pointsPerTriangle  = 3
 )
 
-// QSM DrawingElementsMap const
+// QSM meshes const
 const (
 	AxeExtraLength = 3
 	nodes          = 2
@@ -42,22 +42,27 @@ type DisplayWorld struct {
 	Filter     SpaceDrawingFilter
 	Elements   []SpaceDrawingElement
 
-	NbVertices         int
-	OpenGLBuffer       []float32
-	DrawingElementsMap map[ObjectType]OpenGLDrawingElement
+	// The elements per active node, to update only the changed nodes at each forward time
+	Incremental IncrementalElements
 
-	TopCornerDist  float64
-	Width, Height  int
-	EyeDist        SizeVar
-	FovAngle       SizeVar
+	// The triangles of each object type, sent to the renderer
+	Meshes    map[ObjectType]*Mesh
+	Instances InstanceBuffer
+
+	Camera         Camera
 	LightDirection mgl32.Vec3
 	LightColor     mgl32.Vec3
-	Projection     mgl32.Mat4
-	Camera         mgl32.Mat4
 	Model          mgl32.Mat4
-	previousArea   int64
 	Angle          TimeAutoVar
 	Blinker        TimeAutoVar
+
+	History  TimeHistory
+	AutoPlay TimeAutoVar
+	// The number of ticks per second of the auto play
+	PlayRate SizeVar
+
+	// The paths to a selected node, nil if none
+	Highlight *PathHighlight
 }
 
 type TimeAutoVar struct {
@@ -68,12 +73,6 @@ type TimeAutoVar struct {
 	Value        float64
 }
 
-type OpenGLDrawingElement struct {
-	k            ObjectType
-	OpenGLOffset int32
-	NbVertices   int32
-}
-
 func MakeWorld(env *m3db.QsmEnvironment, Max int64, glfwTime float64) DisplayWorld {
 	if Max%m3point.THREE != 0 {
 		panic(fmt.Sprintf("cannot have a max %d not dividable by %d", Max, m3point.THREE))
@@ -87,42 +86,50 @@ func MakeWorld(env *m3db.QsmEnvironment, Max int64, glfwTime float64) DisplayWor
 	return world
 }
 
+// The world played by default: a red event at the origin, growing with max 3 connections
+func MakeDefaultWorld(env *m3db.QsmEnvironment, glfwTime float64) DisplayWorld {
+	// ******************************************************************
+	//    HERE CHANGE THE SIZE
+	// ******************************************************************
+	max := int64(9 * m3point.THREE)
+	world := MakeWorld(env, max, glfwTime)
+	//world.WorldSpace.CreateSingleEventCenter()
+	world.WorldSpace.EventOutgrowthThreshold = m3space.DistAndTime(1)
+	world.WorldSpace.EventOutgrowthOldThreshold = m3space.DistAndTime(50)
+	world.WorldSpace.MaxConnections = 3
+	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Origin, m3space.RedEvent)
+	world.CreateDrawingElements()
+	return world
+}
+
 func (world *DisplayWorld) initialized(space *m3space.Space, glfwTime float64) {
 	world.Max = 0
 	world.WorldSpace = space
-	world.Filter = SpaceDrawingFilter{false, false, uint8(0xFF), 0, space,}
+	world.Filter = SpaceDrawingFilter{false, false, uint8(0xFF), 0, space, RegionFilter{},}
 	world.Elements = make([]SpaceDrawingElement, 0, 500)
-	world.NbVertices = 0
-	world.OpenGLBuffer = make([]float32, 0)
-	world.DrawingElementsMap = make(map[ObjectType]OpenGLDrawingElement)
-	world.Width = 800
-	world.Height = 600
-	world.FovAngle = SizeVar{10.0, 75.0, 30.0}
+	world.Meshes = make(map[ObjectType]*Mesh)
+	world.Instances = MakeInstanceBuffer()
+	world.Camera = MakeCamera(800, 600)
 	world.LightDirection = mgl32.Vec3{-1.0, 1.0, 1.0}.Normalize()
 	world.LightColor = mgl32.Vec3{1.0, 1.0, 1.0}
-	world.Projection = mgl32.Ident4()
-	world.Camera = mgl32.Ident4()
 	world.Model = mgl32.Ident4()
-	world.previousArea = 0
 	world.Angle = TimeAutoVar{false, 0.01, 0.3, glfwTime, 0.0,}
 	world.Blinker = TimeAutoVar{true, 0.5, 2.0, glfwTime, 0.0,}
+	world.History = MakeTimeHistory()
+	world.AutoPlay = TimeAutoVar{false, 0.01, 2.0, glfwTime, 0.0,}
+	world.PlayRate = SizeVar{0.5, 20.0, 2.0}
 }
 
 func (world *DisplayWorld) CheckMax() bool {
 	if world.WorldSpace.Max > world.Max {
 		max := world.WorldSpace.Max
-		world.TopCornerDist = math.Sqrt(float64(3.0*max*max)) + 1.1
-		//previousVal := world.EyeDist.Val
-		world.EyeDist = SizeVar{float64(max), world.TopCornerDist * 2.0, world.TopCornerDist * 1.5}
-		/*		if previousVal < world.EyeDist.Max && previousVal > world.EyeDist.Min {
-					world.EyeDist.Val = previousVal
-				}
-		*/world.Max = max
-		world.SetMatrices()
-		if world.NbVertices == 0 {
-			world.CreateDrawingElementsMap()
+		world.Camera.SetMax(max)
+		world.Filter.Region.FitMax(max)
+		world.Max = max
+		if len(world.Meshes) == 0 {
+			world.CreateMeshes()
 		} else {
-			world.RedrawAxesElementsMap()
+			world.RedrawAxesMeshes()
 		}
 		return true
 	}
@@ -159,13 +166,13 @@ func verifyData() {
 
 func (world DisplayWorld) DisplaySettings() {
 	fmt.Println("========= DisplayWorld Settings =========")
-	fmt.Println("Width", world.Width, "Height", world.Height)
+	fmt.Println("Width", world.Camera.Width, "Height", world.Camera.Height)
 	fmt.Println("Line Width [B,T]", LineWidth.Val)
 	fmt.Println("Sphere Radius [P,L]", SphereRadius.Val)
-	fmt.Println("FOV Angle [Z,X]", world.FovAngle.Val)
-	fmt.Println("Eye Dist [Q,W]", world.EyeDist.Val)
+	world.Camera.DisplaySettings()
 	world.WorldSpace.DisplayState()
 	world.Filter.DisplaySettings()
+	world.DisplayTimeSettings()
 }
 
 type DrawingElementsCreator struct {
@@ -201,64 +208,53 @@ func (creator *DrawingElementsCreator) VisitLink(space *m3space.Space, srcPoint
 	creator.offset++
 }
 
+// Display the next tick, from the history if in the past, or moving the space forward
 func (world *DisplayWorld) ForwardTime() {
-	world.WorldSpace.ForwardTime()
-	world.CreateDrawingElements()
+	if world.History.IsInPast() {
+		world.History.Step(1)
+		world.showCurrentTick()
+		return
+	}
+	world.forwardSpace()
 }
 
+// Create the drawing elements of the space current time, record and display them
 func (world *DisplayWorld) CreateDrawingElements() {
-	space := world.WorldSpace
+	elements := MakeDrawingElements(world.WorldSpace, world.Max)
+	if elements != nil {
+		world.recordElements(elements)
+		world.Incremental.Reset(elements)
+	}
+}
+
+// Create the axes and all the active nodes and connections drawing elements of the space.
+// Return nil if the space active nodes and links are not consistent.
+func MakeDrawingElements(space *m3space.Space, max m3point.CInt) []SpaceDrawingElement {
 	dec := DrawingElementsCreator{}
 	dec.nbElements = 6 + space.GetNbActiveNodes() + space.GetNbActiveLinks()
 	dec.elements = make([]SpaceDrawingElement, dec.nbElements)
 	dec.offset = 0
-	dec.createAxes(world.Max)
+	dec.createAxes(max)
 	space.VisitAll(&dec, true)
 	if dec.offset != dec.nbElements {
 		fmt.Println("Created", dec.offset, "elements, but it should be", dec.nbElements)
-		return
+		return nil
 	}
 	Log.Debug("Created", dec.nbElements, "drawing elements.")
-	world.Elements = dec.elements
-}
-
-func (world *DisplayWorld) CreateDrawingElementsMap() int {
-	nbTriangles := (axes+connections)*trianglesPerLine + (nodes * trianglesPerSphere)
-	if world.NbVertices != nbTriangles*3 {
-		world.NbVertices = nbTriangles * 3
-		fmt.Println("Creating OpenGL buffer for", nbTriangles, "triangles,", world.NbVertices, "vertices,", world.NbVertices*FloatPerVertices, "buffer size.")
-		world.OpenGLBuffer = make([]float32, world.NbVertices*FloatPerVertices)
-	}
-	ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
-	triangleFiller := TriangleFiller{ppd, make(map[ObjectType]OpenGLDrawingElement), 0, 0, &(world.OpenGLBuffer)}
-	triangleFiller.drawAxes(world.Max)
-	triangleFiller.drawNodes()
-	triangleFiller.drawConnections()
-	world.DrawingElementsMap = triangleFiller.objMap
-	fmt.Println("Saved", len(world.DrawingElementsMap), "objects in world map.")
-
-	return nbTriangles
-}
-
-func (world *DisplayWorld) RedrawAxesElementsMap() {
-	ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
-	triangleFiller := TriangleFiller{ppd, world.DrawingElementsMap, 0, 0, &(world.OpenGLBuffer)}
-	triangleFiller.drawAxes(world.Max)
-	world.DrawingElementsMap = triangleFiller.objMap
+	return dec.elements
 }
 
-func (world *DisplayWorld) RedrawNodesElementsMap() {
-	ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
-	triangleFiller := TriangleFiller{ppd, world.DrawingElementsMap, 0, 0, &(world.OpenGLBuffer)}
-	triangleFiller.drawNodes()
-	world.DrawingElementsMap = triangleFiller.objMap
+// Create the meshes of all the object types, after the max or the line and sphere sizes changed
+func (world *DisplayWorld) CreateMeshes() {
+	world.Meshes = MakeMeshes(world.WorldSpace.GetPointPackData(), world.Max)
+	Log.Debug("Saved", len(world.Meshes), "meshes in world.")
 }
 
-func (world *DisplayWorld) RedrawConnectionsElementsMap() {
-	ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
-	triangleFiller := TriangleFiller{ppd, world.DrawingElementsMap, 0, 0, &(world.OpenGLBuffer)}
-	triangleFiller.drawConnections()
-	world.DrawingElementsMap = triangleFiller.objMap
+// The axes meshes grow with max, the other ones stay
+func (world *DisplayWorld) RedrawAxesMeshes() {
+	for k, mesh := range makeAxesMeshes(world.Max) {
+		world.Meshes[k] = mesh
+	}
 }
 
 type Triangle struct {
@@ -291,11 +287,6 @@ func (t *TimeAutoVar) Tick(glfwTime float64) {
 }
 
 func (world *DisplayWorld) Tick(glfwTime float64) {
-	area := int64(world.Width) * int64(world.Height)
-	if area != world.previousArea {
-		world.SetMatrices()
-		world.previousArea = area
-	}
 	world.Angle.Tick(glfwTime)
 	world.Blinker.Tick(glfwTime)
 	if int32(world.Blinker.Value) >= 4 {
@@ -303,70 +294,9 @@ func (world *DisplayWorld) Tick(glfwTime float64) {
 	}
 }
 
-func (world *DisplayWorld) SetMatrices() {
-	Eye := mgl32.Vec3{float32(world.EyeDist.Val), float32(world.EyeDist.Val), float32(world.EyeDist.Val),}
-	Far := Eye.Len() + float32(world.TopCornerDist)
-	world.Projection = mgl32.Perspective(mgl32.DegToRad(float32(world.FovAngle.Val)), float32(world.Width)/float32(world.Height), 1.0, Far)
-	world.Camera = mgl32.LookAtV(Eye, mgl32.Vec3{0, 0, 0}, mgl32.Vec3{0, 0, 1})
-}
-
-type TriangleFiller struct {
-	ppd            *m3point.PointPackData
-	objMap         map[ObjectType]OpenGLDrawingElement
-	verticesOffset int32
-	bufferOffset   int
-	buffer         *[]float32
-}
-
-func (t *TriangleFiller) drawAxes(max m3point.CInt) {
-	for axe := int16(0); axe < axes; axe++ {
-		p := m3point.Point{}
-		p[axe] = max + AxeExtraLength
-		t.fill(MakeSegment(m3point.Origin, p, ObjectType(axe)))
-	}
-}
-
-func (t *TriangleFiller) drawNodes() {
-	t.fill(MakeSphere(NodeEmpty))
-	t.fill(MakeSphere(NodeActive))
-}
-
-func (t *TriangleFiller) drawConnections() {
-	maxConnId := t.ppd.GetMaxConnId()
-	for connId := m3point.ConnectionId(1); connId <= maxConnId; connId++ {
-		posConn := t.ppd.GetConnDetailsById(connId)
-		t.fill(MakeSegment(m3point.Origin, posConn.Vector, getConnectionObjectType(connId)))
-		negConnId := connId.GetNegId()
-		negConn := t.ppd.GetConnDetailsById(negConnId)
-		t.fill(MakeSegment(m3point.Origin, negConn.Vector, getConnectionObjectType(negConnId)))
-	}
-}
-
-func (t *TriangleFiller) fill(o GLObject) {
-	key := o.Key()
-	wo, ok := t.objMap[key]
-	if !ok {
-		wo = OpenGLDrawingElement{
-			key,
-			t.verticesOffset,
-			int32(o.NumberOfVertices()),
-		}
-		t.objMap[key] = wo
-	} else {
-		t.bufferOffset = int(wo.OpenGLOffset) * FloatPerVertices
-	}
-	triangles := o.ExtractTriangles()
-	for _, tr := range triangles {
-		for _, point := range tr.vertices {
-			t.verticesOffset++
-			for coord := 0; coord < coordinates; coord++ {
-				(*t.buffer)[t.bufferOffset] = float32(point[coord])
-				t.bufferOffset++
-			}
-			for coord := 0; coord < coordinates; coord++ {
-				(*t.buffer)[t.bufferOffset] = float32(tr.normal[coord])
-				t.bufferOffset++
-			}
-		}
-	}
+// Back to the default camera, and stop the rotation of the world
+func (world *DisplayWorld) ResetView() {
+	world.Camera.Reset()
+	world.Angle.Enabled = false
+	world.Angle.Value = 0.0
 }
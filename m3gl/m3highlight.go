@@ -0,0 +1,137 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"strings"
+)
+
+// Not an event color, white in the shader program and the exports
+const HighlightColor = int32(16)
+
+type highlightConn struct {
+	p      m3point.Point
+	connId m3point.ConnectionId
+}
+
+// The nodes and connections of the from-chains leading to a node, drawn on top of the space elements
+type PathHighlight struct {
+	Chains      []m3path.PathChain
+	Elements    []SpaceDrawingElement
+	points      map[m3point.Point]bool
+	connections map[highlightConn]bool
+}
+
+// A node or connection of a highlighted path. Old path nodes are not active anymore, so they have their own elements.
+type PathDrawingElement struct {
+	objectType ObjectType
+	pos        m3point.Point
+}
+
+/***************************************************************/
+// PathHighlight Functions
+/***************************************************************/
+
+func MakePathHighlight(chains []m3path.PathChain) *PathHighlight {
+	h := PathHighlight{chains, make([]SpaceDrawingElement, 0), make(map[m3point.Point]bool), make(map[highlightConn]bool)}
+	for _, chain := range chains {
+		points := chain.GetPoints()
+		for i, p := range points {
+			if !h.points[p] {
+				h.points[p] = true
+				h.Elements = append(h.Elements, &PathDrawingElement{NodeActive, p})
+			}
+			if i == 0 {
+				continue
+			}
+			connId := chain[i].ConnId
+			key := highlightConn{points[i-1], connId}
+			if !h.connections[key] {
+				h.connections[key] = true
+				h.connections[highlightConn{p, connId.GetNegId()}] = true
+				h.Elements = append(h.Elements, &PathDrawingElement{getConnectionObjectType(connId), points[i-1]})
+			}
+		}
+	}
+	return &h
+}
+
+// True if the space element is replaced by a path element
+func (h *PathHighlight) Contains(e SpaceDrawingElement) bool {
+	ot := e.Key()
+	if ot.IsNode() {
+		return h.points[*e.Pos()]
+	}
+	if ot.IsConnection() {
+		return h.connections[highlightConn{*e.Pos(), ot.GetConnectionId()}]
+	}
+	return false
+}
+
+func (pe PathDrawingElement) Key() ObjectType {
+	return pe.objectType
+}
+
+func (pe PathDrawingElement) Pos() *m3point.Point {
+	return &pe.pos
+}
+
+func (pe PathDrawingElement) Color(blinkValue float64) int32 {
+	return HighlightColor
+}
+
+func (pe PathDrawingElement) Dimmer(blinkValue float64) float32 {
+	return noDimmer
+}
+
+// Always displayed, unless outside of the region of interest
+func (pe PathDrawingElement) Display(filter SpaceDrawingFilter) bool {
+	return !filter.Region.IsActive() || filter.Region.Contains(pe.pos)
+}
+
+/***************************************************************/
+// DisplayWorld Highlight Functions
+/***************************************************************/
+
+// Highlight all the shortest paths from the event roots to the node. Return the text form of the paths.
+func (world *DisplayWorld) HighlightNode(node m3space.Node) (string, error) {
+	space := world.WorldSpace
+	var sb strings.Builder
+	allChains := make([]m3path.PathChain, 0)
+	for _, evt := range space.GetEvents() {
+		pn := node.GetPathNode(evt.GetId())
+		if pn == nil {
+			continue
+		}
+		chains, err := m3path.GetFromChains(pn, m3path.DefaultMaxFromChains)
+		if err != nil {
+			return sb.String(), err
+		}
+		sb.WriteString(fmt.Sprintf("========= Event %d paths to %v =========\n", evt.GetId(), pn.P()))
+		sb.WriteString(m3path.FromChainsString(chains))
+		allChains = append(allChains, chains...)
+	}
+	world.Highlight = MakePathHighlight(allChains)
+	return sb.String(), nil
+}
+
+func (world *DisplayWorld) ClearHighlight() {
+	world.Highlight = nil
+}
+
+// The elements to draw: the space ones with the highlighted path elements replacing the ones at the same place
+func (world *DisplayWorld) GetDrawnElements() []SpaceDrawingElement {
+	h := world.Highlight
+	if h == nil {
+		return world.Elements
+	}
+	res := make([]SpaceDrawingElement, 0, len(world.Elements)+len(h.Elements))
+	for _, e := range world.Elements {
+		if e != nil && !h.Contains(e) {
+			res = append(res, e)
+		}
+	}
+	return append(res, h.Elements...)
+}
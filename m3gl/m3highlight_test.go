@@ -0,0 +1,85 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestPathDrawingElement(t *testing.T) {
+	pe := PathDrawingElement{getConnectionObjectType(-3), m3point.Point{1, 2, 3}}
+	assert.Equal(t, m3point.ConnectionId(-3), pe.Key().GetConnectionId())
+	assert.Equal(t, m3point.Point{1, 2, 3}, *pe.Pos())
+	assert.Equal(t, HighlightColor, pe.Color(2.0))
+	assert.Equal(t, mgl32.Vec3{1.0, 1.0, 1.0}, GetColorRGB(pe.Color(0.0)))
+	filter := SpaceDrawingFilter{false, false, uint8(0), 4, nil, RegionFilter{}}
+	assert.True(t, pe.Display(filter))
+	filter.Region.Planes[0] = ClipPlane{true, 2, false}
+	assert.False(t, pe.Display(filter))
+	assert.Equal(t, "red-highlight", getColorMaskName(uint8(m3space.RedEvent)|uint8(HighlightColor)))
+}
+
+func TestHighlightNode(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Origin, m3space.RedEvent)
+	world.CreateDrawingElements()
+	for i := 0; i < 5; i++ {
+		world.ForwardTime()
+	}
+	assert.Equal(t, len(world.Elements), len(world.GetDrawnElements()))
+
+	var picked *NodeDrawingElement
+	for _, e := range world.Elements {
+		if ne, ok := e.(*NodeDrawingElement); ok && ne.Key() == NodeActive && !ne.GetNode().HasRoot(world.WorldSpace) {
+			picked = ne
+			break
+		}
+	}
+	if !assert.NotNil(t, picked) {
+		return
+	}
+	text, err := world.HighlightNode(picked.GetNode())
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(text, "========= Event 1 paths to"), text)
+	h := world.Highlight
+	assert.NotNil(t, h)
+	assert.True(t, len(h.Chains) > 0)
+	d := h.Chains[0].GetLast().D()
+	assert.Equal(t, picked.GetNode().GetPathNode(m3space.EventID(1)).D(), d)
+	nbNodes := 0
+	for _, e := range h.Elements {
+		if e.Key().IsNode() {
+			nbNodes++
+		}
+	}
+	// The root and at least one node per distance
+	assert.True(t, nbNodes >= d+1)
+	assert.True(t, h.Contains(picked))
+	assert.Equal(t, len(h.Elements), len(h.points)+len(h.connections)/2)
+
+	drawn := world.GetDrawnElements()
+	nbHighlighted := 0
+	for _, e := range drawn {
+		if e.Color(0.0) == HighlightColor {
+			nbHighlighted++
+		}
+	}
+	assert.Equal(t, len(h.Elements), nbHighlighted)
+	found := false
+	for _, g := range world.MakeExportScene().getGroups() {
+		if g.name == "nodes-highlight" {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	world.ClearHighlight()
+	assert.Equal(t, len(world.Elements), len(world.GetDrawnElements()))
+}
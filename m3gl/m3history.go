@@ -0,0 +1,253 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3space"
+)
+
+// How many ticks the page up and down keys jump
+const JumpTicks = 10
+
+// The drawing elements of one tick of the space.
+// Only the ticks where all the elements were created keep them, the others keep the delta from the previous tick.
+type TickDrawing struct {
+	Time          m3space.DistAndTime
+	NbActiveNodes int
+	Elements      []SpaceDrawingElement
+	Delta         *ElementsDelta
+}
+
+// The drawing elements added and removed by an incremental update, a replaced element is in both
+type ElementsDelta struct {
+	Added   []SpaceDrawingElement
+	Removed []SpaceDrawingElement
+}
+
+// All the ticks already computed by the space, to display the past without going back in the space state.
+// The space only moves forward, so a past tick keeps the threshold settings it was computed with.
+type TimeHistory struct {
+	ticks []TickDrawing
+	// The index in ticks of the one displayed
+	current int
+}
+
+/***************************************************************/
+// TimeHistory Functions
+/***************************************************************/
+
+func MakeTimeHistory() TimeHistory {
+	return TimeHistory{make([]TickDrawing, 0, 64), 0}
+}
+
+func (th *TimeHistory) Size() int {
+	return len(th.ticks)
+}
+
+// True if the displayed tick is not the latest computed
+func (th *TimeHistory) IsInPast() bool {
+	return th.current < len(th.ticks)-1
+}
+
+func (th *TimeHistory) GetCurrent() *TickDrawing {
+	if len(th.ticks) == 0 {
+		return nil
+	}
+	return &th.ticks[th.current]
+}
+
+func (th *TimeHistory) GetLatest() *TickDrawing {
+	if len(th.ticks) == 0 {
+		return nil
+	}
+	return &th.ticks[len(th.ticks)-1]
+}
+
+// Save the drawing of the latest tick of the space, replacing it if the time did not change, and display it
+func (th *TimeHistory) Record(td TickDrawing) {
+	latest := th.GetLatest()
+	if latest != nil && latest.Time == td.Time {
+		if td.Elements == nil && td.Delta != nil {
+			// The delta applies on top of the latest tick
+			if latest.Elements != nil {
+				latest.Elements = applyDeltas(latest.Elements, []*ElementsDelta{td.Delta})
+			} else if latest.Delta != nil {
+				latest.Delta = &ElementsDelta{
+					append(latest.Delta.Added, td.Delta.Added...),
+					append(latest.Delta.Removed, td.Delta.Removed...),
+				}
+			}
+			latest.NbActiveNodes = td.NbActiveNodes
+		} else {
+			*latest = td
+		}
+	} else {
+		if latest != nil && td.Time < latest.Time {
+			Log.Errorf("cannot record time %d before latest time %d", td.Time, latest.Time)
+			return
+		}
+		th.ticks = append(th.ticks, td)
+	}
+	th.current = len(th.ticks) - 1
+}
+
+// Rebuild the elements of the displayed tick from the closest tick before it keeping all its elements.
+// Return nil if there is none.
+func (th *TimeHistory) GetCurrentElements() []SpaceDrawingElement {
+	if len(th.ticks) == 0 {
+		return nil
+	}
+	start := th.current
+	for start >= 0 && th.ticks[start].Elements == nil {
+		start--
+	}
+	if start < 0 {
+		return nil
+	}
+	if start == th.current {
+		return th.ticks[start].Elements
+	}
+	deltas := make([]*ElementsDelta, 0, th.current-start)
+	for i := start + 1; i <= th.current; i++ {
+		if th.ticks[i].Delta != nil {
+			deltas = append(deltas, th.ticks[i].Delta)
+		}
+	}
+	return applyDeltas(th.ticks[start].Elements, deltas)
+}
+
+// A new list of the given elements with the deltas applied in order, the given list is not modified
+func applyDeltas(elements []SpaceDrawingElement, deltas []*ElementsDelta) []SpaceDrawingElement {
+	// How many times each element is added minus removed
+	counts := make(map[SpaceDrawingElement]int)
+	nbAdded := 0
+	for _, d := range deltas {
+		for _, e := range d.Added {
+			counts[e]++
+		}
+		for _, e := range d.Removed {
+			counts[e]--
+		}
+		nbAdded += len(d.Added)
+	}
+	res := make([]SpaceDrawingElement, 0, len(elements)+nbAdded)
+	for _, e := range elements {
+		if counts[e] < 0 {
+			counts[e]++
+			continue
+		}
+		res = append(res, e)
+	}
+	for _, d := range deltas {
+		for _, e := range d.Added {
+			if counts[e] > 0 {
+				counts[e]--
+				res = append(res, e)
+			}
+		}
+	}
+	return res
+}
+
+// Display the tick at the given time, or the closest before if not recorded. Return false if nothing changed.
+func (th *TimeHistory) MoveTo(time m3space.DistAndTime) bool {
+	if len(th.ticks) == 0 {
+		return false
+	}
+	idx := 0
+	for i, td := range th.ticks {
+		if td.Time <= time {
+			idx = i
+		}
+	}
+	if idx == th.current {
+		return false
+	}
+	th.current = idx
+	return true
+}
+
+// Display the previous or next recorded tick. Return false if already at the end.
+func (th *TimeHistory) Step(step int) bool {
+	idx := th.current + step
+	if idx < 0 || idx >= len(th.ticks) {
+		return false
+	}
+	th.current = idx
+	return true
+}
+
+/***************************************************************/
+// DisplayWorld Time Functions
+/***************************************************************/
+
+// The time of the tick displayed, which may be before the space current time
+func (world *DisplayWorld) GetDisplayTime() m3space.DistAndTime {
+	td := world.History.GetCurrent()
+	if td == nil {
+		return world.WorldSpace.GetCurrentTime()
+	}
+	return td.Time
+}
+
+func (world *DisplayWorld) GetDisplayNbActiveNodes() int {
+	td := world.History.GetCurrent()
+	if td == nil {
+		return world.WorldSpace.GetNbActiveNodes()
+	}
+	return td.NbActiveNodes
+}
+
+// The latest tick displays the live incremental elements, a past one is rebuilt from the history
+func (world *DisplayWorld) showCurrentTick() {
+	if !world.History.IsInPast() && world.Incremental.IsInitialized() {
+		world.Elements = world.Incremental.GetElements()
+	} else {
+		world.Elements = world.History.GetCurrentElements()
+	}
+}
+
+// Display the previous tick. Return false if already at the first one.
+func (world *DisplayWorld) BackwardTime() bool {
+	if !world.History.Step(-1) {
+		return false
+	}
+	world.showCurrentTick()
+	return true
+}
+
+// Display the tick at the given time. In the past it comes from the history, in the future the space moves forward.
+func (world *DisplayWorld) JumpToTime(time m3space.DistAndTime) {
+	if time < 0 {
+		time = 0
+	}
+	for world.WorldSpace.GetCurrentTime() < time {
+		world.forwardSpace()
+	}
+	if world.History.MoveTo(time) {
+		world.showCurrentTick()
+	}
+}
+
+// Jump to the latest tick computed by the space
+func (world *DisplayWorld) JumpToLatest() {
+	world.JumpToTime(world.WorldSpace.GetCurrentTime())
+}
+
+// The number of ticks the auto play needs to move forward since the last call
+func (world *DisplayWorld) NextAutoPlayTicks(glfwTime float64) int {
+	world.AutoPlay.Ratio = world.PlayRate.Val
+	world.AutoPlay.Tick(glfwTime)
+	if !world.AutoPlay.Enabled {
+		world.AutoPlay.Value = 0.0
+		return 0
+	}
+	res := int(world.AutoPlay.Value)
+	world.AutoPlay.Value -= float64(res)
+	return res
+}
+
+func (world *DisplayWorld) DisplayTimeSettings() {
+	fmt.Println("========= Time Settings =========")
+	fmt.Println("Displayed time [Left,Right,PgUp,PgDn,Home,End]", world.GetDisplayTime(), "of", world.WorldSpace.GetCurrentTime(), ",", world.History.Size(), "ticks recorded")
+	fmt.Println("Auto Play [Space]", world.AutoPlay.Enabled, ", Play Rate [-,=]", world.PlayRate.Val, "ticks per second")
+}
@@ -0,0 +1,124 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTimeHistory(t *testing.T) {
+	th := MakeTimeHistory()
+	assert.Nil(t, th.GetCurrent())
+	assert.False(t, th.IsInPast())
+	assert.False(t, th.Step(-1))
+	assert.False(t, th.MoveTo(3))
+
+	for i := 0; i < 5; i++ {
+		th.Record(TickDrawing{m3space.DistAndTime(i), i * 10, nil, nil})
+	}
+	assert.Equal(t, 5, th.Size())
+	assert.Equal(t, m3space.DistAndTime(4), th.GetCurrent().Time)
+	assert.False(t, th.IsInPast())
+	assert.False(t, th.Step(1))
+
+	assert.True(t, th.Step(-1))
+	assert.True(t, th.IsInPast())
+	assert.Equal(t, 30, th.GetCurrent().NbActiveNodes)
+	assert.True(t, th.MoveTo(1))
+	assert.Equal(t, m3space.DistAndTime(1), th.GetCurrent().Time)
+	assert.False(t, th.MoveTo(1))
+	assert.True(t, th.MoveTo(12))
+	assert.Equal(t, m3space.DistAndTime(4), th.GetCurrent().Time)
+
+	// Recording the same time replaces the latest, and displays it
+	th.MoveTo(0)
+	th.Record(TickDrawing{4, 42, nil, nil})
+	assert.Equal(t, 5, th.Size())
+	assert.Equal(t, 42, th.GetCurrent().NbActiveNodes)
+	// Cannot go back in time
+	th.Record(TickDrawing{2, 0, nil, nil})
+	assert.Equal(t, 5, th.Size())
+	assert.Equal(t, m3space.DistAndTime(4), th.GetLatest().Time)
+}
+
+func TestTimeHistoryDeltas(t *testing.T) {
+	elements := make([]SpaceDrawingElement, 5)
+	for i := range elements {
+		elements[i] = &PathDrawingElement{NodeActive, m3point.Point{m3point.CInt(i), 0, 0}}
+	}
+	replaced := &PathDrawingElement{NodeEmpty, m3point.Point{1, 0, 0}}
+	added := &PathDrawingElement{NodeActive, m3point.Point{5, 0, 0}}
+
+	th := MakeTimeHistory()
+	assert.Nil(t, th.GetCurrentElements())
+	th.Record(TickDrawing{0, 5, elements[:3], nil})
+	assert.Equal(t, elements[:3], th.GetCurrentElements())
+	th.Record(TickDrawing{1, 5, nil, &ElementsDelta{elements[3:5], nil}})
+	th.Record(TickDrawing{2, 5, nil, &ElementsDelta{[]SpaceDrawingElement{replaced, added}, elements[1:2]}})
+	th.Record(TickDrawing{3, 4, nil, &ElementsDelta{nil, []SpaceDrawingElement{elements[3], added}}})
+
+	assert.Equal(t, []SpaceDrawingElement{elements[0], elements[2], elements[4], replaced}, th.GetCurrentElements())
+	assert.True(t, th.Step(-1))
+	assert.Equal(t, []SpaceDrawingElement{elements[0], elements[2], elements[3], elements[4], replaced, added}, th.GetCurrentElements())
+	assert.True(t, th.MoveTo(1))
+	assert.Equal(t, elements, th.GetCurrentElements())
+	// The ticks keeping all their elements are not modified
+	assert.True(t, th.MoveTo(0))
+	assert.Equal(t, elements[:3], th.GetCurrentElements())
+
+	// A delta recorded at the same time applies on top of the latest tick
+	th.Record(TickDrawing{3, 5, nil, &ElementsDelta{[]SpaceDrawingElement{added}, nil}})
+	assert.Equal(t, 4, th.Size())
+	assert.Equal(t, []SpaceDrawingElement{elements[0], elements[2], elements[4], replaced, added}, th.GetCurrentElements())
+}
+
+func TestAutoPlayTicks(t *testing.T) {
+	world := DisplayWorld{}
+	world.AutoPlay = TimeAutoVar{false, 0.01, 2.0, 0.0, 0.0}
+	world.PlayRate = SizeVar{0.5, 20.0, 4.0}
+	assert.Equal(t, 0, world.NextAutoPlayTicks(1.0))
+	world.AutoPlay.Enabled = true
+	assert.Equal(t, 2, world.NextAutoPlayTicks(1.6))
+	assert.InDelta(t, 0.4, world.AutoPlay.Value, 1e-6)
+	assert.Equal(t, 0, world.NextAutoPlayTicks(1.7))
+	assert.Equal(t, 1, world.NextAutoPlayTicks(1.8))
+}
+
+func TestWorldTimeScrubbing(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Origin, m3space.RedEvent)
+	world.CreateDrawingElements()
+	assert.Equal(t, 1, world.History.Size())
+	assert.False(t, world.BackwardTime())
+
+	for i := 0; i < 6; i++ {
+		world.ForwardTime()
+	}
+	assert.Equal(t, m3space.DistAndTime(6), world.WorldSpace.GetCurrentTime())
+	assert.Equal(t, 7, world.History.Size())
+	latestElements := len(world.Elements)
+
+	assert.True(t, world.BackwardTime())
+	assert.Equal(t, m3space.DistAndTime(5), world.GetDisplayTime())
+	world.JumpToTime(2)
+	assert.Equal(t, m3space.DistAndTime(2), world.GetDisplayTime())
+	assert.True(t, len(world.Elements) < latestElements)
+	assert.Equal(t, m3space.DistAndTime(6), world.WorldSpace.GetCurrentTime())
+
+	// Forward in the past does not move the space
+	world.ForwardTime()
+	assert.Equal(t, m3space.DistAndTime(3), world.GetDisplayTime())
+	assert.Equal(t, m3space.DistAndTime(6), world.WorldSpace.GetCurrentTime())
+
+	world.JumpToLatest()
+	assert.Equal(t, latestElements, len(world.Elements))
+	world.JumpToTime(8)
+	assert.Equal(t, m3space.DistAndTime(8), world.GetDisplayTime())
+	assert.Equal(t, m3space.DistAndTime(8), world.WorldSpace.GetCurrentTime())
+	assert.Equal(t, 9, world.History.Size())
+}
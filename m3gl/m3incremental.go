@@ -0,0 +1,196 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+)
+
+// Identify a drawing element of a node or a link, the connection id is 0 for the node
+type elementKey struct {
+	pos    m3point.Point
+	connId m3point.ConnectionId
+}
+
+// The live list of drawing elements, updated from the changes of each forward time.
+// Only the elements of the changed nodes and links are touched, so the order is not the one of MakeDrawingElements.
+type IncrementalElements struct {
+	// The axes first, then the nodes and links
+	elements []SpaceDrawingElement
+	// The indexes in elements per key, the same link can come from two events of a node
+	indexes map[elementKey][]int
+	// The links take the color of their source node, so the connection ids of the links per source point
+	linksPerSrc map[m3point.Point]map[m3point.ConnectionId]int
+	nbNodes     int
+}
+
+/***************************************************************/
+// IncrementalElements Functions
+/***************************************************************/
+
+func MakeIncrementalElements() IncrementalElements {
+	return IncrementalElements{nil, make(map[elementKey][]int), make(map[m3point.Point]map[m3point.ConnectionId]int), 0}
+}
+
+func (ie *IncrementalElements) IsInitialized() bool {
+	return ie.elements != nil
+}
+
+func (ie *IncrementalElements) GetNbNodes() int {
+	return ie.nbNodes
+}
+
+// Start from all the elements created by MakeDrawingElements, the given list is not modified
+func (ie *IncrementalElements) Reset(elements []SpaceDrawingElement) {
+	*ie = MakeIncrementalElements()
+	ie.elements = make([]SpaceDrawingElement, 0, len(elements))
+	for i, e := range elements {
+		if i < 6 {
+			// The axes never change
+			ie.elements = append(ie.elements, e)
+		} else {
+			ie.add(e, nil)
+		}
+	}
+}
+
+// Apply the active node and link changes of one forward time, and return the elements added and removed
+func (ie *IncrementalElements) Update(space *m3space.Space, changes *m3space.ActiveChanges) *ElementsDelta {
+	delta := &ElementsDelta{}
+	for _, nl := range changes.RemovedLinks {
+		ie.remove(elementKey{nl.GetSrc(), nl.GetConnId()}, delta)
+	}
+	changedPoints := make(map[m3point.Point]bool, changes.GetNbChanges())
+	for _, n := range changes.DeactivatedNodes {
+		p := *n.GetPoint()
+		ie.remove(elementKey{p, 0}, delta)
+		changedPoints[p] = true
+	}
+	for _, n := range changes.ChangedNodes {
+		p := *n.GetPoint()
+		ie.replace(elementKey{p, 0}, MakeNodeDrawingElement(space, n), delta)
+		changedPoints[p] = true
+	}
+	for _, n := range changes.ActivatedNodes {
+		ie.add(MakeNodeDrawingElement(space, n), delta)
+		changedPoints[*n.GetPoint()] = true
+	}
+	for _, nl := range changes.AddedLinks {
+		ie.add(MakeConnectionDrawingElement(space, nl.GetSrc(), nl.GetConnId()), delta)
+	}
+	for p := range changedPoints {
+		for connId := range ie.linksPerSrc[p] {
+			ie.replace(elementKey{p, connId}, MakeConnectionDrawingElement(space, p, connId), delta)
+		}
+	}
+	return delta
+}
+
+// The live list of elements, modified by the next update
+func (ie *IncrementalElements) GetElements() []SpaceDrawingElement {
+	return ie.elements
+}
+
+func getElementKey(e SpaceDrawingElement) elementKey {
+	return elementKey{*e.Pos(), e.Key().GetConnectionId()}
+}
+
+// The delta is not recorded if nil
+func (ie *IncrementalElements) add(e SpaceDrawingElement, delta *ElementsDelta) {
+	key := getElementKey(e)
+	if delta != nil {
+		delta.Added = append(delta.Added, e)
+	}
+	ie.indexes[key] = append(ie.indexes[key], len(ie.elements))
+	ie.elements = append(ie.elements, e)
+	if key.connId == 0 {
+		ie.nbNodes++
+	} else {
+		conns, ok := ie.linksPerSrc[key.pos]
+		if !ok {
+			conns = make(map[m3point.ConnectionId]int)
+			ie.linksPerSrc[key.pos] = conns
+		}
+		conns[key.connId]++
+	}
+}
+
+// Remove one element of the key, moving the last element of the list in its place
+func (ie *IncrementalElements) remove(key elementKey, delta *ElementsDelta) {
+	idxs := ie.indexes[key]
+	if len(idxs) == 0 {
+		Log.Errorf("No drawing element to remove for %v", key)
+		return
+	}
+	idx := idxs[len(idxs)-1]
+	delta.Removed = append(delta.Removed, ie.elements[idx])
+	ie.setIndexes(key, idxs[:len(idxs)-1])
+	last := len(ie.elements) - 1
+	if idx != last {
+		moved := ie.elements[last]
+		ie.elements[idx] = moved
+		movedIdxs := ie.indexes[getElementKey(moved)]
+		for i, mi := range movedIdxs {
+			if mi == last {
+				movedIdxs[i] = idx
+			}
+		}
+	}
+	ie.elements[last] = nil
+	ie.elements = ie.elements[:last]
+	if key.connId == 0 {
+		ie.nbNodes--
+	} else {
+		conns := ie.linksPerSrc[key.pos]
+		conns[key.connId]--
+		if conns[key.connId] == 0 {
+			delete(conns, key.connId)
+			if len(conns) == 0 {
+				delete(ie.linksPerSrc, key.pos)
+			}
+		}
+	}
+}
+
+func (ie *IncrementalElements) setIndexes(key elementKey, idxs []int) {
+	if len(idxs) == 0 {
+		delete(ie.indexes, key)
+	} else {
+		ie.indexes[key] = idxs
+	}
+}
+
+// Replace all the elements of the key in place
+func (ie *IncrementalElements) replace(key elementKey, e SpaceDrawingElement, delta *ElementsDelta) {
+	for _, idx := range ie.indexes[key] {
+		delta.Removed = append(delta.Removed, ie.elements[idx])
+		delta.Added = append(delta.Added, e)
+		ie.elements[idx] = e
+	}
+}
+
+/***************************************************************/
+// DisplayWorld Incremental Functions
+/***************************************************************/
+
+func (world *DisplayWorld) forwardSpace() {
+	world.UpdateDrawingElements(world.WorldSpace.ForwardTime().GetActiveChanges())
+}
+
+// Update the drawing elements of the nodes and links that changed only, after the space moved forward.
+// Create all of them if the changes are not complete.
+func (world *DisplayWorld) UpdateDrawingElements(changes *m3space.ActiveChanges) {
+	if changes == nil || !changes.Complete || !world.Incremental.IsInitialized() {
+		world.CreateDrawingElements()
+		return
+	}
+	delta := world.Incremental.Update(world.WorldSpace, changes)
+	// The history only keeps the delta, the live list is displayed while it is the latest tick
+	world.Elements = world.Incremental.GetElements()
+	world.History.Record(TickDrawing{world.WorldSpace.GetCurrentTime(), world.WorldSpace.GetNbActiveNodes(), nil, delta})
+}
+
+// Display and record in the history all the elements of the space current time
+func (world *DisplayWorld) recordElements(elements []SpaceDrawingElement) {
+	world.Elements = elements
+	world.History.Record(TickDrawing{world.WorldSpace.GetCurrentTime(), world.WorldSpace.GetNbActiveNodes(), elements, nil})
+}
@@ -0,0 +1,81 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestIncrementalElements(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	space := world.WorldSpace
+	space.SetEventOutgrowthThreshold(m3space.DistAndTime(2))
+	space.CreateEvent(8, 1, 0, m3point.Origin, m3space.RedEvent)
+	space.CreateEvent(8, 2, 0, m3point.Point{3, 0, 3}, m3space.GreenEvent)
+	world.CreateDrawingElements()
+	assert.Equal(t, space.GetNbActiveNodes(), world.Incremental.GetNbNodes())
+
+	for i := 0; i < 8; i++ {
+		changes := space.ForwardTime().GetActiveChanges()
+		world.UpdateDrawingElements(changes)
+		assert.True(t, changes.Complete, "at %d", space.GetCurrentTime())
+		assert.True(t, changes.GetNbChanges() > 0, "at %d", space.GetCurrentTime())
+		assertSameElements(t, MakeDrawingElements(space, world.Max), world.Elements, space.GetCurrentTime())
+		assert.Equal(t, space.GetNbActiveNodes(), world.Incremental.GetNbNodes())
+	}
+
+	// Previous ticks are not modified by the updates
+	assert.True(t, world.BackwardTime())
+	previous := world.Elements
+	world.JumpToLatest()
+	world.ForwardTime()
+	assert.True(t, world.BackwardTime())
+	assert.Equal(t, previous, world.Elements)
+
+	// Not complete changes create all the elements
+	world.JumpToLatest()
+	changes := space.ForwardTime().GetActiveChanges()
+	changes.Complete = false
+	world.UpdateDrawingElements(changes)
+	assertSameElements(t, MakeDrawingElements(space, world.Max), world.Elements, space.GetCurrentTime())
+	assert.Equal(t, space.GetNbActiveNodes(), world.Incremental.GetNbNodes())
+}
+
+// Same elements in any order, the incremental list only keeps the axes first
+func assertSameElements(t *testing.T, expected, actual []SpaceDrawingElement, time m3space.DistAndTime) {
+	if !assert.Equal(t, len(expected), len(actual), "at %d", time) {
+		return
+	}
+	expected = sortedElements(expected)
+	actual = sortedElements(actual)
+	for i, e := range expected {
+		a := actual[i]
+		assert.Equal(t, e.Key(), a.Key(), "at %d element %d", time, i)
+		assert.Equal(t, *e.Pos(), *a.Pos(), "at %d element %d", time, i)
+		assert.Equal(t, e.Color(0.0), a.Color(0.0), "at %d element %d", time, i)
+		assert.Equal(t, e.Dimmer(0.0), a.Dimmer(0.0), "at %d element %d", time, i)
+	}
+}
+
+func sortedElements(elements []SpaceDrawingElement) []SpaceDrawingElement {
+	res := make([]SpaceDrawingElement, len(elements))
+	copy(res, elements)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Key() != res[j].Key() {
+			return res[i].Key() < res[j].Key()
+		}
+		pi, pj := *res[i].Pos(), *res[j].Pos()
+		for k := 0; k < 3; k++ {
+			if pi[k] != pj[k] {
+				return pi[k] < pj[k]
+			}
+		}
+		return res[i].Color(0.0) < res[j].Color(0.0)
+	})
+	return res
+}
@@ -0,0 +1,84 @@
+package m3gl
+
+import (
+	"sort"
+)
+
+// Per instance attributes: the position, the obj_color and the obj_dimmer
+const FloatPerInstance = 5 // PPPCD
+
+// One instanced draw call: the mesh of an object type drawn at all its instances
+type InstanceDraw struct {
+	Key           ObjectType
+	FirstInstance int32
+	NbInstances   int32
+}
+
+// The instance attributes of all the displayed elements grouped by object type.
+// The meshes stay in the renderer, only this is streamed each frame.
+type InstanceBuffer struct {
+	Data  []float32
+	Draws []InstanceDraw
+	// Reused between fills to not allocate each frame
+	perKey map[ObjectType][]float32
+	keys   []ObjectType
+}
+
+/***************************************************************/
+// InstanceBuffer Functions
+/***************************************************************/
+
+func MakeInstanceBuffer() InstanceBuffer {
+	return InstanceBuffer{make([]float32, 0, 1024), make([]InstanceDraw, 0, 64), make(map[ObjectType][]float32), make([]ObjectType, 0, 64)}
+}
+
+func (ib *InstanceBuffer) GetNbInstances() int {
+	return len(ib.Data) / FloatPerInstance
+}
+
+// Collect the instances of the elements displayed by the filter, with the object types having a mesh only
+func (ib *InstanceBuffer) Fill(elements []SpaceDrawingElement, filter SpaceDrawingFilter, meshes map[ObjectType]*Mesh, blinkValue float64) {
+	if ib.perKey == nil {
+		*ib = MakeInstanceBuffer()
+	}
+	for k, data := range ib.perKey {
+		ib.perKey[k] = data[:0]
+	}
+	for _, e := range elements {
+		if e == nil || !e.Display(filter) {
+			continue
+		}
+		k := e.Key()
+		if _, ok := meshes[k]; !ok {
+			continue
+		}
+		pos := e.Pos()
+		ib.perKey[k] = append(ib.perKey[k], float32(pos.X()), float32(pos.Y()), float32(pos.Z()),
+			float32(e.Color(blinkValue)), e.Dimmer(blinkValue))
+	}
+
+	ib.keys = ib.keys[:0]
+	for k, data := range ib.perKey {
+		if len(data) > 0 {
+			ib.keys = append(ib.keys, k)
+		}
+	}
+	sort.Slice(ib.keys, func(i, j int) bool { return ib.keys[i] < ib.keys[j] })
+
+	ib.Data = ib.Data[:0]
+	ib.Draws = ib.Draws[:0]
+	for _, k := range ib.keys {
+		data := ib.perKey[k]
+		ib.Draws = append(ib.Draws, InstanceDraw{k, int32(len(ib.Data) / FloatPerInstance), int32(len(data) / FloatPerInstance)})
+		ib.Data = append(ib.Data, data...)
+	}
+}
+
+/***************************************************************/
+// DisplayWorld Instances Functions
+/***************************************************************/
+
+// Stream the instances of the drawn elements for this frame in world.Instances
+func (world *DisplayWorld) FillInstances() {
+	world.Instances.Fill(world.GetDrawnElements(), world.Filter, world.Meshes, world.Blinker.Value)
+}
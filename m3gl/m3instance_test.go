@@ -0,0 +1,60 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInstanceBuffer(t *testing.T) {
+	conn1 := getConnectionObjectType(1)
+	connN2 := getConnectionObjectType(-2)
+	meshes := map[ObjectType]*Mesh{
+		AxeX:   {},
+		conn1:  {},
+		connN2: {},
+	}
+	red := SpaceDrawingColor{uint8(m3space.RedEvent), 0}
+	elements := []SpaceDrawingElement{
+		&ConnectionDrawingElement{connN2, red, &m3point.Point{1, 2, 3}},
+		&AxeDrawingElement{AxeX, 9, false},
+		&ConnectionDrawingElement{conn1, red, &m3point.Point{4, 5, 6}},
+		nil,
+		// Not displayed
+		&ConnectionDrawingElement{conn1, SpaceDrawingColor{}, &m3point.Point{7, 8, 9}},
+		&ConnectionDrawingElement{connN2, red, &m3point.Point{-1, -2, -3}},
+		// No mesh
+		&AxeDrawingElement{AxeY, 9, true},
+	}
+	filter := SpaceDrawingFilter{false, false, uint8(0xFF), 0, nil, RegionFilter{}}
+
+	ib := InstanceBuffer{}
+	ib.Fill(elements, filter, meshes, 0.0)
+	assert.Equal(t, 4, ib.GetNbInstances())
+	assert.Equal(t, []InstanceDraw{
+		{AxeX, 0, 1},
+		{conn1, 1, 1},
+		{connN2, 2, 2},
+	}, ib.Draws)
+	assert.Equal(t, []float32{0, 0, 0, float32(m3space.RedEvent), 1.0}, ib.Data[0:5])
+	assert.Equal(t, []float32{4, 5, 6, float32(m3space.RedEvent), noDimmer}, ib.Data[5:10])
+	assert.Equal(t, []float32{1, 2, 3}, ib.Data[10:13])
+	assert.Equal(t, []float32{-1, -2, -3}, ib.Data[15:18])
+
+	// Filling again reuses the buffers
+	filter.DisplayEmptyConnections = true
+	filter.Region.Planes[0] = ClipPlane{true, 0, false}
+	ib.Fill(elements, filter, meshes, 0.0)
+	assert.Equal(t, 4, ib.GetNbInstances())
+	assert.Equal(t, []InstanceDraw{
+		{AxeX, 0, 1},
+		{conn1, 1, 2},
+		{connN2, 3, 1},
+	}, ib.Draws)
+	assert.Equal(t, []float32{7, 8, 9, 0, defaultGreyDimmer}, ib.Data[10:15])
+
+	ib.Fill(nil, filter, meshes, 0.0)
+	assert.Equal(t, 0, ib.GetNbInstances())
+	assert.Equal(t, 0, len(ib.Draws))
+}
@@ -0,0 +1,187 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"math"
+	"strings"
+)
+
+// A ray in space coordinates, the direction is normalized
+type Ray struct {
+	Origin    mgl32.Vec3
+	Direction mgl32.Vec3
+}
+
+// The drawing element found under the cursor, with the distance from the ray origin
+type PickedElement struct {
+	Element  SpaceDrawingElement
+	Distance float32
+}
+
+// Cast the ray going through the pixel x,y (from the top left corner) of a screen of width and height,
+// by inverting the projection, camera and model matrices. The ray is in the coordinates before the model transformation.
+func MakePickingRay(projection, camera, model mgl32.Mat4, width, height int, x, y float64) Ray {
+	ndcX := float32(2.0*x/float64(width) - 1.0)
+	ndcY := float32(1.0 - 2.0*y/float64(height))
+	inv := projection.Mul4(camera).Mul4(model).Inv()
+	near := inv.Mul4x1(mgl32.Vec4{ndcX, ndcY, -1.0, 1.0})
+	far := inv.Mul4x1(mgl32.Vec4{ndcX, ndcY, 1.0, 1.0})
+	nearPoint := near.Vec3().Mul(1.0 / near[3])
+	farPoint := far.Vec3().Mul(1.0 / far[3])
+	return Ray{nearPoint, farPoint.Sub(nearPoint).Normalize()}
+}
+
+/***************************************************************/
+// Ray Functions
+/***************************************************************/
+
+func (r Ray) At(t float32) mgl32.Vec3 {
+	return r.Origin.Add(r.Direction.Mul(t))
+}
+
+// Return the distance along the ray of the first hit of the sphere, false if missed or behind
+func (r Ray) IntersectSphere(center mgl32.Vec3, radius float32) (float32, bool) {
+	oc := r.Origin.Sub(center)
+	b := oc.Dot(r.Direction)
+	c := oc.Dot(oc) - radius*radius
+	delta := b*b - c
+	if delta < 0.0 {
+		return 0.0, false
+	}
+	sq := float32(math.Sqrt(float64(delta)))
+	t := -b - sq
+	if t < 0.0 {
+		// Origin inside the sphere
+		t = -b + sq
+	}
+	if t < 0.0 {
+		return 0.0, false
+	}
+	return t, true
+}
+
+// Return the distance along the ray of the point closest to the segment [a,b], and the distance between both
+func (r Ray) ClosestToSegment(a, b mgl32.Vec3) (float32, float32) {
+	u := b.Sub(a)
+	w := r.Origin.Sub(a)
+	uu := u.Dot(u)
+	ud := u.Dot(r.Direction)
+	uw := u.Dot(w)
+	dw := r.Direction.Dot(w)
+	// The direction being normalized, the denominator is uu*1 - ud*ud
+	denom := uu - ud*ud
+	var s float32
+	if uu == 0.0 {
+		s = 0.0
+	} else if denom < 1e-9*uu {
+		// Parallel, take the segment start
+		s = 0.0
+	} else {
+		s = (uw - ud*dw) / denom
+	}
+	s = mgl32.Clamp(s, 0.0, 1.0)
+	onSegment := a.Add(u.Mul(s))
+	t := onSegment.Sub(r.Origin).Dot(r.Direction)
+	if t < 0.0 {
+		t = 0.0
+	}
+	return t, r.At(t).Sub(onSegment).Len()
+}
+
+// Find the closest displayed node or connection hit by the ray.
+// The connection vectors come from the point pack data, if nil the connections are ignored.
+func PickElement(ray Ray, elements []SpaceDrawingElement, filter SpaceDrawingFilter, ppd *m3point.PointPackData) (PickedElement, bool) {
+	res := PickedElement{}
+	found := false
+	for _, e := range elements {
+		if e == nil || !e.Display(filter) {
+			continue
+		}
+		key := e.Key()
+		pos := pointToVec32(*e.Pos())
+		var t float32
+		hit := false
+		if key.IsNode() {
+			radius := float32(SphereRadius.Val)
+			if key == NodeEmpty {
+				radius /= 2.0
+			}
+			t, hit = ray.IntersectSphere(pos, radius)
+		} else if key.IsConnection() && ppd != nil {
+			cd := ppd.GetConnDetailsById(key.GetConnectionId())
+			var dist float32
+			t, dist = ray.ClosestToSegment(pos, pos.Add(pointToVec32(cd.Vector)))
+			hit = dist <= float32(LineWidth.Val)
+		}
+		if hit && (!found || t < res.Distance) {
+			res = PickedElement{e, t}
+			found = true
+		}
+	}
+	return res, found
+}
+
+/***************************************************************/
+// DisplayWorld Picking Functions
+/***************************************************************/
+
+// The ray under the pixel x,y of the framebuffer, in space coordinates so without the world rotation
+func (world *DisplayWorld) GetPickingRay(x, y float64) Ray {
+	model := mgl32.HomogRotate3D(float32(world.Angle.Value), mgl32.Vec3{0, 0, 1})
+	return world.Camera.GetRay(model, x, y)
+}
+
+func (world *DisplayWorld) Pick(x, y float64) (PickedElement, bool) {
+	ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
+	return PickElement(world.GetPickingRay(x, y), world.Elements, world.Filter, ppd)
+}
+
+// The node state flags are the ones of the space current time, even when displaying a past tick.
+// The distances of the events are from the displayed time.
+func (world *DisplayWorld) GetNodeInfo(node m3space.Node) string {
+	space := world.WorldSpace
+	displayTime := world.GetDisplayTime()
+	var sb strings.Builder
+	sb.WriteString(node.GetStateString(space))
+	if world.History.IsInPast() {
+		sb.WriteString(fmt.Sprintf("\ndisplayed time %d, state at current time %d:", displayTime, space.GetCurrentTime()))
+	}
+	sb.WriteString(fmt.Sprintf("\nactive=%v old=%v dead=%v colors=%d last accessed=%d\n",
+		node.IsActive(space), node.IsOld(space), node.IsDead(space), node.GetColorMask(space), node.GetLastAccessed(space)))
+	for _, evt := range space.GetEvents() {
+		pn := node.GetPathNode(evt.GetId())
+		if pn == nil {
+			continue
+		}
+		accessed := m3space.DistAndTime(pn.D()) + evt.GetCreated()
+		if accessed > displayTime {
+			sb.WriteString(fmt.Sprintf("  event %d color %d created %d: dist %d accessed %d after displayed %d, trio %d, path node %d\n",
+				evt.GetId(), evt.GetColor(), evt.GetCreated(), pn.D(), accessed, displayTime, pn.GetTrioIndex(), pn.GetId()))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  event %d color %d created %d: dist %d accessed %d from displayed %d, trio %d, path node %d\n",
+			evt.GetId(), evt.GetColor(), evt.GetCreated(), pn.D(), accessed, displayTime-accessed, pn.GetTrioIndex(), pn.GetId()))
+	}
+	return sb.String()
+}
+
+// The details of a picked node or connection
+func (world *DisplayWorld) GetElementInfo(e SpaceDrawingElement) string {
+	switch de := e.(type) {
+	case *NodeDrawingElement:
+		return world.GetNodeInfo(de.node)
+	case *ConnectionDrawingElement:
+		ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
+		cd := ppd.GetConnDetailsById(de.objectType.GetConnectionId())
+		res := fmt.Sprintf("connection %s from %v to %v\n", cd.String(), *de.pos, de.pos.Add(cd.Vector))
+		src := world.WorldSpace.GetNode(*de.pos)
+		if src != nil {
+			res += world.GetNodeInfo(src)
+		}
+		return res
+	}
+	return fmt.Sprintf("element %d at %v\n", e.Key(), *e.Pos())
+}
@@ -0,0 +1,154 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+// A world with only the camera settings, no space needed
+func makePickingTestWorld() DisplayWorld {
+	world := DisplayWorld{}
+	world.Camera = MakeCamera(800, 600)
+	world.Camera.SetMax(27)
+	world.Filter = SpaceDrawingFilter{false, false, uint8(0xFF), 0, nil, RegionFilter{}}
+	return world
+}
+
+// The framebuffer pixel where the point is drawn
+func projectTestPoint(world DisplayWorld, p m3point.Point) (float64, float64) {
+	model := mgl32.HomogRotate3D(float32(world.Angle.Value), mgl32.Vec3{0, 0, 1})
+	c := world.Camera
+	win := mgl32.Project(pointToVec32(p), c.View.Mul4(model), c.Projection, 0, 0, c.Width, c.Height)
+	return float64(win[0]), float64(c.Height) - float64(win[1])
+}
+
+func TestRayIntersections(t *testing.T) {
+	ray := Ray{mgl32.Vec3{0, 0, 10}, mgl32.Vec3{0, 0, -1}}
+	d, hit := ray.IntersectSphere(mgl32.Vec3{0, 0, 0}, 1.0)
+	assert.True(t, hit)
+	assert.InDelta(t, 9.0, d, 1e-5)
+	_, hit = ray.IntersectSphere(mgl32.Vec3{2, 0, 0}, 1.0)
+	assert.False(t, hit)
+	_, hit = ray.IntersectSphere(mgl32.Vec3{0, 0, 20}, 1.0)
+	assert.False(t, hit)
+	d, hit = ray.IntersectSphere(mgl32.Vec3{0, 0, 10}, 1.0)
+	assert.True(t, hit)
+	assert.InDelta(t, 1.0, d, 1e-5)
+
+	d, dist := ray.ClosestToSegment(mgl32.Vec3{-1, 0.5, 3}, mgl32.Vec3{1, 0.5, 3})
+	assert.InDelta(t, 7.0, d, 1e-5)
+	assert.InDelta(t, 0.5, dist, 1e-5)
+	// Closest to the segment end
+	d, dist = ray.ClosestToSegment(mgl32.Vec3{1, 0, 2}, mgl32.Vec3{3, 0, 2})
+	assert.InDelta(t, 8.0, d, 1e-5)
+	assert.InDelta(t, 1.0, dist, 1e-5)
+	// Parallel
+	_, dist = ray.ClosestToSegment(mgl32.Vec3{0, 2, 0}, mgl32.Vec3{0, 2, 5})
+	assert.InDelta(t, 2.0, dist, 1e-5)
+}
+
+func TestPickingRay(t *testing.T) {
+	world := makePickingTestWorld()
+	ray := world.GetPickingRay(400, 300)
+	assert.True(t, ray.Direction.ApproxEqualThreshold(mgl32.Vec3{-1, -1, -1}.Normalize(), 1e-5), "wrong direction %v", ray.Direction)
+	_, dist := ray.ClosestToSegment(mgl32.Vec3{}, mgl32.Vec3{})
+	assert.InDelta(t, 0.0, dist, 1e-3)
+
+	for _, angle := range []float64{0.0, 0.7, math.Pi / 2.0} {
+		world.Angle.Value = angle
+		for _, p := range []m3point.Point{{3, 0, 0}, {0, -6, 3}, {9, 9, -9}} {
+			x, y := projectTestPoint(world, p)
+			_, dist = world.GetPickingRay(x, y).ClosestToSegment(pointToVec32(p), pointToVec32(p))
+			assert.InDelta(t, 0.0, dist, 1e-2, "angle %f point %v", angle, p)
+		}
+	}
+}
+
+func TestPickElement(t *testing.T) {
+	world := makePickingTestWorld()
+	origin := testDrawingElement{NodeActive, m3point.Origin, [4]int32{}, fullDimmers, true}
+	front := testDrawingElement{NodeActive, m3point.Point{3, 3, 3}, [4]int32{}, fullDimmers, true}
+	hidden := testDrawingElement{NodeActive, m3point.Point{6, 6, 6}, [4]int32{}, fullDimmers, false}
+	side := testDrawingElement{NodeEmpty, m3point.Point{0, 3, 0}, [4]int32{}, fullDimmers, true}
+	world.Elements = []SpaceDrawingElement{origin, nil, front, hidden, side}
+
+	ray := world.GetPickingRay(400, 300)
+	picked, ok := PickElement(ray, world.Elements, world.Filter, nil)
+	assert.True(t, ok)
+	assert.Equal(t, front, picked.Element)
+	assert.InDelta(t, ray.Origin.Sub(mgl32.Vec3{3, 3, 3}).Len()-float32(SphereRadius.Val), picked.Distance, 1e-3)
+
+	picked, ok = PickElement(ray, []SpaceDrawingElement{origin, hidden}, world.Filter, nil)
+	assert.True(t, ok)
+	assert.Equal(t, origin, picked.Element)
+
+	world.Angle.Value = 1.0
+	x, y := projectTestPoint(world, side.pos)
+	picked, ok = PickElement(world.GetPickingRay(x, y), world.Elements, world.Filter, nil)
+	assert.True(t, ok)
+	assert.Equal(t, side, picked.Element)
+
+	_, ok = PickElement(world.GetPickingRay(1, 1), world.Elements, world.Filter, nil)
+	assert.False(t, ok)
+}
+
+func TestPickWorld(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 0, 0, m3point.Origin, m3space.RedEvent)
+	for i := 0; i < 3; i++ {
+		world.ForwardTime()
+	}
+
+	nbNodes := 0
+	nbConnections := 0
+	for _, e := range world.Elements {
+		if e == nil || !e.Display(world.Filter) || e.Key().IsAxe() {
+			continue
+		}
+		p := *e.Pos()
+		if e.Key().IsConnection() {
+			ppd := m3point.GetPointPackData(world.WorldSpace.GetEnv())
+			// Aim at the end of the connection
+			v := ppd.GetConnDetailsById(e.Key().GetConnectionId()).Vector
+			x, y := projectTestPoint(world, p.Add(v))
+			ray := world.GetPickingRay(x, y)
+			_, dist := ray.ClosestToSegment(pointToVec32(p), pointToVec32(p.Add(v)))
+			assert.InDelta(t, 0.0, dist, 1e-2)
+			nbConnections++
+			continue
+		}
+		x, y := projectTestPoint(world, p)
+		picked, ok := world.Pick(x, y)
+		assert.True(t, ok, "nothing picked for %v", p)
+		if ok {
+			info := world.GetElementInfo(picked.Element)
+			assert.True(t, strings.Contains(info, "node"), "wrong info %s", info)
+			if picked.Element == e {
+				nbNodes++
+			}
+		}
+	}
+	// Some nodes may hide others, but not all
+	assert.True(t, nbNodes > 0)
+	assert.True(t, nbConnections > 0)
+
+	x, y := projectTestPoint(world, m3point.Origin)
+	picked, ok := world.Pick(x, y)
+	assert.True(t, ok)
+	assert.True(t, strings.Contains(world.GetElementInfo(picked.Element), "event"))
+
+	// In the past the distances are from the displayed time, and the flags labelled as current
+	assert.True(t, world.BackwardTime())
+	info := world.GetNodeInfo(world.WorldSpace.GetNode(m3point.Origin))
+	assert.True(t, strings.Contains(info, "displayed time 2, state at current time 3"), "wrong info %s", info)
+	assert.True(t, strings.Contains(info, "from displayed 2"), "wrong info %s", info)
+}
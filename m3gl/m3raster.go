@@ -0,0 +1,182 @@
+package m3gl
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+)
+
+// Pure Go Renderer replacing the OpenGL pipeline of playgl, to render a world without any window.
+// Same shading as the shader programs, flat per triangle with a depth buffer.
+type SoftRenderer struct {
+	Width, Height int
+	Background    color.RGBA
+	Image         *image.RGBA
+	depth         []float32
+	meshes        map[ObjectType]*Mesh
+}
+
+func MakeSoftRenderer(width, height int) *SoftRenderer {
+	sr := new(SoftRenderer)
+	sr.Width = width
+	sr.Height = height
+	sr.Background = color.RGBA{0, 0, 0, 255}
+	sr.Image = image.NewRGBA(image.Rect(0, 0, width, height))
+	sr.depth = make([]float32, width*height)
+	sr.meshes = make(map[ObjectType]*Mesh)
+	sr.Clear()
+	return sr
+}
+
+/***************************************************************/
+// SoftRenderer Functions
+/***************************************************************/
+
+func (sr *SoftRenderer) Clear() {
+	for i := range sr.depth {
+		sr.depth[i] = math.MaxFloat32
+	}
+	for y := 0; y < sr.Height; y++ {
+		for x := 0; x < sr.Width; x++ {
+			sr.Image.SetRGBA(x, y, sr.Background)
+		}
+	}
+}
+
+func toColorByte(v float32) uint8 {
+	return uint8(math.Round(float64(mgl32.Clamp(v, 0.0, 1.0)) * 255.0))
+}
+
+// Same lighting as the fragment shader
+func shade(normal, lightDirection, lightColor, objColor mgl32.Vec3) color.RGBA {
+	ambient := lightColor.Mul(0.15)
+	diff := float32(math.Max(float64(normal.Dot(lightDirection)), 0.0))
+	res := ambient.Add(lightColor.Mul(diff))
+	return color.RGBA{toColorByte(res[0] * objColor[0]), toColorByte(res[1] * objColor[1]), toColorByte(res[2] * objColor[2]), 255}
+}
+
+func edgeFunction(a, b mgl32.Vec3, x, y float32) float32 {
+	return (b[0]-a[0])*(y-a[1]) - (b[1]-a[1])*(x-a[0])
+}
+
+// Rasterize one triangle already in screen space, the z being the normalized depth
+func (sr *SoftRenderer) fillTriangle(s [3]mgl32.Vec3, c color.RGBA) {
+	area := edgeFunction(s[0], s[1], s[2][0], s[2][1])
+	if area == 0.0 {
+		return
+	}
+	minX := int(math.Floor(math.Min(float64(s[0][0]), math.Min(float64(s[1][0]), float64(s[2][0])))))
+	maxX := int(math.Ceil(math.Max(float64(s[0][0]), math.Max(float64(s[1][0]), float64(s[2][0])))))
+	minY := int(math.Floor(math.Min(float64(s[0][1]), math.Min(float64(s[1][1]), float64(s[2][1])))))
+	maxY := int(math.Ceil(math.Max(float64(s[0][1]), math.Max(float64(s[1][1]), float64(s[2][1])))))
+	if minX < 0 {
+		minX = 0
+	}
+	if minY < 0 {
+		minY = 0
+	}
+	if maxX > sr.Width-1 {
+		maxX = sr.Width - 1
+	}
+	if maxY > sr.Height-1 {
+		maxY = sr.Height - 1
+	}
+	for y := minY; y <= maxY; y++ {
+		py := float32(y) + 0.5
+		for x := minX; x <= maxX; x++ {
+			px := float32(x) + 0.5
+			// Barycentric weights have the sign of the area when inside, whatever the winding
+			w0 := edgeFunction(s[1], s[2], px, py) / area
+			w1 := edgeFunction(s[2], s[0], px, py) / area
+			w2 := edgeFunction(s[0], s[1], px, py) / area
+			if w0 < 0 || w1 < 0 || w2 < 0 {
+				continue
+			}
+			z := w0*s[0][2] + w1*s[1][2] + w2*s[2][2]
+			idx := y*sr.Width + x
+			if z < sr.depth[idx] {
+				sr.depth[idx] = z
+				sr.Image.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
+
+// Draw the triangles of the mesh like gl.DrawArrays, with the uniforms of the shader program
+func (sr *SoftRenderer) DrawMesh(mesh *Mesh, projection, camera, model mgl32.Mat4,
+	objColor, lightDirection, lightColor mgl32.Vec3) {
+	mvp := projection.Mul4(camera).Mul4(model)
+	for v := 0; v+2 < mesh.NumberOfVertices(); v += pointsPerTriangle {
+		var screen [3]mgl32.Vec3
+		visible := true
+		for i := 0; i < pointsPerTriangle; i++ {
+			vert := mesh.Vertices[v+i]
+			clip := mvp.Mul4x1(vert.Vec4(1.0))
+			// No clipping, triangles crossing the near or far planes are dropped
+			if clip[3] <= 0.0 || clip[2] < -clip[3] || clip[2] > clip[3] {
+				visible = false
+				break
+			}
+			ndc := clip.Vec3().Mul(1.0 / clip[3])
+			screen[i] = mgl32.Vec3{
+				(ndc[0] + 1.0) * 0.5 * float32(sr.Width),
+				(1.0 - ndc[1]) * 0.5 * float32(sr.Height),
+				ndc[2],
+			}
+		}
+		if !visible {
+			continue
+		}
+		normal := model.Mul4x1(mesh.Normals[v].Vec4(0.0)).Vec3()
+		sr.fillTriangle(screen, shade(normal, lightDirection, lightColor, objColor))
+	}
+}
+
+func (sr *SoftRenderer) SetMeshes(meshes map[ObjectType]*Mesh) {
+	sr.meshes = make(map[ObjectType]*Mesh, len(meshes))
+	sr.UpdateMeshes(meshes)
+}
+
+func (sr *SoftRenderer) UpdateMeshes(meshes map[ObjectType]*Mesh) {
+	for k, mesh := range meshes {
+		sr.meshes[k] = mesh
+	}
+}
+
+func (sr *SoftRenderer) DrawFrame(frame *SceneFrame) {
+	sr.Clear()
+	frame.VisitInstances(func(d InstanceDraw, pos mgl32.Vec3, color int32, dimmer float32) {
+		mesh, ok := sr.meshes[d.Key]
+		if !ok {
+			return
+		}
+		model := frame.Model.Mul4(mgl32.Translate3D(pos[0], pos[1], pos[2]))
+		sr.DrawMesh(mesh, frame.Projection, frame.View, model,
+			GetColorRGB(color).Mul(dimmer), frame.LightDirection, frame.LightColor)
+	})
+}
+
+func (sr *SoftRenderer) WritePng(w io.Writer) error {
+	return png.Encode(w, sr.Image)
+}
+
+/***************************************************************/
+// DisplayWorld Rendering Functions
+/***************************************************************/
+
+// Render the displayed elements like the playgl loop does, using the world camera, angle and blinker.
+// The camera size is set to the renderer size.
+func (world *DisplayWorld) Render(sr *SoftRenderer) {
+	world.Camera.SetSize(sr.Width, sr.Height)
+	world.SetRendererMeshes(sr)
+	world.DrawFrame(sr)
+}
+
+func (world *DisplayWorld) WritePng(w io.Writer, width, height int) error {
+	sr := MakeSoftRenderer(width, height)
+	world.Render(sr)
+	return sr.WritePng(w)
+}
@@ -0,0 +1,113 @@
+package m3gl
+
+import (
+	"bytes"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+// Flat triangle in normalized device coordinates facing the Z axis
+func makeTestTriangle(size, z float32) *Mesh {
+	mesh := Mesh{}
+	for _, p := range [3][2]float32{{-size, -size}, {size, -size}, {0, size}} {
+		mesh.Vertices = append(mesh.Vertices, mgl32.Vec3{p[0], p[1], z})
+		mesh.Normals = append(mesh.Normals, mgl32.Vec3{0, 0, 1})
+	}
+	return &mesh
+}
+
+func TestSoftRendererDepth(t *testing.T) {
+	near := makeTestTriangle(0.5, 0.0)
+	big := makeTestTriangle(0.9, 0.5)
+	ident := mgl32.Ident4()
+	light := mgl32.Vec3{0, 0, 1}
+	white := mgl32.Vec3{1, 1, 1}
+	red := color.RGBA{255, 0, 0, 255}
+	green := color.RGBA{0, 255, 0, 255}
+	black := color.RGBA{0, 0, 0, 255}
+
+	sr := MakeSoftRenderer(100, 100)
+	for _, nearFirst := range []bool{true, false} {
+		sr.Clear()
+		assert.Equal(t, black, sr.Image.RGBAAt(50, 50))
+		drawNear := func() {
+			sr.DrawMesh(near, ident, ident, ident, mgl32.Vec3{1, 0, 0}, light, white)
+		}
+		drawFar := func() {
+			sr.DrawMesh(big, ident, ident, ident, mgl32.Vec3{0, 1, 0}, light, white)
+		}
+		if nearFirst {
+			drawNear()
+			drawFar()
+		} else {
+			drawFar()
+			drawNear()
+		}
+		assert.Equal(t, red, sr.Image.RGBAAt(50, 50), "near first %v", nearFirst)
+		// Only the big far triangle close to the bottom corners
+		assert.Equal(t, green, sr.Image.RGBAAt(10, 90), "near first %v", nearFirst)
+		assert.Equal(t, black, sr.Image.RGBAAt(2, 2), "near first %v", nearFirst)
+	}
+
+	// Lit from behind only the ambient light is left
+	sr.Clear()
+	sr.DrawMesh(near, ident, ident, ident, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{0, 0, -1}, white)
+	assert.Equal(t, color.RGBA{38, 38, 38, 255}, sr.Image.RGBAAt(50, 50))
+
+	// Out of the depth range is not drawn
+	sr.Clear()
+	far := makeTestTriangle(0.5, 2.0)
+	sr.DrawMesh(far, ident, ident, ident, mgl32.Vec3{1, 1, 1}, light, white)
+	assert.Equal(t, black, sr.Image.RGBAAt(50, 50))
+
+	var buf bytes.Buffer
+	assert.Nil(t, sr.WritePng(&buf))
+	img, err := png.Decode(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 100, 100), img.Bounds())
+}
+
+func TestRenderWorld(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 0, 0, m3point.Origin, m3space.RedEvent)
+	for i := 0; i < 3; i++ {
+		world.ForwardTime()
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, world.WritePng(&buf, 320, 240))
+	assert.Equal(t, 320, world.Camera.Width)
+	assert.Equal(t, 240, world.Camera.Height)
+	img, err := png.Decode(&buf)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, image.Rect(0, 0, 320, 240), img.Bounds())
+	nbRed := 0
+	nbLit := 0
+	for y := 0; y < 240; y++ {
+		for x := 0; x < 320; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			if r > 0 || g > 0 || b > 0 {
+				nbLit++
+			}
+			if r > 0 && g == 0 && b == 0 {
+				nbRed++
+			}
+		}
+	}
+	// The axes and the red event nodes are visible, but most of the image is the background
+	assert.True(t, nbRed > 0)
+	assert.True(t, nbLit > nbRed)
+	assert.True(t, nbLit < 320*240/2)
+}
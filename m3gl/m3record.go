@@ -0,0 +1,217 @@
+package m3gl
+
+import (
+	"flag"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3util"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	RecordGif = "gif"
+	RecordPng = "png"
+)
+
+type RecordOptions struct {
+	NbTicks       int
+	Width, Height int
+	FramesPerTick int
+	// Time between frames in 1/100 of seconds, as for GIF delays
+	FrameDelay int
+	Format     string
+	// The output file for GIF, or the prefix of the numbered PNG files
+	Output string
+	// Rotate the camera around the Z axis at this speed in radians per second
+	Orbit      bool
+	OrbitSpeed float64
+	// The SpaceDrawingFilter settings
+	DisplayEmptyNodes                 bool
+	DisplayEmptyConnections           bool
+	EventColorMask                    uint
+	EventOutgrowthManyColorsThreshold uint
+}
+
+// Receive each rendered frame of a recording
+type FrameWriter interface {
+	WriteFrame(img *image.RGBA) error
+	Close() error
+}
+
+type gifFrameWriter struct {
+	w     io.Writer
+	delay int
+	anim  gif.GIF
+}
+
+type pngFrameWriter struct {
+	prefix string
+	files  []string
+}
+
+func MakeGifFrameWriter(w io.Writer, delay int) FrameWriter {
+	return &gifFrameWriter{w: w, delay: delay}
+}
+
+func MakePngFrameWriter(prefix string) FrameWriter {
+	return &pngFrameWriter{prefix: prefix}
+}
+
+// Parse the command line arguments of the record command
+func ParseRecordOptions(args []string) (RecordOptions, error) {
+	opts := RecordOptions{}
+	fs := flag.NewFlagSet("record", flag.ContinueOnError)
+	fs.IntVar(&opts.NbTicks, "ticks", 20, "number of forward time ticks to record")
+	fs.IntVar(&opts.Width, "width", 400, "frame width in pixels")
+	fs.IntVar(&opts.Height, "height", 300, "frame height in pixels")
+	fs.IntVar(&opts.FramesPerTick, "fpt", 2, "number of frames per tick")
+	fs.IntVar(&opts.FrameDelay, "delay", 20, "delay between frames in 1/100 of seconds")
+	fs.StringVar(&opts.Format, "format", RecordGif, "gif for an animated GIF or png for a numbered PNG sequence")
+	fs.StringVar(&opts.Output, "out", "", "output GIF file or PNG files prefix, default in the build export dir")
+	fs.BoolVar(&opts.Orbit, "orbit", false, "rotate the camera around the Z axis")
+	fs.Float64Var(&opts.OrbitSpeed, "orbitSpeed", 0.3, "camera rotation speed in radians per second")
+	fs.BoolVar(&opts.DisplayEmptyNodes, "emptyNodes", false, "display the empty nodes")
+	fs.BoolVar(&opts.DisplayEmptyConnections, "emptyConnections", false, "display the empty connections")
+	fs.UintVar(&opts.EventColorMask, "colorMask", 0xFF, "mask of the event colors to display, 1 red, 2 green, 4 blue, 8 yellow")
+	fs.UintVar(&opts.EventOutgrowthManyColorsThreshold, "manyColors", 0, "display only nodes with at least this many event colors")
+	err := fs.Parse(args)
+	if err != nil {
+		return opts, err
+	}
+	if opts.NbTicks < 0 || opts.Width <= 0 || opts.Height <= 0 || opts.FramesPerTick <= 0 || opts.FrameDelay <= 0 {
+		return opts, fmt.Errorf("ticks %d should be positive, and width %d, height %d, frames per tick %d and delay %d strictly positive",
+			opts.NbTicks, opts.Width, opts.Height, opts.FramesPerTick, opts.FrameDelay)
+	}
+	if opts.Format != RecordGif && opts.Format != RecordPng {
+		return opts, fmt.Errorf("record format %q unknown, should be %s or %s", opts.Format, RecordGif, RecordPng)
+	}
+	if opts.EventColorMask > 0xFF || opts.EventOutgrowthManyColorsThreshold > 4 {
+		return opts, fmt.Errorf("color mask %d should be in [0,255] and many colors %d in [0,4]",
+			opts.EventColorMask, opts.EventOutgrowthManyColorsThreshold)
+	}
+	return opts, nil
+}
+
+/***************************************************************/
+// FrameWriter Functions
+/***************************************************************/
+
+func (gw *gifFrameWriter) WriteFrame(img *image.RGBA) error {
+	paletted := image.NewPaletted(img.Bounds(), palette.Plan9)
+	draw.Draw(paletted, img.Bounds(), img, image.Point{}, draw.Src)
+	gw.anim.Image = append(gw.anim.Image, paletted)
+	gw.anim.Delay = append(gw.anim.Delay, gw.delay)
+	return nil
+}
+
+func (gw *gifFrameWriter) Close() error {
+	if len(gw.anim.Image) == 0 {
+		return fmt.Errorf("no frames recorded")
+	}
+	return gif.EncodeAll(gw.w, &gw.anim)
+}
+
+func (pw *pngFrameWriter) WriteFrame(img *image.RGBA) error {
+	fileName := fmt.Sprintf("%s-%04d.png", pw.prefix, len(pw.files))
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(f, img)
+	closeErr := f.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	pw.files = append(pw.files, fileName)
+	return nil
+}
+
+func (pw *pngFrameWriter) Close() error {
+	return nil
+}
+
+/***************************************************************/
+// DisplayWorld Recording Functions
+/***************************************************************/
+
+// Render the current state then step time for the number of ticks, with the frames per tick.
+// Time is simulated from the frame delay to move the orbiting camera and the blinker.
+func (world *DisplayWorld) Record(opts RecordOptions, fw FrameWriter) error {
+	world.Filter.DisplayEmptyNodes = opts.DisplayEmptyNodes
+	world.Filter.DisplayEmptyConnections = opts.DisplayEmptyConnections
+	world.Filter.EventColorMask = uint8(opts.EventColorMask)
+	world.Filter.EventOutgrowthManyColorsThreshold = uint8(opts.EventOutgrowthManyColorsThreshold)
+	world.Angle.Enabled = opts.Orbit
+	world.Angle.Ratio = opts.OrbitSpeed
+	world.Angle.Threshold = 0.0
+
+	frameTime := float64(opts.FrameDelay) / 100.0
+	simTime := 0.0
+	world.Angle.previousTime = simTime
+	world.Blinker.previousTime = simTime
+
+	sr := MakeSoftRenderer(opts.Width, opts.Height)
+	for tick := 0; tick <= opts.NbTicks; tick++ {
+		if tick > 0 {
+			world.ForwardTime()
+			world.CheckMax()
+		}
+		for frame := 0; frame < opts.FramesPerTick; frame++ {
+			world.Render(sr)
+			err := fw.WriteFrame(sr.Image)
+			if err != nil {
+				return err
+			}
+			simTime += frameTime
+			world.Tick(simTime)
+		}
+	}
+	return fw.Close()
+}
+
+// Initialize the DB and record the default world with the command line arguments
+func RecordDefaultWorld(env *m3db.QsmEnvironment, args []string) error {
+	opts, err := ParseRecordOptions(args)
+	if err != nil {
+		return err
+	}
+	output := opts.Output
+	if output == "" {
+		output = filepath.Join(m3util.GetExportDir(), fmt.Sprintf("record-%d", opts.NbTicks))
+		if opts.Format == RecordGif {
+			output += ".gif"
+		}
+	}
+	m3path.InitializeDBEnv(env)
+	world := MakeDefaultWorld(env, 0.0)
+
+	var fw FrameWriter
+	var f *os.File
+	if opts.Format == RecordGif {
+		f, err = os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer m3util.CloseFile(f)
+		fw = MakeGifFrameWriter(f, opts.FrameDelay)
+	} else {
+		fw = MakePngFrameWriter(output)
+	}
+	err = world.Record(opts, fw)
+	if err != nil {
+		return err
+	}
+	Log.Infof("recorded %d ticks with %d frames per tick to %s", opts.NbTicks, opts.FramesPerTick, output)
+	return nil
+}
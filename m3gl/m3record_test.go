@@ -0,0 +1,111 @@
+package m3gl
+
+import (
+	"bytes"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"image/gif"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseRecordOptions(t *testing.T) {
+	opts, err := ParseRecordOptions(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, opts.NbTicks)
+	assert.Equal(t, RecordGif, opts.Format)
+	assert.Equal(t, uint(0xFF), opts.EventColorMask)
+	assert.False(t, opts.Orbit)
+
+	opts, err = ParseRecordOptions([]string{"-ticks", "5", "-width", "64", "-height", "48", "-fpt", "3",
+		"-format", "png", "-orbit", "-emptyNodes", "-colorMask", "5", "-manyColors", "2"})
+	assert.Nil(t, err)
+	assert.Equal(t, RecordOptions{
+		NbTicks: 5, Width: 64, Height: 48, FramesPerTick: 3, FrameDelay: 20, Format: RecordPng,
+		Orbit: true, OrbitSpeed: 0.3, DisplayEmptyNodes: true, EventColorMask: 5, EventOutgrowthManyColorsThreshold: 2,
+	}, opts)
+
+	for _, invalid := range [][]string{{"-ticks", "-1"}, {"-width", "0"}, {"-fpt", "0"}, {"-format", "avi"},
+		{"-colorMask", "256"}, {"-manyColors", "5"}, {"-unknown"}} {
+		_, err = ParseRecordOptions(invalid)
+		assert.NotNil(t, err, "args %v should be invalid", invalid)
+	}
+}
+
+func renderTestFrames(t *testing.T, fw FrameWriter) {
+	mesh := makeTestTriangle(0.5, 0.0)
+	ident := mgl32.Ident4()
+	sr := MakeSoftRenderer(40, 30)
+	for _, c := range []mgl32.Vec3{{1, 0, 0}, {0, 1, 0}} {
+		sr.Clear()
+		sr.DrawMesh(mesh, ident, ident, ident, c, mgl32.Vec3{0, 0, 1}, mgl32.Vec3{1, 1, 1})
+		assert.Nil(t, fw.WriteFrame(sr.Image))
+	}
+	assert.Nil(t, fw.Close())
+}
+
+func TestGifFrameWriter(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NotNil(t, MakeGifFrameWriter(&buf, 10).Close())
+
+	renderTestFrames(t, MakeGifFrameWriter(&buf, 10))
+	anim, err := gif.DecodeAll(&buf)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, 2, len(anim.Image))
+	assert.Equal(t, []int{10, 10}, anim.Delay)
+	r, g, _, _ := anim.Image[0].At(20, 15).RGBA()
+	assert.True(t, r > 0 && g == 0)
+	r, g, _, _ = anim.Image[1].At(20, 15).RGBA()
+	assert.True(t, r == 0 && g > 0)
+}
+
+func TestPngFrameWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qsm-record")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	renderTestFrames(t, MakePngFrameWriter(filepath.Join(dir, "frame")))
+	for _, name := range []string{"frame-0000.png", "frame-0001.png"} {
+		f, err := os.Open(filepath.Join(dir, name))
+		if !assert.Nil(t, err) {
+			continue
+		}
+		img, err := png.Decode(f)
+		f.Close()
+		assert.Nil(t, err)
+		assert.Equal(t, 40, img.Bounds().Dx())
+	}
+}
+
+func TestRecordWorld(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	world.WorldSpace.CreateEvent(8, 0, 0, m3point.Origin, m3space.RedEvent)
+	world.CreateDrawingElements()
+
+	opts, err := ParseRecordOptions([]string{"-ticks", "2", "-fpt", "2", "-width", "80", "-height", "60", "-orbit"})
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	assert.Nil(t, world.Record(opts, MakeGifFrameWriter(&buf, opts.FrameDelay)))
+	assert.Equal(t, m3space.DistAndTime(2), world.WorldSpace.GetCurrentTime())
+	assert.True(t, world.Angle.Value > 0.0)
+
+	anim, err := gif.DecodeAll(&buf)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, 6, len(anim.Image))
+	assert.NotEqual(t, anim.Image[0].Pix, anim.Image[5].Pix)
+}
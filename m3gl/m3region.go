@@ -0,0 +1,197 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+)
+
+const RegionWindowWidth = 250
+
+var axisNames = [3]string{"X", "Y", "Z"}
+
+// An axis-aligned plane hiding everything on one side
+type ClipPlane struct {
+	Enabled bool
+	// The coordinate of the plane on its axis
+	Pos m3point.CInt
+	// Keep what is below the plane instead of above
+	KeepBelow bool
+}
+
+// Restrict the display to a region of interest of the space, to see inside the dense outgrowth shell
+type RegionFilter struct {
+	// One clipping plane per axis X, Y, Z
+	Planes [3]ClipPlane
+	// The plane moved by the keys
+	SelectedPlane int
+
+	// Only display inside the box, bounds included
+	BoxEnabled bool
+	BoxMin     m3point.Point
+	BoxMax     m3point.Point
+
+	// Only display at a distance range from an event center, bounds included
+	DistEnabled bool
+	DistEventId m3space.EventID
+	DistCenter  m3point.Point
+	DistMin     m3point.CInt
+	DistMax     m3point.CInt
+}
+
+/***************************************************************/
+// RegionFilter Functions
+/***************************************************************/
+
+func (rf *RegionFilter) IsActive() bool {
+	return rf.BoxEnabled || rf.DistEnabled || rf.Planes[0].Enabled || rf.Planes[1].Enabled || rf.Planes[2].Enabled
+}
+
+// True if the point is displayed by the clipping planes, the box and the distance range
+func (rf *RegionFilter) Contains(p m3point.Point) bool {
+	for axis, plane := range rf.Planes {
+		if plane.Enabled {
+			if plane.KeepBelow && p[axis] > plane.Pos {
+				return false
+			}
+			if !plane.KeepBelow && p[axis] < plane.Pos {
+				return false
+			}
+		}
+	}
+	if rf.BoxEnabled {
+		for axis := 0; axis < 3; axis++ {
+			if p[axis] < rf.BoxMin[axis] || p[axis] > rf.BoxMax[axis] {
+				return false
+			}
+		}
+	}
+	if rf.DistEnabled {
+		ds := p.Sub(rf.DistCenter).DistanceSquared()
+		if ds < m3point.DInt(rf.DistMin)*m3point.DInt(rf.DistMin) || ds > m3point.DInt(rf.DistMax)*m3point.DInt(rf.DistMax) {
+			return false
+		}
+	}
+	return true
+}
+
+// Make the disabled box and distance range cover all the space up to max
+func (rf *RegionFilter) FitMax(max m3point.CInt) {
+	if !rf.BoxEnabled {
+		rf.BoxMin = m3point.Point{-max, -max, -max}
+		rf.BoxMax = m3point.Point{max, max, max}
+	}
+	if !rf.DistEnabled {
+		rf.DistMin = 0
+		rf.DistMax = 2 * max
+	}
+}
+
+// Measure the distance range from the center of this event
+func (rf *RegionFilter) SetDistEvent(evt *m3space.Event) {
+	rf.DistEventId = evt.GetId()
+	rf.DistCenter = *evt.GetNode().GetPoint()
+}
+
+func (rf *RegionFilter) SelectNextPlane() {
+	rf.SelectedPlane = cycleIndex(rf.SelectedPlane, 3, 1)
+}
+
+func (rf *RegionFilter) ToggleSelectedPlane() {
+	rf.Planes[rf.SelectedPlane].Enabled = !rf.Planes[rf.SelectedPlane].Enabled
+}
+
+func (rf *RegionFilter) FlipSelectedPlane() {
+	rf.Planes[rf.SelectedPlane].KeepBelow = !rf.Planes[rf.SelectedPlane].KeepBelow
+}
+
+// Move the selected plane, staying inside the space
+func (rf *RegionFilter) MoveSelectedPlane(delta m3point.CInt, max m3point.CInt) {
+	plane := &rf.Planes[rf.SelectedPlane]
+	plane.Pos += delta
+	if plane.Pos > max {
+		plane.Pos = max
+	}
+	if plane.Pos < -max {
+		plane.Pos = -max
+	}
+}
+
+func (rf *RegionFilter) DisplaySettings() {
+	fmt.Println("========= Region Settings =========")
+	for axis, plane := range rf.Planes {
+		fmt.Println("Clip", axisNames[axis], "[G,H]", plane.Enabled, "at [<,>]", plane.Pos, ", Keep Below [/]", plane.KeepBelow, ", Selected", axis == rf.SelectedPlane)
+	}
+	fmt.Println("Box", rf.BoxEnabled, rf.BoxMin, rf.BoxMax)
+	fmt.Println("Distance", rf.DistEnabled, "from event", rf.DistEventId, "at", rf.DistCenter, "between", rf.DistMin, rf.DistMax)
+}
+
+/***************************************************************/
+// DisplayWorld Region Window Functions
+/***************************************************************/
+
+// Change one coordinate with a stepper, staying in the range
+func stepCoord(ui *Ui, format string, c *m3point.CInt, min, max m3point.CInt) bool {
+	step := ui.Stepper(format, *c)
+	if step == 0 {
+		return false
+	}
+	nc := *c + m3point.CInt(step)
+	if nc < min || nc > max {
+		return false
+	}
+	*c = nc
+	return true
+}
+
+// Declare the Region Window with the clipping planes, box and distance range of the filter
+func (world *DisplayWorld) DrawRegionWindow(ui *Ui, x, y float32) {
+	rf := &world.Filter.Region
+	max := world.Max
+	ui.BeginWindow("Region", x, y, RegionWindowWidth)
+
+	for axis := 0; axis < 3; axis++ {
+		plane := &rf.Planes[axis]
+		name := axisNames[axis]
+		if ui.Checkbox(fmt.Sprintf("Clip %s [G,H]", name), &plane.Enabled) {
+			rf.SelectedPlane = axis
+		}
+		if plane.Enabled {
+			if stepCoord(ui, name+" Plane %d [<,>]", &plane.Pos, -max, max) {
+				rf.SelectedPlane = axis
+			}
+			ui.Checkbox(fmt.Sprintf("Keep Below %s [/]", name), &plane.KeepBelow)
+		}
+	}
+
+	ui.Checkbox("Box", &rf.BoxEnabled)
+	if rf.BoxEnabled {
+		for axis := 0; axis < 3; axis++ {
+			stepCoord(ui, "Min "+axisNames[axis]+" %d", &rf.BoxMin[axis], -max, rf.BoxMax[axis])
+			stepCoord(ui, "Max "+axisNames[axis]+" %d", &rf.BoxMax[axis], rf.BoxMin[axis], max)
+		}
+	}
+
+	events := world.WorldSpace.GetEvents()
+	if len(events) > 0 {
+		evtIdx := -1
+		for i, evt := range events {
+			if evt.GetId() == rf.DistEventId {
+				evtIdx = i
+			}
+		}
+		if ui.Checkbox("Distance", &rf.DistEnabled) && rf.DistEnabled && evtIdx < 0 {
+			evtIdx = 0
+			rf.SetDistEvent(events[evtIdx])
+		}
+		if rf.DistEnabled {
+			if step := ui.Stepper("From Event %d", rf.DistEventId); step != 0 {
+				rf.SetDistEvent(events[cycleIndex(evtIdx, len(events), step)])
+			}
+			stepCoord(ui, "Min Dist %d", &rf.DistMin, 0, rf.DistMax)
+			stepCoord(ui, "Max Dist %d", &rf.DistMax, rf.DistMin, 4*max)
+		}
+	}
+
+	ui.EndWindow()
+}
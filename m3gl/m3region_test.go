@@ -0,0 +1,96 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegionFilterContains(t *testing.T) {
+	rf := RegionFilter{}
+	rf.FitMax(9)
+	assert.False(t, rf.IsActive())
+	assert.Equal(t, m3point.Point{-9, -9, -9}, rf.BoxMin)
+	assert.Equal(t, m3point.CInt(18), rf.DistMax)
+
+	rf.ToggleSelectedPlane()
+	assert.True(t, rf.IsActive())
+	rf.MoveSelectedPlane(2, 9)
+	assert.True(t, rf.Contains(m3point.Point{2, -5, 7}))
+	assert.False(t, rf.Contains(m3point.Point{1, 5, 7}))
+	rf.FlipSelectedPlane()
+	assert.False(t, rf.Contains(m3point.Point{3, 5, 7}))
+	assert.True(t, rf.Contains(m3point.Point{2, 5, 7}))
+	rf.MoveSelectedPlane(20, 9)
+	assert.Equal(t, m3point.CInt(9), rf.Planes[0].Pos)
+	rf.ToggleSelectedPlane()
+
+	rf.SelectNextPlane()
+	rf.SelectNextPlane()
+	assert.Equal(t, 2, rf.SelectedPlane)
+	rf.SelectNextPlane()
+	assert.Equal(t, 0, rf.SelectedPlane)
+
+	rf.BoxEnabled = true
+	rf.BoxMin = m3point.Point{-3, -3, 0}
+	rf.BoxMax = m3point.Point{3, 3, 3}
+	rf.FitMax(27)
+	assert.Equal(t, m3point.Point{3, 3, 3}, rf.BoxMax)
+	assert.True(t, rf.Contains(m3point.Point{3, -3, 0}))
+	assert.False(t, rf.Contains(m3point.Point{0, 0, -1}))
+	rf.BoxEnabled = false
+
+	rf.DistEnabled = true
+	rf.DistCenter = m3point.Point{3, 0, 0}
+	rf.DistMin = 2
+	rf.DistMax = 4
+	assert.False(t, rf.Contains(m3point.Point{3, 1, 1}))
+	assert.True(t, rf.Contains(m3point.Point{3, 2, 0}))
+	assert.True(t, rf.Contains(m3point.Point{-1, 0, 0}))
+	assert.False(t, rf.Contains(m3point.Point{-2, 0, 0}))
+}
+
+func TestRegionFilterDisplay(t *testing.T) {
+	filter := SpaceDrawingFilter{false, false, uint8(0xFF), 0, nil, RegionFilter{}}
+	conn := ConnectionDrawingElement{getConnectionObjectType(1), SpaceDrawingColor{uint8(m3space.RedEvent), 0}, &m3point.Point{1, 1, 0}}
+	assert.True(t, conn.Display(filter))
+	filter.Region.Planes[2] = ClipPlane{true, 1, false}
+	assert.False(t, conn.Display(filter))
+	filter.Region.Planes[2].KeepBelow = true
+	assert.True(t, conn.Display(filter))
+}
+
+func TestRegionWindow(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Point{3, 0, 0}, m3space.RedEvent)
+	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Point{-3, 0, 0}, m3space.GreenEvent)
+	ui := MakeUi(MakeUiFontAtlas())
+	declare := func() {
+		world.DrawRegionWindow(&ui, 10, 10)
+	}
+
+	clickUi(t, &ui, "Region/Clip Y [G,H]", declare)
+	assert.True(t, world.Filter.Region.Planes[1].Enabled)
+	assert.Equal(t, 1, world.Filter.Region.SelectedPlane)
+	clickUi(t, &ui, "Region/Y Plane %d [<,>]/-", declare)
+	assert.Equal(t, m3point.CInt(-1), world.Filter.Region.Planes[1].Pos)
+	clickUi(t, &ui, "Region/Keep Below Y [/]", declare)
+	assert.True(t, world.Filter.Region.Planes[1].KeepBelow)
+
+	clickUi(t, &ui, "Region/Box", declare)
+	clickUi(t, &ui, "Region/Max Z %d/-", declare)
+	assert.Equal(t, m3point.CInt(26), world.Filter.Region.BoxMax[2])
+
+	clickUi(t, &ui, "Region/Distance", declare)
+	assert.Equal(t, m3space.EventID(1), world.Filter.Region.DistEventId)
+	clickUi(t, &ui, "Region/From Event %d/+", declare)
+	assert.Equal(t, m3point.Point{-3, 0, 0}, world.Filter.Region.DistCenter)
+	clickUi(t, &ui, "Region/Min Dist %d/+", declare)
+	assert.Equal(t, m3point.CInt(1), world.Filter.Region.DistMin)
+	assert.True(t, world.Filter.Region.Contains(m3point.Point{-3, -2, 0}))
+	assert.False(t, world.Filter.Region.Contains(m3point.Point{-3, 0, 0}))
+}
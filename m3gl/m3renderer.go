@@ -0,0 +1,146 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// A backend drawing the world scene: the OpenGL one of playgl, the SoftRenderer, or any test or offscreen one.
+// The meshes are set once and the axes updated when the space grows, then each frame only sends the instances.
+type Renderer interface {
+	// The meshes of all the object types, replacing the previous ones
+	SetMeshes(meshes map[ObjectType]*Mesh)
+	// Only these meshes changed, the axes ones when the space grows
+	UpdateMeshes(meshes map[ObjectType]*Mesh)
+	// Draw all the instances of the frame, clearing the previous one
+	DrawFrame(frame *SceneFrame)
+}
+
+// The triangles of one object type drawn at the origin, three vertices per triangle with the normal of the triangle
+type Mesh struct {
+	Vertices []mgl32.Vec3
+	Normals  []mgl32.Vec3
+}
+
+// Everything needed to draw one frame once the meshes are set, without any OpenGL specifics
+type SceneFrame struct {
+	Width, Height  int
+	Projection     mgl32.Mat4
+	View           mgl32.Mat4
+	Model          mgl32.Mat4
+	LightDirection mgl32.Vec3
+	LightColor     mgl32.Vec3
+	Instances      *InstanceBuffer
+}
+
+/***************************************************************/
+// Mesh Functions
+/***************************************************************/
+
+func MakeMesh(o GLObject) *Mesh {
+	nbVertices := o.NumberOfVertices()
+	m := Mesh{make([]mgl32.Vec3, 0, nbVertices), make([]mgl32.Vec3, 0, nbVertices)}
+	for _, tr := range o.ExtractTriangles() {
+		normal := mgl32.Vec3{float32(tr.normal[0]), float32(tr.normal[1]), float32(tr.normal[2])}
+		for _, point := range tr.vertices {
+			m.Vertices = append(m.Vertices, mgl32.Vec3{float32(point[0]), float32(point[1]), float32(point[2])})
+			m.Normals = append(m.Normals, normal)
+		}
+	}
+	return &m
+}
+
+// The meshes of the axes up to max, of the nodes and of all the connections
+func MakeMeshes(ppd *m3point.PointPackData, max m3point.CInt) map[ObjectType]*Mesh {
+	verifyData()
+	res := makeAxesMeshes(max)
+	res[NodeEmpty] = MakeMesh(MakeSphere(NodeEmpty))
+	res[NodeActive] = MakeMesh(MakeSphere(NodeActive))
+	maxConnId := ppd.GetMaxConnId()
+	for connId := m3point.ConnectionId(1); connId <= maxConnId; connId++ {
+		for _, cId := range []m3point.ConnectionId{connId, connId.GetNegId()} {
+			conn := ppd.GetConnDetailsById(cId)
+			ot := getConnectionObjectType(cId)
+			res[ot] = MakeMesh(MakeSegment(m3point.Origin, conn.Vector, ot))
+		}
+	}
+	return res
+}
+
+func makeAxesMeshes(max m3point.CInt) map[ObjectType]*Mesh {
+	res := make(map[ObjectType]*Mesh)
+	for axe := int16(0); axe < axes; axe++ {
+		p := m3point.Point{}
+		p[axe] = max + AxeExtraLength
+		res[ObjectType(axe)] = MakeMesh(MakeSegment(m3point.Origin, p, ObjectType(axe)))
+	}
+	return res
+}
+
+func (m *Mesh) NumberOfVertices() int {
+	return len(m.Vertices)
+}
+
+// Write the position then the normal of each vertex, FloatPerVertices floats per vertex
+func (m *Mesh) FillInterleaved(buffer []float32) {
+	b := 0
+	for i, v := range m.Vertices {
+		n := m.Normals[i]
+		copy(buffer[b:b+FloatPerVertices], []float32{v[0], v[1], v[2], n[0], n[1], n[2]})
+		b += FloatPerVertices
+	}
+}
+
+/***************************************************************/
+// SceneFrame Functions
+/***************************************************************/
+
+// Visit all the instances with their mesh, position, color and dimmer
+func (frame *SceneFrame) VisitInstances(visitor func(d InstanceDraw, pos mgl32.Vec3, color int32, dimmer float32)) {
+	data := frame.Instances.Data
+	for _, d := range frame.Instances.Draws {
+		for i := d.FirstInstance; i < d.FirstInstance+d.NbInstances; i++ {
+			b := int(i) * FloatPerInstance
+			visitor(d, mgl32.Vec3{data[b], data[b+1], data[b+2]}, int32(data[b+3]+0.5), data[b+4])
+		}
+	}
+}
+
+/***************************************************************/
+// DisplayWorld Renderer Functions
+/***************************************************************/
+
+// Send all the meshes to the renderer, after they were created
+func (world *DisplayWorld) SetRendererMeshes(r Renderer) {
+	r.SetMeshes(world.Meshes)
+}
+
+// Send the axes meshes to the renderer, after the space grew
+func (world *DisplayWorld) UpdateRendererAxes(r Renderer) {
+	axesMeshes := make(map[ObjectType]*Mesh)
+	for k, mesh := range world.Meshes {
+		if k.IsAxe() {
+			axesMeshes[k] = mesh
+		}
+	}
+	if len(axesMeshes) > 0 {
+		r.UpdateMeshes(axesMeshes)
+	}
+}
+
+// The frame of the drawn elements with the current camera, angle and blinker.
+// The instances are translated by the renderer, the model is only the rotation.
+func (world *DisplayWorld) MakeSceneFrame() *SceneFrame {
+	world.Model = mgl32.HomogRotate3D(float32(world.Angle.Value), mgl32.Vec3{0, 0, 1})
+	world.FillInstances()
+	return &SceneFrame{
+		world.Camera.Width, world.Camera.Height,
+		world.Camera.Projection, world.Camera.View, world.Model,
+		world.LightDirection, world.LightColor,
+		&world.Instances,
+	}
+}
+
+func (world *DisplayWorld) DrawFrame(r Renderer) {
+	r.DrawFrame(world.MakeSceneFrame())
+}
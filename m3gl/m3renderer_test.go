@@ -0,0 +1,91 @@
+package m3gl
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/stretchr/testify/assert"
+	"image/color"
+	"testing"
+)
+
+// Keep what the world sends, like a GPU backend would
+type recordingRenderer struct {
+	nbMeshes    int
+	updated     []ObjectType
+	frames      []SceneFrame
+	nbInstances int
+}
+
+func (rr *recordingRenderer) SetMeshes(meshes map[ObjectType]*Mesh) {
+	rr.nbMeshes = len(meshes)
+}
+
+func (rr *recordingRenderer) UpdateMeshes(meshes map[ObjectType]*Mesh) {
+	for k := range meshes {
+		rr.updated = append(rr.updated, k)
+	}
+}
+
+func (rr *recordingRenderer) DrawFrame(frame *SceneFrame) {
+	rr.frames = append(rr.frames, *frame)
+	rr.nbInstances = 0
+	frame.VisitInstances(func(d InstanceDraw, pos mgl32.Vec3, color int32, dimmer float32) {
+		rr.nbInstances++
+	})
+}
+
+func TestRendererHeadless(t *testing.T) {
+	world := DisplayWorld{}
+	triangle := makeTestTriangle(0.5, 0.0)
+	world.Meshes = map[ObjectType]*Mesh{
+		AxeX:       triangle,
+		NodeActive: triangle,
+	}
+	world.Elements = []SpaceDrawingElement{
+		&AxeDrawingElement{AxeX, 9, false},
+		&PathDrawingElement{NodeActive, m3point.Point{0, 0, 0}},
+		&PathDrawingElement{NodeActive, m3point.Point{1, 2, 3}},
+	}
+	buffer := make([]float32, triangle.NumberOfVertices()*FloatPerVertices)
+	triangle.FillInterleaved(buffer)
+	assert.Equal(t, []float32{-0.5, -0.5, 0, 0, 0, 1}, buffer[:FloatPerVertices])
+	assert.Equal(t, []float32{0, 0.5, 0, 0, 0, 1}, buffer[2*FloatPerVertices:])
+	world.Camera = MakeCamera(100, 100)
+	world.LightDirection = mgl32.Vec3{0, 0, 1}
+	world.LightColor = mgl32.Vec3{1, 1, 1}
+	world.Angle.Value = 0.5
+
+	rr := &recordingRenderer{}
+	world.SetRendererMeshes(rr)
+	assert.Equal(t, 2, rr.nbMeshes)
+	world.UpdateRendererAxes(rr)
+	assert.Equal(t, []ObjectType{AxeX}, rr.updated)
+	world.DrawFrame(rr)
+	world.DrawFrame(rr)
+	assert.Equal(t, 2, len(rr.frames))
+	assert.Equal(t, 3, rr.nbInstances)
+	frame := rr.frames[1]
+	assert.Equal(t, 100, frame.Width)
+	assert.Equal(t, world.Camera.View, frame.View)
+	assert.Equal(t, mgl32.HomogRotate3D(0.5, mgl32.Vec3{0, 0, 1}), frame.Model)
+
+	var positions []mgl32.Vec3
+	var colors []int32
+	frame.VisitInstances(func(d InstanceDraw, pos mgl32.Vec3, color int32, dimmer float32) {
+		positions = append(positions, pos)
+		colors = append(colors, color)
+	})
+	assert.Equal(t, []mgl32.Vec3{{0, 0, 0}, {0, 0, 0}, {1, 2, 3}}, positions)
+	assert.Equal(t, []int32{int32(1), HighlightColor, HighlightColor}, colors)
+
+	// Same frame on the soft renderer, with no projection the highlighted triangle is white in the center
+	sr := MakeSoftRenderer(100, 100)
+	world.SetRendererMeshes(sr)
+	ident := mgl32.Ident4()
+	frame.Projection, frame.View, frame.Model = ident, ident, ident
+	world.Elements = world.Elements[1:2]
+	world.FillInstances()
+	sr.DrawFrame(&frame)
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, sr.Image.RGBAAt(50, 50))
+	assert.Equal(t, color.RGBA{0, 0, 0, 255}, sr.Image.RGBAAt(2, 2))
+}
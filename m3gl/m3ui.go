@@ -0,0 +1,369 @@
+package m3gl
+
+import (
+	"fmt"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"image/draw"
+)
+
+// Immediate mode UI drawn over the 3D canvas. Each frame the widgets are declared again,
+// they return the user interaction and fill a 2D triangles buffer using a font atlas texture.
+const (
+	// XY in pixels from the top left corner, UV in the font atlas, RGBA
+	UiFloatPerVertices = 8
+	uiFirstChar        = ' '
+	uiLastChar         = '~'
+	uiPadding          = 4
+	uiRowSpacing       = 3
+)
+
+var (
+	UiTextColor       = [4]float32{0.9, 0.9, 0.9, 1.0}
+	UiWindowColor     = [4]float32{0.1, 0.1, 0.15, 0.85}
+	UiTitleColor      = [4]float32{0.25, 0.25, 0.45, 0.95}
+	UiWidgetColor     = [4]float32{0.3, 0.3, 0.35, 1.0}
+	UiWidgetHotColor  = [4]float32{0.4, 0.4, 0.5, 1.0}
+	UiWidgetDownColor = [4]float32{0.5, 0.5, 0.7, 1.0}
+	UiSliderColor     = [4]float32{0.35, 0.5, 0.8, 1.0}
+)
+
+// Alpha texture with the printable ASCII glyphs of a fixed width font, and a fully opaque block for plain rectangles
+type UiFontAtlas struct {
+	Image       *image.Alpha
+	GlyphWidth  int
+	GlyphHeight int
+	glyphs      map[rune]image.Rectangle
+	opaque      image.Point
+}
+
+type uiRect struct {
+	x, y, w, h float32
+}
+
+type Ui struct {
+	Width, Height int
+	Atlas         *UiFontAtlas
+	Vertices      []float32
+
+	mouseX, mouseY float64
+	mouseDown      bool
+	wasDown        bool
+	// Button changes not seen by a frame yet, so a fast click is not lost
+	pendingButton []bool
+	// The widget under the mouse, and the one pressed and still followed until the mouse is released
+	hot, active string
+	windows     []uiRect
+	// The widgets of the frame per id
+	widgets map[string]uiRect
+
+	// The window being declared
+	title       string
+	window      uiRect
+	windowStart int
+	cursorY     float32
+}
+
+func MakeUiFontAtlas() *UiFontAtlas {
+	face := basicfont.Face7x13
+	atlas := new(UiFontAtlas)
+	atlas.GlyphWidth = face.Advance
+	atlas.GlyphHeight = face.Height
+	nbChars := int(uiLastChar-uiFirstChar) + 1
+	atlas.Image = image.NewAlpha(image.Rect(0, 0, (nbChars+1)*atlas.GlyphWidth, atlas.GlyphHeight))
+	atlas.glyphs = make(map[rune]image.Rectangle, nbChars)
+	for i := 0; i < nbChars; i++ {
+		r := uiFirstChar + rune(i)
+		x := i * atlas.GlyphWidth
+		dr, mask, maskp, _, ok := face.Glyph(fixed.P(x, face.Ascent), r)
+		if ok {
+			draw.DrawMask(atlas.Image, dr, image.Opaque, image.Point{}, mask, maskp, draw.Over)
+		}
+		atlas.glyphs[r] = image.Rect(x, 0, x+atlas.GlyphWidth, atlas.GlyphHeight)
+	}
+	block := image.Rect(nbChars*atlas.GlyphWidth, 0, (nbChars+1)*atlas.GlyphWidth, atlas.GlyphHeight)
+	draw.Draw(atlas.Image, block, image.Opaque, image.Point{}, draw.Src)
+	atlas.opaque = block.Min.Add(image.Pt(atlas.GlyphWidth/2, atlas.GlyphHeight/2))
+	return atlas
+}
+
+func MakeUi(atlas *UiFontAtlas) Ui {
+	return Ui{Atlas: atlas, Vertices: make([]float32, 0, 4096), widgets: make(map[string]uiRect)}
+}
+
+/***************************************************************/
+// UiFontAtlas Functions
+/***************************************************************/
+
+func (atlas *UiFontAtlas) TextWidth(s string) float32 {
+	return float32(len(s) * atlas.GlyphWidth)
+}
+
+func (atlas *UiFontAtlas) uv(x, y int) (float32, float32) {
+	b := atlas.Image.Bounds()
+	return float32(x) / float32(b.Dx()), float32(y) / float32(b.Dy())
+}
+
+/***************************************************************/
+// Ui Functions
+/***************************************************************/
+
+func (r uiRect) contains(x, y float64) bool {
+	return x >= float64(r.x) && x < float64(r.x+r.w) && y >= float64(r.y) && y < float64(r.y+r.h)
+}
+
+func (ui *Ui) rowHeight() float32 {
+	return float32(ui.Atlas.GlyphHeight + 2*uiPadding)
+}
+
+// True if the mouse is over one of the windows of the last frame, or a widget is being dragged
+func (ui *Ui) WantsMouse() bool {
+	if ui.active != "" {
+		return true
+	}
+	for _, w := range ui.windows {
+		if w.contains(ui.mouseX, ui.mouseY) {
+			return true
+		}
+	}
+	return false
+}
+
+// The mouse position in pixels from the top left corner, can be called from input callbacks
+func (ui *Ui) SetMouse(x, y float64) {
+	ui.mouseX = x
+	ui.mouseY = y
+}
+
+// The left button changes are applied one per frame
+func (ui *Ui) SetMouseDown(down bool) {
+	ui.pendingButton = append(ui.pendingButton, down)
+}
+
+func (ui *Ui) BeginFrame(width, height int) {
+	ui.Width = width
+	ui.Height = height
+	ui.wasDown = ui.mouseDown
+	if len(ui.pendingButton) > 0 {
+		ui.mouseDown = ui.pendingButton[0]
+		ui.pendingButton = ui.pendingButton[1:]
+	}
+	ui.Vertices = ui.Vertices[:0]
+	ui.windows = ui.windows[:0]
+	for id := range ui.widgets {
+		delete(ui.widgets, id)
+	}
+	ui.hot = ""
+}
+
+func (ui *Ui) EndFrame() {
+	if !ui.mouseDown {
+		ui.active = ""
+	}
+}
+
+func (ui *Ui) mousePressed() bool {
+	return ui.mouseDown && !ui.wasDown
+}
+
+func (ui *Ui) mouseReleased() bool {
+	return !ui.mouseDown && ui.wasDown
+}
+
+func (ui *Ui) addVertex(x, y, u, v float32, color [4]float32) {
+	ui.Vertices = append(ui.Vertices, x, y, u, v, color[0], color[1], color[2], color[3])
+}
+
+func (ui *Ui) addQuad(r uiRect, u0, v0, u1, v1 float32, color [4]float32) {
+	ui.addVertex(r.x, r.y, u0, v0, color)
+	ui.addVertex(r.x+r.w, r.y, u1, v0, color)
+	ui.addVertex(r.x+r.w, r.y+r.h, u1, v1, color)
+	ui.addVertex(r.x, r.y, u0, v0, color)
+	ui.addVertex(r.x+r.w, r.y+r.h, u1, v1, color)
+	ui.addVertex(r.x, r.y+r.h, u0, v1, color)
+}
+
+func (ui *Ui) drawRect(r uiRect, color [4]float32) {
+	u, v := ui.Atlas.uv(ui.Atlas.opaque.X, ui.Atlas.opaque.Y)
+	ui.addQuad(r, u, v, u, v, color)
+}
+
+func (ui *Ui) drawText(x, y float32, s string, color [4]float32) {
+	gw := float32(ui.Atlas.GlyphWidth)
+	gh := float32(ui.Atlas.GlyphHeight)
+	for _, c := range s {
+		g, ok := ui.Atlas.glyphs[c]
+		if !ok {
+			g = ui.Atlas.glyphs['?']
+		}
+		if c != ' ' {
+			u0, v0 := ui.Atlas.uv(g.Min.X, g.Min.Y)
+			u1, v1 := ui.Atlas.uv(g.Max.X, g.Max.Y)
+			ui.addQuad(uiRect{x, y, gw, gh}, u0, v0, u1, v1, color)
+		}
+		x += gw
+	}
+}
+
+// Start a window at x,y with a title bar. The height grows with the widgets until EndWindow.
+func (ui *Ui) BeginWindow(title string, x, y, width float32) {
+	ui.title = title
+	ui.window = uiRect{x, y, width, 0}
+	ui.windowStart = len(ui.Vertices)
+	ui.cursorY = y
+	titleRect := ui.nextRow()
+	ui.drawRect(titleRect, UiTitleColor)
+	ui.drawText(titleRect.x+uiPadding, titleRect.y+uiPadding, title, UiTextColor)
+}
+
+// Insert the window background below its widgets
+func (ui *Ui) EndWindow() {
+	ui.window.h = ui.cursorY - ui.window.y + uiPadding
+	content := append([]float32(nil), ui.Vertices[ui.windowStart:]...)
+	ui.Vertices = ui.Vertices[:ui.windowStart]
+	ui.drawRect(ui.window, UiWindowColor)
+	ui.Vertices = append(ui.Vertices, content...)
+	ui.windows = append(ui.windows, ui.window)
+	ui.title = ""
+}
+
+// The bottom of the last window declared in this frame, to stack the next one below
+func (ui *Ui) GetLastWindowBottom() float32 {
+	if len(ui.windows) == 0 {
+		return 0
+	}
+	w := ui.windows[len(ui.windows)-1]
+	return w.y + w.h
+}
+
+func (ui *Ui) nextRow() uiRect {
+	r := uiRect{ui.window.x, ui.cursorY, ui.window.w, ui.rowHeight()}
+	ui.cursorY += r.h + uiRowSpacing
+	return r
+}
+
+func (ui *Ui) contentRow() uiRect {
+	r := ui.nextRow()
+	r.x += uiPadding
+	r.w -= 2 * uiPadding
+	return r
+}
+
+// Track the mouse on a widget, return true if it was clicked: pressed and released on it
+func (ui *Ui) interact(id string, r uiRect) bool {
+	ui.widgets[id] = r
+	over := r.contains(ui.mouseX, ui.mouseY)
+	if over {
+		ui.hot = id
+		if ui.mousePressed() {
+			ui.active = id
+		}
+	}
+	return ui.mouseReleased() && over && ui.active == id
+}
+
+func (ui *Ui) widgetColor(id string) [4]float32 {
+	if ui.active == id && ui.mouseDown {
+		return UiWidgetDownColor
+	}
+	if ui.hot == id {
+		return UiWidgetHotColor
+	}
+	return UiWidgetColor
+}
+
+func (ui *Ui) button(id string, r uiRect, label string) bool {
+	clicked := ui.interact(id, r)
+	ui.drawRect(r, ui.widgetColor(id))
+	tx := r.x + (r.w-ui.Atlas.TextWidth(label))/2.0
+	ui.drawText(tx, r.y+uiPadding, label, UiTextColor)
+	return clicked
+}
+
+func (ui *Ui) Label(format string, args ...interface{}) {
+	r := ui.contentRow()
+	ui.drawText(r.x, r.y+uiPadding, fmt.Sprintf(format, args...), UiTextColor)
+}
+
+func (ui *Ui) Button(label string) bool {
+	return ui.button(ui.title+"/"+label, ui.contentRow(), label)
+}
+
+// Buttons sharing a row, return the index of the clicked one or -1
+func (ui *Ui) Buttons(labels ...string) int {
+	row := ui.contentRow()
+	w := (row.w - float32(len(labels)-1)*uiPadding) / float32(len(labels))
+	res := -1
+	for i, label := range labels {
+		r := uiRect{row.x + float32(i)*(w+uiPadding), row.y, w, row.h}
+		if ui.button(ui.title+"/"+label, r, label) {
+			res = i
+		}
+	}
+	return res
+}
+
+// Switch the value on click, return true if changed
+func (ui *Ui) Checkbox(label string, value *bool) bool {
+	row := ui.contentRow()
+	id := ui.title + "/" + label
+	clicked := ui.interact(id, row)
+	if clicked {
+		*value = !*value
+	}
+	box := uiRect{row.x, row.y + 2, row.h - 4, row.h - 4}
+	ui.drawRect(box, ui.widgetColor(id))
+	if *value {
+		ui.drawRect(uiRect{box.x + 3, box.y + 3, box.w - 6, box.h - 6}, UiTextColor)
+	}
+	ui.drawText(box.x+box.w+uiPadding, row.y+uiPadding, label, UiTextColor)
+	return clicked
+}
+
+// Row with minus and plus buttons around a label, return -1, 1 or 0 if not clicked
+func (ui *Ui) Stepper(format string, args ...interface{}) int {
+	row := ui.contentRow()
+	label := fmt.Sprintf(format, args...)
+	bw := row.h + 4
+	res := 0
+	// The ids do not depend on the value in the label
+	if ui.button(ui.title+"/"+format+"/-", uiRect{row.x, row.y, bw, row.h}, "-") {
+		res = -1
+	}
+	if ui.button(ui.title+"/"+format+"/+", uiRect{row.x + row.w - bw, row.y, bw, row.h}, "+") {
+		res = 1
+	}
+	ui.drawText(row.x+bw+uiPadding, row.y+uiPadding, label, UiTextColor)
+	return res
+}
+
+// Drag in the row to set the value in its range, return true if changed
+func (ui *Ui) Slider(label string, v *SizeVar) bool {
+	row := ui.contentRow()
+	id := ui.title + "/" + label
+	ui.interact(id, row)
+	changed := false
+	if ui.active == id && ui.mouseDown && row.w > 0 {
+		ratio := (ui.mouseX - float64(row.x)) / float64(row.w)
+		if ratio < 0.0 {
+			ratio = 0.0
+		}
+		if ratio > 1.0 {
+			ratio = 1.0
+		}
+		newVal := v.Min + ratio*(v.Max-v.Min)
+		if newVal != v.Val {
+			v.Val = newVal
+			changed = true
+		}
+	}
+	ui.drawRect(row, ui.widgetColor(id))
+	if v.Max > v.Min {
+		filled := row
+		filled.w = float32((v.Val - v.Min) / (v.Max - v.Min) * float64(row.w))
+		ui.drawRect(filled, UiSliderColor)
+	}
+	ui.drawText(row.x+uiPadding, row.y+uiPadding, fmt.Sprintf("%s %.2f", label, v.Val), UiTextColor)
+	return changed
+}
@@ -0,0 +1,200 @@
+package m3gl
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// Press and release the mouse in the middle of the widget, the declare function being called for each frame
+func clickUi(t *testing.T, ui *Ui, id string, declare func()) {
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	r, ok := ui.widgets[id]
+	if !assert.True(t, ok, "no widget %s", id) {
+		return
+	}
+	ui.SetMouse(float64(r.x+r.w/2), float64(r.y+r.h/2))
+	ui.SetMouseDown(true)
+	ui.SetMouseDown(false)
+	for i := 0; i < 2; i++ {
+		ui.BeginFrame(800, 600)
+		declare()
+		ui.EndFrame()
+	}
+}
+
+func TestUiFontAtlas(t *testing.T) {
+	atlas := MakeUiFontAtlas()
+	assert.Equal(t, 7, atlas.GlyphWidth)
+	assert.Equal(t, 13, atlas.GlyphHeight)
+	assert.Equal(t, int('~'-' '+1), len(atlas.glyphs))
+	sum := func(c rune) int {
+		res := 0
+		g := atlas.glyphs[c]
+		for y := g.Min.Y; y < g.Max.Y; y++ {
+			for x := g.Min.X; x < g.Max.X; x++ {
+				res += int(atlas.Image.AlphaAt(x, y).A)
+			}
+		}
+		return res
+	}
+	assert.Equal(t, 0, sum(' '))
+	assert.True(t, sum('A') > 0)
+	assert.True(t, sum('W') > sum('.'))
+	assert.Equal(t, uint8(255), atlas.Image.AlphaAt(atlas.opaque.X, atlas.opaque.Y).A)
+	assert.Equal(t, float32(5*7), atlas.TextWidth("hello"))
+}
+
+func TestUiWidgets(t *testing.T) {
+	ui := MakeUi(MakeUiFontAtlas())
+	nbClicks := 0
+	checked := false
+	slider := SizeVar{0.0, 2.0, 1.0}
+	steps := 0
+	pressed := -1
+	declare := func() {
+		ui.BeginWindow("Test", 100, 50, 200)
+		ui.Label("value %d", 12)
+		if ui.Button("Click") {
+			nbClicks++
+		}
+		ui.Checkbox("Check", &checked)
+		ui.Slider("Size", &slider)
+		steps += ui.Stepper("Steps %d", steps)
+		if b := ui.Buttons("A", "B"); b >= 0 {
+			pressed = b
+		}
+		ui.EndWindow()
+	}
+
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.Equal(t, 0, len(ui.Vertices)%(6*UiFloatPerVertices))
+	// The window background is drawn first
+	assert.Equal(t, []float32{100, 50}, ui.Vertices[0:2])
+	assert.Equal(t, UiWindowColor[:], ui.Vertices[4:8])
+	assert.Equal(t, 1, len(ui.windows))
+	ui.SetMouse(150, 60)
+	assert.True(t, ui.WantsMouse())
+	ui.SetMouse(50, 60)
+	assert.False(t, ui.WantsMouse())
+
+	clickUi(t, &ui, "Test/Click", declare)
+	assert.Equal(t, 1, nbClicks)
+	assert.False(t, ui.WantsMouse() && ui.active != "")
+
+	// Pressed on the button but released outside
+	r := ui.widgets["Test/Click"]
+	ui.SetMouse(float64(r.x+2), float64(r.y+2))
+	ui.SetMouseDown(true)
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.True(t, ui.WantsMouse())
+	ui.SetMouse(700, 500)
+	ui.SetMouseDown(false)
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.Equal(t, 1, nbClicks)
+	assert.False(t, ui.WantsMouse())
+
+	clickUi(t, &ui, "Test/Check", declare)
+	assert.True(t, checked)
+	clickUi(t, &ui, "Test/Check", declare)
+	assert.False(t, checked)
+
+	clickUi(t, &ui, "Test/Steps %d/+", declare)
+	clickUi(t, &ui, "Test/Steps %d/+", declare)
+	clickUi(t, &ui, "Test/Steps %d/-", declare)
+	assert.Equal(t, 1, steps)
+
+	clickUi(t, &ui, "Test/B", declare)
+	assert.Equal(t, 1, pressed)
+
+	// Drag the slider to the 3/4 of its width
+	r = ui.widgets["Test/Size"]
+	ui.SetMouse(float64(r.x+2), float64(r.y+2))
+	ui.SetMouseDown(true)
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	ui.SetMouse(float64(r.x+r.w*0.75), float64(r.y-30))
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.InDelta(t, 1.5, slider.Val, 1e-3)
+	ui.SetMouse(float64(r.x-100), float64(r.y))
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.Equal(t, 0.0, slider.Val)
+	ui.SetMouseDown(false)
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	ui.SetMouse(float64(r.x+r.w*0.5), float64(r.y))
+	ui.BeginFrame(800, 600)
+	declare()
+	ui.EndFrame()
+	assert.Equal(t, 0.0, slider.Val)
+}
+
+func TestCycleIndex(t *testing.T) {
+	assert.Equal(t, 1, cycleIndex(0, 5, 1))
+	assert.Equal(t, 4, cycleIndex(0, 5, -1))
+	assert.Equal(t, 0, cycleIndex(4, 5, 1))
+	assert.Equal(t, 2, cycleIndex(7, 5, 0))
+}
+
+func TestControlWindows(t *testing.T) {
+	Log.SetInfo()
+	m3space.Log.SetInfo()
+
+	world := MakeWorld(getGlTestEnv(), 3*9, 0.0)
+	world.WorldSpace.SetEventOutgrowthThreshold(m3space.DistAndTime(1))
+	ui := MakeUi(MakeUiFontAtlas())
+	state := MakeEventWindowState()
+	actions := ControlActions{}
+	created := false
+	declare := func() {
+		actions = world.DrawControlWindow(&ui, 10, 10)
+		created = world.DrawEventWindow(&ui, &state, 500, 10)
+	}
+
+	clickUi(t, &ui, "Control/Forward [Right]", declare)
+	assert.True(t, actions.TimeChanged)
+	clickUi(t, &ui, "Control/Empty Nodes [N]", declare)
+	assert.True(t, world.Filter.DisplayEmptyNodes)
+	clickUi(t, &ui, "Control/Show red [1]", declare)
+	assert.Equal(t, uint8(0xFE), world.Filter.EventColorMask)
+	clickUi(t, &ui, "Control/Outgrowth Threshold %d [Up,Down]/+", declare)
+	assert.Equal(t, m3space.DistAndTime(2), world.WorldSpace.EventOutgrowthThreshold)
+	clickUi(t, &ui, "Control/Many Colors Threshold %d [U,I]/+", declare)
+	assert.Equal(t, uint8(1), world.Filter.EventOutgrowthManyColorsThreshold)
+	clickUi(t, &ui, "Control/Rotate [S]", declare)
+	assert.True(t, world.Angle.Enabled)
+	clickUi(t, &ui, "Control/Reset View [R]", declare)
+	assert.False(t, world.Angle.Enabled)
+	assert.False(t, actions.ReFill)
+
+	clickUi(t, &ui, "Event/Growth Type %d/-", declare)
+	assert.Equal(t, m3point.GrowthType(4), state.GrowthType)
+	clickUi(t, &ui, "Event/Growth Index %d/-", declare)
+	assert.Equal(t, 11, state.GrowthIndex)
+	clickUi(t, &ui, "Event/X %d/+", declare)
+	clickUi(t, &ui, "Event/Z %d/-", declare)
+	assert.Equal(t, m3point.Point{3, 0, -3}, state.Point)
+	clickUi(t, &ui, "Event/Color %s/+", declare)
+	assert.Equal(t, m3space.GreenEvent, state.Color)
+	clickUi(t, &ui, "Event/Create", declare)
+	assert.True(t, created)
+	assert.Equal(t, 1, world.WorldSpace.GetNbEvents())
+	assert.Equal(t, m3space.GreenEvent, world.WorldSpace.GetEvents()[0].GetColor())
+	assert.Equal(t, fmt.Sprintf("Created event 1 at %v", state.Point), state.Message)
+}
@@ -12,6 +12,8 @@ type PathContext interface {
 	GetGrowthOffset() int
 	GetGrowthType() m3point.GrowthType
 	GetGrowthIndex() int
+	IsAbsoluteTrioField() bool
+	SetAbsoluteTrioField(absolute bool)
 	GetPathNodeMap() PathNodeMap
 	CountAllPathNodes() int
 	InitRootNode(center m3point.Point)
@@ -20,6 +22,8 @@ type PathContext interface {
 	GetAllOpenPathNodes() []PathNode
 	MoveToNextNodes()
 	PredictedNextOpenNodesLen() int
+	AddListener(l PathContextListener)
+	RemoveListener(l PathContextListener)
 	dumpInfo() string
 }
 
@@ -39,6 +43,10 @@ type PathNode interface {
 	IsNext(connIdx int) bool
 	IsDeadEnd(connIdx int) bool
 
+	// The path nodes linked by from connections at d - 1, and by next connections at d + 1
+	GetFromNodes() []PathNode
+	GetNextNodes() []PathNode
+
 	GetTrioDetails() *m3point.TrioDetails
 }
 
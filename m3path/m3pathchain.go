@@ -0,0 +1,125 @@
+package m3path
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"strings"
+)
+
+// Past this number of from-chains the combinations are not worth listing
+const DefaultMaxFromChains = 1024
+
+// One node of a from-chain, with the connection used to come to it from the previous node
+type PathChainLink struct {
+	PathNode PathNode
+	// The connection from the previous node closer to the root, 0 for the root
+	ConnId m3point.ConnectionId
+}
+
+// A shortest path from the root of the path context to a path node, the root first
+type PathChain []PathChainLink
+
+/***************************************************************/
+// PathChain Functions
+/***************************************************************/
+
+// All the shortest from-chains going from the root of the path context of the path node to it.
+// Every from link goes to a node at d - 1, so walking all the from links in the DB gives all of them.
+// Stop with an error after maxChains found.
+func GetFromChains(pathNode PathNode, maxChains int) ([]PathChain, error) {
+	pn, ok := pathNode.(*PathNodeDb)
+	if !ok {
+		return nil, MakeQsmModelErrorf(NotDbPathNode, "cannot walk the from links of %s which is not a DB path node", pathNode.String())
+	}
+	walker := fromChainsWalker{maxChains, make(map[int64][]PathChain), false}
+	res := walker.chainsTo(pn)
+	if walker.tooMany {
+		return res, MakeQsmModelErrorf(TooManyPathChains, "more than %d from-chains to %s", maxChains, pn.String())
+	}
+	return res, nil
+}
+
+type fromChainsWalker struct {
+	maxChains int
+	// The chains already found per path node id, since many chains share the same beginning
+	done    map[int64][]PathChain
+	tooMany bool
+}
+
+func (w *fromChainsWalker) chainsTo(pn *PathNodeDb) []PathChain {
+	if pn.id > 0 {
+		if res, ok := w.done[pn.id]; ok {
+			return res
+		}
+	}
+	var res []PathChain
+	if pn.IsRoot() {
+		res = []PathChain{{PathChainLink{pn, 0}}}
+	} else {
+		td := pn.GetTrioDetails()
+		for i := 0; i < NbConnections && !w.tooMany; i++ {
+			if !pn.IsFrom(i) {
+				continue
+			}
+			fromPn := pn.getLinkedNode(i)
+			if fromPn == nil {
+				continue
+			}
+			// The from connection goes from this node to the previous one
+			link := PathChainLink{pn, td.GetConnections()[i].GetNegId()}
+			for _, fromChain := range w.chainsTo(fromPn) {
+				if len(res) >= w.maxChains {
+					w.tooMany = true
+					break
+				}
+				chain := make(PathChain, len(fromChain), len(fromChain)+1)
+				copy(chain, fromChain)
+				res = append(res, append(chain, link))
+			}
+		}
+	}
+	if pn.id > 0 {
+		w.done[pn.id] = res
+	}
+	return res
+}
+
+// The end node of the chain
+func (pc PathChain) GetLast() PathNode {
+	if len(pc) == 0 {
+		return nil
+	}
+	return pc[len(pc)-1].PathNode
+}
+
+func (pc PathChain) GetPoints() []m3point.Point {
+	res := make([]m3point.Point, len(pc))
+	for i, link := range pc {
+		res[i] = link.PathNode.P()
+	}
+	return res
+}
+
+// One line per node with its distance, point, trio and the connection coming to it
+func (pc PathChain) String() string {
+	sb := strings.Builder{}
+	for _, link := range pc {
+		pn := link.PathNode
+		connStr := "root"
+		if link.ConnId != 0 {
+			connStr = link.ConnId.String()
+		}
+		sb.WriteString(fmt.Sprintf("d=%3d %v trio=%2d conn=%s\n", pn.D(), pn.P(), pn.GetTrioIndex(), connStr))
+	}
+	return sb.String()
+}
+
+// The text form of all the chains, numbered
+func FromChainsString(chains []PathChain) string {
+	sb := strings.Builder{}
+	for i, pc := range chains {
+		sb.WriteString(fmt.Sprintf("Chain %d of %d:\n", i+1, len(chains)))
+		sb.WriteString(pc.String())
+	}
+	return sb.String()
+}
@@ -0,0 +1,65 @@
+package m3path
+
+import (
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestFromChains(t *testing.T) {
+	Log.SetInfo()
+	Log.SetAssert(true)
+	m3point.Log.SetInfo()
+	m3db.SetToTestMode()
+
+	env := GetFullTestDb(m3db.PathTestEnv)
+	InitializeDBEnv(env)
+	ppd := m3point.GetPointPackData(env)
+
+	pathCtx := MakePathContextDBFromGrowthContext(env, ppd.GetGrowthContextById(40), 0)
+	pathCtx.InitRootNode(m3point.Origin)
+	root := pathCtx.GetRootPathNode()
+	chains, err := GetFromChains(root, DefaultMaxFromChains)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(chains))
+	assert.Equal(t, PathChain{{root, 0}}, chains[0])
+
+	for d := 0; d < 6; d++ {
+		pathCtx.MoveToNextNodes()
+	}
+	var multiple PathNode
+	nbMultiple := 0
+	for _, pn := range pathCtx.GetAllOpenPathNodes() {
+		chains, err := GetFromChains(pn, DefaultMaxFromChains)
+		if !assert.Nil(t, err) {
+			return
+		}
+		assert.True(t, len(chains) > 0, "no chain to %s", pn.String())
+		if len(chains) > 1 {
+			multiple = pn
+			nbMultiple++
+		}
+		for _, chain := range chains {
+			assert.Equal(t, pn.D()+1, len(chain))
+			assert.Equal(t, pn.P(), chain.GetLast().P())
+			points := chain.GetPoints()
+			assert.Equal(t, m3point.Origin, points[0])
+			for i := 1; i < len(chain); i++ {
+				assert.Equal(t, i, chain[i].PathNode.D())
+				cd := ppd.GetConnDetailsById(chain[i].ConnId)
+				assert.Equal(t, points[i], points[i-1].Add(cd.Vector), "wrong connection %s in %s", chain[i].ConnId, chain.String())
+			}
+			assert.Equal(t, len(chain), strings.Count(chain.String(), "\n"))
+		}
+	}
+	Log.Infof("%d open nodes at d=6 have more than one from-chain", nbMultiple)
+
+	if assert.NotNil(t, multiple) {
+		chains, err = GetFromChains(multiple, 1)
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, len(chains))
+		assert.True(t, strings.HasPrefix(FromChainsString(chains), "Chain 1 of 1:\nd=  0"))
+	}
+}
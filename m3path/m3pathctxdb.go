@@ -15,8 +15,10 @@ type PathContextDb struct {
 	id              int
 	growthCtx       m3point.GrowthContext
 	growthOffset    int
+	absoluteField   bool
 	rootNode        *PathNodeDb
 	openNodeBuilder *OpenNodeBuilder
+	listeners       PathContextListeners
 }
 
 func MakePathContextDBFromGrowthContext(env *m3db.QsmEnvironment, growthCtx m3point.GrowthContext, offset int) PathContext {
@@ -78,6 +80,9 @@ func (pathCtx *PathContextDb) insertInDb() error {
 }
 
 func (pathCtx *PathContextDb) String() string {
+	if pathCtx.absoluteField {
+		return fmt.Sprintf("PathDB%d-%s-%d-Abs", pathCtx.id, pathCtx.growthCtx.String(), pathCtx.growthOffset)
+	}
 	return fmt.Sprintf("PathDB%d-%s-%d", pathCtx.id, pathCtx.growthCtx.String(), pathCtx.growthOffset)
 }
 
@@ -101,6 +106,28 @@ func (pathCtx *PathContextDb) GetGrowthIndex() int {
 	return pathCtx.growthCtx.GetGrowthIndex()
 }
 
+func (pathCtx *PathContextDb) IsAbsoluteTrioField() bool {
+	return pathCtx.absoluteField
+}
+
+// In absolute mode the path builders come from the global trio field of the growth context at the real points.
+// Otherwise the root is centered on origin and all the builders are translated. Set it before the root node init.
+func (pathCtx *PathContextDb) SetAbsoluteTrioField(absolute bool) {
+	if pathCtx.rootNode != nil {
+		Log.Errorf("cannot change the trio field mode of path context %s after its root node was created", pathCtx.String())
+		return
+	}
+	pathCtx.absoluteField = absolute
+}
+
+// The point used to find the path builders: the real one in absolute mode, relative to the root otherwise
+func (pathCtx *PathContextDb) getBuilderPoint(p m3point.Point) m3point.Point {
+	if pathCtx.absoluteField {
+		return p
+	}
+	return p.Sub(pathCtx.rootNode.P())
+}
+
 func (pathCtx *PathContextDb) GetPathNodeMap() PathNodeMap {
 	Log.Fatalf("in DB path context %s never call GetPathNodeMap", pathCtx.String())
 	return nil
@@ -114,8 +141,16 @@ func (pathCtx *PathContextDb) InitRootNode(center m3point.Point) {
 
 	ppd := m3point.GetPointPackData(pathCtx.GetGrowthCtx().GetEnv())
 
-	// the path builder enforce origin as the center
-	nodeBuilder := ppd.GetPathNodeBuilder(pathCtx.growthCtx, pathCtx.growthOffset, m3point.Origin)
+	// the path builder enforce origin as the center, unless using the absolute trio field
+	builderCenter := m3point.Origin
+	if pathCtx.absoluteField {
+		if !center.IsMainPoint() {
+			Log.Fatalf("the root node of absolute trio field path context %s should be a main point not %v", pathCtx.String(), center)
+			return
+		}
+		builderCenter = center
+	}
+	nodeBuilder := ppd.GetPathNodeBuilder(pathCtx.growthCtx, pathCtx.growthOffset, builderCenter)
 
 	rootNode := getNewPathNodeDb()
 
@@ -219,9 +254,9 @@ func (pathCtx *PathContextDb) makeNewNodes(current, next *OpenNodeBuilder, on *P
 			nbBlocked++
 		case ConnectionNotSet:
 			cd := td.GetConnections()[i]
-			center := pathCtx.rootNode.P()
-			npnb, np := pnb.GetNextPathNodeBuilder(on.P().Sub(center), cd.GetId(), pathCtx.GetGrowthOffset())
-			np = np.Add(center)
+			builderPoint := pathCtx.getBuilderPoint(on.P())
+			npnb, np := pnb.GetNextPathNodeBuilder(builderPoint, cd.GetId(), pathCtx.GetGrowthOffset())
+			np = np.Add(on.P().Sub(builderPoint))
 
 			pId := getOrCreatePointTe(pathCtx.pointsTe(), np)
 
@@ -240,6 +275,7 @@ func (pathCtx *PathContextDb) makeNewNodes(current, next *OpenNodeBuilder, on *P
 					pnIdInDB := pathCtx.getPathNodeIdByPoint(pId)
 					if pnIdInDB > 0 {
 						next.selectConflict++
+						pathCtx.listeners.pathNodeConflict(pathCtx, SelectConflict, np, next.d)
 						// point back to old distance outgrowth so dead end
 						on.setDeadEnd(i)
 					} else {
@@ -275,6 +311,7 @@ var nbParallelProcesses = 8
 func (pathCtx *PathContextDb) MoveToNextNodes() {
 	current := pathCtx.openNodeBuilder
 	next := createNewNodeBuilder(current)
+	pathCtx.listeners.moveStarted(pathCtx, next.d)
 
 	current.openNodesMap.Range(func(point m3point.Point, pn PathNode) bool {
 		on := pn.(*PathNodeDb)
@@ -313,6 +350,7 @@ func (pathCtx *PathContextDb) MoveToNextNodes() {
 		} else {
 			if on.state == InConflictNode {
 				next.insertConflict++
+				pathCtx.listeners.pathNodeConflict(pathCtx, InsertConflict, on.P(), on.d)
 			}
 		}
 		return false
@@ -335,12 +373,21 @@ func (pathCtx *PathContextDb) MoveToNextNodes() {
 	Log.Infof("%s dist=%d : move from %d to %d open nodes with %d %d conflicts", pathCtx.String(), next.d, current.openNodesSize(), next.openNodesSize(), next.selectConflict, next.insertConflict)
 	pathCtx.openNodeBuilder = next
 	current.clear()
+	pathCtx.listeners.moveFinished(pathCtx, next.d, next.openNodesSize())
 }
 
 func (pathCtx *PathContextDb) PredictedNextOpenNodesLen() int {
 	return pathCtx.openNodeBuilder.nextOpenNodesLen()
 }
 
+func (pathCtx *PathContextDb) AddListener(l PathContextListener) {
+	pathCtx.listeners.add(l)
+}
+
+func (pathCtx *PathContextDb) RemoveListener(l PathContextListener) {
+	pathCtx.listeners.remove(l)
+}
+
 func (pathCtx *PathContextDb) dumpInfo() string {
 	return pathCtx.String()
 }
@@ -89,3 +89,50 @@ func TestMakeNewPathCtx(t *testing.T) {
 	Log.Infof("Total move next DB test took %v", moveNext.Sub(rootCreated))
 
 }
+
+func TestAbsoluteTrioField(t *testing.T) {
+	Log.SetInfo()
+	m3point.Log.SetInfo()
+	m3db.SetToTestMode()
+	env := GetFullTestDb(m3db.PathTestEnv)
+	InitializeDBEnv(env)
+	ppd := m3point.GetPointPackData(env)
+
+	growthCtx := ppd.GetGrowthContextById(40)
+	center := m3point.Point{9, 0, 9}
+	assert.NotEqual(t, growthCtx.GetBaseTrioIndex(growthCtx.GetBaseDivByThree(center), 0),
+		growthCtx.GetBaseTrioIndex(growthCtx.GetBaseDivByThree(m3point.Origin), 0))
+
+	for _, absolute := range []bool{false, true} {
+		pathCtx := MakePathContextDBFromGrowthContext(env, growthCtx, 0)
+		assert.False(t, pathCtx.IsAbsoluteTrioField())
+		pathCtx.SetAbsoluteTrioField(absolute)
+		assert.Equal(t, absolute, pathCtx.IsAbsoluteTrioField())
+		pathCtx.InitRootNode(center)
+		// Not changing after the root node creation
+		pathCtx.SetAbsoluteTrioField(!absolute)
+		assert.Equal(t, absolute, pathCtx.IsAbsoluteTrioField())
+
+		fieldCenter := m3point.Origin
+		if absolute {
+			fieldCenter = center
+		}
+		for d := 0; d < 6; d++ {
+			nbMainPoints := 0
+			for _, pn := range pathCtx.GetAllOpenPathNodes() {
+				p := pn.P()
+				if !p.IsMainPoint() {
+					continue
+				}
+				nbMainPoints++
+				fieldPoint := p.Sub(center).Add(fieldCenter)
+				assert.Equal(t, growthCtx.GetBaseTrioIndex(growthCtx.GetBaseDivByThree(fieldPoint), 0), pn.GetTrioIndex(),
+					"%s at d=%d main point %v", pathCtx.String(), d, p)
+			}
+			if d%3 == 0 {
+				assert.True(t, nbMainPoints > 0, "%s at d=%d", pathCtx.String(), d)
+			}
+			pathCtx.MoveToNextNodes()
+		}
+	}
+}
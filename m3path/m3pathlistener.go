@@ -0,0 +1,84 @@
+package m3path
+
+import (
+	"github.com/freddy33/qsm-go/m3point"
+)
+
+type PathNodeConflictKind int
+
+const (
+	// A path node of the same path context already exists in DB at this point
+	SelectConflict PathNodeConflictKind = iota
+	// Inserting the new path node in DB conflicted with an existing one
+	InsertConflict
+)
+
+// Receive the steps of a path context.
+// The path context moves its open nodes in parallel, so implementations must be safe for concurrent calls.
+type PathContextListener interface {
+	MoveStarted(pathCtx PathContext, d int)
+	MoveFinished(pathCtx PathContext, d int, nbOpenNodes int)
+	PathNodeConflict(pathCtx PathContext, kind PathNodeConflictKind, p m3point.Point, d int)
+}
+
+// Empty implementation to embed when only some callbacks are needed
+type BasePathContextListener struct{}
+
+// The list of listeners registered on one path context
+type PathContextListeners []PathContextListener
+
+/***************************************************************/
+// BasePathContextListener Functions
+/***************************************************************/
+
+func (l *BasePathContextListener) MoveStarted(pathCtx PathContext, d int) {
+}
+
+func (l *BasePathContextListener) MoveFinished(pathCtx PathContext, d int, nbOpenNodes int) {
+}
+
+func (l *BasePathContextListener) PathNodeConflict(pathCtx PathContext, kind PathNodeConflictKind, p m3point.Point, d int) {
+}
+
+/***************************************************************/
+// PathContextListeners Functions
+/***************************************************************/
+
+func (ll *PathContextListeners) add(l PathContextListener) {
+	if l == nil {
+		return
+	}
+	for _, el := range *ll {
+		if el == l {
+			return
+		}
+	}
+	*ll = append(*ll, l)
+}
+
+func (ll *PathContextListeners) remove(l PathContextListener) {
+	for i, el := range *ll {
+		if el == l {
+			*ll = append((*ll)[:i], (*ll)[i+1:]...)
+			return
+		}
+	}
+}
+
+func (ll PathContextListeners) moveStarted(pathCtx PathContext, d int) {
+	for _, l := range ll {
+		l.MoveStarted(pathCtx, d)
+	}
+}
+
+func (ll PathContextListeners) moveFinished(pathCtx PathContext, d int, nbOpenNodes int) {
+	for _, l := range ll {
+		l.MoveFinished(pathCtx, d, nbOpenNodes)
+	}
+}
+
+func (ll PathContextListeners) pathNodeConflict(pathCtx PathContext, kind PathNodeConflictKind, p m3point.Point, d int) {
+	for _, l := range ll {
+		l.PathNodeConflict(pathCtx, kind, p, d)
+	}
+}
@@ -0,0 +1,60 @@
+package m3path
+
+import (
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type recordingPathListener struct {
+	BasePathContextListener
+	mutex       sync.Mutex
+	started     []int
+	finished    []int
+	openNodes   []int
+	nbConflicts int
+}
+
+func (rl *recordingPathListener) MoveStarted(pathCtx PathContext, d int) {
+	rl.started = append(rl.started, d)
+}
+
+func (rl *recordingPathListener) MoveFinished(pathCtx PathContext, d int, nbOpenNodes int) {
+	rl.finished = append(rl.finished, d)
+	rl.openNodes = append(rl.openNodes, nbOpenNodes)
+}
+
+func (rl *recordingPathListener) PathNodeConflict(pathCtx PathContext, kind PathNodeConflictKind, p m3point.Point, d int) {
+	rl.mutex.Lock()
+	rl.nbConflicts++
+	rl.mutex.Unlock()
+}
+
+func TestPathContextListener(t *testing.T) {
+	Log.SetWarn()
+	m3point.Log.SetWarn()
+	m3db.SetToTestMode()
+	env := GetFullTestDb(m3db.PathTestEnv)
+	InitializeDBEnv(env)
+
+	ppd := m3point.GetPointPackData(env)
+	pathCtx := MakePathContextDBFromGrowthContext(env, ppd.GetGrowthContextById(40), 0)
+	listener := new(recordingPathListener)
+	pathCtx.AddListener(listener)
+	pathCtx.InitRootNode(m3point.Origin)
+
+	for d := 0; d < 5; d++ {
+		pathCtx.MoveToNextNodes()
+		assert.Equal(t, d+1, len(listener.started))
+		assert.Equal(t, d+1, listener.started[d])
+		assert.Equal(t, d+1, listener.finished[d])
+		assert.Equal(t, pathCtx.GetNumberOfOpenNodes(), listener.openNodes[d])
+	}
+	assert.Equal(t, 3, listener.openNodes[0])
+
+	pathCtx.RemoveListener(listener)
+	pathCtx.MoveToNextNodes()
+	assert.Equal(t, 5, len(listener.finished))
+}
@@ -527,6 +527,43 @@ func (pn *PathNodeDb) GetNextConnection(connId m3point.ConnectionId) int64 {
 	return LinkIdNotSet
 }
 
+// The path node linked at this connection index loaded from DB, nil if the link is not set or not yet in DB
+func (pn *PathNodeDb) getLinkedNode(connIdx int) *PathNodeDb {
+	linkId := pn.linkNodeIds[connIdx]
+	if linkId <= 0 {
+		return nil
+	}
+	return pn.PathCtx().getPathNodeDb(linkId)
+}
+
+func (pn *PathNodeDb) GetFromNodes() []PathNode {
+	pn.check()
+	res := make([]PathNode, 0, NbConnections)
+	for i := 0; i < NbConnections; i++ {
+		if pn.getConnectionState(i) == ConnectionFrom {
+			fromPn := pn.getLinkedNode(i)
+			if fromPn != nil {
+				res = append(res, fromPn)
+			}
+		}
+	}
+	return res
+}
+
+func (pn *PathNodeDb) GetNextNodes() []PathNode {
+	pn.check()
+	res := make([]PathNode, 0, NbConnections)
+	for i := 0; i < NbConnections; i++ {
+		if pn.getConnectionState(i) == ConnectionNext {
+			nextPn := pn.getLinkedNode(i)
+			if nextPn != nil {
+				res = append(res, nextPn)
+			}
+		}
+	}
+	return res
+}
+
 func (pn *PathNodeDb) setFrom(connId m3point.ConnectionId, fromNode *PathNodeDb) error {
 	td := pn.GetTrioDetails()
 	for i, cd := range td.GetConnections() {
@@ -560,6 +597,8 @@ type ErrorType int
 const (
 	ConnectionNotFound ErrorType = iota
 	ConnectionNotAvailable
+	NotDbPathNode
+	TooManyPathChains
 )
 
 type QsmModelError struct {
@@ -0,0 +1,106 @@
+package m3path
+
+// Called for each path node reached by a walk with its distance in links from the start node.
+// Returning true stops the walk.
+type PathNodeVisitor func(pn PathNode, dist int) bool
+
+type WalkDirection int
+
+const (
+	// Follow the next links going away from the root
+	WalkNext WalkDirection = iota
+	// Follow the from links going back to the root
+	WalkFrom
+)
+
+type pathWalker struct {
+	dir       WalkDirection
+	maxDist   int
+	visitor   PathNodeVisitor
+	visited   map[int64]bool
+	nbVisited int
+}
+
+/***************************************************************/
+// Walk Functions
+/***************************************************************/
+
+// Visit the path nodes reachable from start up to maxDist links, all the nodes at one distance before the next one.
+// Since each link changes d by one, the distance of a node is the difference of d with the start node.
+// The nodes are identified by their DB id, so walk only between path context moves. Returns the number of visited nodes.
+func WalkBreadthFirst(start PathNode, dir WalkDirection, maxDist int, visitor PathNodeVisitor) int {
+	w := makePathWalker(dir, maxDist, visitor)
+	w.visited[start.GetId()] = true
+	current := []PathNode{start}
+	for dist := 0; len(current) > 0; dist++ {
+		next := make([]PathNode, 0)
+		for _, pn := range current {
+			w.nbVisited++
+			if w.visitor(pn, dist) {
+				return w.nbVisited
+			}
+			if dist < w.maxDist {
+				next = append(next, w.notVisited(pn)...)
+			}
+		}
+		current = next
+	}
+	return w.nbVisited
+}
+
+// Visit the path nodes reachable from start up to maxDist links, following each link as far as possible first.
+// Returns the number of visited nodes.
+func WalkDepthFirst(start PathNode, dir WalkDirection, maxDist int, visitor PathNodeVisitor) int {
+	w := makePathWalker(dir, maxDist, visitor)
+	w.visited[start.GetId()] = true
+	w.depthFirst(start, 0)
+	return w.nbVisited
+}
+
+// Breadth first walk of the path nodes of the path context from the root up to distance maxD
+func WalkPathContext(pathCtx PathContext, maxD int, visitor PathNodeVisitor) int {
+	root := pathCtx.GetRootPathNode()
+	if root == nil {
+		Log.Errorf("cannot walk path context %s without root node", pathCtx.String())
+		return 0
+	}
+	return WalkBreadthFirst(root, WalkNext, maxD, visitor)
+}
+
+func makePathWalker(dir WalkDirection, maxDist int, visitor PathNodeVisitor) *pathWalker {
+	return &pathWalker{dir, maxDist, visitor, make(map[int64]bool), 0}
+}
+
+func (w *pathWalker) depthFirst(pn PathNode, dist int) bool {
+	w.nbVisited++
+	if w.visitor(pn, dist) {
+		return true
+	}
+	if dist >= w.maxDist {
+		return false
+	}
+	for _, npn := range w.notVisited(pn) {
+		if w.depthFirst(npn, dist+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// The linked nodes in the walk direction not visited yet, marked as visited
+func (w *pathWalker) notVisited(pn PathNode) []PathNode {
+	var linked []PathNode
+	if w.dir == WalkFrom {
+		linked = pn.GetFromNodes()
+	} else {
+		linked = pn.GetNextNodes()
+	}
+	res := make([]PathNode, 0, len(linked))
+	for _, npn := range linked {
+		if !w.visited[npn.GetId()] {
+			w.visited[npn.GetId()] = true
+			res = append(res, npn)
+		}
+	}
+	return res
+}
@@ -0,0 +1,147 @@
+package m3path
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// A path node of an in memory graph to test the walkers without DB
+type testWalkNode struct {
+	id        int64
+	d         int
+	fromNodes []PathNode
+	nextNodes []PathNode
+}
+
+func (tn *testWalkNode) String() string                       { return fmt.Sprintf("TN%d-%d", tn.id, tn.d) }
+func (tn *testWalkNode) GetId() int64                         { return tn.id }
+func (tn *testWalkNode) GetPathContext() PathContext          { return nil }
+func (tn *testWalkNode) IsRoot() bool                         { return tn.d == 0 }
+func (tn *testWalkNode) IsLatest() bool                       { return len(tn.nextNodes) == 0 }
+func (tn *testWalkNode) P() m3point.Point                     { return m3point.Point{m3point.CInt(tn.id), 0, 0} }
+func (tn *testWalkNode) D() int                               { return tn.d }
+func (tn *testWalkNode) GetTrioIndex() m3point.TrioIndex      { return m3point.NilTrioIndex }
+func (tn *testWalkNode) HasOpenConnections() bool             { return false }
+func (tn *testWalkNode) IsFrom(connIdx int) bool              { return false }
+func (tn *testWalkNode) IsNext(connIdx int) bool              { return false }
+func (tn *testWalkNode) IsDeadEnd(connIdx int) bool           { return false }
+func (tn *testWalkNode) GetFromNodes() []PathNode             { return tn.fromNodes }
+func (tn *testWalkNode) GetNextNodes() []PathNode             { return tn.nextNodes }
+func (tn *testWalkNode) GetTrioDetails() *m3point.TrioDetails { return nil }
+
+// A diamond graph per level: each node has 2 next nodes shared with its neighbour
+func makeTestWalkGraph(maxD int) []*testWalkNode {
+	nodes := []*testWalkNode{{1, 0, nil, nil}}
+	level := nodes
+	for d := 1; d <= maxD; d++ {
+		nextLevel := make([]*testWalkNode, len(level)+1)
+		for i := range nextLevel {
+			nextLevel[i] = &testWalkNode{int64(len(nodes) + 1), d, nil, nil}
+			nodes = append(nodes, nextLevel[i])
+		}
+		for i, tn := range level {
+			for _, next := range nextLevel[i : i+2] {
+				tn.nextNodes = append(tn.nextNodes, next)
+				next.fromNodes = append(next.fromNodes, tn)
+			}
+		}
+		level = nextLevel
+	}
+	return nodes
+}
+
+func TestWalkTestGraph(t *testing.T) {
+	nodes := makeTestWalkGraph(5)
+	// 1 + 2 + 3 + 4 + 5 + 6
+	assert.Equal(t, 21, len(nodes))
+	root := nodes[0]
+
+	for _, walk := range []func(PathNode, WalkDirection, int, PathNodeVisitor) int{WalkBreadthFirst, WalkDepthFirst} {
+		seen := make(map[int64]int)
+		nb := walk(root, WalkNext, 3, func(pn PathNode, dist int) bool {
+			_, ok := seen[pn.GetId()]
+			assert.False(t, ok, "node %s visited twice", pn.String())
+			seen[pn.GetId()] = dist
+			assert.Equal(t, pn.D(), dist)
+			return false
+		})
+		assert.Equal(t, 10, nb)
+		assert.Equal(t, 10, len(seen))
+
+		last := nodes[len(nodes)-3]
+		nb = walk(last, WalkFrom, 10, func(pn PathNode, dist int) bool {
+			assert.Equal(t, last.D()-pn.D(), dist)
+			return false
+		})
+		// All the nodes above in the cone of the last level node
+		assert.Equal(t, 1+2+3+3+2+1, nb)
+
+		nb = walk(root, WalkNext, 10, func(pn PathNode, dist int) bool {
+			return dist == 2
+		})
+		assert.True(t, nb <= 6)
+	}
+
+	order := make([]int, 0)
+	WalkBreadthFirst(root, WalkNext, 5, func(pn PathNode, dist int) bool {
+		order = append(order, pn.D())
+		return false
+	})
+	for i := 1; i < len(order); i++ {
+		assert.True(t, order[i-1] <= order[i], "breadth first went back from %d to %d", order[i-1], order[i])
+	}
+	assert.Equal(t, 21, len(order))
+}
+
+func TestWalkPathContext(t *testing.T) {
+	Log.SetInfo()
+	Log.SetAssert(true)
+	m3point.Log.SetInfo()
+	m3db.SetToTestMode()
+
+	env := GetFullTestDb(m3db.PathTestEnv)
+	InitializeDBEnv(env)
+	ppd := m3point.GetPointPackData(env)
+
+	pathCtx := MakePathContextDBFromGrowthContext(env, ppd.GetGrowthContextById(40), 0)
+	pathCtx.InitRootNode(m3point.Origin)
+	for d := 0; d < 5; d++ {
+		pathCtx.MoveToNextNodes()
+	}
+
+	nbPerD := make(map[int]int)
+	nb := WalkPathContext(pathCtx, 4, func(pn PathNode, dist int) bool {
+		assert.Equal(t, pn.D(), dist)
+		nbPerD[dist]++
+		for _, fromPn := range pn.GetFromNodes() {
+			assert.Equal(t, pn.D()-1, fromPn.D())
+		}
+		for _, nextPn := range pn.GetNextNodes() {
+			assert.Equal(t, pn.D()+1, nextPn.D())
+		}
+		return false
+	})
+	assert.Equal(t, 1, nbPerD[0])
+	assert.Equal(t, 3, nbPerD[1])
+	assert.Equal(t, 5, len(nbPerD))
+
+	nbDepth := WalkDepthFirst(pathCtx.GetRootPathNode(), WalkNext, 4, func(pn PathNode, dist int) bool {
+		assert.Equal(t, pn.D(), dist)
+		return false
+	})
+	assert.Equal(t, nb, nbDepth)
+
+	// All the open nodes go back to the root
+	for _, pn := range pathCtx.GetAllOpenPathNodes() {
+		reachedRoot := false
+		WalkBreadthFirst(pn, WalkFrom, pn.D(), func(fromPn PathNode, dist int) bool {
+			assert.Equal(t, pn.D()-dist, fromPn.D())
+			reachedRoot = fromPn.IsRoot()
+			return reachedRoot
+		})
+		assert.True(t, reachedRoot, "no from path from %s to root", pn.String())
+	}
+}
@@ -71,7 +71,10 @@ func (ppd *PointPackData) saveAllConnectionDetails() (int, error) {
 		return 0, err
 	}
 	if toFill {
-		connections, _ := ppd.calculateConnectionDetails()
+		connections := ppd.allConnections
+		if !ppd.connectionsLoaded {
+			connections, _ = ppd.calculateConnectionDetails()
+		}
 		if Log.IsDebug() {
 			Log.Debugf("Populating table %s with %d elements", te.TableDef.Name, len(connections))
 		}
@@ -119,7 +122,10 @@ func (ppd *PointPackData) saveAllTrioDetails() (int, error) {
 	}
 
 	if toFill {
-		trios := ppd.calculateAllTrioDetails()
+		trios := ppd.allTrioDetails
+		if !ppd.trioDetailsLoaded {
+			trios = ppd.calculateAllTrioDetails()
+		}
 		if Log.IsDebug() {
 			Log.Debugf("Populating table %s with %d elements", te.TableDef.Name, len(trios))
 		}
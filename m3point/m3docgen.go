@@ -17,6 +17,7 @@ func GenerateTextFilesEnv(env *m3db.QsmEnvironment) {
 	ppd.writeAllTrioPermutationsTable(genDoc)
 	ppd.writeTrioConnectionsTable(genDoc)
 	ppd.writeAllConnectionDetails(genDoc)
+	ppd.writeLinkedDocs(genDoc)
 }
 
 type Int2 struct {
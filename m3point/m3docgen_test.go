@@ -1,10 +1,97 @@
 package m3point
 
 import (
+	"encoding/json"
 	"github.com/freddy33/qsm-go/m3db"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
 func TestWriteAllTables(t *testing.T) {
 	GenerateTextFilesEnv(GetFullTestDb(m3db.PointTestEnv))
-}
\ No newline at end of file
+}
+
+func TestPointPackDoc(t *testing.T) {
+	ppd := GetReferencePointPackData()
+	doc := ppd.GetPointPackDoc()
+	assert.Equal(t, len(ppd.allConnections), len(doc.Connections))
+	assert.Equal(t, len(ppd.allTrioDetails), len(doc.Trios))
+	assert.Equal(t, 64, len(doc.Transitions))
+	assert.Equal(t, 3*12, len(doc.Permutations))
+	assert.Equal(t, totalNbContexts, len(doc.GrowthContexts))
+	assert.Equal(t, TotalNumberOfCubes, len(doc.Cubes))
+	nbCubes := 0
+	for _, dgc := range doc.GrowthContexts {
+		nbCubes += len(dgc.CubeIds)
+	}
+	assert.Equal(t, TotalNumberOfCubes, nbCubes)
+	for i, dc := range doc.Cubes {
+		assert.Equal(t, i+1, dc.Id)
+	}
+	for _, dc := range doc.Connections {
+		for _, trIdx := range dc.Trios {
+			assert.True(t, ppd.GetTrioDetails(TrioIndex(trIdx)).HasConnection(dc.Id), "trio %d does not have %s", trIdx, dc.Name)
+		}
+	}
+
+	jsonData, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	read := PointPackDoc{}
+	assert.Nil(t, json.Unmarshal(jsonData, &read))
+	assert.Equal(t, *doc, read)
+}
+
+func TestPointPackDocLinks(t *testing.T) {
+	tables := GetReferencePointPackData().GetPointPackDoc().getTables()
+	anchors := make(map[string]bool)
+	for _, table := range tables {
+		anchors[table.page+"#top"] = true
+		for _, row := range table.rows {
+			assert.False(t, anchors[table.page+"#"+row.anchor], "duplicate anchor %s in %s", row.anchor, table.page)
+			anchors[table.page+"#"+row.anchor] = true
+		}
+	}
+	nbLinks := 0
+	for _, table := range tables {
+		for _, row := range table.rows {
+			assert.Equal(t, len(table.headers), len(row.cells))
+			for _, cell := range row.cells {
+				for _, l := range cell {
+					if l.page != "" {
+						nbLinks++
+						assert.True(t, anchors[l.page+"#"+l.anchor], "link %s#%s in %s not found", l.page, l.anchor, table.page)
+					}
+				}
+			}
+		}
+	}
+	assert.True(t, nbLinks > TotalNumberOfCubes)
+
+	trios := tables[1]
+	assert.Equal(t, DocPageTrios, trios.page)
+	md := trios.toMarkdown(docNavigation(tables, docLink.toMarkdown))
+	assert.Contains(t, md, "| <a id=\"t00\"></a>[T00](trios.md#t00) | [CP04](connections.md#cp04)")
+	assert.Contains(t, md, "[Cubes](cubes.md#top)")
+	page := trios.toHtml(docNavigation(tables, docLink.toHtml))
+	assert.Contains(t, page, "<tr id=\"t00\"><td><a href=\"trios.html#t00\">T00</a></td><td><a href=\"connections.html#cp04\">CP04</a>")
+}
+
+func TestWriteLinkedDocs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qsmdoc")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	GetReferencePointPackData().writeLinkedDocs(dir)
+	for _, page := range []string{DocPageIndex, DocPageConnections, DocPageTrios, DocPageTransitions, DocPagePermutations, DocPageGrowthContexts, DocPageCubes} {
+		for _, ext := range []string{"md", "html"} {
+			data, err := ioutil.ReadFile(filepath.Join(dir, ext, page+"."+ext))
+			assert.Nil(t, err)
+			assert.True(t, strings.Contains(string(data), "top"), "%s.%s", page, ext)
+		}
+	}
+	_, err = os.Stat(filepath.Join(dir, "json", DocJsonFileName))
+	assert.Nil(t, err)
+}
@@ -0,0 +1,505 @@
+package m3point
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3util"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/***************************************************************/
+// Type declaration
+/***************************************************************/
+
+// All the point pack data in a form ready for JSON, Markdown and HTML documentation.
+// Variable length lists of trio indexes are int slices since a slice of uint8 is written as base64 in JSON.
+type PointPackDoc struct {
+	Connections    []*DocConnection    `json:"connections"`
+	Trios          []*DocTrio          `json:"trios"`
+	Transitions    []*DocTransition    `json:"transitions"`
+	Permutations   []*DocPermutation   `json:"permutations"`
+	GrowthContexts []*DocGrowthContext `json:"growthContexts"`
+	Cubes          []*DocCube          `json:"cubes"`
+}
+
+type DocConnection struct {
+	Id     ConnectionId `json:"id"`
+	Name   string       `json:"name"`
+	Vector Point        `json:"vector"`
+	DS     DInt         `json:"ds"`
+	NegId  ConnectionId `json:"negId"`
+	// The trios using this connection
+	Trios []int `json:"trios"`
+}
+
+type DocTrio struct {
+	Id      TrioIndex       `json:"id"`
+	Name    string          `json:"name"`
+	Conns   [3]ConnectionId `json:"conns"`
+	DSIndex int             `json:"dsIndex"`
+	IsBase  bool            `json:"isBase"`
+}
+
+// The 6 connections between the points of 2 base trios
+type DocTransition struct {
+	From     TrioIndex       `json:"from"`
+	To       TrioIndex       `json:"to"`
+	ConnType string          `json:"connType"`
+	Conns    [6]ConnectionId `json:"conns"`
+}
+
+type DocPermutation struct {
+	GrowthType  GrowthType `json:"growthType"`
+	Index       int        `json:"index"`
+	Trios       []int      `json:"trios"`
+	GrowthCtxId int        `json:"growthCtxId"`
+}
+
+type DocGrowthContext struct {
+	Id          int        `json:"id"`
+	Name        string     `json:"name"`
+	GrowthType  GrowthType `json:"growthType"`
+	GrowthIndex int        `json:"growthIndex"`
+	// The base trio of the main points with div by three values 0 to 7 at offset 0
+	BaseTrios [8]TrioIndex `json:"baseTrios"`
+	CubeIds   []int        `json:"cubeIds"`
+}
+
+type DocCube struct {
+	Id          int           `json:"id"`
+	GrowthCtxId int           `json:"growthCtxId"`
+	Center      TrioIndex     `json:"center"`
+	CenterFaces [6]TrioIndex  `json:"centerFaces"`
+	MiddleEdges [12]TrioIndex `json:"middleEdges"`
+}
+
+// A page of the doc with one anchored row per entry
+type docTable struct {
+	page    string
+	title   string
+	headers []string
+	rows    []docRow
+}
+
+type docRow struct {
+	anchor string
+	cells  [][]docLink
+}
+
+// A text pointing to the anchor of a row in a page, or plain text if page is empty
+type docLink struct {
+	text   string
+	page   string
+	anchor string
+}
+
+/***************************************************************/
+// Global fields declaration
+/***************************************************************/
+
+const (
+	DocPageConnections    = "connections"
+	DocPageTrios          = "trios"
+	DocPageTransitions    = "transitions"
+	DocPagePermutations   = "permutations"
+	DocPageGrowthContexts = "growthcontexts"
+	DocPageCubes          = "cubes"
+	DocPageIndex          = "index"
+	DocJsonFileName       = "PointPackDoc.json"
+)
+
+var middleEdgeNames = [12]string{"+X+Y", "+X-Y", "+X+Z", "+X-Z", "-X+Y", "-X-Y", "-X+Z", "-X-Z", "+Y+Z", "+Y-Z", "-Y+Z", "-Y-Z"}
+var centerFaceNames = [6]string{"+X", "-X", "+Y", "-Y", "+Z", "-Z"}
+
+/***************************************************************/
+// PointPackDoc Functions
+/***************************************************************/
+
+func (ppd *PointPackData) GetPointPackDoc() *PointPackDoc {
+	ppd.checkPathBuildersInitialized()
+	doc := &PointPackDoc{}
+
+	triosPerConn := make(map[ConnectionId][]int)
+	for _, td := range ppd.allTrioDetails {
+		dt := &DocTrio{td.id, fmt.Sprintf("T%02d", td.id), [3]ConnectionId{}, td.GetDSIndex(), td.IsBaseTrio()}
+		for i, cd := range td.conns {
+			dt.Conns[i] = cd.Id
+			triosPerConn[cd.Id] = append(triosPerConn[cd.Id], int(td.id))
+		}
+		doc.Trios = append(doc.Trios, dt)
+	}
+
+	for _, cd := range ppd.allConnections {
+		doc.Connections = append(doc.Connections, &DocConnection{cd.Id, cd.String(), cd.Vector, cd.ConnDS, cd.GetNegId(), triosPerConn[cd.Id]})
+	}
+
+	for a, tA := range allBaseTrio {
+		for b, tB := range allBaseTrio {
+			conns := GetNonBaseConnections(tA, tB)
+			dt := &DocTransition{TrioIndex(a), TrioIndex(b), strings.TrimSpace(GetTrioConnType(conns)), [6]ConnectionId{}}
+			for i, conn := range conns {
+				dt.Conns[i] = ppd.getConnDetailsByVector(conn).GetId()
+			}
+			doc.Transitions = append(doc.Transitions, dt)
+		}
+	}
+
+	for i, perm := range validNextTrio {
+		doc.addPermutation(ppd, GrowthType(2), i, perm[:])
+	}
+	for i, perm := range AllMod4Permutations {
+		doc.addPermutation(ppd, GrowthType(4), i, perm[:])
+	}
+	for i, perm := range AllMod8Permutations {
+		doc.addPermutation(ppd, GrowthType(8), i, perm[:])
+	}
+
+	for _, growthCtx := range ppd.GetAllGrowthContexts() {
+		dgc := &DocGrowthContext{growthCtx.GetId(), growthCtx.String(), growthCtx.GetGrowthType(), growthCtx.GetGrowthIndex(),
+			[8]TrioIndex{}, ppd.GetCubeIdsOfGrowthContext(growthCtx)}
+		for d := range dgc.BaseTrios {
+			dgc.BaseTrios[d] = growthCtx.GetBaseTrioIndex(uint64(d), 0)
+		}
+		doc.GrowthContexts = append(doc.GrowthContexts, dgc)
+	}
+
+	doc.Cubes = make([]*DocCube, len(ppd.cubeIdsPerKey))
+	for cubeKey, cubeId := range ppd.cubeIdsPerKey {
+		cube := cubeKey.cube
+		doc.Cubes[cubeId-1] = &DocCube{cubeId, cubeKey.trCtxId, cube.center, cube.centerFaces, cube.middleEdges}
+	}
+	return doc
+}
+
+func (doc *PointPackDoc) addPermutation(ppd *PointPackData, growthType GrowthType, index int, perm []TrioIndex) {
+	trios := make([]int, len(perm))
+	for i, trIdx := range perm {
+		trios[i] = int(trIdx)
+	}
+	growthCtxId := ppd.GetGrowthContextByTypeAndIndex(growthType, index).GetId()
+	doc.Permutations = append(doc.Permutations, &DocPermutation{growthType, index, trios, growthCtxId})
+}
+
+/***************************************************************/
+// Doc Links Functions
+/***************************************************************/
+
+func connDocLink(connId ConnectionId) docLink {
+	return docLink{connId.String(), DocPageConnections, strings.ToLower(connId.String())}
+}
+
+func trioDocLink(trIdx TrioIndex) docLink {
+	name := fmt.Sprintf("T%02d", trIdx)
+	return docLink{name, DocPageTrios, strings.ToLower(name)}
+}
+
+func transitionDocAnchor(from, to TrioIndex) string {
+	return fmt.Sprintf("tr%d-%d", from, to)
+}
+
+func permDocLink(growthType GrowthType, index int) docLink {
+	name := fmt.Sprintf("Mod%d-%02d", growthType, index)
+	return docLink{name, DocPagePermutations, strings.ToLower(name)}
+}
+
+func growthCtxDocLink(growthCtxId int) docLink {
+	name := fmt.Sprintf("Ctx%02d", growthCtxId)
+	return docLink{name, DocPageGrowthContexts, strings.ToLower(name)}
+}
+
+func cubeDocLink(cubeId int) docLink {
+	name := fmt.Sprintf("Cube%d", cubeId)
+	return docLink{name, DocPageCubes, strings.ToLower(name)}
+}
+
+func textDocCell(format string, args ...interface{}) []docLink {
+	return []docLink{{fmt.Sprintf(format, args...), "", ""}}
+}
+
+func trioDocCell(trios ...TrioIndex) []docLink {
+	res := make([]docLink, len(trios))
+	for i, trIdx := range trios {
+		res[i] = trioDocLink(trIdx)
+	}
+	return res
+}
+
+func intTrioDocCell(trios []int) []docLink {
+	res := make([]docLink, len(trios))
+	for i, trIdx := range trios {
+		res[i] = trioDocLink(TrioIndex(trIdx))
+	}
+	return res
+}
+
+func connDocCell(connIds ...ConnectionId) []docLink {
+	res := make([]docLink, len(connIds))
+	for i, connId := range connIds {
+		res[i] = connDocLink(connId)
+	}
+	return res
+}
+
+/***************************************************************/
+// Doc Tables Functions
+/***************************************************************/
+
+// The pages in the order of the index
+func (doc *PointPackDoc) getTables() []*docTable {
+	return []*docTable{
+		doc.getConnectionsTable(),
+		doc.getTriosTable(),
+		doc.getTransitionsTable(),
+		doc.getPermutationsTable(),
+		doc.getGrowthContextsTable(),
+		doc.getCubesTable(),
+	}
+}
+
+func (doc *PointPackDoc) getConnectionsTable() *docTable {
+	table := &docTable{DocPageConnections, "Connections", []string{"Connection", "Vector", "DS", "Opposite", "Trios"}, nil}
+	for _, dc := range doc.Connections {
+		link := connDocLink(dc.Id)
+		table.rows = append(table.rows, docRow{link.anchor, [][]docLink{
+			{link},
+			textDocCell("%v", dc.Vector),
+			textDocCell("%d", dc.DS),
+			{connDocLink(dc.NegId)},
+			intTrioDocCell(dc.Trios),
+		}})
+	}
+	return table
+}
+
+func (doc *PointPackDoc) getTriosTable() *docTable {
+	table := &docTable{DocPageTrios, "Trios", []string{"Trio", "Connections", "DS Index", "Base", "Transitions"}, nil}
+	for _, dt := range doc.Trios {
+		link := trioDocLink(dt.Id)
+		transitions := textDocCell("")
+		if dt.IsBase {
+			transitions = []docLink{{"from", DocPageTransitions, transitionDocAnchor(dt.Id, 0)}}
+		}
+		table.rows = append(table.rows, docRow{link.anchor, [][]docLink{
+			{link},
+			connDocCell(dt.Conns[:]...),
+			textDocCell("%d", dt.DSIndex),
+			textDocCell("%t", dt.IsBase),
+			transitions,
+		}})
+	}
+	return table
+}
+
+func (doc *PointPackDoc) getTransitionsTable() *docTable {
+	table := &docTable{DocPageTransitions, "Base Trio Transitions", []string{"From", "To", "Type", "Connections"}, nil}
+	for _, dt := range doc.Transitions {
+		table.rows = append(table.rows, docRow{transitionDocAnchor(dt.From, dt.To), [][]docLink{
+			trioDocCell(dt.From),
+			trioDocCell(dt.To),
+			textDocCell("%s", dt.ConnType),
+			connDocCell(dt.Conns[:]...),
+		}})
+	}
+	return table
+}
+
+func (doc *PointPackDoc) getPermutationsTable() *docTable {
+	table := &docTable{DocPagePermutations, "Trio Index Permutations", []string{"Permutation", "Trios", "Growth Context"}, nil}
+	for _, dp := range doc.Permutations {
+		link := permDocLink(dp.GrowthType, dp.Index)
+		table.rows = append(table.rows, docRow{link.anchor, [][]docLink{
+			{link},
+			intTrioDocCell(dp.Trios),
+			{growthCtxDocLink(dp.GrowthCtxId)},
+		}})
+	}
+	return table
+}
+
+func (doc *PointPackDoc) getGrowthContextsTable() *docTable {
+	table := &docTable{DocPageGrowthContexts, "Growth Contexts",
+		[]string{"Growth Context", "Name", "Type", "Index", "Permutation", "Base Trios", "Cubes"}, nil}
+	for _, dgc := range doc.GrowthContexts {
+		link := growthCtxDocLink(dgc.Id)
+		perm := textDocCell("")
+		if dgc.GrowthType.IsPermutation() {
+			perm = []docLink{permDocLink(dgc.GrowthType, dgc.GrowthIndex)}
+		}
+		cubes := make([]docLink, len(dgc.CubeIds))
+		for i, cubeId := range dgc.CubeIds {
+			cubes[i] = cubeDocLink(cubeId)
+		}
+		table.rows = append(table.rows, docRow{link.anchor, [][]docLink{
+			{link},
+			textDocCell("%s", dgc.Name),
+			textDocCell("%d", dgc.GrowthType),
+			textDocCell("%d", dgc.GrowthIndex),
+			perm,
+			trioDocCell(dgc.BaseTrios[:]...),
+			cubes,
+		}})
+	}
+	return table
+}
+
+func (doc *PointPackDoc) getCubesTable() *docTable {
+	headers := []string{"Cube", "Growth Context", "Center"}
+	for _, name := range centerFaceNames {
+		headers = append(headers, name)
+	}
+	for _, name := range middleEdgeNames {
+		headers = append(headers, name)
+	}
+	table := &docTable{DocPageCubes, "Cubes", headers, nil}
+	for _, dc := range doc.Cubes {
+		link := cubeDocLink(dc.Id)
+		cells := [][]docLink{{link}, {growthCtxDocLink(dc.GrowthCtxId)}, trioDocCell(dc.Center)}
+		for _, trIdx := range dc.CenterFaces {
+			cells = append(cells, trioDocCell(trIdx))
+		}
+		for _, trIdx := range dc.MiddleEdges {
+			cells = append(cells, trioDocCell(trIdx))
+		}
+		table.rows = append(table.rows, docRow{link.anchor, cells})
+	}
+	return table
+}
+
+/***************************************************************/
+// Markdown and HTML Functions
+/***************************************************************/
+
+func (l docLink) toMarkdown() string {
+	if l.page == "" {
+		return strings.Replace(l.text, "|", "\\|", -1)
+	}
+	return fmt.Sprintf("[%s](%s.md#%s)", l.text, l.page, l.anchor)
+}
+
+func (l docLink) toHtml() string {
+	if l.page == "" {
+		return html.EscapeString(l.text)
+	}
+	return fmt.Sprintf("<a href=\"%s.html#%s\">%s</a>", l.page, l.anchor, html.EscapeString(l.text))
+}
+
+func docNavigation(tables []*docTable, toLink func(docLink) string) string {
+	links := []string{toLink(docLink{"Index", DocPageIndex, "top"})}
+	for _, table := range tables {
+		links = append(links, toLink(docLink{table.title, table.page, "top"}))
+	}
+	return strings.Join(links, " | ")
+}
+
+func (table *docTable) toMarkdown(nav string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<a id=\"top\"></a>\n\n# %s\n\n%s\n\n", table.title, nav))
+	sb.WriteString("| " + strings.Join(table.headers, " | ") + " |\n")
+	sb.WriteString(strings.Repeat("|---", len(table.headers)) + "|\n")
+	for _, row := range table.rows {
+		for i, cell := range row.cells {
+			sb.WriteString("| ")
+			if i == 0 {
+				sb.WriteString(fmt.Sprintf("<a id=\"%s\"></a>", row.anchor))
+			}
+			texts := make([]string, len(cell))
+			for j, l := range cell {
+				texts[j] = l.toMarkdown()
+			}
+			sb.WriteString(strings.Join(texts, ", ") + " ")
+		}
+		sb.WriteString("|\n")
+	}
+	return sb.String()
+}
+
+func (table *docTable) toHtml(nav string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body id=\"top\">\n", table.title))
+	sb.WriteString(fmt.Sprintf("<h1>%s</h1>\n<p>%s</p>\n<table border=\"1\">\n<tr>", table.title, nav))
+	for _, header := range table.headers {
+		sb.WriteString("<th>" + html.EscapeString(header) + "</th>")
+	}
+	sb.WriteString("</tr>\n")
+	for _, row := range table.rows {
+		sb.WriteString(fmt.Sprintf("<tr id=\"%s\">", row.anchor))
+		for _, cell := range row.cells {
+			texts := make([]string, len(cell))
+			for j, l := range cell {
+				texts[j] = l.toHtml()
+			}
+			sb.WriteString("<td>" + strings.Join(texts, ", ") + "</td>")
+		}
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("</table>\n</body>\n</html>\n")
+	return sb.String()
+}
+
+func docIndexMarkdown(tables []*docTable) string {
+	var sb strings.Builder
+	sb.WriteString("<a id=\"top\"></a>\n\n# Point Pack Data\n\n")
+	for _, table := range tables {
+		sb.WriteString(fmt.Sprintf("- %s: %d entries\n", docLink{table.title, table.page, "top"}.toMarkdown(), len(table.rows)))
+	}
+	sb.WriteString(fmt.Sprintf("\nAll the data in JSON in [%s](../json/%s)\n", DocJsonFileName, DocJsonFileName))
+	return sb.String()
+}
+
+func docIndexHtml(tables []*docTable) string {
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>Point Pack Data</title>\n</head>\n<body id=\"top\">\n")
+	sb.WriteString("<h1>Point Pack Data</h1>\n<ul>\n")
+	for _, table := range tables {
+		sb.WriteString(fmt.Sprintf("<li>%s: %d entries</li>\n", docLink{table.title, table.page, "top"}.toHtml(), len(table.rows)))
+	}
+	sb.WriteString(fmt.Sprintf("</ul>\n<p>All the data in JSON in <a href=\"../json/%s\">%s</a></p>\n</body>\n</html>\n", DocJsonFileName, DocJsonFileName))
+	return sb.String()
+}
+
+/***************************************************************/
+// Write Functions
+/***************************************************************/
+
+func getDocSubDir(dir, subPath string) string {
+	p := filepath.Join(dir, subPath)
+	err := os.MkdirAll(p, 0755)
+	if err != nil {
+		Log.Fatalf("could not create doc dir %s due to error %v", p, err)
+		return ""
+	}
+	return p
+}
+
+func writeDocFile(dir, fileName, content string) {
+	file := m3util.CreateFile(dir, fileName)
+	defer m3util.CloseFile(file)
+	m3util.WriteNextString(file, content)
+}
+
+// Write the cross linked Markdown and HTML pages, and the JSON file, in md, html and json sub dirs
+func (ppd *PointPackData) writeLinkedDocs(dir string) {
+	doc := ppd.GetPointPackDoc()
+	tables := doc.getTables()
+
+	mdDir := getDocSubDir(dir, "md")
+	htmlDir := getDocSubDir(dir, "html")
+	mdNav := docNavigation(tables, docLink.toMarkdown)
+	htmlNav := docNavigation(tables, docLink.toHtml)
+	writeDocFile(mdDir, DocPageIndex+".md", docIndexMarkdown(tables))
+	writeDocFile(htmlDir, DocPageIndex+".html", docIndexHtml(tables))
+	for _, table := range tables {
+		writeDocFile(mdDir, table.page+".md", table.toMarkdown(mdNav))
+		writeDocFile(htmlDir, table.page+".html", table.toHtml(htmlNav))
+	}
+
+	jsonData, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		Log.Fatalf("could not marshal point pack doc due to %v", err)
+		return
+	}
+	writeDocFile(getDocSubDir(dir, "json"), DocJsonFileName, string(jsonData))
+}
@@ -7,7 +7,7 @@ import (
 
 /*
 Define how outgrowth and path evolve from the center. There are 6 types of growth depending of the value of growthType:
-TODO: Create trio index for non nextMainPoint points base on growth type
+Non nextMainPoint points get the trio index of the intermediate path node builder of their near main point, see GetTrioIndexAt.
 1. type = 0 : Type not yet existing TODO: Main points will not be covered. In here trio index switch from trio to next that has neg conn
 2. type = 1 : All nextMainPoint points have the same base trio index
 3. type = 3 : Rotate between valid trios depending on starting index in modulo 3
@@ -140,6 +140,24 @@ func (gowthCtx *BaseGrowthContext) GetBaseDivByThree(mainPoint Point) uint64 {
 	return uint64(AbsDIntFromC(mainPoint[0])/3 + AbsDIntFromC(mainPoint[1])/3 + AbsDIntFromC(mainPoint[2])/3)
 }
 
+// The trio index of the trio field at any lattice point. Main points have their base trio index.
+// The other points are the intermediate points of their near main point, and get the same trio index than the
+// IntermediatePathNodeBuilder of the root builder there. NilTrioIndex if not connected to the near main point.
+func (gowthCtx *BaseGrowthContext) GetTrioIndexAt(p Point, offset int) TrioIndex {
+	if p.IsMainPoint() {
+		return gowthCtx.GetBaseTrioIndex(gowthCtx.GetBaseDivByThree(p), offset)
+	}
+	ppd := GetPointPackData(gowthCtx.env)
+	mainPoint := p.GetNearMainPoint()
+	cd, ok := ppd.getAllConnDetailsByVector()[MakeVector(mainPoint, p)]
+	if !ok || !ppd.getBaseTrioDetails(gowthCtx, mainPoint, offset).HasConnection(cd.GetId()) {
+		return NilTrioIndex
+	}
+	pnb := ppd.GetPathNodeBuilder(gowthCtx, offset, mainPoint)
+	ipnb, _ := pnb.GetNextPathNodeBuilder(mainPoint, cd.GetId(), offset)
+	return ipnb.GetTrioIndex()
+}
+
 func (gowthCtx *BaseGrowthContext) GetBaseTrioIndex(divByThree uint64, offset int) TrioIndex {
 	ctxTrIdx := TrioIndex(gowthCtx.growthIndex)
 	if gowthCtx.growthType == 1 {
@@ -121,3 +121,54 @@ func assertValidConnDetails(t *testing.T, ppd *PointPackData, p1, p2 Point, msg
 	assert.NotEqual(t, EmptyConnDetails, connDetails2, msg)
 	assert.Equal(t, MakeVector(p2, p1), connDetails2.Vector, msg)
 }
+
+func TestTrioIndexAtAllPoints(t *testing.T) {
+	m3db.SetToTestMode()
+	env := GetFullTestDb(m3db.PointTestEnv)
+	ppd := GetPointPackData(env)
+	for _, growthCtx := range ppd.GetAllGrowthContexts() {
+		maxOffset := growthCtx.GetGrowthType().GetMaxOffset()
+		for offset := 0; offset < maxOffset; offset++ {
+			runTrioIndexAtCheck(t, ppd, growthCtx, offset)
+		}
+	}
+}
+
+func runTrioIndexAtCheck(t *testing.T, ppd *PointPackData, growthCtx GrowthContext, offset int) {
+	min := CInt(-3)
+	max := CInt(3)
+	nbIntermediate := 0
+	for x := min; x <= max; x++ {
+		for y := min; y <= max; y++ {
+			for z := min; z <= max; z++ {
+				mainPoint := Point{x, y, z}.Mul(3)
+				pnb := ppd.GetPathNodeBuilder(growthCtx, offset, mainPoint)
+				assert.Equal(t, pnb.GetTrioIndex(), growthCtx.GetTrioIndexAt(mainPoint, offset), "%s-%d main point %v", growthCtx.String(), offset, mainPoint)
+				td := ppd.GetTrioDetails(pnb.GetTrioIndex())
+				for _, cd := range td.GetConnections() {
+					ipnb, ip := pnb.GetNextPathNodeBuilder(mainPoint, cd.GetId(), offset)
+					assert.Equal(t, ipnb.GetTrioIndex(), growthCtx.GetTrioIndexAt(ip, offset), "%s-%d intermediate point %v", growthCtx.String(), offset, ip)
+					nbIntermediate++
+					// The last intermediate points are intermediate points of another main point
+					for _, pl := range ipnb.(*IntermediatePathNodeBuilder).pathLinks {
+						lipnb, lip := ipnb.GetNextPathNodeBuilder(ip, pl.connId, offset)
+						assert.Equal(t, lipnb.GetTrioIndex(), growthCtx.GetTrioIndexAt(lip, offset), "%s-%d last intermediate point %v", growthCtx.String(), offset, lip)
+					}
+				}
+			}
+		}
+	}
+	// 3 intermediate points per main point, all the other points around main points are out of the field
+	nbInField := 0
+	for x := 3*min - 1; x <= 3*max+1; x++ {
+		for y := 3*min - 1; y <= 3*max+1; y++ {
+			for z := 3*min - 1; z <= 3*max+1; z++ {
+				p := Point{x, y, z}
+				if !p.IsMainPoint() && growthCtx.GetTrioIndexAt(p, offset) != NilTrioIndex {
+					nbInField++
+				}
+			}
+		}
+	}
+	assert.Equal(t, nbIntermediate, nbInField, "%s-%d", growthCtx.String(), offset)
+}
@@ -55,7 +55,10 @@ func (ppd *PointPackData) saveAllGrowthContexts() (int, error) {
 		return 0, err
 	}
 	if toFill {
-		growthContexts := ppd.calculateAllGrowthContexts()
+		growthContexts := ppd.allGrowthContexts
+		if !ppd.growthContextsLoaded {
+			growthContexts = ppd.calculateAllGrowthContexts()
+		}
 		if Log.IsDebug() {
 			Log.Debugf("Populating table %s with %d elements", te.TableDef.Name, len(growthContexts))
 		}
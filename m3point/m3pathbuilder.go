@@ -125,25 +125,24 @@ func (rpnb *RootPathNodeBuilder) GetNextPathNodeBuilder(from Point, connId Conne
 }
 
 func (rpnb *RootPathNodeBuilder) verify() {
+	logVerifyFailures(rpnb.checkLinks())
+}
+
+// The failures of the path links connections not part of the trio or not all different
+func (rpnb *RootPathNodeBuilder) checkLinks() []string {
+	var res []string
 	td := rpnb.GetPointPackData().GetTrioDetails(rpnb.trIdx)
-	if !td.HasConnection(rpnb.pathLinks[0].connId) {
-		Log.Errorf("%s failed checking next path link 0 %s part of trio", rpnb.String(), rpnb.pathLinks[0].connId)
-	}
-	if !td.HasConnection(rpnb.pathLinks[1].connId) {
-		Log.Errorf("%s failed checking next path link 1 %s part of trio", rpnb.String(), rpnb.pathLinks[1].connId)
-	}
-	if !td.HasConnection(rpnb.pathLinks[2].connId) {
-		Log.Errorf("%s failed checking next path link 2 %s part of trio", rpnb.String(), rpnb.pathLinks[2].connId)
-	}
-	if rpnb.pathLinks[0].connId == rpnb.pathLinks[1].connId {
-		Log.Errorf("%s failed checking next path links 0 and 1 connections are different", rpnb.String(), rpnb.pathLinks[0].connId, rpnb.pathLinks[1].connId)
-	}
-	if rpnb.pathLinks[0].connId == rpnb.pathLinks[2].connId {
-		Log.Errorf("%s failed checking next path links 0 and 2 connections are different", rpnb.String(), rpnb.pathLinks[0].connId, rpnb.pathLinks[2].connId)
-	}
-	if rpnb.pathLinks[1].connId == rpnb.pathLinks[2].connId {
-		Log.Errorf("%s failed checking next path links 1 and 2 connections are different", rpnb.String(), rpnb.pathLinks[1].connId, rpnb.pathLinks[2].connId)
+	for i, pl := range rpnb.pathLinks {
+		if !td.HasConnection(pl.connId) {
+			res = append(res, fmt.Sprintf("%s failed checking next path link %d %s part of trio", rpnb.String(), i, pl.connId))
+		}
+		for j := i + 1; j < len(rpnb.pathLinks); j++ {
+			if pl.connId == rpnb.pathLinks[j].connId {
+				res = append(res, fmt.Sprintf("%s failed checking next path links %d and %d connections %s are different", rpnb.String(), i, j, pl.connId))
+			}
+		}
 	}
+	return res
 }
 
 type NextMainPathNode struct {
@@ -271,16 +270,21 @@ func (ipnb *IntermediatePathNodeBuilder) GetNextPathNodeBuilder(from Point, conn
 }
 
 func (ipnb *IntermediatePathNodeBuilder) verify() {
+	logVerifyFailures(ipnb.checkLinks())
+}
+
+func (ipnb *IntermediatePathNodeBuilder) checkLinks() []string {
+	var res []string
 	td := ipnb.GetPointPackData().GetTrioDetails(ipnb.trIdx)
-	if !td.HasConnection(ipnb.pathLinks[0].connId) {
-		Log.Errorf("%s failed checking next path link 0 %s part of trio", ipnb.String(), ipnb.pathLinks[0].connId)
-	}
-	if !td.HasConnection(ipnb.pathLinks[1].connId) {
-		Log.Errorf("%s failed checking next path link 1 %s part of trio", ipnb.String(), ipnb.pathLinks[1].connId)
+	for i, pl := range ipnb.pathLinks {
+		if !td.HasConnection(pl.connId) {
+			res = append(res, fmt.Sprintf("%s failed checking next path link %d %s part of trio", ipnb.String(), i, pl.connId))
+		}
 	}
 	if ipnb.pathLinks[0].connId == ipnb.pathLinks[1].connId {
-		Log.Errorf("%s failed checking next path links connections are different", ipnb.String(), ipnb.pathLinks[0].connId, ipnb.pathLinks[1].connId)
+		res = append(res, fmt.Sprintf("%s failed checking next path links connections %s are different", ipnb.String(), ipnb.pathLinks[0].connId))
 	}
+	return res
 }
 
 /***************************************************************/
@@ -321,14 +325,20 @@ func (lipnb *LastIntermediatePathNodeBuilder) GetNextPathNodeBuilder(from Point,
 }
 
 func (lipnb *LastIntermediatePathNodeBuilder) verify() {
+	logVerifyFailures(lipnb.checkLinks())
+}
+
+func (lipnb *LastIntermediatePathNodeBuilder) checkLinks() []string {
+	var res []string
 	td := lipnb.GetPointPackData().GetTrioDetails(lipnb.trIdx)
 	if !td.HasConnection(lipnb.nextMainConnId) {
-		Log.Errorf("%s %s %s failed checking next main connection part of trio", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId)
+		res = append(res, fmt.Sprintf("%s %s %s failed checking next main connection part of trio", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId))
 	}
 	if !td.HasConnection(lipnb.nextInterConnId) {
-		Log.Errorf("%s %s %s failed checking next intermediate connection part of trio", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId)
+		res = append(res, fmt.Sprintf("%s %s %s failed checking next intermediate connection part of trio", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId))
 	}
 	if lipnb.nextMainConnId == lipnb.nextInterConnId {
-		Log.Errorf("%s %s %s failed checking next main and intermediate connections are different", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId)
+		res = append(res, fmt.Sprintf("%s %s %s failed checking next main and intermediate connections are different", lipnb.String(), lipnb.nextMainConnId, lipnb.nextInterConnId))
 	}
+	return res
 }
@@ -123,7 +123,10 @@ func (ppd *PointPackData) saveAllPathBuilders() (int, error) {
 		return 0, err
 	}
 	if toFill {
-		builders := ppd.calculateAllPathBuilders()
+		builders := ppd.pathBuilders
+		if !ppd.pathBuildersLoaded {
+			builders = ppd.calculateAllPathBuilders()
+		}
 		if Log.IsDebug() {
 			Log.Debugf("Populating table %s with %d elements", te.TableDef.Name, len(builders)-1)
 		}
@@ -0,0 +1,253 @@
+package m3point
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3util"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The graph of the path node builders of some cubes, ready for DOT or JSON export
+type PathBuilderGraph struct {
+	Nodes []*PathBuilderGraphNode `json:"nodes"`
+	Edges []*PathBuilderGraphEdge `json:"edges"`
+}
+
+type PathBuilderGraphNode struct {
+	Id          string    `json:"id"`
+	Kind        string    `json:"kind"`
+	CubeId      int       `json:"cubeId"`
+	GrowthCtxId int       `json:"growthCtxId"`
+	TrioIndex   TrioIndex `json:"trioIndex"`
+}
+
+type PathBuilderGraphEdge struct {
+	From   string       `json:"from"`
+	To     string       `json:"to"`
+	Kind   string       `json:"kind"`
+	ConnId ConnectionId `json:"connId"`
+	Conn   string       `json:"conn"`
+}
+
+// The kinds of nodes and edges. The last intermediate nodes go out of the cube tree through their next main and
+// next intermediate connections, shown as edges to one next main and one next intermediate node per cube.
+const (
+	PbRootNode      = "root"
+	PbInterNode     = "intermediate"
+	PbLastInterNode = "lastIntermediate"
+	PbNextMainNode  = "nextMain"
+	PbNextInterNode = "nextIntermediate"
+	PbLinkEdge      = "link"
+	PbNextMainEdge  = "nextMain"
+	PbNextInterEdge = "nextIntermediate"
+)
+
+const (
+	PbExportFormatDot  = "dot"
+	PbExportFormatJson = "json"
+)
+
+type PathBuilderExportOptions struct {
+	CubeId      int
+	GrowthCtxId int
+	Format      string
+	Output      string
+	// Use the embedded reference data instead of the DB
+	Reference bool
+}
+
+/***************************************************************/
+// PathBuilderGraph Functions
+/***************************************************************/
+
+// All the cube ids of the growth context ordered
+func (ppd *PointPackData) GetCubeIdsOfGrowthContext(growthCtx GrowthContext) []int {
+	ppd.checkCubesInitialized()
+	res := make([]int, 0)
+	for cubeKey, cubeId := range ppd.cubeIdsPerKey {
+		if cubeKey.trCtxId == growthCtx.GetId() {
+			res = append(res, cubeId)
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+func (ppd *PointPackData) GetPathBuilderGraph(cubeIds ...int) *PathBuilderGraph {
+	ppd.checkPathBuildersInitialized()
+	graph := &PathBuilderGraph{}
+	for _, cubeId := range cubeIds {
+		if cubeId <= 0 || cubeId >= len(ppd.pathBuilders) || ppd.pathBuilders[cubeId] == nil {
+			Log.Errorf("no path builder for cube id %d", cubeId)
+			continue
+		}
+		graph.addRootBuilder(ppd.pathBuilders[cubeId])
+	}
+	return graph
+}
+
+func (graph *PathBuilderGraph) addNode(id, kind string, ctx *PathBuilderContext, trIdx TrioIndex) {
+	graph.Nodes = append(graph.Nodes, &PathBuilderGraphNode{id, kind, ctx.cubeId, ctx.growthCtx.GetId(), trIdx})
+}
+
+func (graph *PathBuilderGraph) addEdge(from, to, kind string, connId ConnectionId) {
+	graph.Edges = append(graph.Edges, &PathBuilderGraphEdge{from, to, kind, connId, connId.String()})
+}
+
+func (graph *PathBuilderGraph) addRootBuilder(rpnb *RootPathNodeBuilder) {
+	ctx := rpnb.ctx
+	rootId := fmt.Sprintf("c%d", ctx.cubeId)
+	nextMainId := rootId + "_nm"
+	nextInterId := rootId + "_ni"
+	graph.addNode(rootId, PbRootNode, ctx, rpnb.trIdx)
+	graph.addNode(nextMainId, PbNextMainNode, ctx, NilTrioIndex)
+	graph.addNode(nextInterId, PbNextInterNode, ctx, NilTrioIndex)
+	for i, pl := range rpnb.pathLinks {
+		ipnb := pl.pathNode.(*IntermediatePathNodeBuilder)
+		interId := fmt.Sprintf("%s_i%d", rootId, i)
+		graph.addNode(interId, PbInterNode, ctx, ipnb.trIdx)
+		graph.addEdge(rootId, interId, PbLinkEdge, pl.connId)
+		for j, ipl := range ipnb.pathLinks {
+			lipnb := ipl.pathNode.(*LastIntermediatePathNodeBuilder)
+			lastId := fmt.Sprintf("%s_l%d", interId, j)
+			graph.addNode(lastId, PbLastInterNode, ctx, lipnb.trIdx)
+			graph.addEdge(interId, lastId, PbLinkEdge, ipl.connId)
+			graph.addEdge(lastId, nextMainId, PbNextMainEdge, lipnb.nextMainConnId)
+			graph.addEdge(lastId, nextInterId, PbNextInterEdge, lipnb.nextInterConnId)
+		}
+	}
+}
+
+func (graph *PathBuilderGraph) WriteJson(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(graph)
+}
+
+// Write the graph in Graphviz DOT format, one cluster per cube
+func (graph *PathBuilderGraph) WriteDot(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("digraph PathBuilders {\n")
+	sb.WriteString("\trankdir=LR;\n")
+	sb.WriteString("\tnode [fontname=\"Helvetica\", fontsize=10];\n")
+	sb.WriteString("\tedge [fontname=\"Helvetica\", fontsize=9];\n")
+	cubeId := -1
+	for _, node := range graph.Nodes {
+		if node.CubeId != cubeId {
+			if cubeId != -1 {
+				sb.WriteString("\t}\n")
+			}
+			cubeId = node.CubeId
+			sb.WriteString(fmt.Sprintf("\tsubgraph cluster_%d {\n", cubeId))
+			sb.WriteString(fmt.Sprintf("\t\tlabel=\"Cube %d GrowthCtx %d\";\n", cubeId, node.GrowthCtxId))
+		}
+		switch node.Kind {
+		case PbRootNode:
+			sb.WriteString(fmt.Sprintf("\t\t%s [label=\"RNB\\n%s\", shape=doublecircle];\n", node.Id, node.TrioIndex.String()))
+		case PbInterNode:
+			sb.WriteString(fmt.Sprintf("\t\t%s [label=\"INB\\n%s\", shape=circle];\n", node.Id, node.TrioIndex.String()))
+		case PbLastInterNode:
+			sb.WriteString(fmt.Sprintf("\t\t%s [label=\"LINB\\n%s\", shape=circle];\n", node.Id, node.TrioIndex.String()))
+		case PbNextMainNode:
+			sb.WriteString(fmt.Sprintf("\t\t%s [label=\"next main\", shape=box, style=dashed];\n", node.Id))
+		case PbNextInterNode:
+			sb.WriteString(fmt.Sprintf("\t\t%s [label=\"next intermediate\", shape=box, style=dashed];\n", node.Id))
+		}
+	}
+	if cubeId != -1 {
+		sb.WriteString("\t}\n")
+	}
+	for _, edge := range graph.Edges {
+		switch edge.Kind {
+		case PbLinkEdge:
+			sb.WriteString(fmt.Sprintf("\t%s -> %s [label=\"%s\"];\n", edge.From, edge.To, edge.Conn))
+		case PbNextMainEdge:
+			sb.WriteString(fmt.Sprintf("\t%s -> %s [label=\"%s main\", style=dashed, color=blue];\n", edge.From, edge.To, edge.Conn))
+		case PbNextInterEdge:
+			sb.WriteString(fmt.Sprintf("\t%s -> %s [label=\"%s inter\", style=dashed, color=red];\n", edge.From, edge.To, edge.Conn))
+		}
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+/***************************************************************/
+// Export Functions
+/***************************************************************/
+
+func ParsePathBuilderExportOptions(args []string) (PathBuilderExportOptions, error) {
+	opts := PathBuilderExportOptions{}
+	fs := flag.NewFlagSet("pbexport", flag.ContinueOnError)
+	fs.IntVar(&opts.CubeId, "cube", 0, "the cube id of the path builders to export")
+	fs.IntVar(&opts.GrowthCtxId, "ctx", -1, "the growth context id to export all its cubes path builders")
+	fs.StringVar(&opts.Format, "format", PbExportFormatDot, "dot for Graphviz or json")
+	fs.StringVar(&opts.Output, "out", "", "output file, default in the build export dir")
+	fs.BoolVar(&opts.Reference, "ref", false, "use the embedded reference data instead of the DB")
+	err := fs.Parse(args)
+	if err != nil {
+		return opts, err
+	}
+	if (opts.CubeId <= 0) == (opts.GrowthCtxId < 0) {
+		return opts, fmt.Errorf("one of cube id %d or growth context id %d should be given", opts.CubeId, opts.GrowthCtxId)
+	}
+	if opts.Format != PbExportFormatDot && opts.Format != PbExportFormatJson {
+		return opts, fmt.Errorf("path builder export format %q unknown, should be %s or %s", opts.Format, PbExportFormatDot, PbExportFormatJson)
+	}
+	return opts, nil
+}
+
+// Write the DOT or JSON file of the path builders of the cube or growth context of the options
+func ExportPathBuilders(env *m3db.QsmEnvironment, args []string) error {
+	opts, err := ParsePathBuilderExportOptions(args)
+	if err != nil {
+		return err
+	}
+	var ppd *PointPackData
+	if opts.Reference {
+		ppd = GetReferencePointPackData()
+	} else {
+		InitializeDBEnv(env, false)
+		ppd = GetPointPackData(env)
+	}
+	var graph *PathBuilderGraph
+	name := ""
+	if opts.CubeId > 0 {
+		if opts.CubeId > TotalNumberOfCubes {
+			return fmt.Errorf("cube id %d should be in [1,%d]", opts.CubeId, TotalNumberOfCubes)
+		}
+		graph = ppd.GetPathBuilderGraph(opts.CubeId)
+		name = fmt.Sprintf("pathbuilders-cube-%d", opts.CubeId)
+	} else {
+		if opts.GrowthCtxId >= len(ppd.GetAllGrowthContexts()) {
+			return fmt.Errorf("growth context id %d should be less than %d", opts.GrowthCtxId, len(ppd.GetAllGrowthContexts()))
+		}
+		graph = ppd.GetPathBuilderGraph(ppd.GetCubeIdsOfGrowthContext(ppd.GetGrowthContextById(opts.GrowthCtxId))...)
+		name = fmt.Sprintf("pathbuilders-ctx-%d", opts.GrowthCtxId)
+	}
+	output := opts.Output
+	if output == "" {
+		output = filepath.Join(m3util.GetExportDir(), name+"."+opts.Format)
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer m3util.CloseFile(f)
+	if opts.Format == PbExportFormatJson {
+		err = graph.WriteJson(f)
+	} else {
+		err = graph.WriteDot(f)
+	}
+	if err != nil {
+		return err
+	}
+	Log.Infof("Exported %d path builder nodes to %s", len(graph.Nodes), output)
+	return nil
+}
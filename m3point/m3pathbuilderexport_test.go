@@ -0,0 +1,68 @@
+package m3point
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestPathBuilderGraph(t *testing.T) {
+	ppd := GetReferencePointPackData()
+	graph := ppd.GetPathBuilderGraph(1)
+	// 1 root, 3 intermediate, 6 last intermediate and the 2 next main and intermediate nodes
+	assert.Equal(t, 12, len(graph.Nodes))
+	// 3 + 6 links and 2 transitions per last intermediate
+	assert.Equal(t, 21, len(graph.Edges))
+
+	rpnb := ppd.pathBuilders[1]
+	assert.Equal(t, "c1", graph.Nodes[0].Id)
+	assert.Equal(t, PbRootNode, graph.Nodes[0].Kind)
+	assert.Equal(t, rpnb.trIdx, graph.Nodes[0].TrioIndex)
+	nbNextMain := 0
+	for _, edge := range graph.Edges {
+		if edge.Kind == PbNextMainEdge {
+			nbNextMain++
+			assert.Equal(t, "c1_nm", edge.To)
+		}
+		assert.Equal(t, edge.ConnId.String(), edge.Conn)
+	}
+	assert.Equal(t, 6, nbNextMain)
+	lipnb := rpnb.pathLinks[0].pathNode.(*IntermediatePathNodeBuilder).pathLinks[0].pathNode.(*LastIntermediatePathNodeBuilder)
+	assert.Contains(t, graph.Edges, &PathBuilderGraphEdge{"c1_i0_l0", "c1_ni", PbNextInterEdge, lipnb.nextInterConnId, lipnb.nextInterConnId.String()})
+
+	var buf bytes.Buffer
+	assert.Nil(t, graph.WriteDot(&buf))
+	dot := buf.String()
+	assert.True(t, strings.HasPrefix(dot, "digraph PathBuilders {"))
+	assert.Contains(t, dot, "subgraph cluster_1 {")
+	assert.Contains(t, dot, "c1 -> c1_i0 [label=\""+rpnb.pathLinks[0].connId.String()+"\"];")
+	assert.Contains(t, dot, "c1_i0_l0 -> c1_nm [label=\""+lipnb.nextMainConnId.String()+" main\"")
+
+	buf.Reset()
+	assert.Nil(t, graph.WriteJson(&buf))
+	read := PathBuilderGraph{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &read))
+	assert.Equal(t, *graph, read)
+}
+
+func TestPathBuilderGraphOfGrowthContext(t *testing.T) {
+	ppd := GetReferencePointPackData()
+	growthCtx := ppd.GetGrowthContextByTypeAndIndex(8, 0)
+	cubeIds := ppd.GetCubeIdsOfGrowthContext(growthCtx)
+	assert.Equal(t, len(ppd.getCubeList(growthCtx).allCubes), len(cubeIds))
+	graph := ppd.GetPathBuilderGraph(cubeIds...)
+	assert.Equal(t, 12*len(cubeIds), len(graph.Nodes))
+	var buf bytes.Buffer
+	assert.Nil(t, graph.WriteDot(&buf))
+	assert.Equal(t, len(cubeIds), strings.Count(buf.String(), "subgraph cluster_"))
+
+	_, err := ParsePathBuilderExportOptions([]string{"-cube", "3", "-ctx", "2"})
+	assert.NotNil(t, err)
+	_, err = ParsePathBuilderExportOptions([]string{"-cube", "3", "-format", "svg"})
+	assert.NotNil(t, err)
+	opts, err := ParsePathBuilderExportOptions([]string{"-ctx", "2", "-format", "json", "-ref"})
+	assert.Nil(t, err)
+	assert.Equal(t, PathBuilderExportOptions{0, 2, PbExportFormatJson, "", true}, opts)
+}
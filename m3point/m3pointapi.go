@@ -13,6 +13,7 @@ type GrowthContext interface {
 	GetGrowthIndex() int
 	GetBaseDivByThree(mainPoint Point) uint64
 	GetBaseTrioIndex(divByThree uint64, offset int) TrioIndex
+	GetTrioIndexAt(p Point, offset int) TrioIndex
 }
 
 type PathNodeBuilder interface {
@@ -27,7 +27,11 @@ type PointPackData struct {
 	pathBuildersLoaded bool
 }
 
+// The point pack data of the environment, or the embedded reference one for a nil environment
 func GetPointPackData(env *m3db.QsmEnvironment) *PointPackData {
+	if env == nil {
+		return GetReferencePointPackData()
+	}
 	if env.GetData(m3db.PointIdx) == nil {
 		ppd := new(PointPackData)
 		ppd.env = env
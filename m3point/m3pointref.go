@@ -0,0 +1,413 @@
+package m3point
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3util"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+The point pack reference data is all the connections, trio details, growth contexts, cubes and path builders.
+It is generated by "qsm run genpointref" in the m3pointref_data.go file, gzipped to be embedded in the binary.
+The format is the magic string, the version, then each table as the number of rows, the number of columns,
+and all the values as varint. The columns are the same as the insert of the DB tables.
+*/
+const (
+	PointPackRefMagic   = "QSMPP"
+	PointPackRefVersion = 1
+	PointPackRefFile    = "m3pointref_data.go"
+)
+
+// The tables of the reference data in the order of the file
+var pointPackRefTables = [5]string{ConnectionDetailsTable, TrioDetailsTable, GrowthContextsTable, TrioCubesTable, PathBuildersTable}
+
+type pointPackRows [][]int64
+
+var refPpdOnce sync.Once
+var refPpd *PointPackData
+
+/***************************************************************/
+// Reference PointPackData Functions
+/***************************************************************/
+
+// The point pack data initialized from the embedded reference data, without any DB.
+// Its growth contexts have a nil environment, and GetPointPackData(nil) returns it.
+func GetReferencePointPackData() *PointPackData {
+	refPpdOnce.Do(func() {
+		ppd := new(PointPackData)
+		err := ppd.initFromReference(pointPackReferenceData)
+		if err != nil {
+			Log.Fatalf("could not initialize point pack data from embedded reference version %d due to %v", PointPackRefVersion, err)
+		}
+		refPpd = ppd
+	})
+	return refPpd
+}
+
+// Initialize the point pack data of the environment from the embedded reference data, instead of loading from the DB
+func InitializeFromReference(env *m3db.QsmEnvironment, forced bool) {
+	ppd := GetPointPackData(env)
+	if forced {
+		ppd.resetFlags()
+	}
+	if ppd.connectionsLoaded && ppd.trioDetailsLoaded && ppd.growthContextsLoaded && ppd.cubesLoaded && ppd.pathBuildersLoaded {
+		return
+	}
+	err := ppd.initFromReference(pointPackReferenceData)
+	if err != nil {
+		Log.Fatalf("could not initialize environment %d from embedded reference version %d due to %v", ppd.GetId(), PointPackRefVersion, err)
+	}
+}
+
+// Fill the DB of the environment with the embedded reference data, without recalculating anything
+func FillDbEnvFromReference(env *m3db.QsmEnvironment) {
+	InitializeFromReference(env, true)
+	FillDbEnv(env)
+}
+
+// Calculate all the data in a detached environment without DB, the builders getting the data from their environment
+func calculatePointPackData() *PointPackData {
+	ppd := GetPointPackData(new(m3db.QsmEnvironment))
+	ppd.allConnections, ppd.allConnectionsByVector = ppd.calculateConnectionDetails()
+	ppd.connectionsLoaded = true
+	ppd.allTrioDetails = ppd.calculateAllTrioDetails()
+	ppd.trioDetailsLoaded = true
+	ppd.allGrowthContexts = ppd.calculateAllGrowthContexts()
+	ppd.growthContextsLoaded = true
+	ppd.cubeIdsPerKey = ppd.calculateAllContextCubes()
+	ppd.cubesLoaded = true
+	ppd.pathBuilders = ppd.calculateAllPathBuilders()
+	ppd.pathBuildersLoaded = true
+	return ppd
+}
+
+/***************************************************************/
+// Generate and Verify Functions
+/***************************************************************/
+
+// Calculate all the reference data and write it gzipped in the Go source file of the m3point package
+func GeneratePointPackReference() string {
+	ppd := calculatePointPackData()
+	var buf bytes.Buffer
+	m3util.ExitOnError(ppd.WriteReference(&buf))
+
+	fileName := filepath.Join(m3util.GetGitRootDir(), "m3point", PointPackRefFile)
+	file, err := os.Create(fileName)
+	m3util.ExitOnError(err)
+	defer m3util.CloseFile(file)
+	w := bufio.NewWriter(file)
+	m3util.ExitOnError(writeReferenceSource(w, buf.Bytes()))
+	m3util.ExitOnError(w.Flush())
+	Log.Infof("Generated %s with %d bytes of point pack reference version %d", fileName, buf.Len(), PointPackRefVersion)
+	return fileName
+}
+
+func writeReferenceSource(w io.Writer, data []byte) error {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by \"qsm run genpointref\". DO NOT EDIT.\n\n")
+	sb.WriteString("package m3point\n\n")
+	sb.WriteString(fmt.Sprintf("// The gzipped point pack reference data version %d\n", PointPackRefVersion))
+	sb.WriteString("const pointPackReferenceData = \"\" +\n")
+	lineSize := 32
+	for i := 0; i < len(data); i += lineSize {
+		end := i + lineSize
+		if end > len(data) {
+			end = len(data)
+		}
+		sb.WriteString("\t\"")
+		for _, b := range data[i:end] {
+			sb.WriteString(fmt.Sprintf("\\x%02x", b))
+		}
+		sb.WriteString("\"")
+		if end < len(data) {
+			sb.WriteString(" +")
+		}
+		sb.WriteString("\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Check the embedded reference data is the same as the calculated one
+func VerifyPointPackReference() error {
+	ppd := calculatePointPackData()
+	calculated := ppd.getAllReferenceRows()
+	embedded, err := readReference(pointPackReferenceData)
+	if err != nil {
+		return err
+	}
+	for i, name := range pointPackRefTables {
+		diffs := diffReferenceRows(name, embedded[i], calculated[i])
+		if len(diffs) > 0 {
+			return m3db.MakeQsmErrorf("reference %s", diffs[0])
+		}
+	}
+	return nil
+}
+
+// The differences between the rows of the table and the calculated ones
+func diffReferenceRows(name string, rows, calculated pointPackRows) []string {
+	if len(calculated) != len(rows) {
+		return []string{fmt.Sprintf("table %s has %d rows but %d calculated", name, len(rows), len(calculated))}
+	}
+	var res []string
+	for r, row := range calculated {
+		if fmt.Sprint(row) != fmt.Sprint(rows[r]) {
+			res = append(res, fmt.Sprintf("table %s row %d is %v but %v calculated", name, r, rows[r], row))
+		}
+	}
+	return res
+}
+
+/***************************************************************/
+// Write Functions
+/***************************************************************/
+
+// Write the gzipped reference data of this point pack data
+func (ppd *PointPackData) WriteReference(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	var buf [binary.MaxVarintLen64]byte
+	write := func(v int64) error {
+		n := binary.PutVarint(buf[:], v)
+		_, err := gz.Write(buf[:n])
+		return err
+	}
+	_, err := io.WriteString(gz, PointPackRefMagic)
+	if err != nil {
+		return err
+	}
+	err = write(PointPackRefVersion)
+	if err != nil {
+		return err
+	}
+	for _, rows := range ppd.getAllReferenceRows() {
+		nbCols := 0
+		if len(rows) > 0 {
+			nbCols = len(rows[0])
+		}
+		err = write(int64(len(rows)))
+		if err != nil {
+			return err
+		}
+		err = write(int64(nbCols))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			for _, v := range row {
+				err = write(v)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return gz.Close()
+}
+
+func (ppd *PointPackData) getAllReferenceRows() [5]pointPackRows {
+	return [5]pointPackRows{ppd.getConnectionRows(), ppd.getTrioRows(), ppd.getGrowthContextRows(), ppd.getCubeRows(), ppd.getPathBuilderRows()}
+}
+
+func (ppd *PointPackData) getConnectionRows() pointPackRows {
+	ppd.checkConnInitialized()
+	res := make(pointPackRows, len(ppd.allConnections))
+	for i, cd := range ppd.allConnections {
+		res[i] = []int64{int64(cd.Id), int64(cd.Vector.X()), int64(cd.Vector.Y()), int64(cd.Vector.Z()), int64(cd.ConnDS)}
+	}
+	return res
+}
+
+func (ppd *PointPackData) getTrioRows() pointPackRows {
+	ppd.checkTrioInitialized()
+	res := make(pointPackRows, len(ppd.allTrioDetails))
+	for i, td := range ppd.allTrioDetails {
+		res[i] = []int64{int64(td.id), int64(td.conns[0].Id), int64(td.conns[1].Id), int64(td.conns[2].Id)}
+	}
+	return res
+}
+
+func (ppd *PointPackData) getGrowthContextRows() pointPackRows {
+	ppd.checkGrowthContextsInitialized()
+	res := make(pointPackRows, len(ppd.allGrowthContexts))
+	for i, growthCtx := range ppd.allGrowthContexts {
+		res[i] = []int64{int64(growthCtx.GetId()), int64(growthCtx.GetGrowthType()), int64(growthCtx.GetGrowthIndex())}
+	}
+	return res
+}
+
+// Ordered by cube id
+func (ppd *PointPackData) getCubeRows() pointPackRows {
+	ppd.checkCubesInitialized()
+	res := make(pointPackRows, 0, len(ppd.cubeIdsPerKey))
+	for cubeKey, cubeId := range ppd.cubeIdsPerKey {
+		cube := cubeKey.cube
+		row := []int64{int64(cubeId), int64(cubeKey.trCtxId), int64(cube.center)}
+		for _, tr := range cube.centerFaces {
+			row = append(row, int64(tr))
+		}
+		for _, tr := range cube.middleEdges {
+			row = append(row, int64(tr))
+		}
+		res = append(res, row)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i][0] < res[j][0] })
+	return res
+}
+
+func (ppd *PointPackData) getPathBuilderRows() pointPackRows {
+	ppd.checkPathBuildersInitialized()
+	res := make(pointPackRows, 0, len(ppd.pathBuilders))
+	for cubeId, rootNode := range ppd.pathBuilders {
+		if cubeId == 0 || rootNode == nil {
+			continue
+		}
+		row := []int64{int64(cubeId), int64(rootNode.ctx.growthCtx.GetId()), int64(rootNode.trIdx)}
+		for _, pl := range rootNode.pathLinks {
+			row = append(row, int64(pl.pathNode.GetTrioIndex()))
+		}
+		for _, pl := range rootNode.pathLinks {
+			ipn := pl.pathNode.(*IntermediatePathNodeBuilder)
+			for _, ipl := range ipn.pathLinks {
+				lipn := ipl.pathNode.(*LastIntermediatePathNodeBuilder)
+				row = append(row, int64(ipl.connId), int64(lipn.trIdx), int64(lipn.nextMainConnId), int64(lipn.nextInterConnId))
+			}
+		}
+		res = append(res, row)
+	}
+	return res
+}
+
+/***************************************************************/
+// Read Functions
+/***************************************************************/
+
+func readReference(data string) ([5]pointPackRows, error) {
+	res := [5]pointPackRows{}
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return res, err
+	}
+	raw, err := ioutil.ReadAll(gz)
+	if err != nil {
+		return res, err
+	}
+	if !bytes.HasPrefix(raw, []byte(PointPackRefMagic)) {
+		return res, m3db.MakeQsmErrorf("point pack reference data does not start with %s", PointPackRefMagic)
+	}
+	r := bytes.NewReader(raw[len(PointPackRefMagic):])
+	version, err := binary.ReadVarint(r)
+	if err != nil {
+		return res, err
+	}
+	if version != PointPackRefVersion {
+		return res, m3db.MakeQsmErrorf("point pack reference data version %d is not the supported %d", version, PointPackRefVersion)
+	}
+	for i, name := range pointPackRefTables {
+		nbRows, err := binary.ReadVarint(r)
+		if err != nil {
+			return res, err
+		}
+		nbCols, err := binary.ReadVarint(r)
+		if err != nil {
+			return res, err
+		}
+		rows := make(pointPackRows, nbRows)
+		for row := range rows {
+			rows[row] = make([]int64, nbCols)
+			for col := range rows[row] {
+				rows[row][col], err = binary.ReadVarint(r)
+				if err != nil {
+					return res, m3db.MakeQsmErrorf("could not read table %s row %d due to %v", name, row, err)
+				}
+			}
+		}
+		res[i] = rows
+	}
+	return res, nil
+}
+
+func (ppd *PointPackData) initFromReference(data string) error {
+	tables, err := readReference(data)
+	if err != nil {
+		return err
+	}
+
+	ppd.allConnections = make([]*ConnectionDetails, 0, len(tables[0]))
+	ppd.allConnectionsByVector = make(map[Point]*ConnectionDetails, len(tables[0]))
+	for _, row := range tables[0] {
+		cd := ConnectionDetails{ConnectionId(row[0]), Point{CInt(row[1]), CInt(row[2]), CInt(row[3])}, DInt(row[4])}
+		ppd.allConnections = append(ppd.allConnections, &cd)
+		ppd.allConnectionsByVector[cd.Vector] = &cd
+	}
+	ppd.connectionsLoaded = true
+
+	ppd.allTrioDetails = TrioDetailList(make([]*TrioDetails, 0, len(tables[1])))
+	for _, row := range tables[1] {
+		td := TrioDetails{}
+		td.id = TrioIndex(row[0])
+		for i := 0; i < 3; i++ {
+			td.conns[i] = ppd.GetConnDetailsById(ConnectionId(row[i+1]))
+		}
+		ppd.allTrioDetails = append(ppd.allTrioDetails, &td)
+	}
+	ppd.trioDetailsLoaded = true
+
+	ppd.allGrowthContexts = make([]GrowthContext, 0, len(tables[2]))
+	for _, row := range tables[2] {
+		ppd.allGrowthContexts = append(ppd.allGrowthContexts, &BaseGrowthContext{ppd.env, int(row[0]), GrowthType(row[1]), int(row[2])})
+	}
+	ppd.growthContextsLoaded = true
+
+	ppd.cubeIdsPerKey = make(map[CubeKeyId]int, len(tables[3]))
+	for _, row := range tables[3] {
+		cube := CubeOfTrioIndex{}
+		cube.center = TrioIndex(row[2])
+		for i := range cube.centerFaces {
+			cube.centerFaces[i] = TrioIndex(row[3+i])
+		}
+		for i := range cube.middleEdges {
+			cube.middleEdges[i] = TrioIndex(row[3+len(cube.centerFaces)+i])
+		}
+		ppd.cubeIdsPerKey[CubeKeyId{int(row[1]), cube}] = int(row[0])
+	}
+	ppd.cubesLoaded = true
+
+	ppd.pathBuilders = make([]*RootPathNodeBuilder, TotalNumberOfCubes+1)
+	for _, row := range tables[4] {
+		cubeId := int(row[0])
+		builder := RootPathNodeBuilder{}
+		builder.ctx = &PathBuilderContext{ppd.GetGrowthContextById(int(row[1])), cubeId}
+		rootTd := ppd.GetTrioDetails(TrioIndex(row[2]))
+		builder.trIdx = rootTd.GetId()
+		for i := 0; i < 3; i++ {
+			interPathNode := IntermediatePathNodeBuilder{}
+			interPathNode.ctx = builder.ctx
+			interPathNode.trIdx = TrioIndex(row[3+i])
+			for j := 0; j < 2; j++ {
+				b := 6 + 8*i + 4*j
+				lastPathNode := LastIntermediatePathNodeBuilder{}
+				lastPathNode.ctx = builder.ctx
+				lastPathNode.trIdx = TrioIndex(row[b+1])
+				lastPathNode.nextMainConnId = ConnectionId(row[b+2])
+				lastPathNode.nextInterConnId = ConnectionId(row[b+3])
+				interPathNode.pathLinks[j] = PathLinkBuilder{ConnectionId(row[b]), &lastPathNode}
+			}
+			builder.pathLinks[i] = PathLinkBuilder{rootTd.conns[i].GetId(), &interPathNode}
+		}
+		ppd.pathBuilders[cubeId] = &builder
+	}
+	ppd.pathBuildersLoaded = true
+	return nil
+}
@@ -0,0 +1,2624 @@
+// Code generated by "qsm run genpointref". DO NOT EDIT.
+
+package m3point
+
+// The gzipped point pack reference data version 1
+const pointPackReferenceData = "" +
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xdd\x0d\x48\x25\xc9\x9a\x27\xfc\x3c\x51\xd1\x51\xd9\xd9\xd9\xd1\xf5\xfd\x61\x57\xd7" +
+	"\xed\xae\xaa\xdb\x5d\x55\xb7\x6e\x4f\x4f\x73\xb9\x34\x97\xa6\x28\x8a\xe6\x52\x14\xfd\x16\x6e\xbf\xc3\x30\x0c\xc3\x30\x0c\xc3\x30" +
+	"\x2c\xcb\xb2\x2c\xcb\xb2\x2c\xcb\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x85\x88\x88" +
+	"\x88\x48\x21\x22\x22\x22\x22\x22\x22\xcb\x3f\xe2\x79\x22\xe3\x39\x75\x3a\x3d\x79\xba\x7e\x19\xe7\xc9\xf8\xc8\xcc\x93\x1f\x71\x32" +
+	"\xff\xd3\xff\xff\xff\x55\x57\xab\x7f\xcb\x94\x4a\x12\x55\x2a\x25\x89\xd2\x89\x4a\xd4\x95\xa4\x94\x28\x93\x24\x4a\x7d\x92\x24\x25" +
+	"\x95\x2a\x95\xe8\xab\xa5\x52\xa2\x33\x55\x4a\xf4\xa7\x25\x95\xe8\x5c\x25\x4a\x7f\x56\x4a\x4a\xda\xaa\xa4\xa4\x3f\x2f\x25\x4a\x5f" +
+	"\x4b\x94\xd2\x5f\x24\xa5\x92\xbe\x91\xa8\x92\xbe\x9e\x94\x94\xbe\xa5\x94\x32\x37\x4b\xa5\x92\xb9\xa3\x54\xc9\xdc\x2e\x95\x94\xb9" +
+	"\xa7\x4a\xca\xdc\x2d\xa9\x92\xa9\x52\xa5\x92\xb9\x5f\x52\xca\x3c\xd0\x2a\xc9\xbe\xbc\x52\x4a\xb2\x87\xba\x94\x64\x5f\x5d\x51\x49" +
+	"\xf6\xb5\x4e\x54\xf6\xbb\x2b\x49\x29\x7b\xa4\x93\x52\xf6\xcd\x95\x44\x65\x4f\x94\x4e\xb2\xc7\xa5\x2b\x49\xf6\xad\xba\x92\x64\xbf" +
+	"\x2f\xe9\x24\x7b\xaa\x12\x9d\x7d\x57\x4a\xae\x64\xcf\x55\x72\x25\x7b\x86\x8c\xbe\x48\xb4\xca\xfe\x90\x5c\x29\x65\xdf\x27\xba\x94" +
+	"\xfd\x31\xb9\xa2\xb2\x1f\x12\xa5\xb3\xbf\x49\x4a\x57\xb2\x1f\x13\x75\x25\xfb\xdb\xa4\xa4\xb3\x96\x2b\x69\x92\x7e\x76\x5d\x7d\x9a" +
+	"\x7f\xa1\xb3\xcf\x6f\x98\xab\xf6\x5a\x7a\x35\xbf\x91\x65\x9f\x5d\xcb\x3f\xb5\xd7\x6d\xfa\xf9\x17\xd7\x94\xbe\x7a\x43\x5d\xf9\xf4" +
+	"\x96\x32\x9f\xdd\x51\x9f\x7c\x7e\xaf\xa4\xb3\xaa\xd2\x95\xf4\x41\xc9\xd8\x87\xa5\x4f\xf2\xaf\xb5\xf9\xe2\x91\xfe\xe4\xfa\x93\x2b" +
+	"\xe6\xc6\xb7\x57\x3e\xb9\xf6\x54\x5d\xbd\xfb\x5c\x5d\xbd\xff\x42\x7d\x7a\xf3\x7b\xf5\xe9\xed\x1f\xd4\x67\xb7\x7f\x54\x9f\xdd\xff" +
+	"\x93\xfa\xfc\xe6\x9f\xd5\xe7\x77\x7f\x2a\xa5\xf7\xfe\x52\x4a\xab\x7e\x2e\x65\xb7\x5e\x96\xb2\x3b\xaf\x4a\xf9\x9d\xd7\xa5\xbc\xea" +
+	"\x97\x92\xbd\xf5\xd7\x92\xbd\xf7\x46\x5f\xbd\xf7\x56\x5f\xad\xfa\x55\x67\x37\xdf\xe9\xec\x76\xb5\xfe\xe2\xf6\x6f\xfa\x8b\x7b\x7f" +
+	"\xa7\xaf\xdf\xfc\x7b\x7d\xbd\xea\x1f\xae\xa4\x77\xff\xf1\x4a\x7a\xff\x9f\xae\x7c\x7a\xeb\x9f\xaf\x7c\x7a\xe7\x5f\xae\x5c\xbb\xf3" +
+	"\xaf\x57\xae\xdd\xfd\xb7\x2b\x37\x6e\xfd\xfb\x95\x1b\xf7\xff\xc3\x7c\x76\xe7\x3f\x9b\xcf\xaa\xfe\x8b\xb1\x37\xff\xab\xb1\x77\xff" +
+	"\x9b\xf9\xe2\xce\x7f\x37\x5f\xdc\xfd\x1f\xe6\xc6\xcd\xff\x69\x6e\x54\xfd\xaf\x4f\xf2\xdb\xff\xfb\x93\xfc\xfe\xff\xf9\xe4\xf3\x5b" +
+	"\xff\xf7\x93\xcf\xef\xd5\x94\x3e\xb9\x76\xbb\xb6\xf4\xc9\xb5\x7b\x75\xa5\x4f\xae\xdf\xaa\x2f\x7d\x72\xfd\x7e\x43\x49\x5d\xfd\xaa" +
+	"\xb1\xa4\x3e\xfd\xb2\xa9\xa4\x3e\xfb\xa6\xb9\xa4\x3e\xff\x5d\x4b\xa9\x94\x3e\x6c\x2d\x95\xb2\x07\x6d\xa5\x52\xfe\xa8\xbd\x54\xb2" +
+	"\x5f\x77\x94\xf4\xd5\x6f\x3b\x4b\x3a\x7b\xdc\x55\xd2\x5f\xfc\xb1\xbb\xa4\xaf\xff\xa1\xa7\x74\x25\xfd\x7d\x6f\xe9\xca\xa7\x4f\xfa" +
+	"\x4a\x57\xae\x7d\xdf\x5f\xba\x72\xe3\xc5\x40\xc9\x7c\xf6\x7c\xb0\x64\xec\x77\x43\x25\xf3\xc5\x8f\xc3\x25\x73\xe3\x6f\x46\x4a\x9f" +
+	"\xe4\xcf\x46\x4b\x9f\x7c\xfe\x74\xac\xf4\xc9\xb5\xbf\x1d\x2f\x7d\x72\xfd\x87\x89\x52\x7a\xef\xee\x64\x29\xbd\x77\x7f\xaa\x94\xde" +
+	"\xad\x9a\x2e\xa5\x55\xf7\x67\x4a\x57\xef\xdd\x9d\x2d\x5d\xbd\x77\x7f\xae\x74\xf5\x6e\xd5\x7c\xe9\x6a\xd5\xfd\x85\x52\x76\xeb\xe6" +
+	"\x62\x29\xbb\x75\x7b\xa9\x94\xdd\xbc\xb3\x5c\xca\xee\xdc\x5e\x29\x7d\x7a\xeb\xe6\xfb\xd2\xa7\xb7\x6e\xaf\x96\x3e\xbd\x79\x67\xad" +
+	"\xf4\xe9\x9d\xdb\xeb\xa5\xfc\xce\xed\x8d\x52\x7e\xe7\xfe\x66\x29\xbf\x5d\xb5\x55\xca\xab\xee\x6f\x97\x3e\xbb\x73\x7b\xa7\xf4\xd9" +
+	"\x9d\xfb\x1f\x4a\x9f\xdd\xae\xda\x2d\x7d\x56\x75\x7f\xaf\x64\x6f\xdd\xdc\x2f\xd9\x5b\x77\x0f\x4a\xf6\xe6\xbd\xc3\x92\xbd\x77\xf7" +
+	"\xa8\xf4\xf9\xad\x9b\xc7\xa5\xcf\x6f\xdd\x3d\x29\x7d\x7e\xf3\xde\x69\xe9\xf3\x7b\x77\xcf\x4a\xd7\xee\xdc\x3e\x2f\x5d\xbb\x73\xef" +
+	"\xa2\x74\xed\xf6\xdd\xcb\xd2\xb5\x7b\x77\x6b\xd4\x17\x77\x6e\xd7\xaa\x2f\xee\xdc\xab\x53\x5f\xdc\xbe\x5b\xaf\xbe\xb8\x77\xb7\x41" +
+	"\xdd\xb8\x75\xb3\x51\xdd\xb8\x55\xd5\xa4\x6e\xdc\xbc\xdf\xac\x6e\x54\xdd\x6f\x51\xd7\x6f\xdd\x6c\x55\xd7\x6f\x55\xb5\xa9\xeb\x37" +
+	"\xef\xb7\xab\xeb\x55\xf7\x3b\x54\x7a\xef\xf7\x9d\x2a\xbd\xfb\xb0\x4b\xa5\x55\xbf\xef\x56\xe9\xfd\x87\x3d\xea\xea\xbd\xaf\x7a\xd5" +
+	"\xd5\xbb\xdf\xf6\xa9\xab\x55\x5f\xf5\xab\xab\xf7\xbf\x1d\x50\xd9\xad\xc7\x83\x2a\xbb\xf9\x60\x48\x65\x77\x1e\x0f\xab\xec\xf6\x83" +
+	"\x11\xf5\xe9\xad\x2f\x47\xd5\xa7\x37\x9f\x8c\xa9\x4f\xef\x7c\x39\xae\x3e\xbd\xfd\x64\x42\xe5\x77\x9e\x4d\xaa\xfc\xf6\xa3\x29\x95" +
+	"\x57\x3d\x9b\x56\xf9\xfd\x47\x33\xea\xb3\x3b\xdf\xcc\xaa\xcf\x6e\x3f\x9f\x53\x9f\x55\x7d\x33\xaf\x3e\xbb\xff\x7c\x41\xd9\x5b\xdf" +
+	"\x2d\x2a\x7b\xf3\xeb\x25\x65\xef\x7d\xb7\xac\xec\xdd\xaf\x57\xd4\xe7\xb7\x7e\xf7\x5e\x7d\x7e\xf3\xe9\xaa\xfa\xfc\xde\xef\xd6\xd4" +
+	"\xe7\x77\x9f\xae\xab\x6b\x77\xfe\x76\x43\x5d\xbb\xfd\xfd\xa6\xba\x76\xef\xfb\x2d\x75\xed\xee\xdf\x6e\xab\x2f\xee\xfc\x71\x47\x7d" +
+	"\x71\xfb\xc7\x0f\xea\x8b\x7b\x3f\xee\xaa\x2f\xee\xfe\x71\x4f\xdd\xb8\xf5\x37\xfb\xea\xc6\xcd\x17\x07\xea\x46\xd5\x8b\x43\x75\xe3" +
+	"\xfe\xdf\x1c\xa9\xeb\xb7\xfe\x70\xac\xae\xdf\xfc\xe1\x44\x5d\xaf\xfa\xe1\x54\x5d\xbf\xff\x87\x33\x95\x3e\xfc\xfd\xb9\xba\xfa\xd5" +
+	"\xb7\x17\x2a\x7b\xf0\xf8\x52\x7d\xfa\xe5\x93\x9a\x2b\xf9\xa3\x67\xb5\x57\x3e\xfb\xe6\x79\xdd\x15\xfb\xf5\x77\xf5\x57\x3e\xff\xdd" +
+	"\xd3\x86\x2b\xd7\xbe\xff\xdb\xc6\x2b\x5f\xfc\xf1\xc7\xa6\x2b\x37\x5e\xfc\x4d\xf3\x95\xeb\x7f\xf8\xe1\x3f\x4c\xa2\x12\xa5\x94\x56" +
+	"\xda\x28\x93\xaa\x34\x53\x59\xae\x72\xab\xec\x35\x9d\xdc\xd0\xea\x96\xd6\x77\xb4\xb9\xa7\xd3\x2a\x9d\x3d\xd0\xf9\x43\x6d\xbf\xd6" +
+	"\xd7\x1e\xe9\x1b\x4f\xf4\xad\x6f\xf5\x9d\xa7\x26\x79\x6e\xd4\x0b\xa3\xbf\x37\xe6\x07\x93\xfe\x68\xb2\x3f\x99\xfc\xcf\xc6\xfe\x94" +
+	"\x26\x7f\x49\xd5\xcf\xa9\x7e\x99\x9a\x57\x69\xfa\x3a\xcd\x7e\x49\xf3\xbf\xa6\xf6\x4d\x7a\xed\x6d\x7a\xe3\xd7\xf4\xd6\xbb\xf4\x4e" +
+	"\xf5\xb5\xe4\xb7\x6b\xea\xef\xae\xe9\xbf\xbf\x66\xfe\xe1\x5a\xfa\x8f\xd7\xb2\x7f\xba\x96\xff\xf3\x35\xfb\x2f\xd7\xae\xfd\xeb\xb5" +
+	"\x1b\xff\x76\xed\xd6\xbf\x5f\xbb\xd3\xf2\x9f\x9e\xab\x24\x49\x92\x24\x49\x92\x24\xfa\x1f\xad\x2a\xfc\x67\x74\x85\xff\x52\x53\xe1" +
+	"\xbf\x2c\xad\xf0\x5f\x9e\x55\xf8\xcf\xe6\x15\xfe\xbb\x66\x2b\xfc\x77\xe3\x5a\xe2\x3e\x91\xc5\x39\xbd\x75\x8d\xfe\xc9\xf1\x30\xdc" +
+	"\xb9\x91\xb8\x30\x39\xa7\xc2\x70\xef\x06\xfd\x93\x67\x82\xa1\xea\x56\xe2\x62\x5b\x4e\x85\xe1\xc1\x2d\xfa\x27\xcf\x19\xc3\xc3\x3b" +
+	"\xca\x15\x22\xe5\xca\xc0\xf0\xf5\x1d\xfa\x27\x17\x11\xc3\xa3\x7b\x0a\xa1\xf3\x9c\x53\x61\x78\x72\x8f\xfe\xc9\x73\xc6\xf0\x6d\x95" +
+	"\x42\x68\x6b\x39\x15\x86\xa7\x55\xf4\x4f\x9e\x33\x86\xe7\x0f\x34\x42\xa7\x29\xd7\x3a\x86\x17\x0f\xe8\x9f\x3c\x67\x0c\xdf\x3f\xd4" +
+	"\xa8\x82\x2c\xe3\x54\x18\x7e\x78\x48\xff\xe4\x1a\xc2\xf0\xe3\xd7\x1a\xa1\xad\xe5\x54\x18\xfe\xf4\x35\xfd\x93\xe7\x8c\xe1\xcf\x8f" +
+	"\x0c\x42\xa7\xa2\xa9\x7f\x7a\x44\xff\xe4\x39\x63\xf8\xcb\x13\x83\xd0\x59\xc6\xa9\x30\xfc\xfc\x84\xfe\xc9\x73\xc6\xf0\xf2\x5b\x83" +
+	"\x2a\xc8\x73\x4e\x85\xe1\xd5\xb7\xf4\x4f\xae\x21\x0c\xaf\x9f\x56\x68\xf7\x5f\x08\x45\xc3\xfd\xd5\xa3\x15\x29\xdf\x38\xb4\xf2\xe3" +
+	"\x6f\x09\xc5\xc7\x7f\xf5\x28\x3f\xfe\x0e\x68\xcb\xe6\x5e\x4d\x28\x3e\xfe\x9b\x47\xf9\xf1\xbf\x7b\x9a\x64\x39\x06\x06\x0c\x7f\xef" +
+	"\x31\x17\x29\xff\x81\x50\xa4\xfc\x47\x60\x9e\xc9\x8f\xff\x93\x47\xf9\xf1\x7f\x26\x14\x29\xff\xc5\x61\xd9\xdc\xff\xd5\xa3\xfc\xf8" +
+	"\xbf\x11\x8a\x94\xff\xfe\x34\xc9\x6c\x79\xcd\xff\x07\xa1\x28\xfb\x7f\xf6\x28\xcb\xfe\x5f\x1c\x96\xd5\xfc\x7f\x25\x14\x1f\xff\x6f" +
+	"\x1e\xe5\xc7\xff\x3b\xb0\xbc\xe6\xff\x07\xa1\xf8\xf8\xff\xf4\x28\x3f\xfe\xbf\x9e\x26\xa8\x38\x59\xf6\xff\xed\x51\x96\xfd\xff\x10" +
+	"\x8a\x94\xff\x17\x58\x5e\xf3\x35\x25\xaf\xf2\xf3\xb5\xac\x22\x6d\x9d\xd7\xb2\x0c\xd4\x93\xca\x08\x0d\xac\x22\x6d\x23\xf4\xa3\x85" +
+	"\xa7\x89\x54\x46\x68\x66\x15\x69\x5b\x9c\x96\x97\xa2\x95\x54\x46\x68\x63\x15\x69\xdb\xbd\x96\xe5\xa1\x83\x54\x46\xe8\x64\x15\x69" +
+	"\xbb\xa0\x36\xb7\xb9\x68\xb2\x6e\x52\x2b\xd2\xf6\xb0\x8a\xb4\xbd\x4e\x6d\x2e\x23\xf4\x91\xca\x08\xfd\xac\x22\xed\x80\xd7\xb2\x3c" +
+	"\x0c\x92\xca\x08\x43\xac\x22\xed\x70\xe9\x69\x62\x3f\xda\x0a\x8d\xb0\x8a\xb4\xa3\xa4\x72\x79\x1c\xf3\x5a\xb6\x3a\x8c\xb3\x8a\x08" +
+	"\x13\xa4\x32\xc2\xa4\xd3\xf2\x35\x62\x8a\x55\x44\x98\x26\x95\x11\x66\xa0\x79\x79\x5b\xcc\x92\xca\x7a\x98\x63\x15\x69\xe7\x9d\x96" +
+	"\xb7\xc5\x02\xa9\x8c\xb0\xc8\x2a\xd2\x2e\x79\x2d\xcb\xc3\x32\xa9\x8c\xb0\xc2\x2a\xd2\xbe\x87\x7e\xb4\x44\xad\x92\xca\x08\x6b\xac" +
+	"\x22\xed\xba\xd3\xf2\x52\x6c\x90\xca\x08\x9b\xac\x22\xed\x96\xd7\xb2\x3c\x6c\x93\xca\x08\x3b\xac\x22\xed\x87\xd2\x53\x6a\x1b\x2c" +
+	"\x40\xfc\x65\xb7\x1b\x2b\xf6\x8d\xb0\xfa\x67\x7b\x91\xda\x8c\x30\xdf\x2f\x94\xc4\x66\x59\x7e\x20\x14\x5b\x45\xe8\x61\xa4\xd8\x1e" +
+	"\xe4\x88\x9e\x1d\x09\xc5\x66\x15\x7a\x1c\xab\xdb\xa6\xe0\x03\x27\x42\xb1\x14\x42\x4f\x0b\xc5\x6c\x90\x38\xcf\xb2\xb3\x58\xad\xcb" +
+	"\x5a\x96\xd9\xf3\x48\x6d\x86\x5c\x64\xb9\xcd\x2e\x82\x3a\xb1\x08\x63\xb3\x4b\xa1\x9c\xb6\x46\x15\x9a\x53\x6e\x33\x5b\x2b\x94\xe7" +
+	"\x56\x17\x6b\x8e\x15\x24\xcf\xb3\xac\x5e\x28\xe7\xb7\xa1\x50\x0a\x8c\x20\x8d\x42\xb9\xd6\x9b\x22\x75\x7b\xb4\xae\x7c\xcd\x42\xb9" +
+	"\xdd\x5a\x62\xcd\xb9\x9d\x5b\x83\x5a\x22\xe4\xad\x2d\x56\xcb\x2d\xd4\x1e\xa9\xa5\x5a\xc8\xf2\x0e\xf5\x94\x96\x30\x08\xd6\x04\x8c" +
+	"\x3b\x23\x45\xd3\x5b\xb4\x69\xde\x15\xab\x5b\x9f\x31\xea\x0e\x8a\xba\xb5\x14\xa3\x27\x52\x7c\x16\x43\x9e\xf5\xc6\x8a\x84\x2e\x46" +
+	"\x5f\xa1\x7e\x66\x6e\xe8\x8f\x34\xcf\x2c\xf1\x40\xac\x39\xef\x56\x0e\x16\xea\x4a\xe0\xcb\x32\x24\x94\xcb\x36\x1c\x29\x2a\x1c\xb5" +
+	"\x6b\xf3\x11\xa1\x5c\xe2\xd1\x58\x51\x93\x6e\x9e\x63\x42\xb9\x1e\xc6\x83\xba\x3a\x40\x43\xd8\x2c\x9f\x10\xca\xb5\x33\x19\xa9\xa5" +
+	"\x1c\xe4\xd9\x94\x50\xae\xb3\xe9\x58\x5d\x86\x31\xbf\x19\xa1\x5c\x93\xb3\x85\x52\x60\x04\x99\x13\xca\xf5\x3b\x1f\x29\x36\x0f\xc8" +
+	"\x83\xcd\x17\x84\x72\xad\x2f\xc6\x6a\xb9\xe6\x97\xd4\x53\xda\x0e\x61\xe1\x43\x0e\xf0\xbe\x1c\x2b\x16\x4b\x6c\x36\xec\x8a\x50\x04" +
+	"\x81\xbe\x8f\x34\x24\xcd\x56\x85\x22\x0b\xd0\xb5\x42\x2d\x45\xce\xac\x5d\x17\xea\x92\x66\xd6\x6e\xc4\x4a\x83\xcd\x37\x85\xa2\x5c" +
+	"\x99\xb5\xf9\x56\xa4\x08\x81\xc4\xb9\xdd\x16\x8a\x76\x83\xee\x04\xcd\x29\x57\x18\x7f\x88\x14\x0b\x19\xa2\x66\x76\x37\x56\x64\x0b" +
+	"\xa1\xb3\xbd\xa0\x10\x57\x8d\x36\xcb\xf6\x85\x72\xda\x83\x58\x31\x3f\x97\xe3\x43\xa1\x3c\xb7\xa3\x48\x91\x2b\x0c\x36\x3b\x16\xca" +
+	"\xf9\x3d\x29\x14\xcb\x28\x95\xe3\x34\x52\x44\xc4\x60\xf3\xb3\x58\x2d\xd7\xfa\x79\xa1\xbe\xc0\x6e\xb8\x88\xd4\xe6\xdc\x9c\x97\xb1" +
+	"\x5a\x3e\x68\xab\xb9\xf2\xbc\xc2\x51\x70\x2d\xab\x38\x8e\xad\x23\xb5\x22\x6d\xbd\x57\x2b\x23\x34\xb0\x8a\x08\x8d\xa4\x32\x42\x93" +
+	"\x53\x5b\x96\x87\x66\x56\x11\xa1\x85\x54\x46\x68\x85\xe6\x69\x9e\x8a\x23\xf4\x36\xd2\x5c\xa4\x6d\x67\x15\x69\x3b\x9c\xe6\xa9\x8c" +
+	"\xd0\x49\x2a\x23\x74\xb1\x8a\xb4\xdd\x5e\xcb\xf2\xd0\x43\x2a\x23\xf4\xb2\x8a\xb4\x7d\x50\x5b\xde\x16\xfd\xac\xa2\x1e\x06\x48\x65" +
+	"\x3d\x0c\x7a\x2d\x6b\x8b\x21\x56\x11\x61\x98\x54\x46\x18\x71\x5a\xde\x16\xa3\xac\x22\xc2\x18\xa9\x8c\x30\x7e\xe5\xb9\x42\x3d\xca" +
+	"\x7a\x98\x20\x95\xf5\x30\xc9\x2a\xd2\x4e\x39\x2d\x6f\x8b\x69\x52\x19\x61\x86\x55\xa4\x9d\xf5\x5a\x96\x87\x39\x52\x19\x61\x9e\x55" +
+	"\xa4\x5d\x80\x7e\xb4\x44\x2d\x92\xca\x08\x4b\xac\x22\xed\xb2\xd3\xf2\x52\xac\x90\xca\x08\xef\x59\x45\xda\x55\xaf\x65\x79\x58\x23" +
+	"\x95\x11\xd6\x59\x45\xda\x0d\x28\x36\xb0\xa2\xdd\x36\x49\xad\x48\xbb\xc5\x2a\xd2\x6e\x3b\xc5\x76\x97\x05\xc3\x0e\xa9\x8c\xf0\x81" +
+	"\x55\xa4\xdd\xf5\x5a\x96\x87\x3d\x52\x19\x61\x9f\x55\xa4\x3d\xb8\xf2\x5c\xd9\x8f\xb6\x51\x87\xac\x22\xed\x11\xa9\x5c\x26\x8f\xbd" +
+	"\x96\xad\x17\x27\xac\x22\xc2\x29\xa9\x8c\x70\xe6\xb4\x7c\xbd\x38\x67\x15\x11\x2e\x48\x65\x84\x4b\x68\x5e\xde\x16\x35\xda\xab\xac" +
+	"\x87\x5a\x56\x91\xb6\xce\x69\x79\x5b\xd4\x93\xca\x08\x0d\xac\x22\x6d\xa3\xd7\xb2\x3c\x34\x91\xca\x08\xcd\xac\x22\x6d\x0b\xf4\xa3" +
+	"\x25\xaa\x95\x54\x46\x68\x63\x15\x69\xdb\x9d\x96\x97\xa2\x83\x54\x46\xe8\x64\x15\x69\xbb\xbc\x96\xe5\xa1\x9b\x54\x46\xe8\x61\x15" +
+	"\x69\x7b\xf5\x73\x6a\x1b\x2c\x40\x7c\xa4\xd6\x17\x2b\xf6\xae\xb0\xfa\xa7\xfd\x91\xda\x94\x30\x1f\x28\x94\xc4\xa6\x69\x3e\x28\xd4" +
+	"\x6d\x72\xd3\x34\x1f\x8a\x14\xdb\x83\x1c\xd1\xd3\x61\xa1\x6e\x53\x6e\xf3\x74\x24\x56\xb7\x4d\xc1\x07\x46\x85\x62\x29\x84\x8e\x15" +
+	"\x8a\x99\x23\x71\x9e\xa6\xe3\xb1\x5a\x97\xb5\x34\xb5\x13\x91\xda\x14\xb9\x48\x73\x9b\x4e\x06\x75\x82\x2d\x7c\x6e\xd3\x29\xa1\x9c" +
+	"\x76\x3a\xd2\x9c\x72\x9b\xda\x19\xa1\x3c\xb7\xd9\x58\x73\xac\x20\x79\x9e\xa6\x73\x42\x39\xbf\xf3\x85\x52\x60\x04\x59\x10\xca\xb5" +
+	"\xbe\x18\x69\x8e\x4a\x70\xe5\x5b\x12\xca\xed\xb6\x1c\x6b\xce\xed\xbc\x12\xd4\x12\x21\x6f\xef\x63\xb5\xdc\x42\xab\x91\x5a\xaa\x85" +
+	"\x34\x5f\xd3\xcf\x69\x09\x83\x60\x4d\xc0\x78\x3d\x52\x34\xbd\x45\x9b\xe6\x1b\xb1\x86\x23\xb5\xcd\xa0\xa8\x5b\x4b\x31\xb6\x22\xc5" +
+	"\x67\x31\xe4\xe9\x76\xac\xe1\x48\x6d\xa7\x50\x3f\x33\x37\x7c\x88\x34\x4f\xf9\x98\x61\x37\xd6\x70\xa4\xb6\x57\xa8\x2b\x81\x2f\xcb" +
+	"\xbe\x50\x2e\xdb\x41\xa4\xa8\x70\xd4\xae\xcd\x0f\x85\x72\x89\x8f\x62\x45\x4d\xba\x79\x1e\x0b\xe5\x7a\x38\x09\xea\xea\x00\x0d\x61" +
+	"\xd3\xfc\x54\x28\xd7\xce\x59\xa4\x96\x72\x90\xa7\xe7\x42\xb9\xce\x2e\x62\x75\x19\xc6\xfc\x2e\x85\x72\x4d\xd6\x7c\x12\x94\x02\x23" +
+	"\x48\xad\x50\xae\xdf\xba\x48\xb1\x79\x40\x1e\x6c\x5e\x2f\x94\x6b\xbd\x21\xd6\x70\xa4\xd6\xf8\xc9\x73\xda\x0e\x61\xe1\x43\x0e\xf0" +
+	"\xde\x14\x2b\x16\x4b\x6c\x36\x6c\xb3\x50\x04\x81\xb6\x44\x1a\x92\xa6\xad\x42\x91\x05\x68\x5b\xa1\x96\x22\xa7\xd6\xb6\x0b\x75\x49" +
+	"\x53\x6b\x3b\x62\xa5\xc1\xe6\x9d\x42\x51\xae\xd4\xda\xbc\x2b\x52\x84\x40\xe2\xdc\x76\x0b\x45\xbb\x41\x7b\x82\xe6\x94\x2b\x8c\x7b" +
+	"\x23\xc5\x42\x86\xa8\xa9\xed\x8b\x15\xd9\x42\xe8\xb4\x3f\x28\xc4\x55\xa3\x4d\xd3\x01\xa1\x9c\x76\x30\x56\x3a\x52\x4b\xed\x90\x50" +
+	"\x9e\xdb\x70\xa4\xc8\x15\x06\x9b\x8e\x08\xe5\xfc\x8e\x16\x8a\x65\x94\xca\x31\x16\x29\x22\x62\xb0\xf9\x78\xac\x96\x6b\x7d\xa2\x50" +
+	"\x5f\x60\x37\x4c\x46\x6a\x73\x6e\xce\xa9\x58\x2d\x5f\x88\x9b\xfe\xe4\x45\x85\x2b\x86\x33\xac\xe2\x9a\xdf\x2c\xa9\x15\x69\xe7\xbc" +
+	"\x5a\x19\x61\x9e\x55\x44\x58\x20\x95\x11\x16\x9d\xda\xb2\x3c\x2c\xb1\x8a\x08\xcb\xa4\x32\xc2\x0a\x34\x4b\xb3\x54\x5c\xcd\x7c\x4f" +
+	"\x9a\x89\xb4\xab\xac\x22\xed\x9a\xd3\x2c\x95\x11\xd6\x49\x65\x84\x0d\x56\x91\x76\xd3\x6b\x59\x1e\xb6\x48\x65\x84\x6d\x56\x91\x76" +
+	"\x07\x6a\xcb\xdb\xe2\x03\xab\xa8\x87\x5d\x52\x59\x0f\x7b\x5e\xcb\xda\x62\x9f\x55\x44\x38\x20\x95\x11\x0e\x9d\x96\xb7\xc5\x11\xab" +
+	"\x88\x70\x4c\x2a\x23\x9c\x7c\xf2\x42\xa3\x1e\x65\x3d\x9c\x92\xca\x7a\x38\x63\x15\x69\xcf\x9d\x96\xb7\xc5\x05\xa9\x8c\x70\xc9\x2a" +
+	"\xd2\xd6\x18\xa7\x65\x79\xa8\x25\x95\x11\xea\x58\x45\xda\x7a\xe8\x47\x4b\x54\x03\xa9\x8c\xd0\xc8\x2a\xd2\x36\x39\x2d\x2f\x45\x33" +
+	"\xa9\x8c\xd0\xc2\x2a\xd2\xb6\x7a\x2d\xcb\x43\x1b\xa9\x8c\xd0\xce\x2a\xd2\x76\x40\xdd\x79\x1a\x16\x0c\x9d\xa4\x56\xa4\xed\x62\x15" +
+	"\x6d\xdc\xed\x14\x27\x81\x58\x30\xf4\x90\xca\x08\xbd\xac\x22\x6d\x9f\xd7\xb2\x3c\xf4\x93\xca\x08\x03\xac\x22\xed\xa0\x79\xa1\xed" +
+	"\x47\xdb\xa8\x21\x56\x91\x76\x98\x54\x2e\x93\x23\x5e\xcb\xd6\x8b\x51\x56\x11\x61\x8c\x54\x46\x18\x77\x5a\xbe\x5e\x4c\xb0\x8a\x08" +
+	"\x93\xa4\x32\xc2\x14\x34\x2b\x6f\x8b\x69\x52\x59\x0f\x33\xac\x22\xed\xac\xd3\xf2\xb6\x98\x23\x95\x11\xe6\x59\x45\xda\x05\xaf\x65" +
+	"\x79\x58\x24\x95\x11\x96\x58\x45\xda\x65\xe8\x47\x4b\xd4\x0a\xa9\x8c\xf0\x9e\x55\xa4\x5d\x75\x5a\x5e\x8a\x35\x52\x19\x61\x9d\x55" +
+	"\xa4\xdd\xf0\x5a\x96\x87\x4d\x52\x19\x61\x8b\x55\xa4\xdd\x36\x2f\xa8\x6d\xb0\x00\xf1\x59\xcb\x9d\x58\x71\x2e\x13\xab\x7f\xfa\x21" +
+	"\x52\x9b\x12\x66\xbb\x85\x92\xd8\x34\xcd\xf6\x84\xd2\x91\x5a\xb6\x1f\x29\xb6\x07\x19\xa2\xa7\x07\x42\xdd\xa6\xdc\x66\xe9\x61\xac" +
+	"\x6e\x9b\x82\x0f\x1c\x09\xc5\x52\x08\x3d\x2e\x14\xb3\x41\xe2\x2c\x4d\x4f\x62\xb5\x2e\x6b\x69\x6a\x4f\x23\xb5\x29\x72\x91\x66\x36" +
+	"\x3d\x0b\xea\x04\x5b\xf8\xcc\xa6\xe7\x42\x39\xed\x45\xa4\x19\xe5\x36\xb5\x97\x42\x79\x6e\x35\x57\x23\xcd\xb0\x82\x64\x59\x9a\xd6" +
+	"\x0a\xe5\xfc\xd6\x15\x4a\x81\x11\xa4\x5e\x28\xd7\x7a\x43\xa4\xae\xeb\x98\x2b\x5f\xa3\x50\x6e\xb7\xa6\x58\x33\x6e\xe7\xe6\xa0\x96" +
+	"\x08\x79\x6b\x89\xd5\x72\x0b\xb5\x46\x6a\xa9\x16\xd2\xac\xed\xea\x0b\x5a\xc2\x20\x58\x13\x30\x6e\x8f\x14\x4d\x6f\xd1\xa6\x59\x47" +
+	"\xac\x6e\x7d\xc6\xa8\x33\x28\xea\xd6\x52\x8c\xae\x48\xf1\x59\x0c\x59\xda\x1d\x2b\x12\xba\x18\x3d\x85\xfa\x99\xb9\xa1\x37\xd2\x0c" +
+	"\x47\x6a\xc0\xac\x2f\xd6\x8c\x8f\xd4\xfa\x0b\x75\x25\xf0\x65\x19\x10\xca\x65\x1b\x8c\x14\x15\x8e\xda\xb5\xd9\x90\x50\x2e\xf1\x70" +
+	"\xac\xa8\x49\x37\xcf\x11\xa1\x5c\x0f\xa3\x41\x5d\x1d\xa0\x21\x6c\x9a\x8d\x09\xe5\xda\x19\x8f\xd4\x52\x0e\xb2\x74\x42\x28\xd7\xd9" +
+	"\x64\xac\x2e\xc3\x98\xdf\x94\x50\xae\xc9\xe9\x42\x29\x30\x82\xcc\x08\xe5\xfa\x9d\x8d\x14\x9b\x07\xe4\xc1\x66\x73\x42\xb9\xd6\xe7" +
+	"\x63\xa5\xeb\x2a\xd6\x2e\x5c\x7d\x41\xdb\xa1\xf8\x48\x6d\x31\xd6\x70\xf8\xb5\x24\x14\x41\xb0\xce\x2d\x47\x5a\x1c\xa9\xad\x08\x45" +
+	"\x16\xa0\xef\x0b\x8d\x8e\xd4\x56\x85\xba\xa4\xa9\xb5\x6b\xb1\xd2\x60\xb3\x75\xa1\x28\x57\x6a\x6d\xb6\x11\x29\x1f\xa9\x65\x76\x53" +
+	"\x28\xda\x0d\xba\x15\x34\xa3\x5c\x61\xbc\x1d\x29\x16\x32\x44\x4d\xed\x4e\xac\xe1\x48\xed\x43\x50\x48\x46\x47\x6a\xbb\x42\x39\xed" +
+	"\x5e\xac\x74\xed\x2b\xb5\xfb\x42\x79\x6e\x07\x91\x22\x57\x18\x6c\x7a\x28\x94\xf3\x7b\x54\x28\x96\x51\x2a\xc7\x71\xa4\x88\x88\xc1" +
+	"\x66\x27\xb1\x86\x23\xb5\xd3\x42\x7d\x81\xdd\x70\x16\xa9\xcd\xb8\x39\xcf\x63\x0d\x47\x6a\x17\x57\xbf\xaf\xd0\xbb\xf2\x92\x55\xf4" +
+	"\x8f\xac\x49\xbd\xe6\x22\x6d\xad\xd7\x5c\x46\xa8\x63\x15\x11\xea\x49\x65\x84\x06\xa7\x79\x59\x1e\x1a\x59\x45\x84\x26\x52\x19\xa1" +
+	"\x19\x8a\xd5\x57\xf4\xfc\x6c\x21\xcd\x44\xda\x56\x56\x91\xb6\xcd\x29\xb6\x9c\x2c\x18\xda\x49\x65\x84\x0e\x56\x91\xb6\xd3\x6b\x59" +
+	"\x1e\xba\x48\x65\x84\x6e\x56\x91\xb6\x07\x9a\x97\xb7\x45\x2f\xab\xa8\x87\x3e\x52\x59\x0f\xfd\x5e\xcb\xda\x62\x80\x55\x44\x18\x24" +
+	"\x95\x11\x86\x9c\x96\xb7\xc5\x30\xab\x88\x30\x42\x2a\x23\x8c\xa6\xdf\x1b\xd4\xa3\xac\x87\x31\x52\x59\x0f\xe3\xac\x22\xed\x84\xd3" +
+	"\xf2\xb6\x98\x24\x95\x11\xa6\x58\x45\xda\x69\xaf\x65\x79\x98\x21\x95\x11\x66\x59\x45\xda\x39\xe8\x47\x4b\xd4\x3c\xa9\x8c\xb0\xc0" +
+	"\x2a\xd2\x2e\x3a\x2d\x2f\xc5\x12\xa9\x8c\xb0\xcc\x2a\xd2\xae\x78\x2d\xcb\xc3\x7b\x52\x19\x61\x95\x55\xa4\x5d\x83\xa2\xab\x88\x68" +
+	"\xb7\x75\xd2\x5c\xa4\xdd\x60\x15\x69\x37\x9d\xe6\x99\x8c\xb0\x45\x2a\x23\x6c\xb3\x8a\xb4\x3b\x5e\xcb\xf2\xf0\x81\x54\x46\xd8\x65" +
+	"\x15\x69\xf7\xd2\xef\x4d\xfe\xd1\x36\x6a\x9f\x55\xa4\x3d\x20\x95\xcb\xe4\xa1\xd7\xb2\xf5\xe2\x88\x55\x44\x38\x26\x95\x11\x4e\x9c" +
+	"\x96\xaf\x17\xa7\xac\x22\xc2\x19\xa9\x8c\x70\x0e\xcd\xca\xdb\xe2\x82\x54\xd6\xc3\x25\xab\x48\x5b\xf3\x29\xb4\xbc\x2d\x6a\x49\x65" +
+	"\x84\x3a\x56\x91\xb6\xde\x6b\x59\x1e\x1a\x48\x65\x84\x46\x56\x91\xb6\x09\xfa\xd1\x12\xd5\x4c\x2a\x23\xb4\xb0\x8a\xb4\xad\x4e\xcb" +
+	"\x4b\xd1\x46\x2a\x23\xb4\xb3\x8a\xb4\x1d\x5e\xcb\xf2\xd0\x49\x2a\x23\x74\xb1\x8a\xb4\xdd\x9f\x7e\x4f\x6d\xc3\x7b\xf0\x18\x7a\x62" +
+	"\xcd\x79\x8f\xbf\x37\xd2\x3c\x1c\xa9\xf5\x15\x4a\x92\xa7\x69\xd6\x2f\x94\xae\x30\x65\x03\x91\xd2\xb1\x4f\x9e\xa5\x83\x42\xdd\xa6" +
+	"\x3c\xcf\xd2\xa1\x58\xc3\x91\xda\xb0\xd0\x9c\x8e\xd4\x46\x0a\xcd\x8b\x23\xb5\xd1\x58\x73\x3a\x76\xca\xc7\x22\xcd\xe9\x48\x2d\x4f" +
+	"\xc7\x83\x3a\xc1\x16\x3e\xcb\xd3\x09\xa1\x9c\x76\x32\xd2\x8c\x72\x9b\xe6\x53\x42\x79\x6e\xd3\xb1\x66\x58\x41\x70\xf0\x33\x23\x94" +
+	"\xf3\x3b\x5b\x28\x05\x46\x90\x39\xa1\x5c\xeb\xf3\x91\x62\xc7\x06\x79\xc8\xd2\x05\xa1\xdc\x6e\x8b\xb1\x86\x23\xb5\xa5\xa0\x39\x11" +
+	"\xf2\xb6\x1c\x6b\xce\x2d\xb4\x12\x69\x4e\xb5\x90\x66\xef\x3f\xfd\x9e\x96\x30\x08\xd6\x04\x8c\x57\x23\x45\xd5\xe6\x68\xd3\x6c\x2d" +
+	"\xd6\xd0\xd7\x72\x3d\x28\xea\x36\xa7\x18\x1b\x91\xe2\xb3\x18\xb2\x74\x33\xd6\xd0\xc3\x75\xab\x50\x3f\x33\x37\x6c\x47\x9a\xa5\xdc" +
+	"\x0b\x74\x27\xd6\xd0\xd3\xf7\x43\xa1\xae\x04\xbe\x2c\xbb\x42\xb9\x6c\x7b\x91\xe6\x74\xa4\x96\x67\xfb\x42\xb9\xc4\x07\xb1\xa2\x26" +
+	"\xdd\x3c\x0f\x85\x72\x3d\x1c\x05\x75\x75\x80\x86\xc8\xd3\xec\x58\x28\xd7\xce\x49\xa4\x39\xe5\x20\x4b\x4f\x85\x72\x9d\x9d\xc5\xea" +
+	"\x32\x8c\xf9\x9d\x0b\xe5\x9a\xbc\x28\x94\x02\x23\xc8\xa5\x50\xae\xdf\x9a\xac\x50\x6c\x1e\x90\x87\x3c\xab\x15\xca\xb5\x5e\x17\x6b" +
+	"\xe8\x7b\x5b\x9f\x7d\x4f\xdb\x21\xbe\xc6\x8b\xf7\x86\x58\xb1\x58\x62\xb3\x91\x37\x0a\x45\x10\x68\x53\xa4\x21\x69\xda\x2c\x14\x59" +
+	"\x80\xb6\x14\x9a\x53\xe4\x34\xcf\x5b\x85\xba\xa4\x69\x9e\xb7\xc5\x4a\x43\x9e\xb5\x0b\x45\xb9\xd2\x3c\xcf\x3a\x22\x45\x08\x24\xce" +
+	"\xf2\x4e\xa1\x68\x37\x68\x57\xd0\x8c\x72\x85\x71\x77\xa4\x58\xc8\x10\x35\xcd\x7b\x62\x45\xb6\x10\x3a\xed\x0d\x0a\x71\xd5\x98\xa7" +
+	"\x69\x9f\x50\x4e\xdb\x1f\x2b\xf5\xd3\x4d\xf3\x01\xa1\x3c\xb7\xc1\x48\x91\x2b\x0c\x79\x3a\x24\x94\xf3\x3b\x5c\x28\x96\x51\x2a\xc7" +
+	"\x48\xa4\x88\x88\x21\xcf\x46\x63\xcd\xb9\xd6\xc7\x0a\xf5\x05\x76\xc3\x78\xa4\x79\xc6\xcd\x39\x11\x6b\xce\x47\x6a\x93\xd9\x0f\x51" +
+	"\xef\x47\x3e\xe7\x38\x15\x2b\x7e\x21\xa9\xb4\xd2\x6a\x3a\x52\xa3\x08\xf5\x4c\xa1\x24\x46\x29\x3d\x2b\xd4\x28\x0c\x4a\xcf\x45\xaa" +
+	"\x15\x42\x28\xa3\xd5\xbc\x50\xa3\x90\x58\xab\x85\x58\xb5\x7b\x29\xad\x17\x85\x1a\x77\x8d\x50\xeb\xa5\x42\x31\x1b\x24\xd6\x4a\x2d" +
+	"\xc7\x6a\x5c\xd6\x94\x32\x2b\x91\x1a\x85\xa0\x4a\x1b\xf5\x3e\xa8\x13\x83\x30\x46\xad\x0a\xe5\xb4\x6b\x91\x6a\xca\xad\x32\xeb\x42" +
+	"\x79\x6e\x1b\xb1\x6a\x57\x09\x5a\xa9\x4d\xa1\x9c\xdf\xad\x42\x29\x30\x82\x6c\x0b\xe5\x5a\xdf\x89\x54\xa3\x12\x5c\xf9\x3e\x08\xe5" +
+	"\x76\xdb\x8d\x55\x73\x3b\xef\x05\x35\x44\xc8\xdb\x7e\xac\x86\x5b\xe8\x20\x52\x43\xb5\xa0\xf4\x61\xf6\x03\x5d\x8f\x85\x68\xa3\x91" +
+	"\xde\x1c\x45\x8a\xa6\x37\x68\x53\x7d\x1c\xab\xd6\xc6\x18\x8c\x4e\x82\xa2\x6e\x0d\xc5\x38\x8d\x14\x9f\xc5\xa0\xd5\x59\xac\x48\xe8" +
+	"\x62\x9c\x17\xea\x67\xe6\x86\x8b\x48\xb5\x32\xc4\x97\xb1\x6a\xde\x6b\xab\xf9\x2c\xa8\x2b\x81\x2f\x4b\xad\x50\x2e\x5b\x5d\xa4\xa8" +
+	"\x70\xd4\xae\xd1\xf5\x42\xb9\xc4\x0d\xb1\xa2\x66\xdc\x3c\x1b\x85\x72\x3d\x34\x05\x75\x75\x80\x86\x30\x4a\x37\x0b\xe5\xda\x69\x89" +
+	"\xd4\x50\x0e\xb4\x6a\x15\xca\x75\xd6\x16\xab\xcb\x30\xe6\xd7\x2e\x94\x6b\xb2\xa3\x50\x0a\x8c\x20\x9d\x42\xb9\x7e\xbb\x22\xc5\xe6" +
+	"\x01\x79\x30\xba\x5b\x28\xd7\x7a\x4f\xac\x86\x6b\xbe\xf7\xb3\x1f\xe8\x0c\x11\x16\x3e\xe4\x00\xef\x7d\xb1\x62\xb1\xc4\x66\xc3\xf4" +
+	"\x0b\x45\x10\xe8\x40\xa4\x21\xa9\x1a\x14\x8a\x2c\x40\x87\x0a\x35\x14\x59\x19\x33\x2c\xd4\x25\x55\xc6\x8c\xc4\x4a\x83\xd1\xa3\x42" +
+	"\x51\x2e\x65\x8c\x1e\x8b\x14\x21\x90\x58\x9b\x71\xa1\x68\x37\xe8\x44\x50\x4d\xb9\xc2\x78\x32\x52\x2c\x64\x88\xaa\xcc\x54\xac\xc8" +
+	"\x16\x42\xab\xe9\xa0\x10\x57\x8d\x46\xa9\x19\xa1\x9c\x76\x36\x56\xcc\xcf\xe5\x78\x4e\x28\xcf\x6d\x3e\x52\xe4\x0a\x83\x51\x0b\x42" +
+	"\x39\xbf\x8b\x85\x62\x19\xa5\x72\x2c\x45\x8a\x88\x18\x8c\x5e\x8e\xd5\x70\xad\xaf\x14\xea\x0b\xec\x86\xf7\x91\x1a\xcd\xcd\xb9\x1a" +
+	"\xab\xe1\xbe\x8b\x6b\x9f\xfd\x40\xbd\xd2\xf8\x5b\x0c\xc3\x3a\x2b\x7d\xc2\xff\x6d\x90\x1a\xda\x7e\xf9\xbf\x4d\xaf\x46\x46\xd8\x62" +
+	"\x15\x11\xb6\x49\x65\x84\x1d\xa7\xa6\x2c\x0f\x1f\x58\x45\x84\x5d\x52\x19\x61\x0f\x8a\xd5\x97\xd6\x0e\xff\xb7\x4f\xaa\x45\xda\x03" +
+	"\x56\x91\xf6\xd0\x29\xb6\x9c\x2c\x18\x8e\x48\x65\x84\x63\x56\x91\xf6\xc4\x6b\x59\x1e\x4e\x49\x65\x84\x33\x56\x91\xf6\x1c\x6a\xca" +
+	"\xdb\xe2\x82\x55\xd4\xc3\x25\xa9\xac\x87\x9a\xdc\x69\x59\x5b\xd4\xb2\x8a\x08\x75\xa4\x32\x42\xbd\xd3\xf2\xb6\x68\x60\x15\x11\x1a" +
+	"\x49\x65\x84\xa6\xfc\x07\xf7\x0d\x2f\xeb\xa1\x99\x54\xd6\x43\x0b\xab\x48\xdb\xea\xb4\xbc\x2d\xda\x48\x65\x84\x76\x56\x91\xb6\xc3" +
+	"\x6b\x59\x5b\x74\x92\xca\x08\x5d\xac\x22\x6d\x37\xf4\xa3\x25\xaa\x87\x54\x46\xe8\x65\x15\x69\xfb\x9c\x96\x97\xa2\x9f\x54\x46\x18" +
+	"\x60\x15\x69\x07\xbd\x96\xe5\x61\x88\x54\x46\x18\x66\x15\x69\x47\xa0\xd8\xc0\x8a\x76\x1b\x25\x35\x22\xed\x18\xab\x48\x3b\xee\x14" +
+	"\xdb\x5d\x16\x0c\x13\xa4\x32\xc2\x24\xab\x48\x3b\xe5\xb5\x2c\x0f\xd3\xa4\x32\xc2\x0c\xab\x48\x3b\x9b\xff\x80\x6d\x6c\xd9\x32\x39" +
+	"\xc7\x2a\xd2\xce\x93\xca\x65\x72\xc1\x6b\xd9\x7a\xb1\xc8\x2a\x22\x2c\x91\xca\x08\xcb\x4e\xcb\xd7\x8b\x15\x56\x11\xe1\x3d\xa9\x8c" +
+	"\xb0\x0a\xd5\xe5\x6d\xb1\x46\x2a\xeb\x61\x9d\x55\xa4\xdd\x70\x5a\xde\x16\x9b\xa4\x32\xc2\x16\xab\x48\xbb\xed\xb5\x2c\x0f\x3b\xa4" +
+	"\x32\xc2\x07\x56\x91\x76\x17\xfa\xd1\x12\xb5\x47\x2a\x23\xec\xb3\x8a\xb4\x07\x4e\xcb\x4b\x71\x48\x2a\x23\x1c\xb1\x8a\xb4\xc7\x5e" +
+	"\xcb\xf2\x70\x42\x2a\x23\x9c\xb2\x8a\xb4\x67\xf9\x8f\xd1\x3d\x43\xf8\x48\xed\x3c\x56\xec\x5d\x25\x3a\xd1\xc9\x45\xa4\x26\x21\xd4" +
+	"\x97\x85\x92\x98\x24\xd1\x35\x9f\xc7\x6a\x12\x0c\x89\xae\x8d\x54\x27\x08\x91\x18\x9d\xd4\x09\x35\x09\x12\xeb\xa4\x3e\x56\xed\x5e" +
+	"\x89\xd6\x0d\x42\x8d\xfb\xdd\x9d\xd6\x8d\x85\x62\x36\x48\xac\x93\xa4\x29\x56\xe3\xb2\x96\x24\xa6\x39\x52\x93\x20\x28\xf2\xd7\x12" +
+	"\xd4\x89\x41\x18\x93\xb4\x0a\xe5\xb4\x6d\x91\x6a\xca\x6d\x62\xda\x85\xf2\xdc\x3a\x62\xd5\xae\x12\x74\x92\x74\x0a\xe5\xfc\x76\x15" +
+	"\x4a\x81\x11\xa4\x5b\x28\xd7\x7a\x4f\xa4\x1a\x95\xe0\xca\xd7\x2b\x94\xdb\xad\x2f\x56\xcd\xed\xdc\x1f\xd4\x10\x21\x6f\x03\xb1\x1a" +
+	"\x6e\xa1\xc1\x48\x0d\xd5\x42\xa2\x87\x3e\xff\x91\x7a\xdc\x41\xb0\x26\x60\x3c\x1c\x29\x16\x06\x83\x36\xd5\x23\xb1\x86\x23\xb5\xd1" +
+	"\xa0\xa8\x5b\x43\x31\xc6\x22\xc5\x67\x31\xe8\x64\x3c\xd6\x70\xa4\x36\x51\xa8\x9f\x99\x1b\x26\x23\xd5\x09\x1f\x33\x4c\xc5\x1a\x8e" +
+	"\xd4\xa6\x0b\x75\x25\xf0\x65\x99\x11\xca\x65\x9b\x8d\x14\x15\x8e\xda\x35\x7a\x4e\x28\x97\x78\x3e\x56\xd4\xa4\x9b\xe7\x82\x50\xae" +
+	"\x87\xc5\xa0\xae\x0e\xd0\x10\x26\xd1\x4b\x42\xb9\x76\x96\x23\x35\x94\x03\x9d\xac\x08\xe5\x3a\x7b\x1f\xab\xcb\x30\xe6\xb7\x2a\x94" +
+	"\x6b\x72\xad\x50\x0a\x8c\x20\xeb\x42\xb9\x7e\x37\x22\xc5\xe6\x01\x79\x30\x7a\x53\x28\xd7\xfa\x56\xac\xe1\x48\x6d\xfb\xf3\x1f\xe9" +
+	"\x1a\x20\x16\x3e\xe4\x00\xef\x3b\xb1\x62\xb1\xc4\x66\xc3\x7c\x10\x8a\x20\xd0\xdd\x48\x43\xd2\x64\x4f\x28\xb2\x00\xdd\x2f\xd4\x50" +
+	"\xe4\xc4\x98\x03\xa1\x2e\x69\x62\xcc\x61\xac\x34\x18\x7d\x24\x14\xe5\x4a\x8c\xd1\xc7\x91\x22\x04\x12\x6b\x73\x22\x14\xed\x06\x3d" +
+	"\x0d\xaa\x29\x57\x18\x9f\x45\x8a\x85\x0c\x51\x13\x73\x1e\x2b\xb2\x85\xd0\xc9\x45\x50\x88\xab\x46\x93\x24\x97\x42\x39\x6d\x8d\x8d" +
+	"\x94\x8e\xd4\x12\x53\x2b\x94\xe7\x56\x17\x29\x72\x85\xc1\x24\xf5\x42\x39\xbf\x0d\x85\x62\x19\xa5\x72\x34\x46\x8a\x88\x18\x8c\x6e" +
+	"\x8a\xd5\x70\xad\x37\x17\xea\x0b\xec\x86\x96\x48\x8d\xe6\xe6\x6c\x8d\xd5\xf0\xfd\x40\xda\xec\x8f\xd1\xfd\x40\x78\x68\x67\xa5\x4f" +
+	"\xf8\xbf\x0e\x52\x23\xd2\x76\x7a\x35\x32\x42\x17\xab\x88\xd0\x4d\x2a\x23\xf4\x38\x35\x65\x79\xe8\x65\x15\x11\xfa\x48\x65\x84\x7e" +
+	"\x28\x56\x5f\x5a\x3b\xfc\xdf\x00\xa9\x16\x69\x07\x59\x45\xda\x21\xa7\xd8\x72\xb2\x60\x18\x26\x95\x11\x46\x58\x45\xda\x51\xaf\x65" +
+	"\x79\x18\x23\x95\x11\xc6\x59\x45\xda\x09\xa8\x29\x6f\x8b\x49\x56\x51\x0f\x53\xa4\xb2\x1e\xa6\xbd\x96\xb5\xc5\x0c\xab\x88\x30\x4b" +
+	"\x2a\x23\xcc\x39\x2d\x6f\x8b\x79\x56\x11\x61\x81\x54\x46\x58\xb4\x3f\xba\x6f\x78\x59\x0f\x4b\xa4\xb2\x1e\x96\x59\x45\xda\x15\xa7" +
+	"\xe5\x6d\xf1\x9e\x54\x46\x58\x65\x15\x69\xd7\xbc\x96\xb5\xc5\x3a\xa9\x8c\xb0\xc1\x2a\xd2\x6e\x42\x3f\x5a\xa2\xb6\x48\x65\x84\x6d" +
+	"\x56\x91\x76\xc7\x69\x79\x29\x3e\x90\xca\x08\xbb\xac\x22\xed\x9e\xd7\xb2\x3c\xec\x93\xca\x08\x07\xac\x22\xed\x21\x14\x1b\x58\xd1" +
+	"\x6e\x47\xa4\x46\xa4\x3d\x66\x15\x69\x4f\x9c\x62\xbb\xcb\x82\xe1\x94\x54\x46\x38\x63\x15\x69\xcf\xbd\x96\xe5\xe1\x82\x54\x46\xb8" +
+	"\x64\x15\x69\x6b\xbe\xf8\x11\xdb\xd8\xb2\x65\xb2\x96\x55\xa4\xad\x23\x95\xcb\x64\xbd\xd7\xb2\xf5\xa2\x81\x55\x44\x68\x24\x95\x11" +
+	"\x9a\x9c\x96\xaf\x17\xcd\xac\x22\x42\x0b\xa9\x8c\xd0\x0a\xd5\xe5\x6d\xd1\x46\x2a\xeb\xa1\x9d\x55\xa4\xed\x70\x5a\xde\x16\x9d\xa4" +
+	"\x32\x42\x17\xab\x48\xdb\xed\xb5\x2c\x0f\x3d\xa4\x32\x42\x2f\xab\x48\xdb\x07\xfd\x68\x89\xea\x27\x95\x11\x06\x58\x45\xda\x41\xa7" +
+	"\xe5\xa5\x18\x22\x95\x11\x86\x59\x45\xda\x11\xaf\x65\x79\x18\x25\x95\x11\xc6\x58\x45\xda\xf1\x2f\xfe\x14\xdd\x33\x8e\xcf\x5a\x4e" +
+	"\xc4\x8a\x83\x72\xdc\xde\x39\x99\x8c\xd4\x24\x84\x6a\xaa\x50\x12\xdc\x02\x7a\x5a\x28\x1d\xa9\xa9\x99\x48\x71\xef\x68\x85\xe8\xc9" +
+	"\xac\x50\xb7\x29\x37\x2a\x99\x8b\xd5\xdd\xc1\x15\x1f\x98\x17\x8a\xa5\x10\xba\x50\x28\x66\x83\xc4\x2a\x49\x16\x63\x35\x2e\x6b\x49" +
+	"\x62\x96\x22\x35\x09\x72\x81\x9b\x56\x2f\x07\x75\x82\x2d\xbc\x32\xc9\x8a\x50\x4e\xfb\x3e\x52\x45\xb9\x4d\xcc\xaa\x50\x9e\xdb\x5a" +
+	"\xac\x0a\x2b\x08\x6e\x9a\xbd\x2e\x94\xf3\xbb\x51\x28\x05\x46\x90\x4d\xa1\x5c\xeb\x5b\x91\x2a\x54\x82\x2b\xdf\xb6\x50\x6e\xb7\x9d" +
+	"\x58\x15\xb7\xf3\x87\xa0\x86\x08\x79\xdb\x8d\xd5\x70\x0b\xed\x45\x6a\xa8\x16\x12\xb5\xff\xc5\x9f\xe8\x2e\x16\x10\x9c\x90\xc1\xf8" +
+	"\x20\x52\x34\xbd\x41\x9b\xaa\xc3\x58\x15\x2e\x73\x61\x74\x14\x14\x75\x6b\x28\xc6\x71\xa4\xf8\x2c\x06\x95\x9c\xc4\x8a\x84\x2e\xc6" +
+	"\x69\xa1\x7e\x66\x6e\x38\x8b\x54\xe1\x48\x0d\xa8\xce\x63\x55\x7c\xa4\x76\x51\xa8\x2b\x81\x2f\xcb\xa5\x50\x2e\x5b\xcd\xb5\x42\x51" +
+	"\xe1\xa8\x5d\xa3\x6a\x85\x72\x89\xeb\x62\x45\x4d\xba\x79\xd6\x0b\xe5\x7a\x68\x08\xea\xea\x00\x0d\x61\x12\xd5\x28\x94\x6b\xa7\x29" +
+	"\x52\x43\x39\x50\x49\xb3\x50\xae\xb3\x96\x58\x5d\x86\x31\xbf\x56\xa1\x5c\x93\x6d\x85\x52\x60\x04\x69\x17\xca\xf5\xdb\x11\x29\x36" +
+	"\x0f\xc8\x83\x51\x9d\x42\xb9\xd6\xbb\x62\xa5\xeb\x2a\xc6\x74\x5f\xfb\x13\xf5\x4a\x8b\x8f\xd4\x7a\x62\x0d\x87\x5f\xbd\x42\x11\x04" +
+	"\xeb\x5c\x5f\xa4\xc5\x91\x5a\xbf\x50\x64\x01\x3a\x50\x68\x74\xa4\x36\x28\xd4\x25\x4d\x8c\x19\x8a\x95\x06\xa3\x86\x85\xa2\x5c\x89" +
+	"\x31\x6a\x24\x52\x3e\x52\x53\x66\x54\x28\xda\x0d\x3a\x16\x54\x51\xae\x30\x1e\x8f\x14\x0b\x19\xa2\x26\x66\x22\xd6\x70\xa4\x36\x19" +
+	"\x14\xa2\xe8\x48\x6d\x4a\x28\xa7\x9d\x8e\x95\xae\x7d\x25\x66\x46\x28\xcf\x6d\x36\x52\xe4\x0a\x83\x49\xe6\x84\x72\x7e\xe7\x0b\xc5" +
+	"\x32\x4a\xe5\x58\x88\x14\x11\x31\x18\xb5\x18\x6b\x38\x52\x5b\x2a\xd4\x17\xd8\x0d\xcb\x91\x1a\xc5\xcd\xb9\x12\x6b\x38\x52\x7b\x7f" +
+	"\xed\x4f\x74\x5f\x35\xee\xcb\x85\x61\x95\x95\x3e\xe1\xff\xd6\x48\x0d\x6d\xbf\xfc\xdf\xba\x57\x23\x23\x6c\xb0\x8a\x08\x9b\xa4\x32" +
+	"\xc2\x96\x53\x53\x96\x87\x6d\x56\x11\x61\x87\x54\x46\xf8\x00\xc5\xea\x4b\x6b\x07\xdd\x7f\x92\x54\x89\xb4\x7b\xac\x22\xed\xbe\x53" +
+	"\x6c\x39\x59\x30\x1c\x90\xca\x08\x87\xac\x22\xed\x91\xd7\xb2\x3c\x1c\x93\xca\x08\x27\xac\x22\xed\x29\xd4\x94\xb7\xc5\x19\xab\xa8" +
+	"\x87\x73\x52\x59\x0f\x17\x5e\xcb\xda\xe2\x92\x55\x44\xa8\xb9\xee\x55\x46\xa8\x75\x5a\xde\x16\x75\xac\x22\x42\x3d\xa9\x8c\xd0\x70" +
+	"\xfd\x4f\xee\x1b\x5e\xd6\x43\x23\xa9\xac\x87\x26\x56\x91\xb6\xd9\x69\x79\x5b\xb4\x90\xca\x08\xad\xac\x22\x6d\x9b\xd7\xb2\xb6\x68" +
+	"\x27\x95\x11\x3a\x58\x45\xda\x4e\xe8\x47\x4b\x54\x17\xa9\x8c\xd0\xcd\x2a\xd2\xf6\x38\x2d\x2f\x45\x2f\xa9\x8c\xd0\xc7\x2a\xd2\xf6" +
+	"\x7b\x2d\xcb\xc3\x00\xa9\x8c\x30\xc8\x2a\xd2\x0e\x41\xb1\x81\x15\xed\x36\x4c\x6a\x44\xda\x11\x56\x91\x76\xd4\x29\xb6\xbb\x2c\x18" +
+	"\xc6\x48\x65\x84\x71\x56\x91\x76\xc2\x6b\x59\x1e\x26\x49\x65\x84\x29\x56\x91\x76\xfa\xfa\x9f\xb0\x8d\x2d\x5b\x26\x67\x58\x45\xda" +
+	"\x59\x52\xb9\x4c\xce\x79\x2d\x5b\x2f\xe6\x59\x45\x84\x05\x52\x19\x61\xd1\x69\xf9\x7a\xb1\xc4\x2a\x22\x2c\x93\xca\x08\x2b\x50\x55" +
+	"\xde\x16\xef\x49\x65\x3d\xac\xb2\x8a\xb4\x6b\x4e\xcb\xdb\x62\x9d\x54\x46\xd8\x60\x15\x69\x37\xbd\x96\xe5\x61\x8b\x54\x46\xd8\x66" +
+	"\x15\x69\x77\xa0\x1f\x2d\x51\x1f\x48\x65\x84\x5d\x56\x91\x76\xcf\x69\x79\x29\xf6\x49\x65\x84\x03\x56\x91\xf6\xd0\x6b\x59\x1e\x8e" +
+	"\x48\x65\x84\x63\x56\x91\xf6\xe4\xfa\x9f\xa3\xbb\x18\xf3\x91\xda\x69\xac\x9a\xf7\xf8\xcf\x22\xd5\xe1\x48\xed\xbc\x50\x12\x9d\x24" +
+	"\xea\x42\x28\x5d\x61\x52\x97\x91\x62\x7b\xa0\x10\x3d\xa9\xb9\x11\x2b\xce\xe8\x40\x6b\x63\x0d\x47\x6a\x75\x42\x35\x1d\xa9\xd5\x17" +
+	"\xaa\x8b\x23\xb5\x86\x58\x35\x1d\x3b\xe9\xc6\x48\x35\x1d\xa9\xe9\xa4\x29\xa8\x13\x5c\x4b\x52\x3a\x69\x16\xca\x69\x5b\x22\x55\x94" +
+	"\xdb\x44\xb7\x0a\xe5\xb9\xb5\xc5\xea\x0a\x87\x83\x9f\x76\xa1\x9c\xdf\x8e\x42\x29\x30\x82\x74\x0a\xe5\x5a\xef\x8a\x14\x3b\x36\xc8" +
+	"\x83\x4a\xba\x85\x72\xbb\xf5\xc4\x1a\x8e\xd4\x7a\x83\x6a\x22\x14\xb1\x2f\x56\xcd\x2d\xd4\x1f\xa9\xa6\x5a\x48\xd4\xc0\x8d\x3f\xd3" +
+	"\x7d\xd5\x20\xe8\x75\x81\xf1\x60\xa4\xa8\x5a\x8d\x07\x36\xa9\xa1\x58\x43\x5f\xcb\xe1\xa0\xa8\x5b\x4d\x31\x46\x22\xc5\x67\x31\xa8" +
+	"\x64\x34\xd6\xd0\xc3\x75\xac\x50\x3f\x33\x37\x8c\x47\xaa\x12\xee\x05\x3a\x11\xab\xe2\xeb\xc7\x93\x85\xba\x12\xf8\xb2\x4c\x09\xe5" +
+	"\xb2\x4d\x47\xaa\xe9\x48\x4d\xab\x19\xa1\x5c\xe2\xd9\x58\x51\x93\x6e\x9e\x73\x42\xb9\x1e\xe6\x83\xba\x3a\x40\x43\xe8\x44\x2d\x08" +
+	"\xe5\xda\x59\x8c\x54\x53\x0e\x54\xb2\x24\x94\xeb\x6c\x39\x56\x97\x61\xcc\x6f\x45\x28\xd7\xe4\xfb\x42\x29\x30\x82\xac\x0a\xe5\xfa" +
+	"\x5d\x8b\x14\x9b\x07\xe4\x41\xab\x75\xa1\x5c\xeb\x1b\xb1\x86\xbe\xb7\x9b\x37\xfe\x4c\x77\x7a\xe0\x6b\xbc\x78\xdf\x8a\x15\x8b\x25" +
+	"\x36\x1b\x7a\x5b\x28\x82\x40\x77\x22\x0d\x49\x93\x0f\x42\x91\x05\xe8\x6e\xa1\x9a\x22\x27\x5a\xef\x09\x75\x49\x13\xad\xf7\x63\xa5" +
+	"\x41\xab\x03\xa1\x28\x57\xa2\xb5\x3a\x8c\x14\x21\x90\x58\xe9\x23\xa1\x68\x37\xe8\x71\x50\x45\xb9\xc2\xf8\x24\x52\x2c\x64\x88\x9a" +
+	"\xe8\xd3\x58\x91\x2d\x84\x4e\xce\x82\x42\x5c\x35\xea\x24\x39\x17\xca\x69\x2f\x62\xa5\x7e\xba\x89\xbe\x14\xca\x73\xab\xb9\x59\x28" +
+	"\x72\x85\x41\x27\xb5\x42\x39\xbf\x75\x85\x62\x19\xa5\x72\xd4\x47\x8a\x88\x18\xb4\x6a\x88\x55\x73\xad\x37\x16\xea\x0b\xec\x86\xa6" +
+	"\x48\xb5\xe2\xe6\x6c\x8e\x55\xf3\x91\x5a\xcb\xcd\x3f\x47\x77\x31\xe6\xa1\x95\x95\x3e\xe1\xff\xda\x48\xb5\x48\xdb\xee\x55\xcb\x08" +
+	"\x1d\xac\x22\x42\x27\xa9\x8c\xd0\xe5\x54\x97\xe5\xa1\x9b\x55\x44\xe8\x21\x95\x11\x7a\xa1\x58\x7d\x69\xed\xf0\x7f\x7d\xa4\x4a\xa4" +
+	"\xed\x67\x15\x69\x07\x9c\x62\xcb\xc9\x82\x61\x90\x54\x46\x18\x62\x15\x69\x87\xbd\x96\xe5\x61\x84\x54\x46\x18\x65\x15\x69\xc7\xa0" +
+	"\xba\xbc\x2d\xc6\x59\x45\x3d\x4c\x90\xca\x7a\x98\xf4\x5a\xd6\x16\x53\xac\x22\xc2\x34\xa9\x8c\x30\xe3\xb4\xbc\x2d\x66\x59\x45\x84" +
+	"\x39\x52\x19\x61\xfe\xe6\x9f\xdd\x37\xbc\xac\x87\x05\x52\x59\x0f\x8b\xac\x22\xed\x92\xd3\xf2\xb6\x58\x26\x95\x11\x56\x58\x45\xda" +
+	"\xf7\x5e\xcb\xda\x62\x95\x54\x46\x58\x63\x15\x69\xd7\xa1\x1f\x2d\x51\x1b\xa4\x32\xc2\x26\xab\x48\xbb\xe5\xb4\xbc\x14\xdb\xa4\x32" +
+	"\xc2\x0e\xab\x48\xfb\xc1\x6b\x59\x1e\x76\x49\x65\x84\x3d\x56\x91\x76\x1f\x8a\x0d\xac\x68\xb7\x03\x52\x2d\xd2\x1e\xb2\x8a\xb4\x47" +
+	"\x4e\xb1\xdd\x65\xc1\x70\x4c\x2a\x23\x9c\xb0\x8a\xb4\xa7\x5e\xcb\xf2\x70\x46\x2a\x23\x9c\xb3\x8a\xb4\x17\x37\xff\x8c\x6d\x6c\xd9" +
+	"\x32\x79\xc9\x2a\xd2\xd6\xdc\xfa\x33\x6d\xbb\x59\x30\xd4\x7a\x2d\x5b\x2f\xea\x58\x45\x84\x7a\x52\x19\xa1\xc1\x69\xf9\x7a\xd1\xc8" +
+	"\x2a\x22\x34\x91\xca\x08\xcd\x50\x55\xde\x16\x2d\xa4\xb2\x1e\x5a\x59\x45\xda\x36\xa7\xe5\x6d\xd1\x4e\x2a\x23\x74\xb0\x8a\xb4\x9d" +
+	"\x5e\xcb\xf2\xd0\x45\x2a\x23\x74\xb3\x8a\xb4\x3d\xd0\x8f\x96\xa8\x5e\x52\x19\xa1\x8f\x55\xa4\xed\x77\x5a\x5e\x8a\x01\x52\x19\x61" +
+	"\x90\x55\xa4\x1d\xf2\x5a\x96\x87\x61\x52\x19\x61\x84\x55\xa4\x1d\xbd\xf5\x13\x5d\xef\xe4\x3d\x57\x0c\x63\xac\x36\xee\x45\x37\x4e" +
+	"\x6a\x45\x3f\xbc\x09\xaf\x56\xf6\xe4\x9b\x64\x0d\xdf\xf3\xd8\x44\x4f\x91\xda\xa8\x37\xa1\x4e\xa6\x9d\xda\xb2\x7e\x8e\x33\xac\x61" +
+	"\x2f\x08\xef\xb3\xa4\x96\x7a\x8e\xc0\x12\x3d\x07\xb5\x5c\x0a\xde\xf7\x9b\x67\xb5\x01\x13\x9d\x2c\x90\x5a\xea\x63\xa8\x5d\x1f\xc3" +
+	"\x45\xaf\x54\x8a\x84\x66\xb8\xc4\x4a\xcb\x36\x26\x25\x7a\x99\xd4\x52\x8f\x09\x8c\x75\xb2\xe2\x94\x4b\xa1\xa9\x5f\xe6\x7b\x56\xfa" +
+	"\x7e\xf0\xe9\x57\x49\x2d\xf5\x74\xc1\x90\xe8\xb5\x5b\x3f\x45\xcf\x8d\xe2\xb6\x58\x67\xe5\xfd\x54\x7c\x24\xd9\x20\xc5\x33\x9b\x42" +
+	"\x08\xbd\xe9\x15\xcf\x8d\xa2\x59\x21\xc3\x5b\xac\xf4\x2d\x87\xcf\x27\x7a\x9b\xd4\x52\x5f\x19\x3f\x6d\xc7\x29\x72\x41\x35\x86\x09" +
+	"\xfa\x03\x2b\x6d\x25\x30\xd6\xc9\x2e\xa9\xa5\x7e\x1f\xb0\x44\xef\xdd\xfa\x89\xee\x1c\xc5\x73\xc3\x78\x9f\xb4\x98\x1b\x5e\x07\xac" +
+	"\x96\xeb\x0c\xa3\x43\xa7\xf1\xdc\xf0\x7e\x44\x5a\xcc\x0d\x53\x8e\x59\x2d\x27\x44\xd6\x4e\xbc\xa2\xdd\x8a\xc4\xc9\x29\xa9\xe5\x1e" +
+	"\xa3\x98\xa6\xcf\x58\xc5\xde\xe0\xf9\xad\x9f\xf8\x4e\x7d\xd1\xdc\x2e\x48\x8b\x96\xc7\xfb\x25\x2b\x6d\x85\x7d\x9c\x9a\xdb\x3f\xd1" +
+	"\x9d\xfa\x38\x1d\x5e\xb5\xa4\x45\xcb\x63\x5c\xc7\x4a\x7b\x32\xf8\xbc\xd6\xf5\x5e\x51\x93\x94\x18\xe9\x1b\x48\xd1\xf2\x54\x6b\x3a" +
+	"\xd1\x8d\xac\x96\xb3\x80\xf7\x26\x68\x58\x7e\x79\x6e\xcd\xa4\xc5\x5a\x88\x57\x0b\xab\xd8\xe3\x6f\x75\xca\xa5\xe0\x1c\xb7\x91\x16" +
+	"\x6b\x21\xa6\xb4\xb3\x8a\xe3\xa1\x0e\xaf\x54\x0a\xaa\xca\xa4\x93\xd4\x97\x82\xdb\xa2\x8b\x55\x3e\xff\xed\xf6\x4f\x34\x1f\x4b\x77" +
+	"\x4a\xc3\xb8\x87\x54\xd3\xb2\x8d\xb1\xcd\x7a\x59\x35\xdf\x70\x0b\xa3\x3e\xa7\xc8\x79\x66\x8b\x18\xfd\xa4\x9a\xd6\x66\x3f\x65\x80" +
+	"\x55\x73\x42\xac\xbe\x83\x5e\x5d\xaf\x9e\x90\xd8\x0e\x91\x6a\x7e\xe6\x0d\x30\x1b\x66\x15\x77\x5b\x1b\xb9\xfd\x13\x2d\x35\xf1\xdc" +
+	"\x46\x49\x35\x6d\x0f\xfc\xb4\x31\x56\x5a\x5e\x7d\x9c\x71\xa7\x88\xc1\xe9\xf0\x9a\x20\xd5\xb4\x86\xc2\x32\x3b\xc9\x4a\x4b\x10\xdd" +
+	"\xff\xcc\x2b\x6a\x92\x12\x23\xfd\x34\xa9\xfb\x96\xf6\xb3\xca\x6c\x36\xc3\x2a\xee\x5e\x36\x0b\xd5\x5c\x0a\x9e\xdb\x1c\xa9\x0e\x4f" +
+	"\xd8\xc1\x6b\x9e\x55\xdc\xf1\x6d\xc1\x29\x97\x82\x73\xbc\x48\xaa\x69\x8b\x82\x21\xb3\x4b\xac\xe2\x7e\x6d\xcb\x5e\xa9\x14\x54\x95" +
+	"\x76\x85\x54\x27\xf1\x3d\xd8\xde\xb3\x8a\x6f\xbd\xd5\xdb\x3f\xd1\x12\xc6\x7d\x4b\x30\xac\xb1\x6a\x7e\x44\x0f\xe2\xae\x93\x6a\xfa" +
+	"\x8e\x42\x54\x9b\x6d\x78\xd5\xf2\x59\x45\x9b\xac\xa1\x76\x50\xb6\x2d\xd2\xa2\x76\x30\xde\x76\xaa\xcb\x9e\x08\xb4\xc3\x1a\x6a\x07" +
+	"\xef\x1f\x48\x8b\xda\x41\x94\x5d\xa8\xe6\x52\x70\x2b\xed\xb1\xea\x80\x36\xb3\xfb\xa4\x9a\x9e\xb8\x94\xb9\x27\x2e\x1d\x78\xa5\x52" +
+	"\x50\x63\xda\x43\x56\xda\x62\x63\x92\xcd\x8e\x48\x8b\x25\x15\xaf\x63\xa7\x5c\x8a\x8c\x9e\x76\x74\xc2\x1a\x96\x54\xbc\x9f\x92\x16" +
+	"\x4b\x2a\x62\x9c\xdd\xfe\x29\xda\xa7\xe5\xb6\x38\x67\xe5\xfb\xec\xe1\x23\xf6\x82\x14\x6d\x1c\x42\x64\x97\x5e\xb1\x4f\x4b\xb3\x42" +
+	"\x86\x6b\xee\x90\xd2\x56\x18\x9f\xb7\x59\x2d\x69\xb1\xd5\xc0\x7b\x9d\x53\xe4\x82\x6a\x0c\x13\xb2\x7a\xd6\xb0\xd5\x40\xda\x06\xd2" +
+	"\x62\xab\x81\x71\xe3\x9d\xbf\xd0\x7e\x14\xf7\xd5\xc0\xd0\xc4\x8a\x73\xe4\xe8\xdb\xe1\xae\x5a\x34\x93\xe6\x59\x84\xa6\xc5\x6b\x8e" +
+	"\xfe\xca\x94\xda\x24\x49\x2b\x6b\xb8\xb2\x8d\x8b\x92\x6d\xa4\x39\xf5\x79\x03\x9b\xa4\xdd\x29\x6e\x5f\xc1\x01\x30\xb1\x83\x35\x5c" +
+	"\xf7\xc7\x7b\x27\x69\x4e\x7d\x4c\x61\x89\xe9\x82\xe6\x71\x6f\x63\xe4\xa2\x9b\x35\x0f\x98\x98\xa4\x87\xd4\x97\x02\x97\x79\x10\xa9" +
+	"\xd7\x2b\x95\x22\xa1\x19\xf6\xb1\xd2\xd5\x1c\x4c\x4a\x4c\x3f\x69\x4e\xfd\xf9\x30\x36\xc9\x80\x53\x2e\x85\xcb\x04\xfa\x3f\xb0\xe6" +
+	"\x1c\x16\xef\x43\xa4\x39\xf5\x89\xc5\x90\x98\xe1\x3b\x7f\x49\xf2\xb0\x1f\xc5\x6d\x31\xc2\xca\x3d\x33\xf0\x91\x64\x94\x34\xa7\xde" +
+	"\xf2\x0e\xcd\x98\xd7\x9c\xfa\xc5\x53\x46\xc6\x59\xe9\xba\x2e\x92\x26\x66\x82\x34\xa7\x5e\xb5\x7e\xda\xa4\xd3\x9c\xfa\xa4\x73\x5b" +
+	"\x4c\xb1\xd2\x75\x31\x8c\x4d\x32\x4d\x9a\x53\xaf\x44\x58\x62\x66\xee\xfc\x85\xee\xeb\xc2\x73\xc3\x78\x96\xb4\x98\x1b\x5e\x73\xac" +
+	"\x39\xd7\x19\x46\xf3\x4e\xe3\xb9\xe1\x7d\x81\xb4\x98\x1b\xa6\x2c\xb2\xe6\x9c\x10\x59\x5b\xf2\x8a\x76\x2b\x12\x27\xcb\xa4\x68\x79" +
+	"\x9f\x10\xc3\x0a\xab\xec\xff\x70\xe7\x2f\x7c\x1f\xad\x68\x6e\xab\xa4\x45\xcb\xe3\x7d\x8d\x95\xae\x3b\xfa\x38\xeb\x4e\xe3\x96\xc7" +
+	"\x6b\x83\xb4\x68\x79\x8c\x37\x59\xe9\xda\x3d\x3e\x6f\xcc\x96\x57\xd4\x24\x25\x46\xfa\x6d\xd2\x9c\x7f\x53\xe1\x72\xb7\xc3\x9a\x73" +
+	"\x16\xf0\xfe\x01\x1a\x96\x5f\x9e\xdb\x2e\x69\xb1\x16\xe2\xb5\xc7\x2a\xfa\xb8\xec\x3b\xe5\x52\x70\x8e\x0f\x48\x8b\xb5\x10\x53\x0e" +
+	"\x59\x45\x0f\xa0\x23\xaf\x54\x0a\xaa\xca\xe4\x98\x34\x17\xbf\x17\x39\x61\xa5\xbe\x27\xfe\xef\xf4\xce\x5f\x68\x3e\x39\xdd\xc7\x08" +
+	"\xe3\x33\x52\x43\xcb\x36\xc6\x79\x76\xce\x6a\xf8\x76\x38\x18\x5d\x38\x45\xce\xb3\xbc\x88\x71\x49\x6a\x68\x6d\xf6\x53\x6a\xee\x92" +
+	"\x1a\x4e\x88\xd5\xb7\xd6\xab\xeb\xbd\x1b\x12\xe7\x75\xa4\xae\xef\x6d\xce\x77\xe5\xa9\x67\x15\xf7\x42\x6a\xb8\xfb\x17\x5a\x6a\xe2" +
+	"\xb9\x35\x92\x1a\xda\x1e\xf8\x69\x4d\xac\xb4\xbc\xfa\x38\xcd\x4e\x11\x83\xd3\xe1\xd5\x42\x6a\x68\x0d\x85\x65\x79\x2b\x2b\x2d\x41" +
+	"\xf8\x7c\x96\xb5\x79\x45\x4d\x52\x62\xa4\x6f\x27\x35\xfc\xb4\x4f\x57\x8e\x0e\x56\x71\x6f\xa1\x4e\xa8\xe1\x52\xf0\xdc\xba\x48\x0d" +
+	"\x6d\xeb\xfc\xab\x9b\x55\xdc\x8f\xa9\xc7\x29\x97\x82\x73\xdc\x4b\x6a\x68\x8b\x82\x21\xcb\xfb\x58\xc5\xdd\x94\xfa\xbd\x52\x29\xa8" +
+	"\x2a\xf3\x01\x52\x23\x9e\x4e\x3a\xc8\x2a\xae\xf3\x0e\xdd\xfd\x0b\x2d\x61\xfc\xbd\x8d\x61\x98\x15\xed\x86\x12\x23\x40\x3e\x42\x6a" +
+	"\xe8\x3b\xca\x61\x36\xea\x15\x7d\xbc\x73\x4a\x9d\xe5\xf9\x18\x6b\xa8\x1d\x94\x6d\x9c\xb4\xa8\x1d\x8c\x27\x9c\x62\x25\xe1\x00\x68" +
+	"\xa1\x49\xd6\x50\x3b\x78\x9f\x22\x2d\x6a\x07\x51\xa6\xa1\xe1\x17\x1c\xdc\x4a\x33\xac\x26\x60\x9e\xe5\xb3\xa4\xbe\x14\x39\x2d\x14" +
+	"\x73\x5e\xa9\x14\xd4\x98\xf9\x3c\x2b\x6d\xb1\x31\x29\xcf\x16\x48\x8b\x25\x15\xaf\x45\xa7\x5c\x0a\xd7\x40\x79\x96\x2d\xb1\x86\x25" +
+	"\x15\xef\xcb\xa4\xc5\x92\x8a\x18\x2b\x77\xff\x12\xf5\xe2\xe0\xb6\x78\xcf\xca\x77\xc1\xc2\x47\xf2\x55\x52\x43\xcf\xb0\x75\x98\xad" +
+	"\x79\x35\xf4\x14\x5c\xca\xc8\x3a\x2b\x6d\x85\x91\x34\xcf\x36\x48\x8b\xad\x06\xde\x37\x9d\x1a\x7e\x7e\x2c\xb5\xc5\x16\x6b\xd8\x6a" +
+	"\x20\xed\x36\x69\xb1\xd5\xc0\x78\xe7\xee\xcf\xd4\xab\x83\x7b\x0d\x60\xf8\xc0\x2a\xae\x40\xef\x92\x5a\xd1\xdb\x78\xcf\xab\x95\xfd" +
+	"\x95\xf7\x59\xe3\xeb\xe8\xea\x80\xd4\x46\x7d\xa6\x55\x72\xe8\xd4\x52\xff\x5f\xbe\x86\x7d\xc4\x5a\xf4\x32\x50\x2a\x39\x26\xb5\xa1" +
+	"\x47\x39\x22\x9d\x40\x2d\x97\x82\xaf\x8d\x9f\xb2\xf2\xd5\x75\x04\x4f\xce\x48\x2d\xf5\xa4\x56\xae\x27\xf5\xb9\x57\x2a\x45\x42\x33" +
+	"\xbc\x60\xa5\xab\x02\x98\x94\xa8\x4b\x52\x4b\xfd\xc2\x30\x56\x49\xcd\x3d\x28\x97\x42\x51\x9f\x86\x5a\x56\xba\x9a\xe3\xd3\xd7\x91" +
+	"\x5a\xea\xcf\x87\x21\x51\xf5\xf7\x7e\x4e\x6c\xf8\x16\xe1\xb6\x68\x60\xb5\x84\xf8\x48\xd2\x48\x8a\xa7\x59\x87\x10\xaa\xc9\xab\x45" +
+	"\x1e\x8a\x1e\x14\xcd\xac\x74\x4d\x0a\x9f\x4f\x54\x0b\xa9\xa5\x1e\x81\x7e\x5a\xab\x53\xe4\x82\xbb\x18\x60\xa6\x6d\xac\x74\x7d\x05" +
+	"\x63\x95\xb4\x93\x5a\xea\xdd\x06\x4b\x54\xc7\xbd\x9f\xe9\x99\x53\x3c\x37\x8c\x3b\x49\x8b\xb9\xe1\xd5\xc5\x4a\x57\xaa\x5c\xd8\xa4" +
+	"\xdb\x69\x3c\x37\xbc\xf7\x90\x16\x73\xc3\x94\x5e\x56\xba\xea\xe2\x33\xdd\xe7\x15\xed\x56\x24\x4e\xfa\x49\x2d\xf7\x8b\xc7\x34\x35" +
+	"\xc0\x1a\xfa\x55\xe0\x6f\xf0\xde\xcf\xfc\x8c\xbf\x68\x6e\x43\xa4\x45\xcb\xe3\x7d\x98\xd5\x72\x9d\xa1\x14\x23\x4e\xe3\x96\xc7\x6b" +
+	"\x94\xb4\x68\x79\x8c\xc7\x58\x2d\xcd\x0c\x63\x35\xee\x15\x35\x49\x89\x91\x7e\x82\x14\x2d\x4f\xb5\xa6\x12\x35\xc9\x1a\x7a\x00\xe1" +
+	"\x7d\x0a\x1a\x96\x5f\x9e\xdb\x34\x69\xb1\x16\xe2\x35\xc3\xea\x4a\xc1\x6d\x31\xeb\x94\x4b\xc1\x39\x9e\x23\x2d\xd6\x42\x4c\x99\x67" +
+	"\x75\xa5\xe0\xb6\x58\xf0\x4a\xa5\xa0\xaa\x4c\x16\x49\x7d\x29\xb8\x2d\x96\x58\xc5\xf9\xa8\xe5\x7b\x3f\xd3\x7c\x2c\x3d\x63\x0d\xe3" +
+	"\x15\x52\x45\xcb\x36\xc6\x36\x7f\xcf\xaa\xf8\x51\x5d\x18\xad\x3a\x45\xce\x73\x5b\xc4\x58\x23\x55\xb4\x36\xfb\x29\xeb\xac\x8a\x13" +
+	"\x62\xf5\xdd\xf0\xea\xfa\x2e\x86\xc4\x76\x93\xd4\xf5\x5d\xb4\xfc\xc4\xb0\x2d\x56\xf1\x9c\xb6\xed\x7b\x3f\xd3\x52\x13\xcf\x6d\x87" +
+	"\x54\xd1\xf6\xc0\x4f\xfb\xc0\x4a\xcb\xab\x8f\xb3\xeb\x14\x31\x38\x1d\x5e\x7b\xa4\x8a\xd6\x50\x58\x6e\xf7\x59\x69\x09\xc2\xe7\xf3" +
+	"\xfc\xc0\x2b\x6a\x92\x12\x23\xfd\x21\xa9\xe2\xe7\x56\xbb\x72\x1c\xb1\x8a\xe7\x9e\x1d\x43\x15\x97\x82\xe7\x76\x42\xaa\x8a\x67\x7d" +
+	"\x5b\x9b\x9f\xb2\xd2\xba\x88\x1c\x58\x7b\xe6\x94\x4b\xc1\x39\x3e\x27\x55\xb4\x45\xc1\x90\xdb\x0b\x56\xf1\xa4\xb7\x4b\xaf\x54\x0a" +
+	"\xaa\x4a\x5b\x73\xff\x67\xea\x47\xca\x33\xc3\x50\xcb\x2a\xae\xcd\xd4\xdd\xff\x99\x96\x30\xde\xb3\xc2\x50\xcf\x8a\x76\x43\x89\x11" +
+	"\xc0\x36\x90\x2a\xfa\x8e\x72\x98\x37\x7a\x55\x09\x7d\xc3\xe0\xf3\xd6\x36\xb1\x86\xda\x41\xd9\x9a\x49\x8b\xda\xc1\xb8\xc5\x29\x56" +
+	"\x12\x0e\x80\xfa\x69\x65\x0d\xb5\x83\xf7\x36\xd2\xa2\x76\x10\xa5\x1d\xaa\xb8\x14\xdc\x4a\x1d\xac\x2a\xa0\xcd\x6d\x27\xa9\x2f\x85" +
+	"\xa5\x85\xa2\xcb\x2b\x95\x82\x1a\xd3\x76\xb3\xd2\x16\x1b\x93\x6c\xde\x43\x5a\x2c\xa9\x78\xf5\x3a\xe5\x52\xb8\x06\xc2\xf3\xe7\x59" +
+	"\xc3\x92\x8a\xf7\x7e\xd2\x62\x49\x45\x8c\x81\xfb\x3f\x5b\x25\x9e\x84\x8e\xbf\x41\x56\x7e\x42\x1f\x3e\x62\x87\x48\x15\x3d\x8d\xdd" +
+	"\x61\x3e\xec\x55\xd1\xf3\xdc\x29\x23\x23\xac\xb4\x15\x46\x52\x9b\x8f\x92\x16\x5b\x0d\xbc\x8f\x39\x55\xfc\x64\x71\x6a\x8b\x71\xd6" +
+	"\xb0\xd5\x40\xda\x09\xd2\x62\xab\x81\xf1\xe4\xfd\x97\x15\xce\xb8\x4c\xdd\x7f\x59\xe1\x8c\xcb\x34\x6b\x38\xee\xc6\x68\xc6\x69\x7c" +
+	"\xdc\x8d\xf7\xd9\xfb\x2f\x2b\x9c\x71\x99\x63\x0d\xc7\xdd\x08\x3d\xef\x35\x3a\xee\xc6\xdb\x02\x69\x71\xdc\x8d\x61\x91\x35\x1c\x77" +
+	"\xe3\x6f\xe9\xfe\x4b\xda\xbf\x8c\xe7\xb6\x7c\xff\x65\x85\x33\x2e\x2b\xac\xe1\x1c\x08\x4a\xf1\xfe\xfe\xcb\x0a\x67\x5c\x56\xef\xbf" +
+	"\xac\x70\xc6\x65\x8d\x35\x9c\x03\x01\xaf\x7b\x8d\xce\x81\x20\x2f\x1b\xa4\xc5\x39\x10\x4c\xdc\x64\x0d\xe7\x40\xf0\xbe\x05\x0d\x67" +
+	"\x72\x78\x6e\xdb\xa4\x39\x1d\x51\xfb\xd7\x0e\x6b\x1e\x9f\xc3\xfb\xe0\x94\x4b\xc1\x39\xde\x25\xcd\xe9\xb8\x15\x43\x62\xf6\x58\xf3" +
+	"\xf8\x3c\xe2\xbe\x57\x2a\x05\x55\xa5\x39\x20\xcd\xe9\xe8\xd0\xf3\x21\x2b\xf5\xec\xf5\x7f\x47\xf7\x5f\xd2\xaf\x31\xf9\x7b\x10\xc3" +
+	"\x31\x6b\x2e\xce\x3f\x90\xe6\xe2\xce\x14\xa7\x5e\xe9\xac\x1a\x9f\xd7\x38\x63\x0d\xb5\x83\xb2\x9d\x93\x16\xb5\x83\xf1\x85\x53\x3e" +
+	"\xab\xc6\xe7\x56\x2e\x59\x43\xed\xe0\xbd\xa6\xca\x6b\x51\x3b\x88\x52\x0b\xcd\xe3\x73\x83\xc8\x45\x1d\x6b\x38\x37\x88\xbc\xd4\x93" +
+	"\x16\xe7\x06\x11\xa5\xc1\x2b\x95\x82\x1a\xd3\x34\xb2\x86\x73\x83\x98\xd0\x54\xf5\xb2\xc2\xd9\xba\x66\xa7\xf1\xb9\x41\x70\x0b\x6b" +
+	"\x58\x52\xf1\xde\x5a\xf5\xb2\xc2\xd9\xba\xb6\xaa\x97\x26\x0f\x67\x9b\xb9\x2d\xda\x59\xc3\x79\x5a\xe4\xa5\x83\xb4\x38\x4f\x8b\xa1" +
+	"\xd3\x6b\x74\x9e\x16\x99\xeb\x62\x0d\x5b\x0d\x4c\xec\xae\x7a\x59\xe1\x6c\x5d\x8f\xd3\xf8\x3c\x2d\x66\xda\xcb\x1a\xb6\x1a\x28\x45" +
+	"\x5f\xd5\xcb\x0a\x67\xeb\xfa\xab\x5e\xd2\x5d\x2c\x78\x2b\x8b\x61\x80\x55\xdc\xa1\x79\x90\xd4\xd0\xda\xe1\x30\x1f\xf2\x4a\x47\xac" +
+	"\x7c\x5e\x63\x98\x95\xe6\x89\x71\x96\x8f\x90\x1a\xda\x76\x60\x42\x9e\x8d\x3a\xe5\x23\x56\x3e\xb7\x32\xc6\x4a\x2d\x82\xd4\x79\x36" +
+	"\x4e\x6a\x68\xcb\x0a\xcb\xf2\x09\x68\xf8\x55\x11\x1f\xbc\x4f\xb2\x86\xe3\x6e\xe4\x62\x8a\xb4\x38\xee\x46\x94\x69\xaf\xd1\x71\x37" +
+	"\x3e\x32\xc3\x1a\x8e\xbb\x31\x61\xb6\xea\x65\x85\xb3\x75\x73\x4e\xe3\xe3\x6e\xf0\x3c\x6b\x38\xee\xc6\xfb\x42\xd5\xcb\x0a\x67\xeb" +
+	"\x16\xab\x5e\x46\xbf\xd6\xe7\xb6\x58\x62\x0d\xe7\x40\x90\x97\x65\xd2\xe2\x1c\x08\x86\x15\xaf\xd1\x39\x10\x64\xee\x3d\x6b\x38\x07" +
+	"\x82\x89\xab\x55\x2f\x2b\x9c\xad\x5b\x73\x1a\x9f\x03\xc1\x4c\xd7\x59\xc3\x39\x10\x94\x62\xa3\xea\x65\x85\xb3\x75\x9b\x55\x2f\x69" +
+	"\x2b\xc2\x73\xc3\x78\xab\xea\x65\x85\x33\x2e\xdb\xac\xe1\xdc\x20\x46\x3b\x55\x2f\x2b\x9c\x71\xf9\x50\xf5\xb2\xc2\x19\x97\x5d\xd6" +
+	"\x70\x6e\x10\xa1\xf7\xbc\x46\xe7\x06\xf1\xb6\x4f\x5a\x9c\x1b\xc4\x70\xc0\x1a\xce\x0d\xe2\xef\xb0\xea\x25\x6d\x03\xe2\xb9\x1d\x55" +
+	"\xbd\xac\x70\xc6\xe5\x98\x35\x9c\xa7\x45\x29\x4e\x9c\xc6\x2d\x8f\xd7\x69\xd5\xcb\x0a\x67\x5c\xce\x58\x69\x5b\x87\xcf\xe7\xf9\xb9" +
+	"\xd7\xe8\x3c\x2d\xe2\x5c\x90\x16\xe7\x69\x31\xf1\x92\x35\x9c\xa7\xc5\x7b\xcd\x97\x2f\xdd\x77\x4d\xb1\x16\x22\x4c\x2d\x69\xb1\x16" +
+	"\xe2\x55\xc7\x4a\x5b\x2a\xe4\x20\xcb\xea\x9d\x72\x29\x38\xc7\x0d\xa4\xc5\x5a\x88\x29\x8d\xac\xb4\x1d\x47\x0e\xf2\xbc\xc9\x2b\x95" +
+	"\x82\xaa\x32\x6b\x26\x35\x74\xbd\xcd\x73\x0b\xab\xb8\xe7\x40\xeb\x97\xaf\x2a\x9c\x71\x69\xfb\xf2\x55\x85\x33\x2e\xed\xac\xe1\xb8" +
+	"\x1b\xa3\x0e\xa7\xf1\x71\x37\xde\x3b\xbf\x7c\x55\xe1\x8c\x4b\x17\x6b\x38\xee\x46\xe8\x6e\xaf\xd1\x71\x37\xde\x7a\x48\x8b\xe3\x6e" +
+	"\x0c\xbd\xac\xe2\x97\x37\x7d\x5f\xbe\xa2\xfd\xcb\x78\x6e\xfd\x5f\xbe\xaa\x70\xc6\x65\x80\x35\x9c\x03\x41\x29\x06\x9d\xc6\xe7\x40" +
+	"\xf0\x1a\xfa\xf2\x55\x85\x33\x2e\xc3\xac\xe1\x1c\x08\x78\xc4\x6b\x74\x0e\x04\x71\x46\x49\x8b\x73\x20\x98\x38\xc6\x2a\x7e\x5b\x32" +
+	"\x0e\x0d\x67\x72\x78\x6e\x13\xa4\x96\x8e\xa8\xfd\x6b\x92\x55\xfc\xce\x67\xca\x29\x97\x82\x73\x3c\x4d\x6a\xe9\xb8\x15\x43\xa2\x66" +
+	"\x58\xc5\xaf\x69\x66\xbd\x52\x29\xa8\x2a\xd5\x1c\xa9\xa5\xa3\x43\xcf\xf3\xac\xd4\x1f\xd5\xff\x2d\x7c\xf9\x8a\x7e\x41\xc5\xdf\xdb" +
+	"\x18\x16\x59\xad\x38\xff\x40\x6a\xf3\x08\x93\x65\xaf\x74\x56\x8d\xcf\x6b\xac\xb0\x86\xda\x41\xd9\xde\x93\x16\xb5\x83\xf1\xaa\x53" +
+	"\x3e\xab\xc6\xe7\x56\xd6\x58\x43\xed\xe0\x7d\x9d\xb4\xa8\x1d\x44\xd9\x80\x5a\x2e\x05\xb7\xd2\x26\x6b\x38\x37\x88\xbc\x6c\x91\x16" +
+	"\xe7\x06\x11\x65\xdb\x2b\x95\x82\x1a\x53\xed\xb0\x86\x73\x83\x98\xf0\xe1\xcb\x57\x15\xce\xd6\xed\x3a\x8d\xcf\x0d\x82\xf7\x58\xc3" +
+	"\x92\x8a\xf7\xfd\x2f\x5f\x55\x38\x5b\x77\xf0\xe5\x2b\x65\xc3\xd9\x66\x6e\x8b\x43\xd6\x70\x9e\x16\x79\x39\x22\x2d\xce\xd3\x62\x38" +
+	"\xf6\x1a\x9d\xa7\x45\xe6\x4e\x58\xc3\x56\x03\x13\x4f\xbf\x7c\x55\xe1\x6c\xdd\x99\xd3\xf8\x3c\x2d\x66\x7a\xce\x1a\xb6\x1a\x28\xc5" +
+	"\xc5\x97\xaf\x2a\x9c\xad\xbb\xfc\xf2\x15\x5d\x85\xe1\xf3\x09\x18\x6a\x1e\x90\x8a\xa7\xc7\xd7\x92\xaa\x44\x3c\x7f\xde\x2b\x1d\xb1" +
+	"\xf2\x79\x8d\x7a\x56\x9a\x27\xc6\xb9\x6d\x20\x55\xb4\xed\xc0\x04\x9b\x37\x3a\xe5\x23\x56\x3e\xb7\xd2\xc4\x4a\x2d\x82\xd4\x36\x6f" +
+	"\x26\x55\xb4\x65\x85\xe5\xb6\x05\xaa\xb8\x14\x7c\xf0\xde\xca\x1a\x8e\xbb\x91\x8b\x36\xd2\xe2\xb8\x1b\x51\xda\xbd\x52\x29\xf8\x00" +
+	"\xb9\x83\x35\x1c\x77\x63\x42\x27\x69\x71\xdc\x8d\x57\x97\xd3\xf8\xb8\x1b\x31\xba\x59\xc3\x71\x37\xde\x7b\x1e\xbc\xaa\x70\xb6\xae" +
+	"\xf7\xc1\xab\x5c\x85\x7d\x5a\x6e\x8b\x3e\xd6\x70\x0e\x04\x79\xe9\x27\x2d\xce\x81\x60\x18\xf0\x1a\x9d\x03\x41\x29\x06\x59\xc3\x39" +
+	"\x10\x4c\x1c\x7a\xf0\xaa\xc2\xd9\xba\x61\xa7\xf1\x39\x10\xcc\x74\x84\x35\x9c\x03\x41\x29\x46\x1f\xbc\xaa\x70\xb6\x6e\xec\xc1\x2b" +
+	"\xda\x8a\xf0\xdc\x30\x1e\x7f\xf0\xaa\xc2\x19\x97\x09\xd6\x70\x6e\x10\xa3\xc9\x07\xaf\x2a\x9c\x71\x99\x7a\xf0\xaa\xc2\x19\x97\x69" +
+	"\xd6\x70\x6e\x10\xa1\x67\xbc\x46\xe7\x06\xf1\x36\x4b\x5a\x9c\x1b\xc4\x30\xc7\x1a\xce\x0d\xe2\x6f\xfe\xc1\x2b\xda\x06\xc4\x73\x5b" +
+	"\x20\x2d\x5a\x1e\xef\x8b\xac\xe1\x3c\x2d\x4a\xb1\xe4\x34\x6e\x79\xbc\x96\x1f\xbc\xaa\x70\xc6\x65\x85\x35\x9c\xa7\x05\xbf\xf7\x1a" +
+	"\x9d\xa7\x45\x9c\x55\xd2\xe2\x3c\x2d\x26\xae\xb1\x86\xf3\xb4\x78\x5f\x87\x86\xe5\x97\xe7\xb6\x41\x5a\xac\x85\x78\x6d\xb2\xd2\x96" +
+	"\x0a\x39\xc8\xf3\x2d\xa7\x5c\x0a\xce\xf1\x36\x69\xb1\x16\x62\xca\x0e\x2b\x6d\xc7\x91\x03\x6b\x3f\x78\xa5\x52\x50\x55\xe6\xbb\xa4" +
+	"\x8a\xae\xb7\x79\xde\x63\x15\xbf\x13\xde\x7f\xf0\xba\x42\x4f\xea\x83\x07\xaf\x2b\xf4\xa4\x3e\x64\x0d\xfd\x69\x31\x3a\x72\x1a\xf7" +
+	"\xa7\xc5\xfb\xf1\x83\xd7\x15\x7a\x52\x9f\xb0\x86\xfe\xb4\x08\x7d\xea\x95\x7a\xa2\x52\x62\x7d\x46\x5a\xf4\xa7\xc5\x70\xce\x1a\xfa" +
+	"\xd3\xe2\xef\xe2\xc1\x6b\xea\x37\x16\xcf\xed\xf2\xc1\xeb\x0a\x3d\xa9\x6b\xbe\x22\x0d\x7d\x9b\x51\x8a\xda\xaf\x5e\x57\xe8\x49\x5d" +
+	"\xf7\xd5\xeb\x0a\x3d\xa9\xeb\x59\x43\xdf\x66\x70\x83\xd7\xa8\x6f\x33\xf2\xd2\x48\x5a\xf4\x6d\xc6\xc4\x26\xd6\xd0\xb7\x19\xef\xcd" +
+	"\xd0\xd0\x43\x9b\xe7\xd6\x42\x6a\xa9\xa7\xac\x7f\xb5\xb2\xda\xb8\x6f\x7e\x9b\x53\x2e\x05\xe7\xb8\x9d\xd4\x52\x7f\x54\x0c\x89\xee" +
+	"\x60\xb5\xf1\xef\x03\x3a\xbd\x52\x29\xa8\x2a\x75\x17\xa9\xa5\x5e\x9f\x9e\xbb\x59\xe5\xf3\xe7\xbf\x7a\x4d\xf7\x0c\xe3\xef\x6d\x0c" +
+	"\xbd\xac\xd4\x3b\xd3\x05\xd0\x7d\xa4\x36\x8b\x30\xe9\xf7\x4a\xbd\xe5\xb9\xbf\xf2\x00\x6b\xa8\x1d\x94\x6d\x90\xb4\xa8\x1d\x8c\x87" +
+	"\x9c\x72\x6f\x79\xee\x33\x3d\xcc\x1a\x6a\x07\xef\x23\xa4\x45\xed\x20\xca\x28\xd4\x72\x29\xb8\x95\xc6\x58\x43\x9f\x7f\xe4\x65\x9c" +
+	"\xb4\xe8\xf3\x8f\x28\x13\x5e\xa3\x3e\xff\xf8\xc8\x24\x6b\xe8\xf3\x8f\x09\x53\x5f\xbd\xae\xd0\x0b\x7f\xda\x69\xdc\xe7\x1f\x3c\xc3" +
+	"\x1a\x96\x54\xbc\xcf\x7e\xf5\xba\x42\x2f\xfc\xb9\xaf\x5e\x6b\x1b\x7e\x45\xc2\x6d\x31\xcf\x1a\x7e\x7f\x81\xbc\x2c\x90\x16\xbf\xbf" +
+	"\xc0\xb0\xe8\x35\xfa\xfd\x05\x32\xb7\xc4\x1a\xb6\x1a\x98\xb8\xfc\xd5\xeb\x0a\xbd\xf0\x57\x9c\xc6\xbf\xbf\xc0\x4c\xdf\xb3\x86\xad" +
+	"\x06\x4a\xb1\xfa\xd5\xeb\x0a\xbd\xf0\xd7\xbe\x7a\x4d\xe7\xa3\xf8\x7b\x1b\xc3\x3a\xab\x78\xb6\xf3\x06\xa9\xa6\xb5\xc3\xa1\xdd\xf4" +
+	"\x4a\x3d\x51\xb9\xbf\xf2\x16\x2b\xcd\x93\xfa\xff\x92\x6a\xda\x76\x60\x82\xcd\x76\x9c\x72\x4f\x54\xee\x33\xfd\x81\x95\x5a\x84\xfa" +
+	"\xff\x92\x6a\xda\xb2\xc2\x32\xbb\x07\x0d\xbf\x9d\xe4\x4e\xb9\xfb\xac\xa1\x3f\x2d\x72\x71\x40\x5a\xf4\xa7\x45\x94\x43\xaf\x51\x7f" +
+	"\x5a\x7c\xe4\x88\x35\xf4\xa7\xc5\x84\xe3\xaf\x5e\x57\xe8\x85\x7f\xe2\x34\xee\x4f\x0b\x3e\x65\x0d\xfd\x69\xf1\x7e\xf6\xd5\xeb\x0a" +
+	"\xbd\xf0\xcf\xbf\x7a\x9d\xe9\x70\x3e\x8a\xdb\xe2\x82\x35\xf4\x6d\x46\x5e\x2e\x49\x8b\xbe\xcd\x18\x6a\x1e\x3a\x8d\xfa\x36\x23\x73" +
+	"\xb5\xac\xa1\x6f\x33\x26\xd6\x3d\x7c\x5d\xa1\x17\x7e\xbd\xd3\xb8\x6f\x33\x66\xda\xc0\x1a\xfa\x36\xa3\x14\x8d\x0f\x5f\x57\xe8\x85" +
+	"\xdf\xf4\xf0\x35\x6d\x45\x78\x6e\x18\x37\x3f\x7c\x5d\xa1\x27\x75\x0b\xab\xe6\x3a\xc3\xa8\xf5\xe1\xeb\x0a\x3d\xa9\xdb\x1e\xbe\xae" +
+	"\xd0\x93\xba\x9d\x35\xf4\xf9\x47\xe8\x0e\xaf\x51\x9f\x7f\xbc\x75\x92\x16\x7d\xfe\x31\x74\xb1\x86\x3e\xff\xf8\xeb\x7e\xf8\x9a\xb6" +
+	"\x01\xf1\xdc\x7a\x1e\xbe\xae\xd0\x93\xba\x97\x95\xd6\x7c\x1f\xa7\xcf\x69\xdc\xf2\x78\xf5\x3f\x7c\x5d\xa1\x27\xf5\x00\x6b\xf8\xfd" +
+	"\x05\x78\xd0\x6b\xf4\xfb\x0b\xc4\x19\x22\x2d\x7e\x7f\x81\x89\xc3\xac\x9a\xb3\x80\xf7\x11\x68\x58\x7e\x79\x6e\xa3\xa4\xc5\x5a\x88" +
+	"\xd7\x18\x2b\x6d\xa9\x90\x83\x2c\x1b\x77\xca\xa5\xe0\x1c\x4f\x90\x16\x6b\x21\xa6\x4c\xb2\xd2\x76\x1c\x39\xb0\x76\xca\x2b\x95\x82" +
+	"\xaa\x32\x9b\x26\xd5\xf4\x3b\x3a\xcf\x33\xac\xe2\xce\x98\xb3\x0f\x7f\xa1\x27\x49\xf0\xf7\x20\x86\x39\x56\x71\x8f\x84\x79\x52\x9b" +
+	"\x46\xa8\x17\xbc\x5a\xf9\x8c\xb4\x45\xd6\xf8\x4e\x0f\x7a\x89\xd4\xa6\xc5\xbd\x22\xb4\x5a\x76\x6a\xe9\x99\x63\x7c\xb7\x89\x15\xd6" +
+	"\xe2\x7e\x15\x5a\xab\xf7\xa4\x36\xe5\xb0\x88\xb4\x0a\xb5\x5c\x0a\xbe\x7b\xc3\x1a\x2b\xdf\xff\x41\xbb\xfb\x3f\x90\x5a\x7a\x7a\x9b" +
+	"\x76\x4f\x6f\xdb\xf0\x4a\xa5\x50\x34\xc3\x4d\x56\xfa\x75\x28\x26\x29\xbd\x45\x6a\xe9\x59\x34\x18\x6b\xb5\xed\x94\x4b\xa1\xe9\x4e" +
+	"\x1a\x3b\xac\xf4\xab\x5e\x9f\xfe\x03\xa9\xa5\x67\x08\x61\x50\x7a\xf7\xe1\x2f\xca\x7e\xf4\x8c\xbf\x3d\x56\x4b\x88\x8f\xa8\x7d\x52" +
+	"\x9b\x52\x16\x80\xfa\xc0\xab\x45\x1e\x8a\xbb\x8f\x1c\xb2\xd2\x6f\x93\x91\x54\xe9\x23\x52\x4b\x4f\x21\xf2\xd3\x8e\x9d\x22\x17\x7c" +
+	"\x13\x0c\xcc\xf4\x84\x95\x7e\x67\x8b\xb1\x56\xa7\xa4\x96\x9e\xa8\x03\x53\xfa\xec\xe1\x2f\xf4\xbc\x7f\x9e\x1b\xc6\xe7\xa4\xc5\xdc" +
+	"\xf0\xba\x60\xa5\x5f\x2c\xbb\xb0\xea\xd2\x69\x3c\x37\xbc\xd7\xfc\xce\x6b\x31\x37\x4c\xa9\x65\xa5\x5f\xdf\xfa\x4c\xd7\x79\x45\xbb" +
+	"\x15\x89\x55\x3d\xa9\xe5\x67\xf1\x61\x9a\x6e\x60\x0d\x77\xfe\xc0\x5f\xe3\xef\x7e\x71\x4f\xf6\x2f\x5a\x1e\xe1\x9b\x48\x8b\x96\xc7" +
+	"\x7b\x33\xab\xe5\x3a\x43\x29\x5a\x9c\xc6\x2d\x8f\x57\x2b\x69\xd1\xf2\x18\xb7\xb1\x5a\x9a\x19\xc6\xba\xdd\x2b\x6a\x92\x12\x23\x7d" +
+	"\x07\x29\x5a\x9e\x6a\x4d\x2b\xdd\xc9\x1a\xee\x1a\x83\xf7\x2e\x68\x58\x7e\x79\x6e\xdd\xa4\xc5\x5a\x88\x57\x0f\xab\x2b\x05\xb7\x45" +
+	"\xaf\x53\x2e\x05\xe7\xb8\x8f\xb4\x58\x0b\x31\xa5\x9f\xd5\x95\x82\xdb\x62\xc0\x2b\x95\x82\xaa\x52\x0d\x92\xfa\x52\x70\x5b\x0c\xb1" +
+	"\x8a\x33\x9c\xc3\xbf\xfb\x85\xe6\x83\xa2\xa0\x34\x18\x8f\x90\x6a\x5a\xb6\x31\xb6\xe9\x28\x2b\x5a\x04\xe2\x78\xcc\x29\x72\x9e\xda" +
+	"\x22\xc6\x38\xa9\xa6\xb5\xd9\x4f\x99\x60\xd5\x9c\x10\xab\xef\xa4\x57\xf7\xbc\xa4\x90\xd8\x4e\x91\xba\xe7\x25\x21\xdf\xc0\x74\x9a" +
+	"\x55\x53\x16\xdc\x30\xf3\xbb\x5f\x68\xa9\x89\xe7\x36\x4b\xaa\x69\x7b\xe0\xa7\xcd\xb1\xd2\xf2\xea\xe3\xcc\x3b\x45\x0c\x4e\x87\xd7" +
+	"\x02\xa9\xa6\x35\x14\x96\xda\x45\x56\x5a\x82\xf0\xf9\x34\x5d\xf2\x8a\x9a\xa4\xc4\x48\xbf\x4c\xea\x7e\xdf\xed\x67\x95\xda\x74\x85" +
+	"\x55\x73\x16\xf0\xfe\x1e\xaa\xb9\x14\x3c\xb7\x55\x52\x4d\xdb\x3a\xff\x5a\x63\xa5\x75\x11\x39\xb0\x76\xdd\x29\x97\x82\x73\xbc\x41" +
+	"\xaa\x69\x8b\x82\x21\xb5\x9b\xac\xb4\xc4\x23\x07\x69\xba\xe5\x95\x4a\x41\x55\x69\xb7\x49\x7d\x29\xb8\x2d\x76\x58\xb5\xb8\xff\xc3" +
+	"\xef\x7e\xa1\x25\x8c\x9f\xda\x83\x61\x97\x15\xed\x86\x12\x23\x80\xdd\x23\xd5\xf4\x1d\xe5\x30\xdd\xf7\xaa\x15\x7d\xc3\xe0\xf3\xd6" +
+	"\x1e\xb0\x86\xda\x41\xd9\x0e\x49\x8b\xda\xc1\xf8\xc8\x29\x56\x12\x0e\x80\xb2\x1d\xb3\x86\xda\xc1\xfb\x09\x69\x51\x3b\x88\x72\x0a" +
+	"\xd5\x5c\x0a\x6e\xa5\x33\x56\x1d\xd0\xa6\xf6\x9c\xd4\x97\xc2\xd2\x42\x71\xe1\x95\x4a\x41\x8d\x69\x2f\x59\x69\x8b\x8d\x49\x36\xad" +
+	"\xf9\xfa\x17\xfa\xfe\x28\x2c\xb5\xb5\x4e\xb9\x14\x68\x24\xc4\xa8\x63\x0d\x4b\x2a\xde\xeb\x49\x8b\x25\x15\x31\x1a\xbe\xfe\xc5\x6d" +
+	"\x71\x94\xe2\x12\xe0\xaf\x91\x55\x13\xe2\x23\xb6\x89\x14\x6d\x1c\x42\xa4\xcd\x5e\x35\xf2\xe0\x1d\x19\x6e\x61\xa5\xad\x30\x3e\x6f" +
+	"\xd3\x56\xd2\x62\xab\x81\xf7\x36\xa7\xc8\x05\xaf\x6f\x98\x69\x3b\x6b\xd8\x6a\x20\x6d\x07\x69\xb1\xd5\xc0\xb8\xf3\xeb\xbf\xd2\x7e" +
+	"\x54\xe8\xe0\x62\x8c\xe9\x62\x15\x77\x05\xee\x26\xcd\xd3\x08\x4d\x8f\xd7\x3c\x4d\x09\xf1\x11\xd5\xcb\x1a\xdf\xdb\xd8\xf4\x91\xe6" +
+	"\xf4\x34\x31\xb0\x51\xfd\x4e\xf1\xc8\x60\x0e\x80\x89\x03\xac\xc5\x1d\x9a\x8d\x51\x83\xa4\x79\x78\x7a\x26\x22\x0d\x41\xf3\xf8\x39" +
+	"\x8e\x28\xc7\x30\x2b\xdf\xf1\x18\xc1\xd5\x08\xa9\x2f\x85\xa2\x3b\x58\x8f\x7a\xa5\x52\x28\x9a\xe1\x18\x2b\xdd\x0f\x11\x93\x94\x19" +
+	"\x27\xcd\xe9\x49\x69\x18\x1b\x35\xe1\x94\x4b\x61\xe8\xde\xd1\x93\xac\x74\x1f\x4b\x9f\x7e\x8a\x34\xa7\xa7\x0d\x62\x50\x66\xfa\xeb" +
+	"\xbf\xaa\x3c\xec\x47\x71\x5b\xcc\xb0\xe6\x84\xf8\x88\x9a\x25\xcd\xe9\x39\xa4\x18\x29\x33\xe7\x35\xa7\x27\x8e\x52\x46\xe6\x59\xe9" +
+	"\x6e\x9c\x48\xaa\xcc\x02\x69\x4e\xcf\x2b\xf4\xd3\x16\x9d\xe6\xf4\xb4\x4f\x6e\x8b\x25\x56\xba\xb3\x24\xc6\x46\x2d\x93\xe6\xf4\xbc" +
+	"\x37\x98\x32\x2b\x5f\xff\x95\x9e\xc6\xcd\x73\xc3\xf8\x3d\x69\x31\x37\xbc\x56\x59\xe9\x1e\x9d\x2e\xac\x5a\x73\x1a\xcf\x0d\xef\xeb" +
+	"\xa4\xc5\xdc\x30\x65\x83\x95\xee\x37\xe9\x6b\x6e\xd3\x2b\xda\xad\x48\xac\xb6\x48\xd1\xf2\xbe\x74\x18\xb6\x59\xc3\xbd\xae\xf1\xb7" +
+	"\xf3\xf5\x5f\xdd\x73\xb7\x8b\x96\x47\xf8\x0f\xa4\x45\xcb\xe3\x7d\x97\x35\xe7\x3a\x43\x29\xf6\x9c\xc6\x2d\x8f\xd7\x3e\x69\xd1\xf2" +
+	"\x18\x1f\xb0\xe6\x34\x33\x8c\xcd\xa1\x57\xd4\x24\x25\x46\xfa\x23\xd2\x9c\x9f\x56\xeb\x5a\xe4\x98\x35\xdc\x27\x1d\xef\x27\xd0\xb0" +
+	"\xfc\xf2\xdc\x4e\x49\x8b\xb5\x10\xaf\x33\x56\x57\x0a\x6e\x8b\x73\xa7\x5c\x0a\xce\xf1\x05\x69\xb1\x16\x62\xca\x25\x6b\x1e\x3f\x05" +
+	"\xb7\xe6\x1b\xa7\x54\x0a\xaa\x4a\x55\x4b\x9a\xd3\x73\x52\x3d\xd7\xb1\x52\xdf\x60\xff\x57\xff\xcd\x5f\x69\x3e\x28\x0a\x4a\x83\x71" +
+	"\x03\xa9\xa1\x65\x1b\xe3\x3c\x6d\x64\x35\xf4\x08\x73\xc7\x4d\x4e\x91\xf3\x34\x2f\x62\x34\x93\x1a\x5a\x9b\xfd\x94\x16\x56\xc3\x09" +
+	"\xb1\xfa\xb6\x7a\x75\xcf\x45\x0c\x89\xf3\x36\x52\xf7\x54\x43\xe4\x1b\x98\xb6\xb3\x1a\xca\x82\x1b\x3a\xbe\xf9\x2b\x2d\x35\xf1\xdc" +
+	"\x3a\x49\x0d\x6d\x0f\xfc\xb4\x2e\x56\x5a\x5e\x7d\x9c\x6e\xa7\x88\xc1\xe9\xf0\xea\x21\x35\xb4\x86\xc2\xd2\xbc\x97\x95\x96\x20\x7c" +
+	"\x3e\x4d\xfb\xbc\xa2\x26\x29\x31\xd2\xf7\x93\xba\x3b\x9a\xfa\x59\xa5\x79\x3a\xc0\x1a\x9e\xe7\x8e\xf7\x41\xa8\xe1\x52\xf0\xdc\x86" +
+	"\x48\x0d\x6d\xeb\xfc\x6b\x98\x95\xd6\x45\xe4\x20\xcf\x47\x9c\x72\x29\x38\xc7\xa3\xa4\x86\xb6\x28\x18\xd2\x7c\x8c\x95\x96\x78\xe4" +
+	"\x20\x4d\xc7\xbd\x52\x29\xa8\x2a\xf3\x09\x52\x5f\x0a\x6e\x8b\x49\x56\xf1\x7b\xbd\xa9\x6f\xfe\x4a\x4b\x18\x7f\x6f\x63\x98\x66\x35" +
+	"\xfc\x70\x7e\xc4\x9d\x21\x35\xf4\x1d\x85\xa8\x79\x3a\xeb\x15\x4f\xcf\xe4\x47\xf9\xa7\x79\x3e\xc7\x1a\x6a\x07\x65\x9b\x27\x2d\x6a" +
+	"\x07\xe3\x05\xa7\x58\x49\x38\x00\xca\xb6\xc8\x1a\x6a\x07\xef\x4b\xa4\x45\xed\x20\xca\x32\xd4\x70\x29\xb8\x95\x56\x58\x4d\xc0\x3c" +
+	"\xcd\xdf\x93\xfa\x52\xe4\xb4\x50\xac\x7a\xa5\x52\x50\x63\xe6\x6b\xac\xb4\xc5\xc6\xa4\x3c\x5d\x27\x2d\x96\x54\xbc\x36\x9c\x72\x29" +
+	"\xd0\x48\x88\xb1\xc9\x1a\x96\x54\xbc\x6f\x91\x16\x4b\x2a\x62\x6c\x7f\xf3\x57\xb7\xc5\x51\x8a\x4b\x80\xbf\x1d\x56\x43\x88\x8f\xe4" +
+	"\x1f\x48\xd1\xc6\x21\x44\xba\xeb\xd5\x20\x0f\xde\x91\xe1\x3d\x56\xda\x0a\xe3\xf3\x79\xba\x4f\x5a\x6c\x35\xf0\x7e\xe0\x14\xb9\xe0" +
+	"\xf5\x0d\x33\x3d\x64\x0d\x5b\x0d\xa4\x3d\x22\x2d\xb6\x1a\x18\x1f\x7f\xf3\xa6\xc2\x9e\xc2\xc9\x37\x6f\x2a\xec\x29\x9c\xb2\x86\xef" +
+	"\x0b\x8c\xce\x9c\xc6\xdf\x17\x78\x3f\xff\xe6\x4d\x85\x3d\x85\x0b\xd6\xf0\x7d\x81\xd0\x97\x5e\xa3\xef\x0b\xbc\xd5\x3c\x7a\x43\xa5" +
+	"\xe2\x2f\x06\x0c\xb5\xac\xe1\xfb\x02\x7f\x75\x8f\xde\x50\xbd\xc4\x73\xab\x7f\xf4\xa6\xc2\x9e\x42\x03\x6b\xf8\xee\x46\xf8\xc6\x47" +
+	"\x6f\x2a\xec\x29\x34\x3d\x7a\x53\x61\x4f\xa1\x99\x35\x7c\x77\x83\x5b\xbc\x46\xdf\xdd\xc8\x4b\x2b\x69\xf1\xdd\x8d\x89\x6d\xac\xe2" +
+	"\x39\x15\xed\xd0\xb0\x07\xc2\x73\xeb\x20\xcd\xe9\x9b\xc0\xbf\x3a\x59\xc5\xb3\x3d\xba\x9c\x72\x29\x38\xc7\xdd\xa4\x39\x6d\x6f\x31" +
+	"\x28\xd3\xc3\x9a\x8b\xfd\x5f\xaf\x54\x0a\xaa\x4a\xd3\x47\x9a\xd3\x56\xcd\x73\x3f\xab\xe8\x67\x3e\xf0\xe8\x0d\x3f\xe5\x3d\xfa\x6f" +
+	"\x90\x95\xb6\x3e\x2e\x80\x19\x22\xcd\xc5\xb3\xea\x87\xbd\xd2\xde\x20\x7f\x1f\x8f\xb0\x86\xda\x41\xd9\x46\x49\x8b\xda\xc1\x78\xcc" +
+	"\x29\xef\x0d\xf2\x3e\xc1\x38\x6b\xa8\x1d\xbc\x4f\x90\x16\xb5\x83\x28\x93\xd0\x3c\xde\xa7\x45\x2e\xa6\x58\xc3\x3e\x2d\xf2\x32\x4d" +
+	"\x5a\xec\xd3\x22\xca\x8c\xd7\x68\x9f\x16\x1f\x99\x65\x0d\xfb\xb4\x98\x30\xf7\xe8\x4d\x85\xbd\xcc\x79\xa7\xf1\x3e\x2d\x78\x81\x35" +
+	"\x2c\xa9\x78\x5f\x7c\xf4\xa6\xc2\x5e\xe6\xd2\xa3\x37\x26\x0f\x47\x49\xdc\x16\xcb\xac\xe1\xf8\x02\x79\x59\x21\x2d\x8e\x2f\x30\xbc" +
+	"\xf7\x1a\x1d\x5f\x20\x73\xab\xac\x61\xab\x81\x89\x6b\x8f\xde\x54\xd8\xcb\x5c\x77\x1a\x1f\x5f\x60\xa6\x1b\xac\x61\xab\x81\x52\x6c" +
+	"\x3e\x7a\x53\x61\x2f\x73\xeb\xd1\x1b\x5a\x96\xf8\x7b\x10\xc3\x36\x2b\xed\xbb\xb9\x4a\x4d\x77\x48\x0d\xad\x1d\xb4\xfd\xf5\x4a\x5b" +
+	"\x5a\xfe\x3e\xde\x65\xa5\x79\x62\x9c\xe6\x7b\xa4\x86\xb6\x1d\xb4\xfd\x75\xca\x5b\x5a\xde\x27\x38\x60\xa5\x16\x41\xea\x3c\x3d\x24" +
+	"\x35\xb4\x65\xa5\xed\x2f\xd4\xc4\xdf\x17\xc8\xc5\x31\x6b\xf8\xbe\x40\x2e\x4e\x48\x8b\xef\x0b\x44\x39\xf5\x1a\x7d\x5f\xe0\x23\x67" +
+	"\xac\xe1\xfb\x02\x13\xce\x1f\xbd\xa9\xb0\x97\x79\xe1\x34\xfe\xbe\x00\x5f\xb2\x86\xef\x0b\xbc\xd7\x3c\x7e\x43\x4b\x23\x7f\x5f\x20" +
+	"\x6e\xed\xe3\x37\xd1\xf3\xbb\xb9\x2d\xea\x58\xc3\x77\x37\x52\xd7\x93\x16\xdf\xdd\x18\x1a\xbc\x46\xdf\xdd\xc8\x5c\x23\x2b\xad\x07" +
+	"\xf8\x7c\x9a\x37\x3d\x7e\x53\x61\x2f\xb3\xd9\x69\xfc\xdd\x8d\x99\xb6\xb0\x86\xef\x6e\xe4\xba\xf5\xf1\x9b\x0a\x7b\x99\x6d\x8f\xdf" +
+	"\xd0\x56\x84\xe7\x86\x71\xfb\xe3\x37\x15\xf6\x14\x3a\x58\xc3\x3e\x2d\x46\x9d\x8f\xdf\x54\xd8\x53\xe8\x7a\xfc\xa6\xc2\x9e\x42\x37" +
+	"\x6b\xd8\xa7\x45\xe8\x1e\xaf\xd1\x3e\x2d\xde\x7a\x49\x8b\x7d\x5a\x0c\x7d\xac\x61\x9f\x16\x7f\xfd\x8f\xdf\xd0\x36\x20\x9e\xdb\xc0" +
+	"\xe3\x37\x15\xf6\x14\x06\x59\xc3\xf1\x05\x4a\x31\xe4\x34\x6e\x79\xbc\x86\x1f\xbf\xa9\xb0\xa7\x30\xc2\x1a\x8e\x2f\xc0\xa3\x5e\xa3" +
+	"\xe3\x0b\xc4\x19\x23\x2d\x8e\x2f\x30\x71\x9c\x35\x1c\x5f\xe0\x7d\x02\x1a\x96\x5f\x9e\xdb\x24\x69\xb1\x16\xe2\x35\xc5\x4a\x5b\x2a" +
+	"\xe4\x20\x4d\xa7\x9d\x72\x29\x38\xc7\x33\xa4\xc5\x5a\x88\x29\xb3\xac\xb4\x1d\x47\x0e\xf2\x7c\xce\x2b\x95\x82\xaa\x32\x9d\x27\x35" +
+	"\x74\x9e\xc8\xf3\x02\x2b\x7d\xcb\xf9\xbf\xc5\xc7\x6f\x2b\x5c\xb9\x5a\x7a\xfc\xb6\xc2\x95\xab\x65\xd6\x70\xfd\x02\xa3\x15\xa7\xf1" +
+	"\xf5\x0b\xbc\xbf\x7f\xfc\xb6\xc2\x95\xab\x55\xd6\x70\xfd\x02\xa1\xd7\xbc\xd2\x99\x7f\x4a\xac\xd7\x49\x8b\xeb\x17\x18\x36\x58\xc5" +
+	"\x5d\xef\x37\x1f\xbf\xa5\xf3\x74\xf1\xdc\xb6\x1e\xbf\xad\x70\xe5\x6a\x9b\x35\x5c\x4b\x42\x29\x76\x1e\xbf\xad\x70\xe5\xea\xc3\xe3" +
+	"\xb7\x15\xae\x5c\xed\xb2\x86\x6b\x49\xe0\x3d\xaf\xd1\xb5\x24\xe4\x65\x9f\xb4\xb8\x96\x84\x89\x07\xac\xe1\x5a\x12\xde\x0f\xa1\xe1" +
+	"\x8a\x18\xcf\xed\x88\xd4\xd2\x95\x09\xff\x3a\x66\xa5\x3b\xfb\xd2\xf5\x37\xa7\x5c\x0a\xce\xf1\x29\xa9\xa5\xf3\xff\x18\x94\x3e\x63" +
+	"\xa5\x3b\xcf\x22\x07\x4a\x9d\x7b\xa5\x52\x50\x55\xea\x0b\x52\x4b\x67\xd9\x3d\x5f\xb2\x8a\xfe\x51\x35\x4f\xde\xd2\xd3\xef\xf9\x7b" +
+	"\x1b\x43\x2d\x2b\x9d\x0d\x77\x01\x74\x1d\xa9\x4d\x23\x54\xf5\x5e\xe9\xea\x24\x5f\x1f\x6a\x60\x0d\xb5\x83\xb2\x35\x92\x16\xb5\x83" +
+	"\x71\x93\x53\xbe\x3a\xc9\xd7\xa8\x9a\x59\x43\xed\xe0\xbd\x85\xb4\xa8\x1d\x44\x69\x85\x5a\x2e\x05\xb7\x52\x1b\x6b\xb8\xc6\x8a\xbc" +
+	"\xb4\x93\x16\xd7\x58\x11\xa5\xc3\x6b\x74\x8d\x15\x1f\xe9\x64\x0d\xd7\x58\x31\xa1\xeb\xc9\xdb\x0a\x57\x3d\xbb\x9d\xc6\xd7\x58\xc1" +
+	"\x3d\xac\x61\x49\xc5\x7b\xef\x93\xb7\x15\xae\x7a\xf6\x3d\x79\xab\x6d\xb8\x6a\xcf\x6d\xd1\xcf\x1a\xae\x77\x23\x2f\x03\xa4\xc5\xf5" +
+	"\x6e\x0c\x83\x5e\xa3\xeb\xdd\xc8\xdc\x10\x6b\xd8\x6a\x60\xe2\xf0\x93\xb7\x15\xae\x7a\x8e\x38\x8d\xaf\x77\x63\xa6\xa3\xac\x61\xab" +
+	"\x81\x52\x8c\x3d\x79\x5b\xe1\xaa\xe7\xf8\x93\xb7\xb4\x1f\xc5\xd7\x65\x30\x4c\xb0\xd2\xb5\x44\x57\xa9\xe9\x24\xa9\xa6\xb5\x83\xae" +
+	"\xbf\x79\xa5\x33\xff\x7c\x7d\x68\x9a\x95\xe6\x89\x71\x6a\x67\x48\x35\x6d\x3b\x30\xc1\xa6\xb3\x4e\xf9\xcc\x3f\x5f\xa3\x9a\x63\xa5" +
+	"\x16\x41\x6a\x9b\xce\x93\x6a\xda\xb2\xc2\x52\xbb\x00\xd5\xf1\xf5\x0b\xe4\x62\x91\x35\x5c\xbf\x40\x2e\x96\x48\x8b\xeb\x17\x88\xb2" +
+	"\xec\x95\x4a\xc1\x17\x1a\x56\x58\xc3\xf5\x0b\x4c\x78\xff\xe4\x6d\x85\xab\x9e\xab\x4e\xe3\xeb\x17\xe0\x35\xd6\x70\xfd\x02\xef\xeb" +
+	"\x4f\xde\x56\xb8\xea\xb9\xf1\xe4\x6d\xaa\xc3\x7e\x14\xb7\xc5\x26\x6b\xb8\x96\x84\xbc\x6c\x91\x16\xd7\x92\x30\x6c\x7b\x8d\xae\x25" +
+	"\x21\x73\x3b\xac\xe1\x5a\x12\x26\x7e\x78\xf2\xb6\xc2\x55\xcf\x5d\xa7\xf1\xb5\x24\xcc\x74\x8f\x35\x5c\x4b\x42\x29\xf6\x49\x8b\x6b" +
+	"\x49\x18\x1f\x3c\x79\x4b\x5b\x11\x9e\x1b\xc6\x87\x4f\xde\x56\xb8\x72\x75\xc4\x1a\xae\xb1\x62\x74\xfc\xe4\x6d\x85\x2b\x57\x27\xa4" +
+	"\xc5\xdc\x10\xf7\x94\x55\x73\x42\x84\x3e\xf3\x1a\x5d\x63\xc5\xdb\x39\x69\x71\x8d\x15\xc3\x05\x6b\xb8\xc6\x8a\xbf\xcb\x27\x6f\x69" +
+	"\x1b\x10\xcf\xad\xe6\xf7\x6f\x69\xfb\x16\xe7\xac\x96\x35\x5c\xef\x46\x29\xea\x9c\xc6\x2d\x8f\x57\xfd\xef\xdf\x56\xb8\x72\xd5\xc0" +
+	"\x1a\xae\x77\x83\x1b\xbd\x46\xd7\xbb\x11\xa7\x89\xb4\xb8\xde\x8d\x89\xcd\xac\xe1\x7a\x37\xde\x5b\xa0\x61\xf9\xe5\xb9\xb5\x92\x16" +
+	"\x6b\x21\x5e\x6d\xac\xb4\xa5\xa2\xeb\x6f\x4e\xb9\x14\x9c\xe3\x0e\xd2\x62\x2d\xc4\x94\x4e\x56\xda\x8e\x23\x07\xd6\x76\x79\xa5\x52" +
+	"\x50\x55\xa6\xdd\xa4\x9a\xfa\x2d\x79\xee\x61\xa5\x6f\x39\xff\xd7\xfb\xfb\x5f\xe9\xdf\x7c\x3e\x01\x43\x1f\x2b\x3d\x97\xd8\x3d\x0a" +
+	"\x59\xf7\x93\x66\x69\x84\x66\xc0\x6b\x96\xa6\x84\x18\xe9\x41\x56\x7a\x6a\x33\xc6\xda\x0c\x91\x66\x29\xfe\x85\x17\xc6\xc3\x4e\x33" +
+	"\x6c\xed\x79\x76\xda\x98\x11\x56\x7a\x32\xb7\x43\x3d\x4a\x9a\xa5\x1c\x16\x3e\x06\xcd\xb8\x14\x28\x24\x4a\x31\xce\x9a\x05\xd4\x46" +
+	"\x4f\x90\xfa\x52\x20\x0b\x88\x32\xe9\x95\x4a\xa1\x69\x86\x53\xac\xf4\x44\x6a\x4c\xd2\x66\x9a\xd4\x97\x82\x73\x36\xe3\x94\x4b\xe1" +
+	"\xe6\xa7\x8d\x99\x65\xa5\x27\x89\xfb\xf4\x73\xa4\xbe\x14\x5c\x3b\xf3\xbf\xff\x55\x67\xe1\x5b\x8f\xdb\x62\x81\x35\x23\x74\xa9\x17" +
+	"\x49\xb3\x94\xb2\x00\x34\x4b\x5e\x33\xe4\xc1\x3b\x32\xb7\xcc\x4a\xcf\x43\x47\x52\x6d\x56\x48\xb3\x34\xce\xd9\x7b\xa7\xc8\x05\xd5" +
+	"\x18\x26\x98\x55\x56\x7a\xb6\x37\xc6\x46\xaf\x91\x66\x69\xf1\x79\x6d\xd6\x7f\xff\xab\x49\xb3\x78\x6e\x18\x6f\x90\x16\x73\xc3\x6b" +
+	"\x93\x95\x9e\x92\xee\xc2\xea\x2d\xa7\xf1\xdc\xf0\xbe\x4d\x5a\xcc\x0d\x53\x76\x58\x33\x4e\x88\x18\x1f\xbc\xa2\xdd\x8a\xc4\x7a\x97" +
+	"\x14\x2d\xef\x13\x62\xd8\x63\xcd\x42\xb3\x69\xad\xf7\x7f\xff\xab\xc9\xc2\xf2\xcb\x73\x3b\x20\x2d\x5a\x1e\xef\x87\xac\x19\xd7\x19" +
+	"\x4a\x71\xe4\x34\x6e\x79\xbc\x8e\x49\x8b\x96\xc7\xf8\x84\x35\xa3\x99\x61\x6c\x4e\xbd\xa2\xdd\x28\x31\xd2\x9f\x91\xa2\xe5\xa9\xd6" +
+	"\x8c\x36\xe7\xac\x19\x67\x01\xef\x17\xd0\xb0\xfc\xf2\xdc\x2e\x49\x8b\xb5\x10\xaf\x9a\x6f\x49\x5d\x29\xb8\x2d\x6a\x9d\x72\x29\x38" +
+	"\xc7\x75\xa4\xc5\x5a\x88\x29\xf5\xac\xae\x14\xdc\x16\x0d\x5e\xa9\x14\x54\x95\xba\x91\xd4\x97\x82\xdb\xa2\x89\x95\xee\x41\xed\xff" +
+	"\x9a\xbf\xfd\x95\xe6\x83\xa2\xa0\x34\x18\xb7\x90\x1a\x5a\xb6\x31\xce\xd2\x56\x56\xb4\x08\xc4\x71\x9b\x53\xe4\x3c\xcd\x8a\x18\xed" +
+	"\xa4\x86\xd6\x66\x3f\xa5\x83\xd5\x70\x42\xac\xbe\x9d\x5e\x8d\x36\xf4\x61\x24\xce\xba\x48\x8d\xdf\xaa\x11\x77\xb3\x1a\xca\x82\x1b" +
+	"\x7a\xbe\xfd\x95\x96\x9a\x78\x6e\xbd\xa4\x86\xb6\x07\x7e\x5a\x1f\x2b\x2d\xaf\x3e\x4e\xbf\x53\xc4\xe0\x74\x78\x0d\x90\x1a\x5a\x43" +
+	"\x61\x69\x36\xc8\x4a\x4b\x10\x3e\x9f\xa6\x43\x5e\x51\x93\x94\x18\xe9\x87\x49\xdd\x33\xe5\xfd\xac\xd2\x2c\x1d\x61\x35\x9c\x05\xbc" +
+	"\x8f\x42\x0d\x97\x82\xe7\x36\x46\x6a\x68\x5b\xe7\x5f\xe3\xac\xb4\x2e\x22\x07\x59\x36\xe1\x94\x4b\xc1\x39\x9e\x24\x35\xb4\x45\xc1" +
+	"\x90\x66\x53\xac\xb4\xc4\x23\x07\x69\x3a\xed\x95\x4a\x41\x55\x99\xcd\x90\xfa\x52\x70\x5b\xcc\xb2\x8a\x5f\xa9\xcf\x7d\xfb\x2b\x2d" +
+	"\x61\xe1\x8b\x30\x4d\xd3\x79\x56\xb4\x1b\x4a\x8c\x00\xd9\x02\xa9\xa1\xef\x28\x87\xe9\xa2\x57\xa3\xe9\x1b\x06\x11\xb2\x6c\x89\x35" +
+	"\xd4\x0e\xca\xb6\x4c\x5a\xd4\x0e\xc6\x2b\x4e\xb1\x92\x70\x00\x94\xed\x3d\x6b\xa8\x1d\xbc\xaf\x92\x16\xb5\x83\x28\x6b\x50\xc3\xa5" +
+	"\xe0\x56\x5a\x67\x35\x01\xb3\x34\xdb\x20\xf5\xa5\xc8\x68\xa1\xd8\xf4\x4a\xa5\xa0\xc6\xcc\xb6\x58\x69\x8b\x8d\x49\x59\xba\x4d\x5a" +
+	"\x2c\xa9\x78\xed\x38\xe5\x52\xa0\x91\x10\xe3\x03\x6b\x58\x52\xf1\xbe\x4b\x5a\x2c\xa9\x88\xb1\xf7\xed\xaf\x6e\x8b\xa3\x35\x97\x00" +
+	"\x7f\xfb\xac\x86\x10\x1f\xc9\x0e\x48\xd1\xc6\x21\x44\x7a\xe8\xd5\x20\x0f\xde\x91\xe1\x23\x56\xda\x0a\xe3\xf3\x59\x7a\x4c\x5a\x6c" +
+	"\x35\xf0\x7e\xe2\x14\xb9\xe0\xf5\x0d\x33\x3d\x65\x0d\x5b\x0d\xa4\x3d\x23\x2d\xb6\x1a\x18\x9f\x7f\xfb\xae\xc2\x9e\xc2\xc5\xb7\xef" +
+	"\x2a\xec\x29\x5c\xb2\x86\xef\x0b\x8c\x6a\xbe\x7b\x47\xcb\x07\x6f\x65\xf1\x5e\xfb\xdd\xbb\x0a\x7b\x0a\x75\xac\xe1\xfb\x02\xa1\xeb" +
+	"\xbd\xd2\x96\x96\x12\x9b\x06\xd2\xe2\xfb\x02\x43\x23\x6b\xf8\xbe\xc0\x5f\xd3\x77\xef\xa8\x5e\xe2\xb9\x35\x7f\xf7\xae\xc2\x9e\x42" +
+	"\x0b\x6b\xf8\xee\x46\x29\x5a\xbf\x7b\x57\x61\x4f\xa1\xed\xbb\x77\x15\xf6\x14\xda\x59\xc3\x77\x37\xb8\xc3\x6b\xf4\xdd\x8d\xbc\x74" +
+	"\x92\x16\xdf\xdd\x98\xd8\xc5\x1a\xbe\xbb\xf1\xde\x0d\x0d\x7b\x20\x3c\xb7\x1e\xd2\x8c\xbe\x09\xfc\xab\x97\x35\x8b\xf7\x3d\xfb\x9c" +
+	"\x72\x29\x38\xc7\xfd\xa4\x19\x6d\x6f\x31\x68\x33\xc0\x9a\x89\xfd\x5f\xaf\x54\x0a\xaa\x4a\x33\x44\x9a\xd1\x56\xcd\xf3\x30\x2b\xdd" +
+	"\x31\x98\xee\xbf\xfe\xdd\x3b\x3a\xe3\x19\xbe\x08\xb5\xd6\xa3\xac\xb4\xf5\x71\x01\xcc\x18\x69\x96\x46\xa8\xc7\xbd\xd2\xde\x20\x7f" +
+	"\x1f\x4f\xb0\x86\xda\x41\xd9\x26\x49\x8b\xda\xc1\x78\xca\x29\xef\x0d\xf2\x3e\xc1\x34\x6b\xa8\x1d\xbc\xcf\x90\x16\xb5\x83\x28\xb3" +
+	"\xd0\x2c\xde\xa7\x45\x2e\xe6\x58\xc3\x3e\x2d\xf2\x32\x4f\x5a\xec\xd3\x22\xca\x82\xd7\x68\x9f\x16\x1f\x59\xfc\xee\x5d\x85\xbd\xcc" +
+	"\xa5\xef\xde\x55\xd8\xcb\x5c\x76\x1a\xef\xd3\x82\x57\x58\xc3\x92\x8a\xf7\xf7\xdf\xbd\xab\xb0\x97\xb9\xfa\xdd\x3b\x93\x85\xa3\x24" +
+	"\x6e\x8b\x35\xd6\x70\x7c\x81\xec\xad\x93\x16\xc7\x17\x18\x36\xbc\x46\xc7\x17\xc8\xdc\x26\x6b\xd8\x6a\x60\xe2\xd6\x77\xef\x2a\xec" +
+	"\x65\x6e\x3b\x8d\x8f\x2f\x30\xd3\x1d\xd6\xb0\xd5\x40\x29\x3e\x7c\xf7\xae\xc2\x5e\xe6\xee\x77\xef\xa8\xaf\x30\x7f\x0f\x62\xd8\x63" +
+	"\xa5\x7d\x37\x57\xa9\xe9\x3e\xa9\xa1\xb5\x83\xb6\xbf\x5e\x69\x4b\xcb\xdf\xc7\x87\xac\x34\x4f\x8c\xd3\xec\x88\xd4\xd0\xb6\x83\xb6" +
+	"\xbf\x4e\x79\x4b\xcb\xfb\x04\x27\xac\xd4\x22\x48\x9d\xa5\xa7\xa4\x86\xb6\xac\xb4\xfd\x85\x9a\xf8\xfb\x02\xb9\x38\x67\x0d\xdf\x17" +
+	"\xc8\xc5\x05\x69\xf1\x7d\x81\x28\x97\x5e\xa3\xef\x0b\x7c\xa4\xe6\x29\x69\xf8\xbe\xc0\x84\xda\xa7\xef\x2a\xec\x65\xd6\x39\x8d\xbf" +
+	"\x2f\xc0\xf5\xac\xe1\xfb\x02\xef\x0d\x4f\xdf\x55\xd8\xcb\x6c\x7c\xfa\x2e\x35\x61\x0f\x84\xdb\xa2\x89\x35\x7c\x77\x23\x2f\xcd\xa4" +
+	"\xc5\x77\x37\x86\x16\xaf\xd1\x77\x37\x32\xd7\xca\x1a\xbe\xbb\x31\xb1\xed\xe9\xbb\x0a\x7b\x99\xed\x4e\xe3\xef\x6e\xcc\xb4\x83\x35" +
+	"\x7c\x77\xa3\x14\x9d\x4f\xdf\x55\xd8\xcb\xec\x7a\xfa\x8e\xb6\x22\x3c\x37\x8c\xbb\x9f\xbe\xab\xb0\xa7\xd0\xc3\x1a\xf6\x69\x31\xea" +
+	"\x7d\xfa\xae\xc2\x9e\x42\xdf\xd3\x77\x15\xf6\x14\xfa\x59\xc3\x3e\x2d\x42\x0f\x78\x8d\xf6\x69\xf1\x36\x48\x5a\xec\xd3\x62\x18\x62" +
+	"\x0d\xfb\xb4\xf8\x1b\x7e\xfa\x8e\xb6\x01\xf1\xdc\x46\x9e\xbe\xab\xb0\xa7\x30\xca\x1a\x8e\x2f\x50\x8a\x31\xa7\x71\xcb\xe3\x35\xfe" +
+	"\xf4\x5d\x85\x3d\x85\x09\xd6\x70\x7c\x01\x9e\xf4\x1a\x1d\x5f\x20\xce\x14\x69\x71\x7c\x81\x89\xd3\xac\xe1\xf8\x02\xef\x33\xd0\xb0" +
+	"\xfc\xf2\xdc\x66\x49\x8b\xb5\x10\xaf\x39\x56\xda\x52\x21\x07\x69\x3a\xef\x94\x4b\xc1\x39\x5e\x20\x2d\xd6\x42\x4c\x59\x64\x35\x62" +
+	"\xff\xd7\x2b\x95\x82\xaa\x32\x5d\x26\x35\x74\x9e\xc8\xf3\x0a\xab\xb8\xae\xf7\xfe\x69\x75\x85\xe7\xeb\xad\x3e\xad\xae\xf0\x7c\xbd" +
+	"\xb5\xa7\xd5\x15\x9e\xaf\xb7\xee\x95\x7e\x19\xcd\xbf\x9f\xdf\x60\xa5\x5f\x50\x62\x9c\xa8\xcd\xa7\xd5\x1f\x3d\x5f\x4f\x25\x5b\x4e" +
+	"\xf9\x97\xd1\xfc\x1b\xfe\x6d\x56\xfa\x7d\x29\x3e\xaf\x93\x9d\xa7\xd5\x65\xcf\xd7\xc3\x23\xc3\x3f\x3c\xad\xae\xf0\x7c\xbd\x5d\x56" +
+	"\xfe\x7d\x37\x1e\xdd\x9b\xec\x91\xf2\xef\xbb\xf1\xf9\x44\xef\x7b\xe5\xdf\x77\x03\x75\x92\x1c\xb0\x8a\x27\x80\x1f\x3e\xad\x8e\xef" +
+	"\x0a\x41\x4f\x0b\x3f\x72\xca\xa5\x50\x74\x3f\x85\x63\x56\xf1\xdc\xf5\x13\x52\x9b\xc5\xcf\x68\x3f\x7d\x5a\x5d\xe1\xf9\x7a\x67\xac" +
+	"\x96\x1e\x7f\x8c\x8f\x24\xe7\xa4\xf8\xad\xbd\x72\xbf\xb5\xc7\x70\xe1\x15\xbf\xb5\x57\xfe\xb7\xf6\x28\xf8\x25\xab\x78\x2a\x7d\xcd" +
+	"\xb3\x6a\xfa\x4d\x29\x3d\xa2\xdd\xd5\x65\xad\x53\xe4\x42\x51\x26\x50\x8a\x3a\x56\xf1\x9c\xf8\x7a\x52\x9b\xc5\x4f\x84\x6f\x78\x56" +
+	"\x4d\x77\x48\xc2\x45\x6f\x4c\xc1\xb8\x91\xd4\xba\xab\xf4\x88\xa2\x4c\xa2\x9a\x58\xad\x89\xee\x2d\xd4\xec\x14\xf7\x00\xc1\x85\xf7" +
+	"\x84\x62\xb4\x90\x5a\xd7\x5b\x01\x25\x41\x8c\x56\x56\x4b\x33\x43\x25\xa9\x36\xaf\xb8\xbf\x91\x32\xe1\x5e\x48\xed\xa4\xb8\x43\x92" +
+	"\x32\x7c\xdf\xa4\x0e\xd6\x70\x2f\x2f\xfc\x75\x3e\xab\xa6\x3b\xc3\x20\x11\xf5\x9b\x4a\xba\x48\x71\xaf\xa9\x84\x7a\x06\x25\xaa\x9b" +
+	"\x15\xb5\x93\x18\xba\xcf\x53\xcf\xb3\xea\xf8\x0e\x49\xe8\x4b\x91\x18\xd5\xfb\xac\x3a\xbe\x43\x92\xfb\xbc\x32\x7d\xac\x68\x21\x17" +
+	"\x18\xa5\xe8\xf7\x1a\xee\xab\x06\x56\xc9\xc0\xb3\x6a\x79\x3f\x73\x83\x89\x83\xcf\xaa\x2b\xdc\xcf\x7c\xe8\x59\x75\xd9\xfd\xcc\x11" +
+	"\x63\xf8\x59\x75\xf9\xfd\xcc\x4d\xa2\x46\x9e\x55\x57\xb8\x9f\xf9\xa8\x53\x2e\x05\xb7\xc5\x18\x29\xee\xda\xc5\x83\x32\xe3\xcf\xaa" +
+	"\x2b\xdc\xcf\x7c\xc2\x2b\x95\x82\xdb\x62\xf2\x59\x75\x85\xfb\x99\x4f\x3d\xab\xae\x70\x3f\xf3\xe9\x67\xd5\x15\xce\x99\xcf\x3c\xab" +
+	"\xae\x70\xce\x7c\xf6\x59\x75\x85\x73\xe6\x73\x5e\x69\x6f\x90\x8f\x19\xe6\x59\xe9\x5b\x03\x63\x9d\x2c\x90\x16\x67\xeb\xb4\x49\xf4" +
+	"\xa2\x53\xde\x1b\xe4\xe3\x96\x25\x56\xfa\x4e\xc5\xe7\x8d\x5e\x7e\x56\x5d\x76\xce\x3c\xd1\xda\xac\x3c\xab\xae\x70\xce\xfc\xfd\xb3" +
+	"\x6a\x79\xce\x3c\xc1\x07\x56\x9f\x55\x8b\x73\xe6\x89\x3b\x67\xbe\xe6\x95\xf7\x69\x81\x46\xeb\x75\xd6\x8c\x92\x26\xf8\xc0\xc6\xb3" +
+	"\xea\xf8\x48\x18\x2b\xb1\x49\xf4\xa6\x53\x2e\x45\x42\xc7\x90\x5b\xac\x19\x85\x75\x31\xb6\x49\x71\x84\x82\x1a\xc0\xa0\xcd\xce\xb3" +
+	"\xea\x0a\xe7\xcc\x3f\xb0\x62\xfb\x9b\x70\xb5\xef\x92\xe2\xf8\x22\xe1\xc3\x5b\xb3\xe7\x15\xc7\x17\x89\x3f\xbe\x40\xc1\xf7\x59\xb1" +
+	"\xfd\x4d\xb8\x2d\x0e\x48\xb3\x14\xdb\x27\x6e\x8b\x43\xa7\xc8\x45\x42\x99\x40\x29\x8e\x58\xfd\xdd\x1a\x80\x89\xd1\xc7\xa4\x59\x9a" +
+	"\xd0\xec\x30\x3e\x79\x56\x4d\xbd\x14\x21\x58\xb7\x31\x3e\x25\xcd\xfd\xfe\x23\x7a\x13\x69\x65\xce\x58\x73\x1d\xf5\xef\x3b\x77\x8a" +
+	"\x7e\x38\xc8\x95\xa2\x18\x17\xa4\x39\x5a\x48\x53\xbf\x41\x7d\xc9\x9a\xd3\xcc\xb0\xf9\x31\x35\xcf\xab\xc3\x6f\x61\x74\xf8\x2d\x4c" +
+	"\x2d\x29\x7a\x29\x1a\xcd\x7d\x17\xeb\x9e\x57\x57\xf8\x2d\x4c\xfd\xf3\x6a\xea\x9d\x85\x44\x74\x34\xa3\x1a\x48\xd1\xdf\x53\xd1\xd1" +
+	"\x88\x32\x8d\xac\xd8\xfe\x2a\x4d\x7d\x2d\x9b\x9e\x57\xc7\xbd\x14\x51\x8d\x4a\x9b\xe6\xe7\xd5\x71\x2f\x45\xf7\x79\xa3\x5b\x58\xb1" +
+	"\xfd\x75\x81\x51\x8a\x56\xaf\xa1\x6f\x33\xd8\xa8\xb6\xe7\xd5\xf2\xb7\x30\xae\x2e\xda\x9f\x57\x57\xf8\x2d\x4c\xc7\xf3\xea\xb2\xdf" +
+	"\xc2\x20\x46\xe7\xf3\xea\xf2\xdf\xc2\x68\x65\xba\x9e\x57\x57\xf8\x2d\x4c\xb7\x53\x2e\x05\xb7\x45\xcf\xf3\xea\x8f\x7f\x0b\xa3\x7b" +
+	"\x59\xf3\xb8\xef\x6d\x9f\x57\x2a\x05\xb7\x45\xff\xf3\xea\x0a\xbf\x85\x19\x78\x5e\x5d\xe1\xb7\x30\x83\xcf\xab\xe3\xa3\x99\x3c\xcd" +
+	"\xb3\x34\xcd\x87\x9e\x57\xc7\x47\x33\xe8\xcc\x95\xe5\xe9\x30\xab\xc9\xa2\xfe\x7d\x23\x4e\x79\x6f\x30\xa7\x18\xa3\xa4\x7e\x6f\x90" +
+	"\xfa\x8f\x65\x63\xac\x86\x66\xe6\x62\x8c\x7b\xa5\xbd\x41\xee\x8f\x38\x41\xea\xf7\x06\x73\xe2\x49\x56\xf1\x5b\x98\xa9\xe7\xd5\x7c" +
+	"\x34\xe3\x4a\x80\xcd\x4a\x9a\x4f\x93\x1a\x8d\x86\x76\x9b\xa5\x2c\x4f\x67\x58\xdd\x92\x99\x51\x5f\xcb\x59\xa7\x88\xe1\x4a\x80\xad" +
+	"\x4a\x9e\xa5\x73\xa4\x46\x63\xde\xfe\xf3\x69\x36\xcf\xea\x96\x0a\x37\xab\x3c\x4d\x17\xbc\x1a\x4d\xfd\x06\xc1\x69\xbe\x48\x6a\x34" +
+	"\x75\xab\xcb\x30\x71\x89\x55\xfc\x16\x66\x19\x6a\xb8\x14\xdc\x16\x2b\xa4\xbe\x14\xdc\x16\xef\x59\x69\x5d\x04\xe7\xf9\xaa\x53\x2e" +
+	"\x05\xb7\xc5\x1a\xa9\x2f\x05\xb7\xc5\x3a\xab\xf8\x2d\xcc\x86\x57\x2a\x05\xb7\xc5\x26\xa9\x2f\x05\xb7\xc5\xd6\xf3\xea\x0a\xbf\x85" +
+	"\xd9\x7e\x5e\x1d\xdf\xcf\x26\xcd\x52\x9b\x65\xe9\x0e\xa9\xbf\x1f\x08\xda\x22\xb3\x69\xf6\x81\x55\xdb\xe8\x58\x6f\xd7\x29\xdf\x0f" +
+	"\x24\xa5\x18\x7b\xa4\xfe\x7e\x20\x74\x7c\x61\xf7\x59\x35\xcd\xcc\xc5\x38\xf0\x4a\xf7\x03\xe1\xe3\x8b\x43\x52\x7f\x3f\x10\x3e\xbe" +
+	"\x38\x62\x15\xe7\x95\x8f\x9f\x57\xf3\xfd\x6c\x5c\x09\xb0\x33\x9d\xa5\x27\xa4\x3a\x41\x09\x5c\x49\x6c\x9a\x9d\xb2\x62\x6d\x41\x69" +
+	"\x5d\x9c\x33\xa7\x88\xe1\x4a\x80\x7d\xe9\xd4\x66\xe7\xa4\x3a\xc1\xbc\xfd\xe7\x33\x7b\xc1\x8a\xb5\xc5\x05\x46\x29\x2e\xbd\xea\xc4" +
+	"\x7f\x2b\x53\x01\x6b\xfe\x50\x4d\x47\x2c\x74\x50\x67\x31\xb1\x96\x55\x9c\xe3\xaf\x83\x6a\x2e\x05\xb7\x45\x3d\xa9\x2f\x05\xb7\x45" +
+	"\x03\xab\xb8\xce\xd0\xe8\x94\x4b\xc1\x6d\xd1\x44\xaa\x93\xe8\x58\xcf\x36\xb3\x8a\x6b\x1d\x2d\x5e\xa9\x14\xdc\x16\xad\xa4\x3a\x89" +
+	"\x8f\xf5\xda\x58\x69\xbf\xc9\xff\xb5\xff\xa1\x9a\xb6\x59\xdc\x1f\x07\x43\x07\xab\xe1\x1b\x63\x62\xe8\x24\x35\xe2\x99\x21\x5d\x5e" +
+	"\x8d\x7c\xbe\x48\x37\xab\xa1\x64\xd8\xeb\x4d\x7b\x48\x0d\xed\x31\x63\x52\x9a\xf7\x3a\x35\x65\xcf\x38\xe9\x63\x35\xe1\x5e\x81\xa9" +
+	"\xcd\xfb\x49\x0d\x1d\x4f\xe0\xf3\xb9\x1d\x80\x1a\x2e\x05\xdf\x89\x70\x90\xd5\x10\xa6\xf8\xc0\x10\xa9\xa1\x7b\x34\xe2\xf3\xb9\x1d" +
+	"\xf6\x4a\xa5\xc0\x7a\x88\x8f\x8c\xb0\x1a\x4a\x9a\x22\x17\xa3\xa4\xbe\x14\xd8\x12\x20\x17\x63\x4e\xb9\x14\x29\xdd\x87\x73\x9c\xd5" +
+	"\x50\x58\x17\x63\x82\xd4\x97\x82\xfa\xe9\xda\xc9\x3f\x54\x47\xbf\xae\xe2\xb6\x98\x62\xc5\x96\x20\xe5\x6a\x9f\x26\x45\x9f\xe9\x34" +
+	"\xdc\x87\x73\xc6\x2b\xf5\x96\xe7\xb6\x98\x65\xc5\xd6\x28\xe5\xb6\x98\x23\xf5\xbd\x8d\xb9\x2d\xe6\x9d\x86\xde\xf2\xd4\x16\x0b\xac" +
+	"\xd8\x22\xa6\xdc\x16\x8b\x7f\xa8\x8e\xfb\x4c\xbb\xb6\xcc\xed\xd2\x1f\xaa\x69\x4f\x9d\xcf\xa8\x61\x58\x66\x55\xf1\xfd\x82\x56\x48" +
+	"\x15\x1d\xeb\xd3\xf3\x8f\xbd\x2a\xf9\x9c\xe2\x55\x56\xc5\x77\x2c\xca\xac\xcd\xd7\x48\x15\x1d\x51\x63\x52\x6e\xd7\x9d\x2a\xba\x0b" +
+	"\x16\xdf\x97\x6a\x83\x55\x85\xfb\x26\xe5\x99\xdd\x24\x55\x74\xdc\x8a\xcf\xdb\x6c\x0b\xaa\xe2\x7b\x79\x21\x17\xdb\xac\x8a\x30\xc7" +
+	"\x07\x76\x48\x15\xdd\xcb\x0b\x9f\xb7\xd9\x07\xaf\x54\x0a\xb4\x05\x3e\xb2\xcb\xaa\x28\x69\x8e\x5c\xec\x91\xfa\x52\xb8\x2a\xcf\x72" +
+	"\xbb\xef\x94\x4b\x91\xd3\x73\x8a\x0f\x58\x15\x85\x75\x31\x0e\x49\x7d\x29\xe8\xce\x98\xd9\xd1\x1f\xaa\xa3\xe7\xc2\x70\x5b\x1c\xb3" +
+	"\x62\x6d\xc9\xb9\xda\x4f\x48\x71\x97\xd2\x9c\x6f\x99\x95\x9d\x7a\xa5\xfb\xd3\x72\x5b\x9c\xb1\x62\x6d\xc9\xb9\x2d\xce\x49\xfd\xfd" +
+	"\x3d\xb9\x2d\x2e\x9c\x86\xfb\xd3\x52\x5b\x5c\xb2\xaa\x9c\x66\x87\x52\xd4\xbc\xa8\xa6\xa3\xd2\x9c\x66\x87\x71\xed\x8b\xdf\x2a\x9c" +
+	"\x8f\xaa\x63\xcd\xf9\x04\x11\x86\x7a\xd2\x5c\x9c\x8f\x6a\xf0\x9a\xcb\xf3\x51\x8d\xac\x39\x25\xc3\x39\x10\xd3\x44\x9a\x47\xe7\xa3" +
+	"\x4c\xd2\xec\x34\x2f\x3b\x1f\xd5\xc2\x9a\x87\xf3\x51\x46\x27\xad\xa4\x79\x38\x1f\x65\x92\x44\xb7\x41\xf3\xf2\xf3\x51\xed\xac\x39" +
+	"\x21\x8e\xe1\x92\x0e\xd2\x9c\xce\x47\x19\x77\x3e\xaa\xd3\x2b\x95\x02\x8f\x17\xc0\x47\xba\x58\x73\x4a\x8a\x23\x3b\xd3\x4d\xea\x4b" +
+	"\x81\x9b\xe2\x27\xda\x24\x3d\x4e\xb9\x14\x86\xce\x47\xf5\xb2\xe6\x14\xd6\xc5\xe8\x23\xf5\xa5\x70\x37\xc5\x4f\x12\xdd\xff\xe2\xb7" +
+	"\xe8\x39\xc5\xdc\x16\x03\xac\x38\x72\x37\x5c\xed\x83\xa4\xfe\x59\x24\xdc\x16\x43\x5e\xe9\x59\x24\xdc\x16\xc3\xac\xee\xf9\x06\xdc" +
+	"\x16\x23\xa4\xfe\x89\x2a\xdc\x16\xa3\x4e\xc3\xb3\x48\xa8\x2d\xc6\x58\xdd\xb3\x05\xb8\x2d\xc6\x5f\xfc\x16\x3f\x35\x87\xda\x62\xe2" +
+	"\xc5\x6f\x15\xee\xa4\x31\xc9\x6a\xe3\x5f\xfc\x4d\x91\x5a\xfa\x7d\xb4\xdb\xe0\x99\x69\xaf\x56\xde\x49\x63\x86\xd5\x86\xc3\x0b\xa5" +
+	"\xf4\x2c\xa9\x0d\xc7\x2d\xca\x68\x35\xe7\xd4\xd2\x6f\xc4\xf8\xd7\x8c\xf3\xac\x96\x8f\x5b\x94\x36\x6a\x81\xd4\x86\xe3\x16\x8d\xdf" +
+	"\xbf\x41\x2d\x97\x82\x8f\x9f\x96\x58\x2d\x21\x0e\x45\xd5\x32\xa9\xa5\x5f\xba\xe1\xf3\xca\xac\x78\xa5\x52\xa0\x03\x2c\x3e\xf2\x9e" +
+	"\xd5\x52\x52\x1c\x8a\xea\x55\x52\x5f\x0a\x74\xdb\x44\x29\xd6\x9c\x72\x29\x34\xfd\xb2\x74\x9d\xd5\x52\x58\x17\x63\x83\xd4\x97\x82" +
+	"\xfa\xcb\x9b\xcd\x17\xbf\x45\x77\x24\xe3\xb6\xd8\x62\xb5\x74\x68\x8a\x8f\xa8\x6d\x52\xdf\x5b\x9e\xdb\x62\xc7\x2b\xf5\x96\xe7\xb6" +
+	"\xf8\xc0\xea\x7a\xe0\x72\x5b\xec\x92\xfa\x3e\xff\xdc\x16\x7b\x4e\x43\x6f\x79\x6a\x8b\x7d\x56\xd7\xfb\x95\xdb\xe2\x80\x94\x7a\xcb" +
+	"\x53\x5b\x1c\xbe\xf8\x2d\xbe\x77\xa9\xd2\x38\x23\xaa\x8e\x5e\xfc\x16\xdf\xbb\x54\x29\x9c\xef\xd5\xc7\xac\x36\x89\x7a\xb5\x9f\xbc" +
+	"\xf8\x2d\xba\x77\xa9\xa2\x18\xa7\xa4\xfe\x0e\xac\xd4\x5b\x3e\x39\x63\xb5\x34\x33\x17\xe3\xdc\x2b\xdd\x9f\x96\x7b\xe1\x5f\x90\xe2" +
+	"\xdc\x36\x4e\x4c\x7b\xbe\x64\x15\x77\x24\xab\xf9\xe3\x6f\x74\xcf\x46\x24\xa2\xf3\x3b\xaa\x96\xd4\x66\xa8\x07\xac\x59\xc8\x49\x1d" +
+	"\x2b\x6a\x07\xa5\x75\x71\xea\x9d\x22\x86\x2b\x01\x36\x11\x2a\xd1\x0d\xa4\x36\xc3\xbc\xfd\xe7\x75\xd2\xc8\x8a\x16\x72\x81\x51\x8a" +
+	"\x26\xaf\x36\xa3\x5f\xf4\x80\xb5\x6a\x26\xb5\x19\xfd\x9a\x21\xc1\xc4\x16\x56\x71\x47\xb2\x56\xa8\xe5\x52\x70\x5b\xb4\x91\xfa\x52" +
+	"\x70\x5b\xb4\xb3\xd2\x3d\x7f\xc0\x4a\x75\x38\xe5\x52\x70\x5b\x74\x92\xfa\x52\x70\x5b\x74\xb1\xd2\x1d\x91\x7c\x4e\xba\xbd\x52\x29" +
+	"\xb8\x2d\x7a\x48\x7d\x29\xb8\x2d\x7a\xff\xf8\x5b\x85\x3b\x92\xf5\xfd\xf1\x37\x3a\x56\xc5\x32\x8a\xad\x2f\xc6\xfd\x7f\xfc\x2d\xfe" +
+	"\xd5\x2c\x9e\xa2\xa2\x12\x33\xc0\x9a\xab\xe8\xb9\x46\x83\x7f\xfc\x2d\xfa\xd5\x6c\x42\x31\x86\x48\x71\x2e\xc8\x28\x7a\x5e\x92\x1a" +
+	"\x66\xcd\x69\x66\x2e\xc6\x88\x57\xfa\x65\x34\x3f\x87\x69\x94\xd4\x9d\x8f\xc2\x17\x3b\xd0\x8c\xb1\x8a\x67\xa9\x8f\xff\xf1\x37\x3e" +
+	"\x1f\xe5\x4a\xe0\xcb\x32\x41\x9a\xa7\xd8\x56\x63\xcd\x42\x4e\x26\x59\xb1\x05\xc7\xd9\x71\x17\x67\xca\x29\x62\xb8\x12\x60\xf3\x92" +
+	"\x28\x33\x4d\x9a\xa7\x98\xb7\xff\xbc\x51\x33\xac\xf8\x16\x71\x81\x51\x8a\x59\xaf\x79\x9a\x27\x94\x18\x19\x9e\x23\xcd\x53\x7a\x9e" +
+	"\x95\xab\x8b\x79\x56\xf1\x2c\xf5\x05\x68\xce\xa5\xe0\xb6\x58\x24\xf5\xa5\xe0\xb6\x58\x62\xa5\xa7\x15\x83\x93\x64\xd9\x29\x97\x82" +
+	"\xdb\x62\x85\xd4\x97\x22\x31\x89\x49\x8c\x51\xff\x8f\xb0\xb7\x01\xc9\x23\x4b\xf6\xff\xbb\x3b\x9d\x93\x9e\x4e\xcf\x99\x4c\xde\x13" +
+	"\x96\xe5\xc7\x65\x59\xc2\x30\x37\x93\x9d\x9d\x97\x9d\x4c\x26\x33\x9b\xcd\x64\x67\x67\x67\x5f\xfe\x41\x42\x10\x09\x12\x42\x18\x96" +
+	"\x65\xb9\x5c\x86\x61\x18\x2e\x17\x11\x11\x11\x11\x11\x91\x10\x42\x08\x21\x84\x10\x42\x08\x21\x04\x09\x41\x44\x82\x88\x04\x91\x20" +
+	"\x22\x22\x22\x22\x22\x22\x22\x21\x88\x88\xc8\x9f\xaa\xf3\xfd\xf6\x73\xfa\x79\xda\xbd\xf8\xa0\xf0\xb1\xba\xce\xa9\xae\xee\x7e\xba" +
+	"\xab\xab\xea\xbc\x22\x2d\xac\xa5\x3e\xee\x28\xac\xa0\x2f\x26\x40\x9d\x15\xf4\xc5\x24\x29\xd6\x58\x70\x9f\xa9\xff\x3c\x8f\xef\x0f" +
+	"\xb9\xb8\x8b\x35\xf2\x7b\x1a\xd4\xe8\x75\x51\x76\x53\x62\xb3\x64\x86\xd4\x58\x84\x50\xc4\x31\xb3\x4a\xe5\xe2\x28\xf9\xf0\x19\x74" +
+	"\xcc\x81\x1a\xfd\x7e\x40\x0c\xc4\xce\x93\x1a\x0c\x26\x73\x4f\x16\x1c\xd5\xaa\x43\xcb\xa2\xd7\x6c\x11\x54\xab\x0e\x2d\x63\x20\x4b" +
+	"\xa4\x85\x78\xd4\xf2\x7f\x9e\xf7\xaa\x66\x91\xf5\x9f\xad\x80\x4a\xe5\x63\x86\x22\x83\x2c\x59\x25\xd5\x23\xd3\x22\x1e\xf5\x5a\x69" +
+	"\x5e\xc7\x2a\xdf\x53\x99\x4d\xde\xfc\xe7\x79\xbf\x6a\x56\xb7\x4f\xec\x1a\xa9\x1e\x15\xa2\x58\xac\x58\x77\x34\xaf\x63\x15\x9c\x64" +
+	"\x1b\xa0\x79\x1d\xab\x95\x7f\x6e\x92\x16\xe2\x51\x5b\x42\xf3\x6a\x5c\xfa\xa2\xe9\xf8\xf9\xea\xde\x2c\x36\x4b\x9a\x49\x0b\xf1\xa8" +
+	"\x16\xa5\xb4\x82\xbe\x68\x3d\x7e\xbe\xb6\x37\x8b\x6d\x23\x2d\xc4\xa3\xda\x1d\x85\x15\xf4\x45\xc7\xf1\xf3\x25\xbd\x59\x3a\x8f\x9f" +
+	"\x2f\x89\x47\x75\x1d\x3f\x8f\x7b\x5c\xb9\x01\x95\x2b\xae\xfc\xee\x06\x8d\xf5\xde\x4d\x2e\xe5\x69\x66\xd3\x1e\xd2\x38\xc3\x23\x95" +
+	"\x7c\x79\xf4\x2a\x95\x1b\x38\x59\x11\xc9\x42\xc7\x35\xd0\x58\xef\x61\x11\x21\xca\xae\x93\xc6\x18\x4c\xae\xaf\xe9\x0d\x47\x25\x0e" +
+	"\x93\x66\x6c\x8f\x6c\x6f\x82\x6a\x3c\x4a\x1e\x3c\x04\xa6\xb7\x48\xf3\x2e\xbb\xf2\xb9\x7d\xfc\x3c\xe3\x51\x6a\x81\xb3\xe5\x0e\xa8" +
+	"\xc4\xc4\x2c\x96\x99\xb2\xe9\x5d\x52\xfd\xf6\xcc\xd0\x25\xfa\xde\xf1\xf3\x7e\x7f\x65\xb9\x97\xb6\x59\x7a\x1f\x54\xe2\x72\x16\xdb" +
+	"\xa7\xd9\x03\x52\xfd\xe6\x12\xc5\x62\xc5\x43\x47\xf3\x8e\xc7\x82\x53\xfb\x08\x34\xef\x78\x2c\x8f\x55\xe9\x63\xd2\x98\x4f\x75\xf2" +
+	"\xf7\x89\xd0\xbc\x6f\x33\x7d\xf1\x14\x34\xce\x9f\x8f\x65\x16\x7d\xa4\xb8\x5f\x90\x19\x58\xfb\x4c\x29\xad\xa0\x2f\x9e\x83\xc6\xf9" +
+	"\xf3\xb1\xe8\xe8\x27\xc5\xb7\xb2\xcc\x20\x4d\x07\x1c\x85\x15\xf4\xc5\x20\x68\x8c\x9e\xfa\x0e\xbf\x20\x2d\x74\x4d\x1c\x3a\x7e\x1e" +
+	"\xd7\x2c\x3e\xad\xca\xcf\xf0\xf1\xf3\x25\xab\xf3\x8f\x1c\x3f\x5f\xb2\x3a\xff\x4b\x47\xb1\xa6\x5b\x8a\x25\xc6\x46\x8f\x9f\xaf\x5d" +
+	"\x9d\x3f\x19\x3b\x7e\xbe\x66\x75\xfe\x24\x7b\xa5\x94\x6b\xba\x71\x65\xaf\xf1\xe3\xe7\xab\x57\xe7\x4f\xd2\x6c\xe2\xf8\xf9\xaa\xd5" +
+	"\xf9\x93\x2c\x4b\x27\x8f\x9f\x2f\x59\x9d\x7f\x8a\xd4\x00\x26\xb2\xc1\x34\x28\x57\xa6\x93\xed\xb3\x74\xc6\x51\x58\x21\xa7\xa7\x6c" +
+	"\x32\x4b\x6a\x20\x2a\xc1\xfb\x64\xee\xf8\x79\x7f\xad\x38\xb9\x44\xa5\x49\x36\xaf\x94\x56\x24\x58\x65\x6d\x81\xd4\x40\xad\xea\x58" +
+	"\x04\x95\x15\xce\xb2\x14\xf1\xa8\x74\xe9\xf8\xf9\x92\xd5\xf9\x97\x49\xe5\x4a\x90\x70\xb7\xaf\x80\xca\x5a\x71\x49\xbe\x82\xdc\xaa" +
+	"\xa3\xb2\x4a\x60\xe2\x56\x09\x14\x2b\x5e\x93\xca\xd5\x28\xa1\x2f\xde\x80\x9a\x40\xae\x65\xf4\xc5\x9a\x52\x99\x45\x82\x49\x88\x15" +
+	"\xeb\xa4\x72\x45\x4c\xe8\x8b\x0d\x50\x13\x24\x18\x4e\x7e\x6f\x1e\x3f\x5f\xd2\x87\x73\x8b\x14\xd5\xc2\xfa\x35\x62\x9b\x3e\x38\x5f" +
+	"\xd2\x87\xb3\xd9\xd1\xaa\x3e\x9c\x2d\x1f\x9c\xaf\xed\xc3\x99\xb6\x7e\x70\xbe\xa6\x0f\x67\x6a\xdb\x94\xb2\xea\x90\xb5\x67\xed\xa4" +
+	"\x31\x6b\xd4\x6c\x9a\xd8\x8e\x0f\xce\x57\xf5\xe1\x4c\xad\x4d\x3a\x3f\x38\x5f\xd2\x87\xb3\xeb\x83\xf3\xc5\x3e\x9c\xa9\x6c\xd0\x0d" +
+	"\xca\xda\x49\xd9\xde\x26\x3d\x8e\xc2\x0a\x39\x0f\x65\x93\x5e\xd2\x18\xa2\xa9\xcc\xe2\xda\x07\xe7\xfd\x6a\x46\xb9\x38\x24\xa9\xbd" +
+	"\xae\x94\x56\xa4\xa8\x03\xbc\x41\x1a\x43\xad\xea\xb8\x09\x2a\x35\x78\x36\x41\x84\x28\xb9\xf5\xc1\xf9\x92\x3e\x9c\xb7\x49\xe5\x4a" +
+	"\x90\x72\xb7\xdf\x01\x95\x6a\xc6\x34\xaf\x71\xbc\xeb\xa8\xd4\xb1\xa6\xae\x8e\x55\xac\xb8\x47\x2a\x57\xa3\x94\xbe\xb8\x0f\x1a\x6b" +
+	"\xa4\x8c\xbe\x78\xa0\x54\x66\x91\x62\x12\x62\xc5\x43\x52\xb9\x22\xa6\xf4\xc5\x23\xd0\xd8\x45\xeb\xe0\x8b\xc7\x1f\xd4\x21\x1e\xc5" +
+	"\xa7\x55\xf9\x79\x42\x8a\x35\xfd\xf4\x34\x0d\x9e\x82\x66\xa9\xb7\x58\xad\xe9\x73\x14\x2b\x99\x72\xbd\xdb\x67\xa4\xf9\x5d\xa6\x09" +
+	"\x82\xe8\x39\x68\x86\xd5\x27\x05\x47\x41\xbf\x52\xae\x64\x9a\xaf\x7f\x4c\x9a\xdf\x65\x06\x91\x09\x06\x41\x33\xac\xcd\x29\xdb\x07" +
+	"\xe6\x85\xd0\x8c\x56\xf0\x6e\x77\x88\x34\x03\x8c\x64\x2e\xc3\xa0\x5c\x8f\x55\xb6\x0f\xcc\x88\xa3\xb0\x42\x82\x79\xb2\xc9\x4b\xd2" +
+	"\x0c\xa2\xf2\x94\x12\x8d\x7e\x50\xe7\xaf\xe2\x2c\xf1\x4b\x13\x05\x63\x4a\x69\x45\xc4\xf5\x8f\x49\x33\xa8\x55\x1d\xe3\xa0\xce\x0a" +
+	"\xe4\x47\x99\x89\x0f\xea\xbc\x78\x14\x7d\x31\x49\x9a\xe1\x41\x42\x36\x09\xa6\x40\x25\x1e\x15\xe5\x6b\x3b\x4f\x3b\x2a\xf1\xa8\xa8" +
+	"\xb2\x0e\xf4\x0c\xa9\x66\xb3\xd0\x17\xb3\xa0\x59\x2a\x59\x2f\xf4\xc5\x9c\xd2\x8c\xf9\x51\x5c\xff\x98\x54\x33\x6a\xe8\x8b\x05\xd0" +
+	"\xcc\xe5\x47\xc1\x17\x8b\x1f\xd4\xa1\xab\x54\x7e\xb3\x1e\x04\xc1\xd2\x07\x75\x25\xeb\xeb\x2d\x7f\x50\x57\xb2\xbe\xde\x8a\xa3\x58" +
+	"\x99\x8e\x79\x36\xab\xa4\x88\x39\xcb\xef\x28\x7e\xfd\x41\x5d\xcd\xfa\x7a\x71\xf4\xe6\x83\xba\x92\xf5\xf5\xd6\x48\x11\xd9\x95\xed" +
+	"\x83\x68\xfd\x83\xba\xaa\xf5\xf5\xe2\x28\x0a\x36\x3e\xa8\x2b\x59\x5f\x6f\xf3\x83\xba\xe2\xfa\x7a\x92\x49\x17\x6d\x7d\x50\x57\x58" +
+	"\x5f\x2f\xd6\xf5\xf5\x9a\x4e\xd4\xf9\xeb\xeb\xc5\x6e\x7d\xbd\x66\x52\x0b\x51\x09\x63\xc4\x2d\xa0\x92\x6f\x24\x29\x6c\x22\x1c\x47" +
+	"\xad\x27\xea\xbc\xf5\xf5\x62\xac\xaf\xd7\x46\x6a\xa1\x56\x75\xb4\x83\x4a\xe6\x96\x6c\x2b\x3f\x51\xd0\x71\xa2\xae\x64\x7d\xbd\x4e" +
+	"\x52\xbd\xba\x73\xb7\x77\x81\x4a\xf6\x58\x9c\xe7\x94\x75\x3b\x2a\xeb\xeb\xc5\x95\xf5\xf5\x7a\x48\xf5\xca\x4a\x5f\xf4\x82\xda\x4c" +
+	"\x2c\xa0\x2f\xae\x29\x95\x59\xc4\x98\x84\x58\x71\x9d\x54\xaf\x6a\xf4\xc5\x0d\x50\x9b\xc5\x18\x4e\x7e\xdf\x3c\x51\x87\xef\x25\x79" +
+	"\xe9\x2d\xff\x91\xdf\xb7\x40\xad\xbe\xa5\x17\x2d\xb1\x89\xe2\xdb\xa4\xd6\x20\x84\x22\x97\xf3\x3b\x4a\xe5\x4a\x28\x2f\xde\x23\xe8" +
+	"\xb8\x0b\x6a\x35\x5b\x01\x31\x10\x73\x8f\xd4\x62\x30\xd9\x49\xf1\x7d\x47\xa5\xd3\x83\xe4\x8b\x20\x06\xf2\x00\x54\x7a\x45\x48\x60" +
+	"\x51\x61\xfc\x90\xb4\x10\x8f\x7a\x74\xa2\x0e\x35\xf2\x22\x24\x96\x88\xfa\xc7\xa0\x12\x81\x8b\x90\x01\x14\xc5\x4f\x48\x65\xef\x48" +
+	"\xde\x8a\xea\x79\x7a\xa2\xce\xef\x15\x21\x19\x40\x91\x89\xfb\x4e\xd4\xf9\xbd\x22\x74\xfb\xd8\x3c\x23\x15\x0f\xa9\x62\xb1\xe2\xb9" +
+	"\xa3\x79\x87\x19\xc1\x71\xd4\x7f\xa2\xae\xd8\x21\xdf\xc8\x3f\x07\x48\x0b\xf1\xa8\xc1\x13\x75\x55\x1d\xf2\x45\xc7\x0b\x50\x8b\x2e" +
+	"\x05\xa2\x3a\x8a\x87\x48\x0b\xf1\xa8\x61\xa5\xb4\x82\xbe\x18\x39\x51\x57\xdb\x21\xdf\xbc\x24\x2d\xc4\xa3\x46\x1d\x85\x15\xf4\xc5" +
+	"\xd8\x89\xba\x92\x0e\xf9\xaf\x4e\xd4\x95\xc4\xa3\xc6\x4f\xd4\x95\xd4\xc2\x4c\x9c\xa8\x2b\xa9\x85\x99\x3c\x51\x57\x52\x0b\x33\x75" +
+	"\xa2\xae\xa4\x16\x66\x9a\x14\x59\xec\xf2\xdb\x04\x33\x27\xea\x6a\x6a\x61\x02\x33\x7b\xa2\xae\xa4\x16\x66\xee\x44\x5d\x75\x2d\x4c" +
+	"\x10\x9b\xf9\x13\x75\x55\xb5\x30\x81\xd4\x5f\x9c\xa8\x2b\xa9\x85\x59\x3c\x51\x57\xac\x85\x91\xc4\x32\xb3\x74\xa2\xae\x50\x0b\x13" +
+	"\x68\x2d\xcc\xf2\x89\x3a\xbf\x16\x26\x70\xb5\x30\x2b\xa4\x29\x44\x25\xdb\x2c\x58\x3d\x51\xe7\xd7\xc2\xc8\x97\x5b\x1c\x98\xd7\x27" +
+	"\xea\xbc\x5a\x98\x00\xb5\x30\x6f\x48\x53\xa8\x55\x1d\x6b\xa0\x92\x79\x68\x62\xf9\xbe\x11\x1d\xeb\x27\xea\x4a\x6a\x61\x36\x48\x53" +
+	"\x24\xf2\xc9\x26\x66\x13\x54\x6a\x61\x82\xbc\x16\x66\xcb\x51\xc9\x1b\x14\x85\xf0\x45\xd3\x6f\x40\xe5\x1b\x3d\xa0\x2f\x9a\x41\x53" +
+	"\x8d\x73\xd1\x17\x2d\x4a\x65\x16\x01\x26\x21\x56\xb4\x92\xea\x1b\x1b\xfa\xa2\x0d\x34\xd5\x38\x17\x7d\xd1\xfe\x9b\x3a\x1c\x4b\x7c" +
+	"\xfa\x93\x9f\x0e\x52\xc3\x66\x29\x72\xea\x74\x82\x9a\x42\x3f\xfe\x2e\x47\x4d\xb1\x77\x7f\x37\xa9\xe1\x1d\xb4\x95\xfa\x0b\x50\x93" +
+	"\xdf\x6d\x27\x36\x4d\x7a\x95\x9a\xaa\xf5\x03\xae\x91\x1a\xde\xc5\x27\xa9\x4d\xae\x83\x9a\xfc\x8e\x3f\x4d\x12\x7b\x43\xa8\xa1\x15" +
+	"\xbc\x3b\xbf\x49\x6a\x00\x53\xd9\xe0\x16\xa8\xb3\x42\x77\xbf\x4d\xec\x6d\x47\x61\x85\x42\x9b\x24\x77\x48\x0d\x44\xe5\xae\x34\xbd" +
+	"\x0b\xea\xac\x90\x9c\x11\xb1\xe2\x9e\x52\x5a\x91\xa2\x37\xcb\x7d\x52\x03\xb5\xaa\xe3\x01\xa8\xb3\x02\xf9\x5c\xf6\xe1\x6f\xea\xbc" +
+	"\x5a\x18\xfa\xe2\x11\xa9\xc1\x0d\xbf\x6c\x92\x3c\x06\x95\xec\xb1\x34\xef\xcd\xf2\xe4\x37\x75\x5e\x2d\x0c\x7d\xf1\x94\x54\xcf\x03" +
+	"\xfa\xa2\x0f\x54\x32\xd8\x52\x44\xeb\xd2\xe4\xd9\x6f\xea\xfc\x5a\x18\xf8\xe2\x39\xa9\x1e\x83\xf4\x45\xff\x6f\xea\xfc\x5a\x18\xf8" +
+	"\x62\xe0\x37\x75\xb8\xc7\x95\x1b\x50\xb1\x4c\x7e\x0f\x82\x62\x15\x72\x39\x92\xb3\x24\x7d\x41\x6a\x32\x2f\x3f\x6a\x48\x29\xd7\xef" +
+	"\x4e\xa0\x63\x18\xd4\xad\xdf\x8d\x9c\x9c\x6c\x84\xd4\x60\x30\xd5\xf1\xd2\x51\xac\xdf\x8d\xe4\x9b\x64\x14\x54\x9e\xf2\xd3\x2c\xaf" +
+	"\xbf\x20\x2d\xd4\xc2\xbc\xfa\x4d\x1d\xd7\x9f\x57\x0b\x9c\x2d\xe3\xa0\x46\x33\x8b\xd4\x92\x2c\x49\x27\x48\x65\xef\xc8\x93\xb0\xea" +
+	"\x99\x54\x2a\x3a\xd4\x02\xb9\x97\x4e\xb2\x74\x0a\xd4\x68\x66\x91\xdb\x3e\xcd\xa6\x49\xc5\x43\xf2\x34\x2e\xbf\xd3\x19\x47\x4d\x80" +
+	"\x5a\x98\x4c\x6b\x61\x66\x41\x0d\xf3\xa3\x24\xf5\x2f\x9d\x23\x2d\xd4\xc2\xcc\x0b\x35\xb4\x82\xbe\x58\x00\x35\x95\xfc\xa8\x2c\x49" +
+	"\x17\x49\x0b\xb5\x30\x4b\x4a\x69\x05\x7d\xb1\x0c\x6a\xfc\xfc\xa8\x6c\x85\xb4\x50\x0b\xb3\xea\x28\xac\xa0\x2f\x5e\x83\x9a\x42\x7e" +
+	"\xd4\x1b\x52\x7c\xcb\xb9\xcf\xda\x6f\xea\x4a\xe2\x51\xeb\xa4\x11\x03\x44\xb2\x37\x37\x40\xa3\x42\x3c\x6a\xd3\xd1\xa8\x18\x8f\xda" +
+	"\x22\x8d\x20\x26\xcf\x46\xb6\xe9\xc3\x3a\xac\x53\x5c\x89\x47\xd9\xac\x59\x69\x54\x15\x8f\x6a\x21\x8d\xf2\x78\x94\x4d\xb3\x56\xd0" +
+	"\x28\x8f\x47\xd9\x2c\x4b\xdb\x84\xe6\xd9\x2c\x8c\xe6\xb4\x93\x46\x80\x56\x36\xe8\x00\x8d\x10\x8f\xb2\x1a\x8f\xea\x74\x14\x56\xc8" +
+	"\x4d\xa8\x6c\xd2\x45\x1a\x41\x54\x62\x9f\xb6\x1b\xd4\x59\x21\xf9\x1d\x62\x45\x8f\x52\x5a\x61\x11\x8f\xea\x25\x8d\xa0\x56\x75\x5c" +
+	"\x03\x75\x56\x20\x63\x29\xbd\xfe\x61\x9d\xb7\x4e\x31\x7d\x71\x83\x54\x9f\x69\xb8\xdb\x6f\x82\xca\x3a\xaf\x36\x8f\x47\xdd\xfa\xb0" +
+	"\xce\x5b\xa7\x98\xbe\xb8\x4d\xaa\xcf\x13\xf4\xc5\x1d\x50\xc9\x45\xb1\x88\x47\xd9\xec\xee\x87\x75\xfe\x3a\xc5\xf0\xc5\x3d\x52\xbd" +
+	"\x97\xa7\x2f\xee\x7f\x58\xe7\xaf\x53\x0c\x5f\x3c\xf8\xb0\x0e\xf7\x55\x8c\xa2\xcb\xcf\x43\xd2\xd8\x5f\x97\xf6\x11\x68\x5c\x58\xc3" +
+	"\xf6\xb1\xa3\x71\x71\xbd\xdb\x27\xa4\x31\x33\xe3\x32\x6b\x93\xa7\xa0\x31\x9e\x7f\xe4\x5f\x89\xed\x53\x1a\x57\xad\xb9\xfb\x8c\x34" +
+	"\x66\x76\x9e\x4d\x32\xfb\x1c\x34\xc6\x53\x86\x6c\x6f\xb3\x7e\xa1\xb1\x9f\xab\x26\xb3\x18\x20\x8d\x01\x25\xb1\xcc\x0e\x82\xc6\x58" +
+	"\x4f\x38\xd1\xf5\x84\x5f\x38\x0a\x2b\xe4\xfb\x42\x36\x19\x22\x8d\x21\x2a\xd9\x66\xc9\x30\xa8\xb3\x42\xbf\x16\xb2\xc4\x8e\x28\xa5" +
+	"\x15\x09\xd7\x3f\x26\x8d\xa1\x56\x75\x8c\x82\x3a\x2b\x10\x21\xca\xc6\x3e\xac\xf3\xe2\x51\xf4\xc5\x2b\xd2\x18\x89\x7c\xb2\x89\x1d" +
+	"\x07\x95\x78\x54\xc2\xa5\x99\xb3\x09\x47\xd1\x57\x8d\xbe\x98\x24\x95\x6f\xf4\x84\xbe\x98\x02\x95\x98\x58\x82\xcc\xad\xc4\x4e\x2b" +
+	"\xcd\xfb\xaa\xc1\x17\x33\xa4\xf2\x54\x94\xd0\x17\xb3\x1f\xd6\xf9\xdd\xb5\xd4\x97\x36\x9b\xfb\xf0\x42\x49\x3c\x6a\x9e\xd4\x32\x40" +
+	"\x24\x17\xe3\x05\x50\x5b\x88\x47\x2d\x3a\x6a\x8b\xf1\xa8\x25\x52\x0b\x31\x89\xe7\xc4\xcb\xa0\xd6\x8b\x47\xc5\xc1\x8a\x52\x5b\x15" +
+	"\x8f\x5a\x25\xb5\x79\x3c\x2a\x36\xc1\x6b\x50\x8b\xef\x6a\xc9\xd3\x09\xcc\x1b\xa1\xb6\x3a\x1e\xb5\x46\x6a\x01\xe5\x8e\x3b\x58\x07" +
+	"\xb5\x88\x47\xc5\x1a\x8f\xda\x70\x14\x56\xc8\xeb\x18\xd9\x64\x93\xd4\xfa\x19\x40\x5b\xa0\x36\xf5\xb3\x85\x9a\x7e\x7b\x81\xf5\x7a" +
+	"\x72\x4b\x8b\x78\x54\x33\xa9\xf5\xb3\x90\x5a\x40\x6d\xea\x67\x2c\xb5\xfe\xf6\x82\x57\xaf\x47\x5f\xb4\x91\x4a\x0c\x24\xe6\x6e\x6f" +
+	"\x07\xb5\x29\x52\xb4\xf4\xa7\xc3\x51\xac\x8d\x4b\x5f\x74\x92\x5a\x3f\x1b\xab\x0b\xd4\xa6\x7e\xe6\x56\xb7\xd2\x7c\x6d\x5c\xf8\xa2" +
+	"\x87\xd4\xfa\x19\x61\xbd\xbf\xbd\xe0\xaf\xe2\x8c\xec\xb1\x6b\xbf\xbd\x80\x68\x39\x9f\x2d\xe4\xe7\x3a\x69\xe6\x77\xf2\xbc\x01\x9a" +
+	"\x61\x1d\x2e\x85\xf1\x4d\x47\xb3\x04\xab\x7e\xa1\xff\x24\xa9\xc6\x2a\x10\x71\x31\xb7\x41\xb3\xfc\x49\x58\xf2\x6c\xee\x28\xcd\xd0" +
+	"\xfb\x91\x5d\x4a\xef\x92\x66\x7c\x12\x96\xfc\x9b\x7b\xa0\x59\xfe\x24\x2c\xb9\x3a\xf7\x85\x66\xb4\x82\x4f\xe4\x0f\x48\x33\x40\x09" +
+	"\x6e\x44\x0f\x41\x33\x74\xb0\x94\xed\xa3\xf8\x91\xa3\xb0\x42\x93\xa1\xa4\xff\x24\x69\xe6\x67\x00\x3d\x01\xcd\x12\x3f\x5b\xe8\xa9" +
+	"\x52\x5a\x61\xd0\x31\xb6\x8f\x34\xf3\xb3\x90\x9e\x81\x66\x89\x9f\xb1\xf4\xfc\xb7\x17\xbc\x95\x86\xe8\x8b\x7e\xd2\x0c\xc1\x0e\xd9" +
+	"\x24\x1a\x00\x75\x5d\xd9\xe9\x8b\x41\x47\xd1\x95\x9d\xbe\x78\x41\x9a\xf9\xd9\x58\x43\xa0\x59\xe2\x67\x6e\x0d\x2b\xcd\xbb\xb2\xc3" +
+	"\x17\x23\xa4\x99\x9f\x11\xf6\x12\xd4\xe5\x97\xd0\x17\xa3\xbf\xbd\x80\xa7\x26\x3f\x03\x68\xec\xb7\x17\xfc\xde\xa5\xc8\x16\x7a\x45" +
+	"\x6a\x23\x6f\x55\xe4\x71\xa5\xcc\xc6\x62\x16\xd2\x04\xa8\x4d\xfc\x8c\xa5\x49\x52\x8b\xc1\x54\xc7\x94\xa3\xe8\x4f\xcb\x55\x9c\xa7" +
+	"\x41\x35\x1e\x15\x71\xc5\xe7\x19\xd2\x7c\x15\x72\xf9\xcc\xfe\xf6\x02\xe3\x51\x5e\x36\xd6\x1c\xa8\x4d\xfc\xcc\xad\x79\x52\x39\xdf" +
+	"\x24\x3e\xa9\x7a\x16\x94\x8a\x0e\x3f\x23\x6c\x11\xd4\x26\x7e\xf6\xd8\x12\xa9\x9c\xf3\x12\x9f\x94\xdf\xf1\xb2\xa3\x36\xc1\x8a\xf0" +
+	"\x82\xe3\x60\x05\xd4\x26\x48\xcb\xd4\x7d\xb1\x4a\x9a\xaf\x08\x2f\x7f\x5f\x0b\xb5\xb4\x82\xbe\x78\x03\xea\xac\xa0\x2f\xd6\x48\xad" +
+	"\xbf\x5a\xf8\xba\x52\x5a\x41\x5f\x6c\x80\x3a\x2b\xe8\x8b\x4d\x52\xeb\xaf\x58\xbe\xe5\x28\xac\xa0\x2f\x9a\x3e\xba\x80\x78\x14\x07" +
+	"\x93\x9f\x66\xd2\x42\x05\x68\xcb\x47\x17\xf0\x2e\xd7\xcf\x00\x6a\x05\xcd\x52\x3f\x5b\xa8\x8d\x34\x0b\xbc\x7a\xbd\x76\xa5\xcc\x18" +
+	"\x65\x16\x52\x07\x68\x96\xfa\x19\x4b\x9d\xa4\x19\x06\x53\x1d\x5d\x8e\xca\xbb\x0e\x37\x98\xfe\x74\x83\xca\xdb\x12\x79\xd5\xa1\xd0" +
+	"\xf4\x90\x16\xea\xf5\x7a\x3f\xba\x20\xcf\x9f\xa2\xc1\xcb\xc6\xba\x06\x9a\xa5\x7e\xe6\xd6\x75\x52\x39\xdf\xe4\x05\x86\xea\xb9\xa1" +
+	"\x54\x74\xf8\x19\x61\x37\x41\xb3\xd4\xcf\x1e\xbb\x45\x2a\xe7\xbc\x2a\x16\x2b\x6e\x3b\x9a\xa5\xae\xb2\x02\x06\xde\x01\xcd\x24\x57" +
+	"\x4d\xc6\x0a\xe4\x9f\x77\x49\x0b\xf5\x7a\xf7\x84\x66\xb4\x82\xbe\xb8\x0f\xea\xac\xa0\x2f\x1e\x90\x16\xea\xf5\x1e\x2a\xa5\x15\xf4" +
+	"\xc5\x23\x50\x67\x05\x7d\xf1\x98\x14\xd5\x1d\x6e\x26\x4f\x1c\x85\x15\xf4\xc5\x53\x50\x67\x05\x7d\xd1\xf7\xd1\x85\x92\x7a\xbd\x67" +
+	"\x1f\x5d\xc0\xf7\x87\x9f\x01\xf4\x1c\x34\x8e\xfc\x6c\xa1\x7e\xd2\x38\xf3\x62\x15\x03\x4a\xe5\xac\xf6\xb3\x90\x06\x41\xe3\xc8\xcf" +
+	"\x58\x7a\x41\x1a\x63\x30\x39\xec\x93\x21\x47\xe5\x7d\x6c\x92\xe5\xb1\x95\x61\x50\x79\xa3\x2b\x37\xc4\x7a\xf2\x26\x23\xa4\x85\x98" +
+	"\xcd\xcb\x8f\x2e\x78\xdd\x70\x99\x01\x34\x0a\x1a\x47\x7e\xe6\xd6\x18\xa9\x5e\xeb\x32\xc4\x8d\x5e\x29\xe5\x1b\x5d\x66\x21\x8d\x7f" +
+	"\x74\xc1\xef\x86\x8b\x8c\xa5\x09\x52\xbd\xce\xa8\x25\x36\x49\x26\x1d\xcd\xfb\xd3\x0a\x4e\xec\xd4\x47\x17\x8a\x6b\x80\xea\xbe\x98" +
+	"\x26\x2d\xc4\xcf\x66\x84\xe6\xef\xa5\xe9\x8b\x59\xd0\xca\xdb\x75\x99\xc5\x1c\x69\x21\x86\x37\xaf\x94\x56\xd0\x17\x0b\xa0\x95\xb7" +
+	"\xeb\xa2\x63\x91\xb4\x10\x47\x5c\x72\x14\x56\xd0\x17\xcb\x1f\x5d\x28\x59\x03\x74\x85\x14\x57\x2a\xf7\x59\xfd\xe8\x02\xee\x71\xfd" +
+	"\x0c\xa0\xd7\xa0\x26\xf0\xb3\x85\xde\x90\x1a\x8b\x87\x74\x39\xd5\xd7\x94\xca\x59\xed\x67\x21\xad\x83\x9a\xc0\xcf\x58\xda\x20\x35" +
+	"\x18\x4c\xce\x86\x74\xd3\x51\x89\xc3\x48\x6d\x0c\xc2\x02\x5b\xa0\x1a\x8f\x92\xa7\x16\xc5\x4d\x1f\x83\x1a\xc6\x09\xe4\xd3\xfc\xf1" +
+	"\x05\xc6\xa3\xbc\x6c\xac\x16\x50\x13\xf8\x99\x5b\xad\xa4\x7a\xad\x53\x4b\xd2\x2c\x6b\x53\xca\xac\x13\x66\x21\xb5\x83\x9a\xc0\xcf" +
+	"\x1e\xeb\x20\xd5\xeb\x8c\x0e\x95\xa5\x69\xa7\xa3\x12\xd9\x83\xb0\x4c\xb8\x0b\x54\xb2\x4e\xf4\x61\x5e\xf7\x45\x37\xa9\x61\x9c\x40" +
+	"\xfe\xf6\x08\xcd\x73\x67\xe8\x8b\x5e\xd0\x4a\x06\x90\xcc\xe2\x1a\x29\xae\xee\xb2\x1f\xb3\xec\xba\x52\x5a\x41\x5f\xdc\x00\xad\x64" +
+	"\x00\x89\x8e\x9b\xa4\xb8\x86\xca\x0c\xd2\xf4\x96\xa3\xb0\x82\xbe\xb8\x0d\xea\xac\xa0\x2f\xee\x90\x16\x32\xee\xee\x7e\x7c\x01\xd7" +
+	"\xac\x3c\x44\x95\x24\xc9\xbd\x8f\x2f\x94\xac\x86\x76\xff\xe3\x0b\x25\xab\xa1\x3d\x70\x14\x6b\x91\x70\x35\x88\x87\xa4\x79\x3e\xa2" +
+	"\xe4\xce\x3c\xfa\xf8\x42\xcd\x6a\x68\x69\xf6\x58\x29\xd7\x22\xe1\xea\x20\x4f\x48\xf3\x7c\x44\xc9\xbf\x79\xfa\xf1\x85\xaa\xd5\xd0" +
+	"\x24\x63\xa9\xef\xe3\x0b\x25\xab\xa1\x3d\xfb\xf8\x42\x71\x35\x34\xcd\x44\x7a\x0e\xca\x15\x55\x64\xfb\x2c\xe9\x77\x94\x2b\xaa\x08" +
+	"\x4c\xb2\x6c\x80\xd4\xf8\x19\x40\x83\x1f\x5f\xf0\xd7\x38\x41\xb6\xd0\x0b\xa5\xb4\x22\xc5\xea\x20\x43\xa4\xc6\xcf\x42\x1a\x06\x35" +
+	"\x91\x9f\xb1\x34\xf2\xf1\x05\xaf\x5e\x8f\xbe\x78\x49\x6a\x50\x02\x2d\x9b\x64\xa3\xa0\x92\x1b\x9a\xe6\x2b\x9f\x8c\x39\x2a\xf5\x7a" +
+	"\xa2\x10\xbe\x78\x45\x6a\xfc\x6c\xac\x71\x50\x13\xf9\x99\x5b\x13\x4a\x65\x16\x29\x26\x21\x56\x4c\x92\x1a\x3f\x23\x6c\x0a\xd4\x44" +
+	"\x7e\xf6\xd8\xf4\xc7\x17\x4a\xea\xf5\x66\x48\xe3\xd4\x2b\xcd\x9b\x05\x8d\xf1\xac\xaf\x30\x9d\x73\x34\x2e\xd6\xeb\xcd\x93\xe6\xf9" +
+	"\x88\x92\xb0\xb3\x00\x5a\xc9\x47\x94\x6c\xa1\x45\xa5\x71\x55\xbd\xde\x12\x69\x9e\x8f\x28\xf9\x37\xcb\xa0\x31\x9e\x5b\x65\x7b\x9b" +
+	"\xae\x08\x8d\x69\x05\xf3\x22\x57\x49\x63\x40\xcd\x44\x7a\x0d\x1a\xa3\x5e\x2f\xd1\x7a\xbd\x37\x8e\xc2\x0a\xb9\xe0\xcb\x26\x6b\xa4" +
+	"\xb1\x9f\x01\xb4\x0e\xea\xac\x60\xb6\xd0\x86\x52\x5a\x91\xa0\x5e\x6f\x93\x34\xf6\xb3\x90\xb6\x40\xe3\xc0\xcf\x58\x6a\xfa\xe4\x82" +
+	"\xde\x67\x17\xeb\xf5\x9a\x49\xe5\xbb\x25\xe1\x6e\x6f\x01\x95\xac\xca\x24\xaf\xd7\x6b\x75\x34\x0e\x02\x98\x25\xe2\xb6\x8d\x34\xf6" +
+	"\xb3\xb1\xda\x41\xe3\xc0\xcf\xdc\xea\x50\x2a\xb3\x48\x30\x09\xb1\xa2\x93\x34\xf6\x33\xc2\xba\x40\xe3\xc0\xcf\x1e\xeb\xfe\xe4\x62" +
+	"\x50\x7b\x77\xd5\x43\x2a\xf1\x48\xb9\x2b\xd6\xc8\x68\x2f\xa8\xcd\x3c\x18\x5d\x73\x54\x32\x28\x02\x48\x4b\xfe\x03\x69\xde\xb9\x46" +
+	"\x7a\x0b\xdd\x00\xad\x74\xae\x91\x9e\x50\x37\x95\xca\x17\x1b\x15\x48\x28\xf7\x16\x69\xde\xb9\x46\xfa\x13\xdd\x06\xb5\x88\x65\xcb" +
+	"\xf6\x41\x74\x47\xa8\xa5\x15\xcc\x68\xb9\x4b\x6a\x01\x25\x69\x23\xb8\x07\xea\xac\x08\xd0\x17\xea\xbe\xa3\xb0\x42\x61\x14\x04\x0f" +
+	"\x48\xad\xdf\x21\xe9\x21\xa8\xcd\xfc\x6e\x4a\x8f\x94\xd2\x0a\xf1\x89\xe8\x78\x4c\x6a\xa1\x56\x75\x3c\x01\x75\x56\x20\x1e\x15\x3d" +
+	"\xfd\xe4\xa2\x8b\x54\xe7\x39\x39\xf2\xe9\x23\xb5\x48\x92\x91\x4d\x82\x67\xa0\x36\x43\x0b\x2b\x81\xd1\x73\x47\x25\xb3\x48\x14\xc2" +
+	"\x17\xfd\xa4\xd6\xef\x56\x35\x00\x6a\x33\xbf\xb3\xd5\xa0\x52\x99\x45\x8c\x49\x88\x15\x2f\x48\xad\xdf\x31\x6b\x08\xd4\x66\x7e\x77" +
+	"\xad\xe1\x4f\x2e\x22\x1e\xc5\x88\x9a\xfc\x8c\x7c\x72\xb1\x64\xe5\xeb\x97\x9f\x5c\x2c\x59\xf9\x7a\xd4\xd1\xac\x58\xaf\x37\x46\x9a" +
+	"\x3f\xb7\x48\x2f\xa4\x57\xa0\x95\x3e\x23\xd2\x91\x69\x5c\x29\xd7\x74\x63\x8d\xd8\x04\x69\xfe\xdc\x22\xbd\x90\x26\x41\x2b\x7d\x46" +
+	"\xa4\x17\xd2\xd4\x27\x17\x4b\x56\xbe\x9e\xfe\xe4\x62\x71\xe5\x6b\x79\x14\x8d\x66\x40\x33\xd4\xeb\x05\x5a\xaf\x37\xeb\x28\x57\xa6" +
+	"\x13\x68\xa2\x68\x8e\x34\x83\xa8\x3c\x8a\x06\xf3\xa0\x58\x99\x0e\x1d\x9d\x16\x94\xd2\x8a\x00\xf5\x7a\x8b\xa4\x99\xdf\x5d\x6b\x09" +
+	"\xd4\x59\x81\x8c\x25\xb3\xfc\xc9\x45\x2f\x1e\x45\x5f\xac\x90\x66\x78\x34\x95\x4d\xa2\x55\x50\x89\x47\x05\x79\xbd\xde\x6b\x47\x25" +
+	"\x1e\x25\x0a\xe1\x8b\x37\xa4\x99\xdf\x5d\x6b\x0d\x34\x4b\xfc\xee\x5a\xeb\x4a\x65\x16\x01\x26\x21\x56\x6c\x90\x66\x7e\x77\xad\x4d" +
+	"\xd0\x2c\xf1\xbb\x6b\x6d\x7d\x72\x11\xdf\x4b\x7e\x87\xa4\xa6\x4f\x2f\xa2\x5e\xcf\xef\x90\xd4\x4c\x6a\x8d\x17\x8f\x6a\x51\x2a\x17" +
+	"\x5e\xbf\x43\x52\x2b\xa8\x4d\xfd\x0e\x49\x6d\xa4\x16\x83\xa9\x8e\x76\x47\xb5\x5e\x4f\xc2\xae\xf2\x8f\x38\xe8\x00\xd5\x7a\x3d\xc3" +
+	"\x18\x48\x27\x69\x21\x1e\xd5\xf5\xe9\x45\xd6\xeb\x79\x1d\x92\xba\x41\x6d\xea\x77\x48\xea\x21\x95\xf3\x4d\x12\x41\x54\x4f\xaf\x52" +
+	"\xf6\xa2\x63\x87\xa4\x6b\xa0\x36\xf5\x3b\x24\x5d\x27\x95\x73\x5e\x15\x8b\x15\x37\x1c\x95\xc8\x34\x84\x65\xc2\x37\x41\x25\xb6\x1d" +
+	"\xc8\x58\xba\x2f\x6e\x91\x16\xe2\x51\xb7\x85\xe6\x1d\xf5\xe8\x8b\x3b\xa0\x95\xbe\x80\x32\x8b\xbb\xa4\x85\x78\xd4\x3d\xa5\xb4\x82" +
+	"\xbe\xb8\x0f\xea\xac\xa0\x2f\x1e\x90\x16\xe2\x51\x0f\x1d\x85\x15\xf4\xc5\x23\x50\x9b\xfa\xf1\xa8\xc7\x9f\x5e\x2c\x89\x47\x3d\xf9" +
+	"\xf4\x22\x32\x80\xfc\x0e\x49\x4f\x41\xd3\xc4\xef\xa6\xd4\x47\x9a\x46\x5e\xee\xcc\x33\xa5\xf2\xa6\x1b\xcd\x94\x24\x6b\xce\x3c\x07" +
+	"\x4d\x13\xbf\xa3\x53\x3f\x69\x8a\xc1\x64\x17\x9b\x01\x47\xb5\xe3\xb1\x0e\x26\xae\x8b\x07\x41\x25\x03\x48\xc2\xb4\xe8\x7f\xf6\xe9" +
+	"\xc5\x92\x35\x17\x86\x3e\xbd\xc8\x0c\x20\xaf\x5b\xd5\x30\x68\x9a\xf8\x9d\xad\x46\x48\x25\x63\x49\xca\x71\x55\xcf\x4b\xa5\xec\x1c" +
+	"\x26\x96\x88\x9e\xd1\x4f\x2f\xfa\xdd\x70\xd1\xd1\x69\x8c\x54\xce\x16\x55\x2c\x56\xbc\x72\x34\xef\xa1\x2d\xd8\xc4\xe3\x9f\x5e\x2c" +
+	"\xae\xb9\xa0\xfb\x62\x82\x34\xf5\xf3\xb9\x26\x85\xe6\xfd\xcf\xe8\x8b\x29\xd0\x4a\x17\x37\x99\xc5\x34\x29\xba\x9a\x63\xfd\x0b\xa5" +
+	"\xb4\x82\xbe\x98\xfd\xf4\x62\xed\x9a\x0b\xd1\xdc\xa7\x17\x4b\xd6\x5c\x98\x77\x14\x56\x60\xa7\xc7\x0b\x9f\x5e\x2c\x59\x73\x61\x91" +
+	"\xb4\xd0\xe1\x60\xe9\xd3\x8b\xf8\xfe\x40\xd3\x23\xb9\x93\x4f\x97\x3f\xbd\xe8\xaf\x72\x89\x6e\x4a\x2b\xa4\x26\xf3\xfa\xc8\xae\x2a" +
+	"\x65\xdf\x8e\x14\x3a\x5e\x83\x9a\xc8\xef\xe8\xf4\x86\xd4\x60\x30\xd5\xb1\xe6\x28\x56\x09\x44\x33\xdb\x74\x1d\x54\x9e\xc9\xe4\x81" +
+	"\xca\xe1\x0d\xd2\x3c\x0f\x44\x3e\x9b\x9f\x5e\x64\xbd\x9e\xd7\xad\x6a\x0b\xd4\x44\x7e\x67\xab\xa6\xdf\x81\xea\x91\x99\xa1\xa7\x6f" +
+	"\xb3\x52\xe1\x7e\xc7\xac\x16\x50\x13\xf9\xdd\xb5\x5a\x49\xf5\xa8\xd0\xa1\xd2\x24\x69\x73\xd4\x44\xe8\x4f\x2b\x38\x49\xdb\x41\x4d" +
+	"\x84\x94\x95\x4c\xfe\xd9\x41\x9a\xe7\xe4\xc8\xdf\x4e\xa1\x86\x56\xd0\x17\x5d\xa0\xce\x0a\xfa\xa2\x9b\xb4\xb0\xe6\x42\x8f\x52\x5a" +
+	"\x41\x5f\xf4\x82\x3a\x2b\xe8\x8b\x6b\xa4\x85\x35\x17\xae\x3b\x0a\x2b\xe8\x8b\x1b\xa0\xce\x0a\xfa\xe2\xe6\xef\x2e\x96\xac\xb9\x70" +
+	"\xeb\x77\x17\x4b\x6a\xae\x6e\x93\x1a\xbf\x3e\xeb\x0e\xa8\xc1\xf5\x56\xa1\xbd\xeb\xa8\x29\xd6\x7d\xdd\x23\x35\x7c\x26\x93\x5e\x48" +
+	"\xf7\x41\x4d\xfe\xfc\x26\xbd\x90\x1e\x28\x35\x55\xb5\x67\x0f\x49\x0d\x9f\x0b\xa5\x17\xd2\x23\x50\x93\x3f\x43\x4a\x2f\xa4\xc7\x42" +
+	"\x4d\x4d\xfd\x1b\xa9\x01\x94\x47\x9a\xf4\x29\xa8\x41\x5d\x5d\xa2\x75\x75\x7d\x8e\xc2\x0a\xd9\x6b\xb2\xc9\x33\x52\xe3\x77\xd7\x7a" +
+	"\x0e\xea\xac\x60\x47\xa7\x7e\xa5\xb4\x22\x41\x1d\xe0\x00\xa9\xf1\xbb\x6b\x0d\x82\x3a\x2b\x90\xb1\x64\x5f\xfc\xee\xa2\xb7\xe6\x02" +
+	"\x7d\x31\x44\x6a\xf0\x08\x29\x9b\xa4\xc3\xa0\xd2\x49\x39\xc9\x6b\x1c\x47\x1c\x45\x0f\x6d\xfa\xe2\x25\xa9\xf1\xbb\x6b\x8d\x82\x9a" +
+	"\xd8\xef\xae\x35\xa6\x34\xef\xa1\x0d\x5f\xbc\x22\x35\x7e\x77\xad\xf1\xdf\x5d\xf4\x3b\x29\xc3\x17\x13\xbf\xbb\x88\xa7\x26\xbf\x43" +
+	"\xd2\x24\x68\x14\xf8\x1d\x92\xa6\x48\x23\xeb\xc5\x2a\xa6\x95\xb2\x13\x0c\x3b\x24\xcd\x80\x46\x81\xdf\x21\x69\x96\x34\xc2\x60\xaa" +
+	"\x63\xce\x51\xc9\xdf\x71\x83\xe9\xcf\x3c\xa8\x64\x00\x49\xa8\x5a\x61\xb6\x40\x5a\x88\xd9\x2c\xfe\xee\x22\x33\x80\xbc\x0e\x49\x4b" +
+	"\xa0\x51\xe0\x77\x48\x5a\x26\x95\xeb\xaf\x64\x66\xa8\x9e\x15\xa5\xa2\xc3\xef\x90\xb4\x0a\x1a\x05\x7e\x87\xa4\xd7\xa4\x72\xfd\x95" +
+	"\xec\x10\xf9\x9d\xbd\x71\x34\x0a\x10\xe7\xb2\x1a\xe7\x5a\x03\x8d\x02\xc4\xc4\x24\x78\x9f\xad\x93\x16\xe2\x67\x1b\x42\x23\x5a\x41" +
+	"\x5f\x6c\x82\x3a\x2b\xe8\x8b\x2d\xd2\x42\x0c\xaf\xe9\x33\xa1\xb4\x82\xbe\x68\x06\x75\x56\xd0\x17\x2d\xa4\x85\x38\x62\xab\xa3\xb0" +
+	"\x82\xbe\x68\x03\x75\x56\xd0\x17\xed\xa4\x78\xb6\x73\x9f\x8e\xcf\x2e\xe2\xbe\x0a\x21\x4f\xfd\x74\x92\x16\xf2\xa3\xba\x40\x63\x3c" +
+	"\xeb\x2b\xcc\xba\x1d\x8d\x03\x3c\xa9\xcb\xf6\xd6\xf6\x90\xfa\xf9\x51\x69\x2f\x68\x8c\x27\x6a\xc1\xa9\xbd\xa6\x34\x46\x96\x17\xf3" +
+	"\xa3\xae\x93\x56\xf2\xa3\xd2\xcc\xde\x00\x8d\xf1\xdc\x2a\xdb\xdb\xec\xa6\xd0\x98\x56\x30\xbb\xe8\x16\x29\xf3\xa3\x52\xd9\xe0\x36" +
+	"\xa8\xb3\xc2\xa2\x2f\xd4\x1d\x47\x61\x85\xc2\xcc\xda\xbb\xa4\xb1\xdf\x21\xe9\x1e\x68\x1c\xf8\xdd\x94\xee\x2b\xa5\x15\x29\xf2\xa3" +
+	"\x1e\x90\xc6\x7e\x97\xa6\x87\xa0\xce\x0a\x44\x88\xb2\x47\x9f\x5d\xf4\xe2\x51\xf4\xc5\x63\x52\xb9\xfe\xa6\xdc\xed\x4f\x40\x25\x1e" +
+	"\x25\x89\x6e\x0a\xb3\xa7\x8e\x4a\x3c\x2a\xad\xe4\x47\xf5\x91\xc6\x7e\xb7\xaa\x67\xa0\x71\xe0\x77\xb6\x7a\xae\x54\x66\x91\x62\x12" +
+	"\x62\x45\x3f\x69\xec\x77\xcc\x1a\x00\x8d\x03\xbf\xbb\xd6\xe0\x67\xf5\x25\x15\x69\x2f\x3e\xab\x2f\xa9\x48\x1b\x22\xcd\x8c\x17\xab" +
+	"\x18\xfe\xac\xbe\xa4\x22\x6d\x04\xb4\x58\x91\xf6\x92\x34\xc3\x60\x72\x36\x04\xa3\x8e\xca\xdb\xea\xc0\xe4\xb1\x95\x31\x50\xa9\x48" +
+	"\x93\x87\x76\x85\xc1\x2b\xd2\x42\xcc\x66\xfc\xb3\xfa\x92\x8a\xb4\x89\xcf\xea\x4b\x2a\xd2\x26\x49\xf5\x5a\x67\x10\x37\x9a\xfa\xac" +
+	"\xbe\xa4\x22\x6d\xfa\xb3\xfa\x92\x8a\xb4\x19\x52\xbd\xce\x88\x62\xb1\x62\xd6\x51\x7d\xc7\xea\x84\x65\xc2\x73\xa0\xfa\x8e\x55\x86" +
+	"\x92\xc8\x44\x30\x4f\x5a\x88\x9f\x2d\x08\xcd\x68\x05\x7d\xb1\x08\xea\xea\xea\xe8\x8b\x25\xd2\x42\x0c\x6f\x59\x29\xad\xa0\x2f\x56" +
+	"\x40\x5d\x5d\x1d\x7d\xb1\x4a\x5a\x88\x23\xbe\x76\x14\x56\xd0\x17\x6f\x40\x9d\x15\xf4\xc5\xda\x67\xf5\x25\xb1\xcc\xf5\xcf\xea\x4b" +
+	"\x2a\xd2\x36\x3e\xab\x2f\xa9\x48\xdb\x24\xb5\x81\x97\x3b\xb3\xf5\x59\x7d\x49\x45\x5a\xd3\xc9\xfa\x92\x8a\xb4\x66\x52\x8b\xc1\x54" +
+	"\x47\x8b\xa3\x12\x47\x8c\x82\x3c\xd7\xa7\x15\x54\x22\x91\x92\x44\xa2\x30\x6a\x23\xcd\xeb\x92\xe4\xd3\x7e\xb2\xbe\xa4\x22\xad\x03" +
+	"\xb4\x58\x91\xd6\x49\xaa\x39\x39\x01\xf2\x98\xba\x4e\xd6\x97\x54\xa4\x75\x9f\xac\x2f\xa9\x48\xeb\x21\xd5\x9c\x1c\x1d\x2a\x8e\xa2" +
+	"\x5e\x47\x6d\x86\x95\xaf\x03\x5d\xf9\xfa\x1a\xa8\x65\x8e\x96\x64\xbf\x44\xd7\x49\xf3\x1a\x31\xf9\x7b\x43\xa8\xa5\x15\xf4\xc5\x4d" +
+	"\x50\x5b\xc9\x13\x0b\xe2\xe8\x16\x29\x56\x4f\x15\xe5\x71\x7c\x5b\x29\xad\xa0\x2f\xee\x80\x5a\x3f\x57\x2d\xb8\x4b\x5a\x58\xf9\xfa" +
+	"\x9e\xa3\xb0\x82\xbe\xb8\x0f\x6a\x0b\xf9\x72\x0f\x48\xb1\x12\xa8\xfb\x3c\x3c\x59\x5f\xd2\xcf\xfc\x11\xa9\x35\x5e\xeb\xf2\xc7\xa0" +
+	"\x16\x5d\x6a\xb1\xfe\x90\xa3\xb6\xd8\xcf\xfc\x29\xa9\x85\x98\xdc\xd7\x45\x7d\xa0\x36\x7f\x12\x96\xda\xb3\x67\x4a\x6d\x55\x3f\xf3" +
+	"\xe7\xa4\x96\x4f\xc2\x71\x64\xe2\x7e\x50\x9b\x3f\x09\x4b\xfd\xda\x80\xd0\xbc\x23\x19\x9f\xc8\x07\x49\x2d\xa0\x04\x37\xe2\x17\xa0" +
+	"\x16\xfd\xcc\x23\xed\x67\x3e\xe4\x28\xac\x90\xbd\x26\x9b\x0c\x93\x16\xaa\x19\x47\x40\x8b\xd5\x8c\x2f\x95\xd2\x8a\x08\xfd\xcc\x47" +
+	"\x4f\xd6\x97\x54\x33\x8e\x9d\xac\x2f\xa9\x66\x7c\x75\xb2\xde\x5b\xf9\x9a\xbe\x18\x27\xb5\x08\x76\xc8\x74\xe3\x09\x50\xc9\x1e\x93" +
+	"\x90\x06\xea\xdf\x4e\xd6\x7b\x2b\x5f\xd3\x17\x53\xa4\x85\x6a\xc6\xe9\x93\xf5\x25\xd5\x8c\x33\x4a\x2d\xf2\x06\xe9\x8b\xd9\x93\xf5" +
+	"\x25\xd5\x8c\x73\x27\xeb\x4b\xaa\x19\xe7\x4f\xd6\x97\x54\xa4\x2d\x9c\xac\x2f\xa9\x48\x5b\x24\x4d\x63\xaf\x7f\xd4\xd2\xc9\xfa\x92" +
+	"\x8a\xb4\xe5\x93\xf5\x25\x15\x69\x2b\xa4\x29\x06\x53\x1d\xab\x8e\x62\x05\x2e\xf6\x2c\x7a\x0d\xaa\xf1\xa8\x98\x3d\x8b\xde\x90\xe6" +
+	"\xf9\xbf\xf2\x59\x3b\x59\x5f\x52\x91\xb6\x7e\xb2\xbe\xa4\x22\x6d\x83\x54\xbe\xd1\xc5\x5a\xd5\xb3\x79\xb2\xbe\xa4\x22\x6d\xeb\x64" +
+	"\x7d\x49\x45\x5a\xd3\xe7\xa0\xf2\x8d\x2e\xf1\x54\xf9\x6d\x9a\x1d\x4d\x93\x34\x80\xb0\x4c\xb8\x05\x34\x65\xff\x28\xdd\x17\xad\xa4" +
+	"\x79\xfe\xaf\xfc\x6d\x13\x9a\xd2\x0a\xfa\xa2\x1d\x34\xad\xf4\x8f\x8a\x03\xd3\x41\x9a\xfa\xfd\xa3\x3a\x95\xd2\x0a\xfa\xa2\x0b\x34" +
+	"\xf5\xfb\x47\xc5\xdd\xa4\x29\x3a\x42\xa9\x8e\x1e\x47\x61\x05\x7d\xd1\x0b\x9a\x16\xfa\x47\x5d\x23\x4d\xe9\x07\xf9\x5c\xff\xbc\xbe" +
+	"\xa4\x22\xed\xc6\xe7\xf5\x25\x15\x69\x37\x49\x8d\xf5\xe2\x51\xb7\x3e\xaf\x2f\xa9\x48\xbb\xfd\x79\x7d\x49\x45\xda\x1d\x52\x83\xc1" +
+	"\x54\xc7\x5d\x47\xa5\x9e\x4c\xde\x64\x22\x06\x72\x0f\x54\x2a\xd2\xe4\xa1\x1d\xeb\x0f\x91\x16\xe2\x51\x0f\x3e\xaf\x2f\xa9\x48\x7b" +
+	"\xf8\x79\x7d\x49\x45\xda\x23\x52\x3d\x32\x2d\xe2\x51\x8f\x3f\xaf\x2f\xa9\x48\x7b\xf2\x79\x7d\x49\x45\xda\x53\x52\x3d\x2a\x44\xb1" +
+	"\x58\xd1\xe7\x68\xbe\x5e\x92\xe0\x24\x7d\xf6\x79\x7d\x71\x0d\x50\xdd\x17\xcf\x49\x0b\xf1\xa8\x7e\xa1\xf9\xaa\x4f\xf4\xc5\x00\x68" +
+	"\x65\x95\x4b\xa9\x8b\x1b\x24\x2d\xc4\xa3\x5e\x28\xa5\x15\xf4\xc5\x10\x68\x65\x95\x4b\xd1\x31\x4c\x5a\x88\x47\x8d\x38\x0a\x2b\xe8" +
+	"\x8b\x97\x9f\xd7\x97\xac\x01\x3a\xfa\x79\x7d\x49\x3c\x6a\xec\xf3\x7a\x1c\x61\x7c\xb6\x90\x9f\x57\xa4\xc8\xf2\xd3\xdb\xc5\x74\x1c" +
+	"\xd4\xe0\x9e\x50\x61\x36\xe1\xa8\x74\x98\xf1\xf2\x6c\x26\x49\x91\x03\x29\xbf\xd3\x64\x0a\xd4\xe0\x8e\x59\xfe\x91\xa4\xd3\x4a\xe5" +
+	"\x24\xa1\x02\xb9\x71\x9e\x21\x45\x86\xa8\x6c\x9f\xa5\xb3\xa0\x06\xcf\x13\xb2\x7d\x9a\xcd\x09\x35\xb4\x82\xf9\x46\xf3\xa4\x06\x50" +
+	"\xb2\x54\xd2\x05\x50\x67\x45\x8a\x3a\xbc\x45\x47\x61\x85\xc2\x2c\x4d\x97\x48\x0b\xd5\x8c\xcb\x9f\xd7\x97\x54\x33\xae\x28\xa5\x15" +
+	"\x09\xf2\xae\x56\x3f\xaf\x2f\xa9\x66\x7c\xfd\x79\x7d\x49\x35\xe3\x9b\xcf\xeb\xf5\x8a\x53\xa9\x11\x93\x9f\x35\x52\x83\x26\x42\x32" +
+	"\xdd\x74\x1d\x54\xb2\xc7\x92\x3c\xa7\x6c\xc3\x51\xe9\x59\x94\x78\xf9\x67\x9f\xd7\x97\x54\x33\x6e\x7d\x5e\x5f\x52\xcd\xd8\x74\x4a" +
+	"\xa8\xcc\x22\xc1\x24\x64\x26\xcd\xa7\xea\x4b\xaa\x19\x5b\x4e\xd5\x97\x54\x33\xb6\x9e\xaa\x2f\xa9\x48\x6b\x3b\x55\x5f\x52\x91\xd6" +
+	"\x4e\x1a\xa5\x78\x48\x97\x1b\xdc\x8e\x53\xf5\x25\x15\x69\x9d\xa7\xea\x4b\x2a\xd2\xba\x48\x23\x0c\x26\xf7\x80\x59\xb7\xa3\x12\x87" +
+	"\x91\x2c\x33\x84\x05\x7a\x40\x35\x1e\x95\xb2\x8f\x77\x2f\x69\xa1\xe7\xf7\xb5\x53\xf5\x25\x15\x69\xd7\x4f\xd5\x97\x54\xa4\xdd\x20" +
+	"\xd5\x3b\xfc\x14\x7d\xc7\x6f\x9e\xaa\x2f\xa9\x48\xbb\x75\xaa\xbe\xa4\x22\xed\x36\xa9\xde\x5d\x8b\x62\xb1\xe2\x8e\xa3\x12\xd9\x83" +
+	"\xb0\x4c\xf8\x2e\xa8\x74\x89\x96\x67\x71\xa9\x69\xb3\xd9\x3d\xd2\x62\xff\x75\xa1\x11\xad\xa0\x2f\x1e\x80\x46\x79\xc4\x45\x74\x3c" +
+	"\x24\x2d\xf4\x80\x7f\xa4\x94\x56\xd0\x17\x8f\x41\xa3\x3c\xe2\x22\x3a\x9e\x90\x16\xfa\xd0\x3f\x75\x14\x56\xd0\x17\x7d\xa0\xce\x0a" +
+	"\xfa\xe2\x19\x29\xee\xcf\xd1\x7f\xfd\x54\x7d\x49\x45\x5a\xff\xa9\xfa\x92\x8a\xb4\x01\xd2\x38\x43\x32\xab\x7c\x39\x0c\x9e\xaa\x2f" +
+	"\xa9\x48\x7b\x71\xaa\xbe\xa4\x22\x6d\x88\x34\xc6\x60\xf2\x85\x66\x87\x1d\xd5\x4c\xd4\x2c\xef\x85\x34\x02\x2a\x15\x69\x12\xf7\xd4" +
+	"\xaf\x32\xfb\x92\x34\xcf\xa7\x95\xcf\xe8\xa9\xfa\x92\x8a\xb4\xb1\x53\xf5\x25\x15\x69\xaf\x48\xe5\x1b\x5d\xac\x55\x3d\xe3\xa7\xea" +
+	"\x4b\x2a\xd2\x26\x4e\xd5\x97\x54\xa4\x4d\x92\xca\x37\xba\xc4\x53\xe5\xb7\x9d\x72\x54\x72\x7a\x21\x2c\x13\x9e\x06\xd5\xdc\x66\x19" +
+	"\x4b\xf7\xc5\x0c\x69\x9e\xdb\x2c\x7f\x67\x85\xc6\xb4\x82\xbe\x98\x03\x8d\xd1\xd9\x57\x54\x27\x76\x9e\x14\xf7\xe7\x32\x83\x24\x59" +
+	"\x50\x4a\x2b\xe8\x8b\x45\xd0\x18\x7d\x8f\xe5\xb7\xcd\x96\x48\xf1\xf4\x22\x33\xb0\x76\xd9\x51\x58\x41\x5f\xac\x80\xc6\xe8\x0a\xed" +
+	"\xf0\x2a\x69\x61\xcd\x85\xd7\xa7\x1a\x4a\x3a\x76\xbf\x39\xd5\x50\xd2\xb1\x7b\x8d\x34\xf3\x6b\xb9\xd6\x4f\x35\x94\x74\xec\xde\x38" +
+	"\xd5\x50\xd2\xb1\x7b\x93\x34\xc3\x60\x7a\x4f\xba\xe5\xa8\xc4\x61\xbc\xda\xb3\xa6\x2f\x1a\x2a\xf1\xa8\xbc\x4e\xad\x99\x34\xef\x89" +
+	"\x2a\x9f\x96\x2f\x1a\x4a\x3a\x76\xb7\x82\x66\xa9\x5f\x2b\xd7\x46\x9a\xf9\x75\x75\xed\x4a\xab\x3b\x76\x77\x7c\xd1\x50\xd2\xb1\xbb" +
+	"\x93\x54\x62\x78\x79\x6d\x5f\x97\xa3\xa8\xbf\x60\x1d\x60\x37\x68\x96\xfa\x35\x83\x3d\xa4\x79\x7f\x5a\xf9\xdb\x2b\x34\xaf\x22\xa1" +
+	"\x2f\xae\x81\x66\xf9\xdd\xb6\xe8\xb8\x4e\x9a\xf9\x35\x8e\x37\x94\xd2\x0a\xfa\xe2\x26\x68\x96\xdf\xf1\x8b\x8e\x5b\xa4\x6a\x05\x7d" +
+	"\x71\xdb\x51\x58\x41\x5f\xdc\x01\x75\x56\xd0\x17\x77\x49\x33\xfa\x41\x3e\xf7\xbe\x68\x28\xe9\xd8\x7d\xff\x8b\x86\x92\x8e\xdd\x0f" +
+	"\x48\xad\x5f\xcb\xf5\x50\x69\x75\xc7\xee\x47\x5f\x34\x94\x74\xec\x7e\x4c\x6a\x31\x98\xea\x78\xe2\xa8\x54\x78\x79\xb5\x67\x4f\x41" +
+	"\x6d\xe2\xd7\xa9\xf5\x91\x16\xe2\x51\xcf\xbe\x68\x28\xe9\xd8\xfd\x1c\xb4\xd8\xb1\xbb\x9f\xd4\xfa\x75\x75\x03\x4a\x45\x87\x5f\x83" +
+	"\x37\xf8\x45\x43\x49\xc7\xee\x17\xa4\xd6\xaf\xed\x1b\x72\x54\x9e\xd1\x21\x2c\x06\x0e\x83\xda\xc4\xaf\x19\x1c\x21\x2d\xc4\xa3\x5e" +
+	"\x0a\xcd\x2b\xdd\xe8\x8b\x51\x50\x8b\xfa\x10\x51\x1d\x47\x63\xa4\x85\x78\xd4\x2b\xa5\xb4\x82\xbe\x18\x07\xb5\x89\x17\x8f\x32\x13" +
+	"\xa4\x85\x78\xd4\xa4\xa3\xb0\x82\xbe\x98\x02\xb5\xa8\x75\x70\x78\xfa\x8b\x86\x92\x78\xd4\xcc\x17\x0d\x58\x0f\x1b\x0f\x7f\xfa\x99" +
+	"\x25\xc5\xfb\x15\x39\xbb\x83\x78\x0e\xd4\xa6\x1e\x0c\xe6\x1d\x45\x1d\x2b\xf3\x6c\x16\x48\xf1\x16\x43\x7e\xc7\xd1\x22\xa8\x45\x2f" +
+	"\x58\xf9\x47\x14\x2f\x29\x65\x1d\x2b\x73\x7d\x96\x49\xf1\xae\x40\xb6\x0f\xe2\x15\x50\x9b\xfa\x35\x6d\xab\x42\x2d\xad\x60\xbe\xd1" +
+	"\x6b\x52\x0b\xa8\xb5\x72\x6f\x40\x6d\xea\xd7\xd5\xad\x39\x0a\x2b\x58\x83\xb7\xfe\x45\x43\x49\xb7\xf7\x8d\x2f\x1a\x4a\xba\xbd\x6f" +
+	"\x2a\xa5\x15\xac\x03\xdc\xfa\xa2\xa1\xa4\xdb\x7b\xd3\xe9\x86\x92\x6e\xef\xcd\xa7\x1b\x62\x9b\x67\x87\xd0\x17\x2d\xa4\xd6\xaf\x5b" +
+	"\x6c\x05\x95\xec\xb1\x28\xcf\x29\x6b\x73\x54\x56\x32\xf5\xea\x21\xdb\x49\xad\x5f\x3b\xd9\x01\xea\xfa\xa4\xd3\x17\x9d\x4a\x65\x16" +
+	"\x7e\x4d\x66\x17\xa9\xf5\xeb\x37\xbb\x4f\x37\x94\x74\x7b\xef\x39\xdd\xa0\xcf\xe1\x49\xc2\x28\xba\x7c\x7a\x49\x51\x93\x20\x67\x77" +
+	"\x64\xae\x81\x66\x89\x07\xa3\xeb\x8e\xa2\x8e\x95\x75\x5f\x37\x48\x91\xf9\x2f\xbf\x4d\x70\x13\x34\x43\xfe\x8b\xfc\x23\x30\xb7\x94" +
+	"\xb2\x8e\x95\xb5\x67\xb7\x49\x91\x5f\x2f\xdb\x47\xe6\x0e\x68\x86\x2c\x13\xd9\xde\x44\x77\x85\x66\xb4\x22\xaf\x7f\x23\xcd\x00\x25" +
+	"\x55\xd1\xdc\x07\xcd\x12\xbf\xae\xee\x81\xa3\xb0\x82\x35\x78\x0f\x49\x33\x88\x4a\xfe\x62\xf0\xe8\x74\x43\x49\xb7\xf7\xc7\x4a\x69" +
+	"\x05\xeb\x00\x9f\x90\x66\x50\xab\x3a\x9e\x9e\x6e\x28\xe9\xf6\xde\x77\xba\xc1\xe5\x09\xe5\x99\xa8\xf2\x79\x46\x9a\x21\x35\x54\xa6" +
+	"\x6b\x9e\x83\x66\x89\x5f\xe3\xd8\xef\xa8\x64\xa2\x7a\xf5\x90\x03\xa7\x1b\x4a\xba\xbd\x0f\x82\x16\xbb\xbd\xbf\x50\x9a\xb1\x7f\x14" +
+	"\x7c\x31\x44\x9a\xf9\xf5\x9b\xc3\xa7\x1b\x4a\xba\xbd\x8f\x9c\x6e\xc0\xb1\x94\xdf\xac\x4b\xfd\x1b\x69\xa1\x7f\xd4\x28\xa8\xc1\xf5" +
+	"\x56\xa1\x1d\x73\xd4\x44\xb8\xba\x8b\x86\x24\x79\x45\xea\xf7\x8f\xca\xc6\x41\x4d\x7e\x97\x29\xf5\x64\x13\x4a\x0d\xba\x37\xb0\xf6" +
+	"\x6c\x92\xb4\xd2\x3f\x2a\xb3\xc9\x14\xa8\xc9\xef\x32\xa5\xa6\x6d\x5a\x68\xbe\x76\x15\xef\x76\x67\x48\xd9\x3f\x4a\x6b\xe5\x66\x41" +
+	"\x4d\xe4\xd7\xd5\xcd\x39\x0a\x2b\x58\x83\x37\x4f\x6a\x20\x2a\x0f\x0e\xd9\xc2\xe9\x86\x92\x6e\xef\x8b\x4a\x69\x05\xeb\x00\x97\x4e" +
+	"\x37\x94\x74\x7b\x5f\x3e\xdd\x50\xd2\xed\x7d\xe5\x74\x43\x62\xf2\x77\xf9\xf4\xc5\x2a\xa9\xf1\xeb\x16\x5f\x83\x9a\xc8\xaf\x71\x7c" +
+	"\xe3\x28\x2a\x7a\xe8\x8b\xb5\xd3\x0d\x25\xdd\xde\xd7\x4f\x37\x94\x74\x7b\xdf\x50\xca\x8a\x1e\xfa\x62\xf3\x74\x43\x49\xb7\xf7\xad" +
+	"\xd3\x0d\x25\xdd\xde\x9b\xbe\x6c\x28\x89\x47\x35\x93\xc6\x0c\x10\xc9\x0d\x63\x0b\x68\x5c\x88\x47\xb5\x3a\x1a\x17\xe3\x51\x6d\xa4" +
+	"\x31\xc4\x24\xe2\x61\xdb\x41\x63\x2f\x1e\x65\xd3\x0e\xa5\x71\x55\x3c\xaa\x93\x34\xf6\xeb\xd4\xba\x40\xe3\x3c\x1e\x25\x35\x6d\xdd" +
+	"\x42\xf3\xdc\x03\x46\x73\x7a\x48\x63\x40\xad\x95\xeb\x05\x8d\x11\x8f\xb2\x1a\x8f\xba\xe6\x28\xac\x60\x0d\xde\x75\xd2\x18\xa2\x12" +
+	"\x57\xb0\x37\xbe\x6c\x28\xe9\xf6\x7e\x53\x29\xad\x60\x1d\xe0\x2d\xd2\x18\x6a\x55\xc7\xed\x2f\x1b\x4a\xba\xbd\xdf\xf9\xb2\x21\x8d" +
+	"\xf3\xba\x24\xfa\xe2\x2e\x69\xec\xd7\x2d\xde\x03\x75\x15\x3d\xf4\xc5\x7d\x47\x51\xd1\x43\x5f\x3c\x20\x8d\xfd\xda\xc9\x87\x5f\x36" +
+	"\x94\x74\x7b\x7f\xa4\x94\x15\x3d\xf4\xc5\x63\xd2\xd8\xaf\xdf\x7c\xf2\x65\x43\x49\xb7\xf7\xa7\x5f\x36\x94\x74\xec\xee\xfb\xb2\xa1" +
+	"\xa4\x63\xf7\x33\x52\xe3\xd7\x72\x3d\x57\xca\xaa\x43\xd6\x7d\xf5\x7f\xd9\x50\xd2\xb1\x7b\x80\xd4\x60\x30\xd5\x31\xe8\x28\xaa\x0e" +
+	"\x91\x7c\x93\xbc\x00\x35\x81\x5f\xa7\x36\x44\x9a\xaf\x56\x20\x9f\xe1\x2f\x1b\x4a\x3a\x76\x8f\x7c\xd9\x50\xd2\xb1\xfb\x25\xa9\xf1" +
+	"\xeb\xea\x46\xbf\x6c\x28\xe9\xd8\x3d\x06\x5a\xec\xd8\xfd\x8a\xd4\xf8\xb5\x7d\xe3\x8e\x6a\x2f\xaf\x4a\x1d\xe0\x04\xa8\x61\x7e\x94" +
+	"\xee\x8b\x49\x52\xe3\xe7\x47\x4d\x09\x35\xb4\x22\xaf\x7f\x03\x35\x95\xfc\xa8\x34\xc9\x66\x48\xf1\xdd\x27\xfb\x31\x49\x66\x95\xd2" +
+	"\x0a\xfa\x62\x0e\xd4\xf8\xf9\x51\xe9\x3c\x29\xee\x0c\xdc\x4c\x16\x1c\x85\x15\xf4\xc5\x22\xa8\x29\xe4\x47\x2d\x91\xe2\xbe\xc9\x7d" +
+	"\x96\xbf\x6c\xc0\x93\x85\x5f\x73\xb5\xf2\x65\x43\x49\xc7\xee\x55\xd2\xd8\xaf\xe5\x7a\xad\x94\x95\xd1\xac\xfb\x7a\xf3\x65\x43\x49" +
+	"\xc7\xee\x35\xd2\x18\x83\xa9\x8e\x75\x47\x51\x19\xcd\xda\xb3\x0d\xd0\x38\xf2\xeb\xd4\x36\x49\x63\x4c\x41\x7f\xb6\xbe\x6c\x28\xe9" +
+	"\xd8\xdd\xf4\x55\x43\x49\xc7\xee\x66\xd2\xd8\xaf\xab\x6b\xf9\xaa\xa1\xa4\x63\x77\xeb\x57\x0d\x25\x1d\xbb\xdb\x48\x63\xbf\xb6\xaf" +
+	"\xdd\xd1\x38\x8a\xfd\x3a\xc0\x0e\xd0\x38\x42\x5a\xa6\xee\x8b\x4e\xd2\x3c\x5b\x53\xfe\x76\x09\x8d\x69\x05\x7d\xd1\x0d\xea\xac\xa0" +
+	"\x2f\x7a\x48\x71\x7f\x2e\x38\x4d\x7b\x95\xd2\x0a\xfa\xe2\x1a\x68\xec\xaf\x75\x98\x5c\x27\xc5\xd3\x8b\xcc\xc0\xda\x1b\x8e\xc2\x0a" +
+	"\xfa\xe2\x26\x68\x5c\x58\x6f\xf1\x16\x29\x9e\xed\xdc\xe7\xf6\x57\x97\x4a\x62\x0a\x77\x48\x0b\xfd\xa3\xee\x82\xda\x42\xac\xe2\x9e" +
+	"\xa3\xb6\x18\xd7\xb8\x4f\xea\xf7\x8f\x8a\x1e\x80\x5a\x2f\x5e\x12\x05\x0f\x95\xda\xaa\xd8\xca\x23\x52\x9b\xc7\x61\x22\x13\x3c\x06" +
+	"\xb5\x79\xcc\x46\x72\x7d\x9e\x08\xb5\xd5\xf1\x9d\xa7\xa4\xec\x1f\xa5\x99\x53\x7d\xa0\x16\x71\xa3\x48\xe3\x46\xcf\x1c\x85\x15\xec" +
+	"\x67\xfe\x9c\xd4\xfa\xd9\x63\xfd\xa0\x36\xf3\xb3\xc7\x06\x94\xd2\x0a\xf6\x33\x1f\x24\xb5\x7e\xf6\xd8\x0b\x50\x9b\xf9\xd9\x63\x43" +
+	"\x5f\x5d\xf2\xea\xf5\xe8\x8b\x61\x52\xeb\xf7\x33\x1f\x01\xb5\x99\xdf\xcf\xfc\xa5\xa3\x52\xaf\xe7\xf5\x33\x1f\x25\xd5\x8c\x25\xfa" +
+	"\x62\x0c\xd4\x66\x7e\xf6\xd8\x2b\xa5\xec\xa1\x4d\x5f\x8c\x93\x5a\x3f\x7b\x6c\x02\xd4\x66\x7e\xf6\xd8\xe4\x57\x97\x70\x8f\xeb\x67" +
+	"\x00\x4d\x81\xda\xc4\xcf\x16\x9a\x26\xb5\x7e\xaf\xeb\x19\xa5\xcc\x74\x60\x16\xd2\x2c\xa8\x44\x51\x2a\x19\x4b\x73\xa4\x16\x83\xa9" +
+	"\x8e\x79\x47\xd1\xf1\x98\xbd\xb9\x17\x40\x35\x1e\x95\xf7\xf1\x5e\x24\xb5\xcc\x55\x93\xcf\xd2\x57\x97\x18\x8f\xf2\xb2\xb1\x96\x41" +
+	"\x6d\xe2\x67\x6e\xad\x90\x5a\xbf\xef\xf8\xaa\x52\xd1\xe1\x67\x84\xbd\x06\xb5\x89\x9f\x3d\xf6\x86\xd4\xfa\xbd\xcf\xd7\x1c\xd5\x3e" +
+	"\x39\x95\x3e\xe9\xeb\xa0\x36\xf1\x7b\xaa\x6f\x90\x5a\x66\xdb\xc9\xdf\x4d\xa1\x96\x56\xd0\x17\x5b\xa0\xce\x0a\xfa\xa2\xe9\xf7\xa0" +
+	"\x58\x09\x54\x70\x10\x34\x2b\xa5\x15\xf4\x45\x0b\xa8\xb3\x82\xbe\x68\x25\xc5\x3a\xa9\x32\x83\x28\x6a\x73\x14\x56\xd0\x17\xed\xa0" +
+	"\xce\x0a\xfa\xa2\x83\xb4\x50\x5d\xd5\xf9\xfb\x4b\x88\xa1\xf3\x69\x55\x7e\xba\x48\x0b\xeb\xa9\x75\x83\xa6\x89\x07\xa3\x1e\x47\x53" +
+	"\xc9\xa8\x81\x74\x14\xc7\xbd\xa4\xfe\x9a\x6e\xe6\x1a\x68\x9a\x47\xeb\x24\xe7\xe9\xba\xd2\x14\xab\x04\xb2\x37\xf7\x0d\xd2\xca\xba" +
+	"\x72\x26\x8a\x6f\x82\xa6\x79\xb4\x4e\xf2\xa6\x6e\x09\x4d\x69\x05\xa3\x86\xb7\x49\xb9\xb6\x9d\x04\x60\xe3\x3b\xa0\x69\xe2\xf7\x1d" +
+	"\xbf\xeb\x28\xac\x60\x8f\xf2\x7b\xa4\xa9\x9f\x01\x74\x1f\xd4\x59\x21\xe9\x06\x62\xc5\x03\xa5\xb4\x82\x7d\xd2\x1f\x92\xa6\x50\xab" +
+	"\x3a\x1e\x81\xa6\x89\x9f\xb1\xf4\xf8\xf7\x97\xe2\x34\xcf\x8f\xa2\x2f\x9e\x90\xa6\x08\xc8\xca\x26\xf1\x53\xd0\x34\xf1\x7b\xc0\xf7" +
+	"\x39\x8a\x1e\xda\xf4\xc5\x33\xd2\xd4\xcf\xc6\x7a\x0e\x9a\x26\x7e\xe6\x56\xbf\x52\xf6\xd0\xa6\x2f\x06\x48\x53\x3f\x23\x6c\x10\x34" +
+	"\x4d\xfc\xec\xb1\x17\xbf\xbf\x84\x5c\x04\xc6\x3c\xe5\x67\x88\x34\xf3\xeb\xb3\x86\x41\xb3\x42\x2d\xd7\x88\xa3\x59\xb1\xee\xeb\x25" +
+	"\x69\x06\x31\xc9\xbc\x08\x46\x41\x33\xaf\x9e\x2c\x30\x63\x4a\xb3\xaa\xda\xb3\x57\xa4\x19\xb3\xf3\x24\xd7\x67\x1c\x34\xcb\x6b\xda" +
+	"\x24\xd7\x67\x42\x68\x56\x53\xff\x46\x9a\x01\x6a\xe6\xd4\x14\x68\x86\xba\xba\x40\xeb\xea\xa6\x1d\x85\x15\xec\x67\x3e\x43\x9a\x41" +
+	"\x54\xf2\xa9\x82\x59\xd0\x2c\xf5\xb3\xc7\xe6\x94\xd2\x0a\xf6\x33\x9f\x27\xcd\xfc\xec\xb1\x05\x50\x67\x05\x33\x96\x16\x7f\x7f\xc9" +
+	"\x64\x79\xee\x17\x7d\xb1\x44\x9a\xf9\xfd\xcc\x97\x41\xb3\xd4\xef\x67\xbe\xe2\x28\xd6\x22\xa1\x2f\x56\x49\x33\x3f\x7b\xec\x35\x68" +
+	"\x96\xfa\xd9\x63\x6f\x94\x72\x2d\x12\xfa\x62\x8d\x34\xf3\xb3\xc7\xd6\x41\xb3\xd4\xcf\x1e\xdb\xf8\xfd\xa5\x92\x78\xd4\x26\x69\xcc" +
+	"\x00\x91\x5c\x8c\xb7\x40\xe3\x42\x3c\xaa\xe9\xcc\x25\x74\xe2\x07\x94\x5f\x49\x33\x69\xfe\xd6\x53\x72\x7d\x5a\x40\x2b\x6f\x3d\x25" +
+	"\xd7\xa7\x55\x69\x5c\x15\x8f\x6a\x23\xcd\xdf\x7a\x4a\xae\x4f\x3b\x68\xe5\xad\xa7\xe4\xfa\x74\x08\x8d\x69\x05\xa3\x39\x9d\xa4\x31" +
+	"\xa0\xdc\x45\x27\x5d\xa0\x31\xe2\x51\xa9\xc6\xa3\xba\x1d\x85\x15\xec\x67\xde\x43\x1a\xfb\xd9\x63\xbd\xa0\xce\x0a\x66\x2c\x5d\x53" +
+	"\x4a\x2b\x52\xc4\xa3\xae\x93\xc6\x7e\xf6\xd8\x0d\xd0\x38\xf2\xb3\xc7\x6e\x9e\xb9\x94\xc4\x35\xf1\xa8\x5b\xa4\xb1\xdf\xcf\xfc\x36" +
+	"\x68\x1c\xf9\xfd\xcc\xef\x38\x2a\xeb\x25\xa5\x95\x78\xd4\x5d\xd2\xd8\xcf\x1e\xbb\x07\x1a\x47\x7e\xf6\xd8\x7d\xa5\x32\x8b\x14\x93" +
+	"\x10\x2b\x1e\x90\xc6\x7e\xf6\xd8\x43\xd0\x38\xf2\xb3\xc7\x1e\x9d\xb9\x84\x7a\xbd\xfc\xf1\x2f\x49\x92\xc7\xa4\x58\x4d\x41\x66\x92" +
+	"\xa4\x4f\x40\x0d\xbe\xa3\x14\x26\x4f\x1d\x45\x57\x76\xf6\xc5\xee\x23\xcd\xb3\xc7\x24\x61\xe7\x19\x68\x25\x7b\x4c\x72\x9e\x9e\x2b" +
+	"\x95\xf3\x81\x0a\xc4\x49\xfd\xa4\x79\xf6\x98\xe4\x42\x0d\x80\x56\xb2\xc7\x24\x6f\x6a\x50\xa8\xa1\x15\xcc\x62\x7b\x41\x6a\x00\x35" +
+	"\x13\x69\x08\xd4\xc4\x7e\xdf\xf1\x61\x47\x61\x05\x7b\x94\x8f\x90\x1a\x3f\x03\xe8\x25\xa8\x89\xfd\x6c\xa1\x51\xa5\xb4\x82\x7d\xd2" +
+	"\xc7\x48\x8d\x9f\x85\xf4\x0a\xd4\x59\x81\x08\x51\x32\x7e\xe6\x92\x57\xaf\x47\x5f\x4c\x90\x1a\xbf\xaf\xfb\x24\xa8\xd4\xeb\x49\x6c" +
+	"\x45\x61\x32\xe5\xa8\xd4\xeb\x79\xfd\xe2\xa7\x49\x8d\x9f\x8d\x35\x03\x6a\x62\x3f\x73\x6b\x56\xa9\xe1\x1a\x8a\xf0\xc5\x1c\xa9\xf1" +
+	"\x33\xc2\xe6\x41\x4d\xec\x67\x8f\x2d\x9c\xb9\x84\xa7\x26\x3f\x03\x68\x11\xd4\x04\x7e\xb6\xd0\x12\xa9\xf1\x7b\x5d\x2f\x2b\x95\x2b" +
+	"\xa7\x9f\x85\xb4\x02\x6a\x02\x3f\x63\x69\x95\xd4\x60\x30\xd9\x49\xd9\x6b\x47\x25\x0e\xe3\xf5\xe6\x7e\x03\xaa\xf1\xa8\xbc\x8f\xf7" +
+	"\x1a\xa9\x61\x2c\x53\x3e\xeb\x67\x2e\x31\x1e\xe5\x65\x63\x6d\x80\x9a\xc0\xcf\xdc\xda\x24\x35\x7e\xdf\xf1\x2d\xa5\xcc\x8c\x63\x16" +
+	"\x52\xd3\x1f\x2e\x21\x1e\xe5\x67\x8f\x35\x93\x1a\xbf\xf7\x79\x8b\xa3\xe8\xf3\xcf\x3e\xe9\xad\xa0\x26\xf0\x7b\xaa\xb7\x91\xe6\x7d" +
+	"\xd5\xe4\x6f\xbb\xd0\x3c\xbf\x8f\xbe\xe8\x00\xad\x64\x29\x8a\x8e\x4e\x52\x7c\xf7\xc9\x7e\x4c\xd3\x2e\xa5\xb4\x82\xbe\xe8\x06\xad" +
+	"\x64\x29\x8a\x8e\x1e\x52\xdc\x19\xc8\x0c\xb2\xac\xd7\x51\x58\x41\x5f\x5c\x03\x35\x58\xa5\xcc\xe1\xeb\xa4\x85\x1c\xce\x1b\x7f\xb8" +
+	"\x54\x52\xaf\x77\x93\x14\xab\x52\xc9\xbe\xcc\xec\x2d\xd0\xa8\x50\xaf\x77\xdb\xd1\xa8\x58\xaf\x77\x87\x34\xcf\x1e\x93\x5c\x9f\xbb" +
+	"\xa0\x95\xec\x31\xc9\xf5\xb9\xa7\x34\xaa\xaa\xd7\xbb\x4f\x9a\x67\x8f\x49\xae\xcf\x03\xd0\x4a\xf6\x98\xe4\xfa\x3c\x14\x1a\xd1\x0a" +
+	"\x66\xb1\x3d\x22\x8d\x00\x35\x73\xea\x31\x68\x84\x7a\xbd\x44\xeb\xf5\x9e\x38\x0a\x2b\xd8\xcf\xfc\x29\x69\xe4\x67\x8f\xf5\x81\x46" +
+	"\x81\x9f\x3d\xf6\x4c\x29\xad\x60\x3f\xf3\xe7\xa4\x91\x9f\x3d\xd6\x0f\xea\xac\x60\xc6\xd2\xc0\x1f\x2e\xd9\xa8\xa6\x5e\x6f\x90\x34" +
+	"\xf2\xfb\x99\xbf\x00\x8d\x02\xbf\x9f\xf9\x90\xa3\x91\xeb\x1f\x45\x5f\x0c\x93\x46\x7e\xf6\xd8\x08\x68\x14\xf8\xd9\x63\x2f\x95\xca" +
+	"\x2c\xfc\x7e\xe6\xa3\xa4\x91\x9f\x3d\x36\x06\x1a\x05\x7e\xf6\xd8\xab\x3f\x34\x96\x44\x5c\xc6\xff\xd0\x58\x12\x71\x99\x20\xb5\x7e" +
+	"\xbd\xde\xe4\x1f\x1a\x4b\x22\x2e\x53\x7f\x68\x2c\x89\xb8\x4c\x93\x5a\x0c\xa6\xcf\xcd\x33\x8e\x4a\xbc\xc4\xab\xd7\x9b\x05\xb5\x99" +
+	"\x5f\xaf\x37\x47\x9a\x3f\x77\xcb\x67\xfe\x0f\x8d\x25\x11\x97\x85\x3f\x34\x96\x44\x5c\x16\x49\xad\x5f\xaf\xb7\xf4\x87\xc6\x92\x88" +
+	"\xcb\xf2\x1f\x1a\x4b\x22\x2e\x2b\xa4\xd6\xaf\xd7\x5b\x75\x14\x35\x62\xac\xd7\x7b\x0d\x6a\x33\xbf\x5e\xef\x0d\x69\x1e\x03\x91\xbf" +
+	"\x6b\x42\xf3\x4a\x37\xfa\x62\x1d\xb4\xb2\x82\x9c\xe8\xd8\x20\xb5\x2c\xb6\x13\x5f\x6c\x2a\xa5\x15\xf4\xc5\x16\x68\x65\x05\x39\xd1" +
+	"\xd1\x74\x16\xd4\xa2\x02\x4f\x75\x34\x3b\x0a\x2b\xe8\x8b\x16\x50\x8b\xac\x4f\x87\x5b\x49\x0b\x6b\x57\xb5\x9d\x6d\x44\x8d\x31\x9f" +
+	"\x2d\xe4\xa7\x9d\x14\x51\x55\xbd\x39\x8d\x3a\x40\x6d\xa1\x9f\x79\xa7\xa3\xa8\xe8\x61\x9e\x4d\x17\x29\x62\xce\xf2\x3b\x0a\xba\x41" +
+	"\x2b\x2b\x7b\x49\x6c\xa5\x47\x29\x2b\x7a\x98\xeb\xd3\x4b\x8a\x88\xbc\x6c\x1f\x47\xd7\x40\x2b\x2b\x7b\x49\x6c\xe5\xba\x50\xeb\x3f" +
+	"\x77\xcb\x2c\x6e\x90\x5a\x40\x8d\x54\xdd\x04\xb5\xe8\x67\x1e\x68\x3f\xf3\x5b\x8e\xc2\x0a\xd6\xe0\xdd\x26\x2d\x44\xeb\xee\x9c\x6d" +
+	"\x2c\x89\xd6\xdd\x55\x4a\x2b\x58\x07\x78\xef\x6c\x63\x49\xb4\xee\xfe\xd9\xc6\x92\x68\xdd\x83\xb3\x8d\x91\xcd\x23\x39\xf4\xc5\x43" +
+	"\x52\xeb\xd7\x2d\x3e\x02\xb5\x89\x5f\xe3\xf8\xd8\x51\xeb\xfa\x47\xd1\x17\x4f\xce\x36\x96\x44\xeb\x9e\x9e\x6d\x2c\x89\xd6\xf5\x29" +
+	"\xb5\xec\x1f\x05\x5f\x3c\x3b\xdb\x58\x12\xad\x7b\x7e\xb6\xb1\x24\x5a\xd7\x7f\xb6\xb1\x24\xe2\x32\x70\xb6\xb1\x24\xe2\x32\x48\x9a" +
+	"\xfa\xb5\x5c\x2f\xce\x36\x96\x44\x5c\x86\xce\x36\x96\x44\x5c\x86\x49\x53\x0c\xa6\x0f\xa8\x23\x8e\x4a\xbc\xc4\xab\x3d\x7b\x09\x2a" +
+	"\x11\x97\x4a\x9d\xda\x28\x69\x1e\x27\x90\xcf\xd8\xd9\xc6\x92\x88\xcb\xab\xb3\x8d\x25\x11\x97\x71\xd2\xd4\xaf\xab\x9b\x38\xdb\x58" +
+	"\x12\x71\x99\x3c\xdb\x58\x12\x71\x99\x22\x4d\xfd\xda\xbe\x69\x47\x51\x23\xc6\x3a\xc0\x19\xd0\x34\xf1\x6b\x06\x67\x49\xf3\x38\x81" +
+	"\xfc\x9d\x13\x9a\xd2\x0a\xfa\x62\x1e\xb4\xb2\x82\x9c\xe8\x58\x20\x4d\xfd\x1a\xc7\x45\xa5\xb4\x82\xbe\x58\x02\xad\xac\x20\x27\x3a" +
+	"\x96\x49\x53\xbf\xce\x72\xc5\x51\x58\x41\x5f\xac\x82\xa6\xa8\x9e\x71\xf8\x35\x69\x61\xbd\x8e\x37\x67\x1b\x4b\x22\x2e\x6b\x67\x1b" +
+	"\x4b\x22\x2e\xeb\xa4\x99\x5f\xaf\xb7\x71\xb6\xb1\x24\xe2\xb2\x79\xb6\xb1\x24\xe2\xb2\x45\x9a\x61\x30\xf9\x1a\x30\x4d\x5f\x37\xe6" +
+	"\xfd\xcc\x2b\xf5\x7a\xcd\xa0\x12\x71\xa9\xd4\xeb\xb5\x90\xe6\xef\x5b\xe4\xd3\xfa\x75\x63\x49\xc4\xa5\xed\xeb\xc6\x92\x88\x4b\x3b" +
+	"\x69\xe6\xd7\xeb\x75\x7c\xdd\x58\x12\x71\xe9\xfc\xba\xb1\x24\xe2\xd2\x45\x9a\xf9\xf5\x7a\xdd\x8e\xa2\xd6\x9e\xf5\x7a\x3d\xa0\x59" +
+	"\xea\xd7\xeb\xf5\x92\xe6\xef\x5b\xe4\xef\x35\xa1\x79\xc7\x00\xfa\xe2\x3a\x68\x65\x25\x5e\xd1\x71\x83\x14\xeb\x5f\xcb\x7e\x0c\x82" +
+	"\x9b\x4a\x69\x05\x7d\x71\x0b\x34\x43\x5d\x8f\xfc\x98\xf8\x36\x29\x56\x07\x97\x19\x18\x73\xc7\x51\x58\x41\x5f\xdc\x05\xcd\x50\xf5" +
+	"\x84\xf7\x6f\xa4\x58\xab\xca\x7d\xee\x7f\xdd\x58\x12\x71\x79\xf0\x75\x63\x49\xc4\xe5\x21\x69\xec\xd7\xeb\x3d\xfa\xba\xb1\x24\xe2" +
+	"\xf2\xf8\xeb\xc6\x92\x88\xcb\x13\xd2\x18\x83\xa9\x8e\xa7\x8e\x22\x5b\x9e\x35\x62\x7d\xa0\x71\xe4\xd7\xeb\x3d\x23\xcd\xe3\x04\xf2" +
+	"\x79\xfe\x75\x63\x49\xc4\xa5\xff\xeb\xc6\x92\x88\xcb\x00\x69\xec\xd7\xeb\x0d\x7e\xdd\x58\x12\x71\x79\xf1\x75\x63\x49\xc4\x65\x88" +
+	"\x34\xf6\xeb\xf5\x86\x1d\xd5\x77\xac\x95\x7a\xbd\x11\xd0\x98\xfd\xa3\x74\x5f\xbc\x24\xcd\xe3\x04\xf2\x77\x54\x68\x4c\x2b\xe8\x8b" +
+	"\x31\xd0\xb8\xd2\x3f\xca\xa6\xc9\x2b\x52\x5c\xdd\x65\x3f\xa6\xe9\xb8\x52\x5a\x41\x5f\x4c\x80\xc6\x7e\xff\x28\x3b\x49\x8a\x6b\xa8" +
+	"\xcc\x20\x49\xa6\x1c\x85\x15\xf4\xc5\x34\x68\x5c\xe8\x1f\x35\x43\x5a\x78\x53\x3c\xfb\x75\x63\x49\xc4\x65\xee\xeb\xc6\x92\x88\xcb" +
+	"\x3c\xa9\xf1\x6b\xb9\x16\xbe\x6e\x2c\x89\xb8\x2c\x7e\xdd\x58\x12\x71\x59\x22\x35\x18\x4c\x75\x2c\x3b\x8a\xaa\x43\xe6\xfa\xac\x80" +
+	"\x9a\xd8\xaf\x53\x5b\x25\x2d\xf4\x33\x7f\xfd\x75\x63\x49\xc4\xe5\xcd\xd7\x8d\x25\x11\x97\x35\x52\xe3\xd7\xd5\xad\x7f\xdd\x58\x12" +
+	"\x71\xd9\xf8\xba\xb1\x24\xe2\xb2\x49\x6a\xfc\xda\xbe\x2d\x47\x4d\x6c\xfc\x3a\xc0\xa6\x73\x8d\xf8\x56\x41\x88\x40\xf7\x45\x33\x69" +
+	"\x1e\x03\x91\xbf\x2d\x42\x0d\xad\xa0\x2f\x5a\x41\x5d\xdc\x88\xbe\x68\x23\x2d\xf4\x33\x6f\x57\x4a\x2b\xe8\x8b\x0e\x50\xe3\xe7\xaa" +
+	"\x25\x9d\xa4\x85\x7e\xe6\x5d\x8e\xc2\x0a\xfa\xa2\x1b\xd4\x14\xf2\xe5\x7a\xce\x35\x96\xf4\x33\xef\x3d\xd7\x88\x6b\x16\x63\xb4\xf2" +
+	"\x73\x8d\xd4\xf8\xf5\x59\xd7\x41\x0d\x9e\x32\x14\xda\x1b\x8e\x9a\x00\xcf\x34\xb2\x7d\x96\xdd\x24\x35\x7c\xca\x97\xd8\xca\x2d\x50" +
+	"\x83\x67\x30\xf9\x57\x9a\xdd\x56\x2a\x97\x5e\x2a\x90\x47\xb1\x3b\xa4\x86\x91\x06\x89\xad\xdc\x05\x35\x78\x42\x95\xed\x33\x7b\x4f" +
+	"\xa8\xf1\x9f\xbb\x65\x16\xf7\x49\x0d\xa0\x46\xaa\x1e\x80\x9a\xc0\xaf\xab\x7b\xe8\x28\xac\x60\x0d\xde\x23\xd2\x42\xb4\xee\xf1\xb9" +
+	"\xc6\x92\x68\xdd\x13\xa5\xb4\x82\x75\x80\x4f\xcf\x35\x96\x44\xeb\xfa\xce\x35\x96\x44\xeb\x9e\x9d\x6b\xd4\xef\xb0\x4a\x0c\x44\x7e" +
+	"\x9e\x93\x1a\xbf\x6e\xb1\x1f\xd4\x04\x7e\x8d\xe3\x80\xa3\xa8\x63\xa5\x2f\x06\xcf\x35\x96\x44\xeb\x5e\x9c\x6b\x2c\x89\xd6\x0d\x29" +
+	"\x65\x1d\x2b\x7d\x31\x7c\xae\xb1\x24\x5a\x37\x72\xae\xb1\x24\x5a\xf7\xf2\x5c\x63\x49\xc4\x65\xf4\x5c\x63\x49\xc4\x65\x8c\x34\xf2" +
+	"\xeb\xf5\x5e\x9d\x6b\x2c\x89\xb8\x8c\x9f\x6b\x2c\x89\xb8\x4c\x90\x46\x18\x4c\x75\x4c\x3a\x8a\xaa\xc3\x04\xaf\x57\xa6\x40\x25\xe2" +
+	"\x52\xa9\xd7\x9b\x26\xcd\xdf\xb7\xc8\x67\xe6\x5c\x63\x49\xc4\x65\xf6\x5c\x63\x49\xc4\x65\x8e\x34\xf2\xeb\xf5\xe6\xcf\x35\x96\x44" +
+	"\x5c\x16\xce\x35\x96\x44\x5c\x16\x49\x23\xbf\x5e\x6f\xc9\x51\xed\xe5\x55\xa9\xd7\x5b\x06\x8d\x02\xbf\x5e\x6f\x85\x34\x7f\xdf\x22" +
+	"\x7f\x57\x85\x46\xb4\x82\xbe\x78\x0d\xea\xe2\x46\xf4\xc5\x1b\x52\x3c\xf1\x09\x4e\x92\x35\xa5\xb4\x82\xbe\x58\x07\x75\x71\x23\xfa" +
+	"\x62\x83\x14\xcf\xc3\x32\x03\x6b\x37\x1d\x85\x15\xf4\xc5\x16\x68\x14\xf8\xf5\x7a\x4d\x7f\x6c\xb4\xb5\x1d\xc9\x9a\xff\x78\x39\x48" +
+	"\xf3\xfe\xb4\xec\x49\xdd\x02\x6a\x53\xbf\x7f\x75\x2b\xa9\xf5\x7b\x5d\xb7\x29\x65\x7f\x5a\xf6\xc5\x6e\x07\xb5\xa9\xdf\x43\xbb\x83" +
+	"\xd4\x62\x30\xd5\xd1\xe9\x28\xfa\xd3\x32\x06\xd2\x05\x2a\x35\x2b\x95\x3e\xde\xdd\xa4\x85\x78\x54\xcf\x1f\x2f\x73\x05\x39\xaf\x3f" +
+	"\x78\x2f\xa8\x4d\xfd\x5e\xe2\xd7\x48\xad\xdf\x77\xfc\xba\x52\xd1\xe1\xf7\x28\xbf\x01\x6a\x53\xbf\x9f\xf9\x4d\x52\xeb\xf7\x3e\xbf" +
+	"\xe5\xa8\x4d\x11\x8f\x8a\x35\x1e\x75\x1b\xd4\xb2\x7f\x94\x24\xfc\x04\x77\x48\x0b\xf1\xa8\xbb\x42\x2d\xad\xa0\x2f\xee\x81\xda\x4a" +
+	"\xff\xa8\x38\x0a\xee\x93\x16\xe2\x51\x0f\x94\xd2\x0a\xfa\xe2\x21\xa8\xf5\xfb\x47\xc5\x8f\x48\x0b\xf1\xa8\xc7\x8e\xc2\x0a\xfa\xe2" +
+	"\x09\xa8\x2d\xf4\x8f\x7a\xfa\xc7\xcb\x25\xf1\xa8\xbe\x3f\x5e\x8e\x6a\x33\xee\x9e\x91\x5a\x36\x18\x97\xf4\xfd\xe7\xa0\x36\xf3\xa0" +
+	"\xe9\x77\x54\x72\xd5\x22\x48\x9b\x28\x1a\x20\xb5\xac\x59\x91\x4e\xe0\x83\xa0\x36\xaf\x6f\x91\x1e\xd8\x2f\x94\xca\x97\x15\x15\xc8" +
+	"\x3f\x87\x48\x2d\xeb\x66\xa4\x9f\xf9\x30\xa8\xcd\xa8\x36\x88\x22\x33\x22\x34\xcf\xb8\x63\x3d\xcc\x4b\x52\x0b\x28\x25\x1f\xd1\x28" +
+	"\xa8\xcd\xfc\x7e\xe6\x63\x8e\xc2\x0a\xf6\x33\x7f\x45\x6a\x21\x2a\xa1\xd6\x60\x1c\xd4\x59\xc1\x7e\xe6\x13\x4a\x69\x05\xfb\x99\x4f" +
+	"\x92\x5a\xbf\x9f\xf9\x14\xa8\xb3\x02\xf1\x28\x33\xfd\xc7\xcb\x91\xcd\x3b\x7c\xd1\x17\x33\xa4\x16\x25\x36\xb2\x49\x34\x0b\x6a\x33" +
+	"\xbf\x9f\xf9\x9c\xa3\xe8\xf3\x4f\x5f\xcc\x93\x5a\xbf\x9f\xf9\x02\xa8\xcd\xfc\x7e\xe6\x8b\x4a\x2d\x3a\x9d\xd1\x17\x4b\xa4\xd6\xef" +
+	"\x67\xbe\x0c\x6a\x33\xbf\x9f\xf9\xca\x1f\x2f\xe3\xc9\xc2\xef\x67\xbe\x0a\x9a\x26\x7e\x3f\xf3\xd7\xa4\xa9\xdf\xcf\xfc\x8d\x52\x76" +
+	"\x3c\x66\x0f\xed\x35\xd0\x34\xf1\xfb\x99\xaf\x93\xa6\x18\x4c\x75\x6c\x38\x8a\x8e\xc7\xac\x3d\xdb\x04\x4d\x13\xbf\x9f\xf9\x16\x69" +
+	"\xa1\xa6\xad\xe9\x9b\xcb\x8c\x47\x79\xfd\xcc\x9b\x41\xd3\xc4\xef\x67\xde\x42\x9a\xfa\xfd\xcc\x5b\x95\x8a\x0e\xbf\x9f\x79\x1b\x68" +
+	"\x9a\xa0\x45\xb9\x11\x2b\xda\x49\x53\xbf\x9f\x79\x87\xa3\xda\x27\xa7\xd2\xcf\xbc\x13\x34\xe5\xfa\x7a\x52\x83\x1c\x77\x91\x16\xea" +
+	"\x0b\xbb\x85\xa6\xb4\x82\xbe\xe8\x01\x4d\x2b\xeb\xeb\x99\x20\xee\x25\x45\xfd\x82\xec\xc7\x20\xb8\xa6\x94\x56\xd0\x17\xd7\x41\x9d" +
+	"\x15\xf4\xc5\x0d\xd2\x42\x9d\xe5\x4d\x47\x61\x05\x7d\x71\x0b\x34\x2d\xac\xaf\x77\x9b\x14\xb5\x2f\xee\x73\xe7\x9b\xcb\xa6\x36\x66" +
+	"\x7e\x97\xb4\x90\x1f\x75\x0f\x34\xc3\x5a\xfa\x0a\xe3\xfb\x8e\xca\xea\x99\x5e\x7e\xd4\x03\x52\x3f\x3f\x2a\x7a\x08\x9a\xe5\xd1\x3a" +
+	"\xe9\xb7\xfd\x48\x69\x86\x2c\x2f\xe6\xe4\x3c\x26\xad\xe4\x47\x45\xb1\x79\x02\x9a\xe5\xd1\x3a\xe9\xf9\xfd\x54\x68\xbe\x06\x28\xa3" +
+	"\x86\x7d\xa4\xcc\x8f\x8a\x64\x83\x67\xa0\x59\xe2\xf7\x1d\x7f\xee\x28\xac\x60\x8f\xf2\x7e\xd2\x0c\xa2\x51\x6c\x4c\x34\x00\x9a\x25" +
+	"\x7e\xef\xf3\x41\xa5\xb4\x82\x7d\xd2\x5f\x90\x66\x50\xab\x3a\x86\x40\x9d\x15\xa8\xa0\x8b\x87\xbf\xb9\xec\xd5\xeb\xd1\x17\x23\xa4" +
+	"\x19\x02\xb2\xb2\x89\x79\x09\x9a\x25\x7e\x0f\xf8\x51\x47\xb1\x72\x04\x7d\x31\x46\x9a\xf9\xbd\xe5\x5f\x81\x4a\xe5\xa3\x84\xa0\x05" +
+	"\x47\x66\x5c\x29\x57\x8e\xa0\x2f\x26\x48\x33\xbf\xbf\xfd\x24\xa8\x54\x5f\x46\xd8\xde\xc4\x53\xdf\x5c\x2e\x89\x29\x4c\x93\x9a\x42" +
+	"\xfc\x01\xd4\x14\x62\x15\xb3\x8e\x9a\x62\x5c\x63\x8e\xd4\x40\x4c\x42\x34\xd9\x3c\xa8\xf1\xe2\x25\x59\xb2\xa0\xd4\x54\xc5\x56\x16" +
+	"\x49\xd1\xef\x4c\xb6\x4f\x93\x25\x50\x93\xc7\x6c\xa4\xe7\xf7\xb2\x50\x53\x1d\xdf\x59\x21\x35\x80\xf2\xa4\x9f\xac\x82\x1a\xc4\x8d" +
+	"\x32\x8d\x1b\xbd\x76\x14\x56\xb0\x47\xf9\x1b\x52\x03\x51\x89\x2b\x64\x6b\xa0\x26\xf6\x7b\x9f\xaf\x2b\xa5\x15\x19\xe2\x5c\x1b\xa4" +
+	"\x06\x6a\x55\xc7\x26\xa8\x89\x0b\xfd\xd7\xbf\xb9\x9c\x98\x9a\x58\x5b\xd3\x9f\x40\x8d\xdf\xd7\xbd\x19\xd4\xc4\x7e\x0f\xf8\x16\x47" +
+	"\x25\x27\xc7\xeb\x17\xdf\x4a\xaa\xe7\x01\x7d\xd1\x06\x6a\x62\xbf\x0f\x7d\xbb\x52\xc3\x35\x14\xe1\x8b\x0e\x52\xe3\xf7\xb7\xef\x04" +
+	"\x35\x71\x86\xe1\xe4\x77\xd7\x9f\x2e\xe3\x9a\xc5\xa7\x55\xf9\xe9\x26\xc5\x3a\x0f\x7a\xbb\x98\xf6\x80\xc6\xb8\x27\x54\x68\x7b\x1d" +
+	"\x45\x7f\x5a\xf6\xd0\xbe\x46\x9a\xd7\x75\x48\x3f\xf2\xeb\xa0\x31\xee\x98\x05\x27\xe9\x0d\xa5\xec\x4f\xcb\x7e\xe6\x37\x49\xf3\xba" +
+	"\x0e\xe9\x67\x7e\x0b\x34\xc6\xf3\x84\x6c\x9f\xda\xdb\x42\x63\x5a\xc1\xfa\x92\x3b\xa4\x31\xa0\x94\xea\xa4\x77\x41\xe3\xc0\xef\x67" +
+	"\x7e\xcf\x51\x58\xc1\x7e\xe6\xf7\x49\x63\x88\x4a\xa8\x35\x79\x00\x1a\x07\x7e\x3f\xf3\x87\x4a\x69\x05\xfb\x99\x3f\x22\x8d\xa1\x56" +
+	"\x75\x3c\x06\x75\x56\x20\x7e\x66\x9f\xfc\xe9\xb2\x57\xaf\x47\x5f\x3c\x25\x8d\xfd\x7e\xe6\x7d\xa0\x71\xe0\xf7\x33\x7f\xe6\xa8\xd4" +
+	"\xeb\x79\xfd\xcc\x9f\x93\xc6\x7e\x3f\xf3\x7e\xd0\x38\xf0\xfb\x99\x0f\x28\x8d\xd9\x3f\x0a\xbe\x18\x24\x8d\xfd\x7e\xe6\x2f\x40\x63" +
+	"\xd7\x3f\x0a\xbe\x18\xfa\xd3\x65\xdc\xe3\xfa\xfd\xcc\x87\x41\x4d\xe4\xf7\x33\x1f\x21\x35\x7e\x3f\xf3\x97\x4a\xe5\xca\xe9\xf7\x33" +
+	"\x1f\x05\x35\x91\xdf\xcf\x7c\x8c\xd4\xf8\xfd\xcc\x5f\x39\x6a\x22\x83\xc1\xe4\x54\x4a\xc6\x41\x65\x05\xb9\x4a\x3f\xf3\x09\xd2\x42" +
+	"\xbd\xde\xe4\x9f\x2e\x73\x05\x39\xaf\x9f\xf9\x14\xa8\x89\xfc\x7e\xe6\xd3\xa4\xc6\xef\x67\x3e\xa3\x94\x2b\x47\xb0\x9f\xf9\x2c\xa8" +
+	"\x89\xfc\x7e\xe6\x73\xa4\xc6\xef\x67\x3e\xef\x28\x56\x8e\x60\x3f\xf3\x05\x50\x13\xf9\xfd\xcc\x17\x49\x0b\xf5\x7a\x4b\x42\x0d\xad" +
+	"\xa0\x2f\x96\x41\x0d\xd6\x05\x92\x19\x24\xd9\x0a\x29\xbe\xfb\xc4\x45\x49\xb2\xaa\x94\x56\xd0\x17\xaf\x41\x9d\x15\xf4\xc5\x1b\x52" +
+	"\xdc\x19\xb8\x99\xac\x39\x0a\x2b\xe8\x8b\x75\x50\x83\x35\xa5\x1c\xde\x20\xc5\x7d\x93\xfb\x6c\xfe\xe9\x32\x9e\x55\xfd\x9e\xd4\x5b" +
+	"\xa0\x51\xe0\xf7\xaf\x6e\xfa\x16\x34\xf2\x7b\x5d\x37\x2b\x95\xe7\x05\xbf\x2f\x76\x0b\x68\x14\xf8\x3d\xb4\x5b\x49\x23\x0c\x26\xbb" +
+	"\xd8\xb6\x39\x2a\x71\x18\xaf\x37\x77\x3b\xa8\xc6\xa3\x52\xe6\x05\x75\x90\xe6\xbd\xbc\xe4\xd3\xf9\xed\x65\xc6\xa3\xbc\xfe\xe0\x5d" +
+	"\xa0\x51\xe0\xf7\x12\xef\x26\x8d\xfc\xbe\xe3\x3d\x4a\xd9\xe7\x9f\x3d\xca\x7b\x41\xa3\xc0\xef\x67\x7e\x8d\x34\xf2\x7b\x9f\x5f\x77" +
+	"\x14\x7d\xfe\xd9\x27\xfd\x06\x68\x14\xf8\x3d\xd5\x6f\x92\xe6\x7d\xd5\xe4\xef\x2d\xa1\xf9\x6a\x05\xf4\xc5\x6d\xd0\x28\x8f\x0a\x8b" +
+	"\x8e\x3b\xa4\x78\xe2\x93\xfd\x98\x65\x77\x95\xd2\x0a\xfa\xe2\x1e\x68\x94\x47\x85\x45\xc7\x7d\x52\x3c\x0f\xcb\x0c\xac\x7d\xe0\x28" +
+	"\xac\xa0\x2f\x1e\x82\x3a\x2b\xe8\x8b\x47\xa4\x88\x16\xb8\xcf\xe3\x6f\xaf\xe0\xfb\xc3\xef\x90\xf4\x04\xd4\xa6\x7e\x37\xa5\xa7\xa4" +
+	"\x2e\x3f\x4a\x9f\x50\xe2\xb8\x4f\xa9\xf8\x00\xcd\x94\xe4\x51\x29\x78\x06\x6a\x53\xbf\xa3\xd3\x73\x52\x8b\xc1\x54\x47\xbf\xa3\x12" +
+	"\x87\x91\xb7\xa3\x78\x1a\x19\x00\xd5\x78\x94\x7c\xb1\x2b\x1e\x24\xcd\x57\x7d\x92\xcf\x8b\x6f\xaf\x30\x1e\xe5\x75\xab\x1a\x02\xb5" +
+	"\xa9\xdf\xd9\x6a\x98\x54\x7a\xd4\xeb\x43\xa4\x98\x32\xa2\x94\x3d\x54\xd8\xa5\xe9\x25\xa8\x4d\xfd\xee\x5a\xa3\xa4\x12\xc3\x53\xc5" +
+	"\x62\xc5\x98\xa3\x12\xd9\x83\xb0\x4c\xf8\x15\xa8\xf4\x50\x91\xef\x12\xd1\x10\x07\xe3\xa4\x96\xcf\x79\xf2\x77\x42\x68\xde\x09\x86" +
+	"\xbe\x98\x04\xad\xf4\xb3\x11\x1d\x53\xa4\x6a\x05\x7d\x31\xad\x94\x56\xd0\x17\x33\xa0\x95\x7e\x36\xa2\x63\x96\x54\xad\xa0\x2f\xe6" +
+	"\x1c\x85\x15\xf4\xc5\x3c\xa8\xb3\x82\xbe\x58\x20\x2d\x74\xfb\x59\xfc\xf6\x0a\xae\x85\x68\x7a\x24\x99\x1c\x66\x09\x34\x4b\xfc\x6e" +
+	"\x4a\xcb\xa4\x59\xec\xc5\x2a\x56\x94\xca\x35\xd6\xef\xd2\xb4\x0a\x9a\x25\x7e\x47\xa7\xd7\xa4\x19\x06\x93\xeb\x6b\xf4\xc6\x51\xe9" +
+	"\x80\x12\xc5\x79\x6c\x65\x0d\x54\x7a\xa8\xc8\x23\x96\xc2\x68\x9d\xb4\x10\xb3\xd9\xf8\xf6\x0a\x57\x90\xf3\xba\x55\x6d\x82\x66\x89" +
+	"\xdf\xd9\x6a\x8b\x54\xbf\x3d\x63\xc4\x8d\x9a\xfe\x7c\x05\x2b\xc8\xf9\x1d\xb3\x9a\x41\xb3\xc4\xef\xae\xd5\x42\xaa\xdf\x5c\x3a\x94" +
+	"\x89\xa2\x56\x47\xe5\x89\x0a\xc2\x32\xe1\x36\x50\x79\x26\x93\xd2\x28\xd1\x60\xa2\x76\xd2\x42\xfc\xac\x43\x68\xde\x09\x86\xbe\xe8" +
+	"\x04\xad\xf4\xb3\x11\x1d\x5d\xa4\x85\x18\x5e\xb7\x52\x5a\x41\x5f\xf4\x80\x56\xfa\xd9\x88\x8e\x5e\xd2\x42\x1c\xf1\x9a\xa3\xb0\x82" +
+	"\xbe\xb8\x0e\x9a\x61\x6d\x22\x87\x6f\xfc\xf9\x4a\x49\x2c\xf3\xe6\x9f\xaf\x94\xd4\xeb\xdd\x22\xb5\x2c\xa0\x93\xbd\x79\x1b\xd4\x16" +
+	"\xea\xf5\xee\x38\x6a\x8b\xf5\x7a\x77\x49\xf3\xee\x5a\xd2\xd0\xe8\x1e\xa8\x45\xb6\x82\xe0\x20\xbe\xaf\xd4\x56\xd5\xeb\x3d\x20\xcd" +
+	"\xbb\x6b\x49\x7f\xa3\x87\xa0\x16\x39\x01\xb2\x7d\x1c\x3d\x12\x6a\x69\x05\xb3\xee\x1e\x93\x5a\x40\xa9\xd6\x8c\x9f\x80\x5a\xd4\xeb" +
+	"\x05\x5a\xaf\xf7\xd4\x51\x58\x21\xe7\xa1\x6c\xd2\x47\x6a\xfd\x0e\x49\xcf\x40\x6d\xe2\x77\x53\x7a\xae\x94\x56\xe8\x29\x1f\x47\x51" +
+	"\x3f\xa9\xf5\xbb\x34\x0d\x80\x3a\x2b\x10\x21\x8a\x06\xff\x7c\xc5\xeb\x67\x4e\x5f\xbc\x20\xb5\x48\xe4\x93\x4d\xe2\x21\x50\xc9\x1b" +
+	"\x0c\xf2\x7a\xbd\x61\x47\x25\x6f\x30\xa8\xd4\xeb\x8d\x90\x5a\xbf\x5b\xd5\x4b\x50\x9b\xf8\x9d\xad\x46\x95\xca\x2c\x02\x4c\x42\xf6" +
+	"\xc4\x18\xa9\xf5\x3b\x66\xbd\x02\xb5\x89\xdf\x5d\x6b\xfc\xcf\x57\x90\x77\x9e\x87\xa8\x82\x20\x98\x20\xc5\x3b\x60\xb9\xde\x07\x66" +
+	"\x12\x34\x2b\xf4\xba\x9e\x72\x34\x2b\xf6\xc5\x9e\x26\xc5\x9b\x56\xf9\x6d\xa2\x19\xd0\x4a\x07\x40\xe9\xa6\x34\xab\x34\xab\xea\xcd" +
+	"\x3d\x47\x8a\xf7\x99\xb2\x7d\x60\xe6\x41\x2b\x1d\x00\xa5\xa3\xd3\x82\xd0\x8c\x56\x30\xdf\x68\x91\x34\x03\x94\x0c\x5e\xb3\x04\x9a" +
+	"\xa1\xef\x78\xa4\x7d\xc7\x97\x1d\x85\x15\x72\x1e\xca\x26\x2b\xa4\x19\x44\x23\x99\xc5\x2a\x68\x96\xfa\xdd\x94\x5e\x2b\xa5\x15\x11" +
+	"\xf2\xae\xde\x90\x66\x50\xab\x3a\xd6\x40\x9d\x15\x88\x47\x05\xeb\x7f\xbe\xe2\xd5\xeb\xd1\x17\x1b\xa4\x19\x8a\xdf\x65\x13\xb3\x09" +
+	"\x2a\xd9\x63\x92\xda\xac\x30\xd8\x72\x54\xea\xf5\x22\x2f\xff\xec\x3b\x50\xb9\x1a\x45\xf4\x45\x33\x68\x96\xfa\x9d\xad\x5a\x94\x66" +
+	"\x5c\x43\x11\xbe\x68\x25\xcd\xfc\x8e\x59\x6d\xa0\x59\xea\x77\xd7\x6a\xff\xee\x0a\xae\x4e\xf9\xad\x55\x96\x65\x1d\xa4\x92\xbd\x20" +
+	"\x57\x67\xb9\x14\x27\x9d\xa0\x31\xae\xb7\x0a\xb3\x2e\x47\xe3\x08\x57\x77\xd1\x90\x24\xdd\xa4\x71\xfe\x78\x91\x24\xb6\x07\x34\xc6" +
+	"\xb7\x91\x60\x9b\xf4\x2a\x95\xf3\x81\x0a\xe4\x9f\xd7\x48\x63\x3e\xb7\x48\xaf\xa8\xeb\xa0\x71\xfe\xdc\x22\x1d\x9d\x6e\x08\x8d\x69" +
+	"\x05\x9f\x9f\x6e\x92\xc6\x80\xf2\x28\x9a\xdc\x02\x75\x56\x24\xe8\x0b\x75\xdb\x51\x58\xa1\x30\x4b\x92\x3b\xa4\xb1\xdf\x21\xe9\x2e" +
+	"\xa8\xb3\x82\xdd\x94\xee\x29\xa5\x15\x72\x96\x8b\x8e\xfb\xa4\x31\xd4\xaa\x8e\x07\xa0\xce\x0a\xe4\x73\x65\x0f\xbf\xbb\xe2\xd5\xeb" +
+	"\xd1\x17\x8f\x48\x63\x3c\x9a\xca\x26\xc9\x63\x50\xc9\x1e\xd3\x16\x56\x02\xb3\x27\x8e\x4a\xbd\x9e\x28\xc4\x53\xe4\x53\xd2\xd8\xef" +
+	"\x56\xd5\x07\x1a\x47\x7e\x67\xab\x67\x4a\x63\xbc\x81\xa6\x2f\x9e\x93\xc6\x7e\xc7\xac\x7e\xd0\x38\xf2\xbb\x6b\x0d\x7c\x77\xa5\x24" +
+	"\x1e\x35\x48\x5a\x58\x5f\xef\x05\xa8\x29\xc4\xa3\x86\x1c\x35\xc5\x78\xd4\x30\xa9\x81\x98\x44\x3c\xb2\x11\x50\xe3\xc5\xa3\xb2\xf4" +
+	"\xa5\x52\x53\x15\x8f\x1a\x25\xad\xac\xaf\x97\xd9\x74\x0c\xd4\xe0\x79\x42\xba\x31\xa5\xf6\x95\xd0\x3c\x9b\x85\xd1\x9c\x71\x52\xae" +
+	"\xaf\x27\xcf\x65\xe9\x04\xa8\x41\x3c\x2a\xd3\x78\xd4\xa4\xa3\xb0\x42\x4e\x47\xd9\x64\x8a\xd4\xf8\x1d\x92\xa6\x41\x9d\x15\x9a\x87" +
+	"\x64\xb3\x74\x46\x29\xad\xc8\x10\x8f\x9a\x25\x35\x7e\x97\xa6\x39\x50\x67\x05\x32\x96\xec\xfc\x77\x57\xbc\x7e\xe6\xf4\xc5\x02\xa9" +
+	"\x5e\xdd\xb9\xdb\x17\x41\xa5\xde\x29\xcb\xe3\x51\x4b\x8e\x4a\x3f\xf3\xac\x12\x8f\x5a\x26\x35\x7e\xb7\xaa\x15\x50\x13\xf8\x9d\xad" +
+	"\x56\x95\xca\x2c\x32\x4c\x42\xac\x78\x4d\x6a\xfc\x8e\x59\x6f\x40\x4d\xe0\x77\xd7\x5a\xfb\xee\x0a\xee\x03\xfd\x0e\x49\xeb\xa0\x26" +
+	"\xf2\xbb\x29\x6d\x90\x9a\xc4\xab\xd7\xdb\x54\xca\xfe\x67\xec\xd2\xb4\x05\x6a\x22\xbf\xa3\x53\xd3\x5f\x40\x0d\x06\x53\x1d\xcd\x8e" +
+	"\x4a\x1c\xc6\x0d\xa6\x3f\x2d\xa0\x1a\x8f\x4a\x58\x23\xd6\x4a\x9a\xe7\xaa\xc9\xa7\xed\x2f\x57\x18\x8f\xf2\xba\x55\xb5\x83\x9a\xc8" +
+	"\xef\x6c\xd5\x41\x2a\x7b\x47\x53\xf9\xc4\x94\x4e\xa5\xa2\xc3\xef\x98\xd5\x05\x6a\x22\xbf\xbb\x56\x37\xa9\x78\x48\x5b\x66\x89\x15" +
+	"\x3d\x8e\xea\xea\x99\x4e\x58\x26\xdc\x0b\x6a\x22\xa4\xd5\x69\x77\xab\x6b\xa4\x79\xde\xa0\xfc\xbd\x2e\xd4\xd0\x0a\xfa\xe2\x06\xa8" +
+	"\xb3\x82\xbe\xb8\x49\x8a\xef\x3e\xc1\x69\x7a\x4b\x29\xad\xa0\x2f\x6e\x83\x3a\x2b\xe8\x8b\x3b\xa4\xb8\x33\x90\x19\x64\xd9\x5d\x47" +
+	"\x61\x05\x7d\x71\x0f\xd4\x44\x7e\xbd\xde\xfd\xbf\x5c\x29\xa9\xd7\x7b\xf0\x97\x2b\x78\x56\xf5\x3b\x24\x3d\x04\x8d\x03\xbf\x9b\xd2" +
+	"\x23\xd2\x38\xf5\xf2\xa3\x1e\x2b\x65\xff\x33\x76\x69\x7a\x02\x1a\x07\x7e\x47\xa7\xa7\xa4\x31\x06\x53\x1d\x7d\x8e\x4a\x64\xda\x0d" +
+	"\xa6\x3f\xcf\x40\x25\xb6\x2d\xc9\xb8\x0a\xed\x73\xd2\x42\x3f\xf3\xfe\xbf\x5c\xe1\x0a\x72\x5e\xb7\xaa\x01\xd0\x38\xf0\x3b\x5b\x0d" +
+	"\x92\xca\x15\x5c\xe2\xbf\xaa\xe7\x85\x52\xd1\xe1\x77\xcc\x1a\x02\x8d\x03\xbf\xbb\xd6\x30\xa9\x7c\x8b\x48\x0c\x5a\x7e\xdb\x11\x47" +
+	"\xe3\x00\xfd\xcc\x53\xed\x67\xfe\x12\x34\x66\x7e\x94\x64\x11\xdb\x51\xd2\x42\x3f\xf3\x31\xa1\x31\xad\xa0\x2f\x5e\x81\xc6\x95\xfc" +
+	"\xa8\x34\xb1\xe3\xa4\x85\x7e\xe6\x13\x4a\x69\x05\x7d\x31\x09\x1a\xfb\xf9\x51\xe9\x14\x69\xa1\x9f\xf9\xb4\xa3\xb0\x82\xbe\x98\x01" +
+	"\x8d\x0b\xf9\x51\xb3\xa4\x78\xb6\x73\x9f\xb9\xbf\x5c\x45\xdc\xcb\xcf\x00\x9a\x07\xb5\x99\x9f\x2d\xb4\x40\x6a\x23\x2f\x1e\xb5\xa8" +
+	"\x94\x99\x0e\xcc\x42\x5a\x02\x75\x99\x0e\x88\x81\x44\xcb\xa4\x16\x83\xa9\x8e\x15\x47\xa5\x4e\xcd\x0d\xa6\x3f\xab\xa0\x5a\xaf\x17" +
+	"\x31\x06\xf2\x9a\xb4\x10\x8f\x7a\xf3\x97\xab\xac\xd7\xf3\xb2\xb1\xd6\x40\x6d\xe6\x67\x6e\xad\x93\xea\x91\x19\x21\x1e\xb5\xa1\x54" +
+	"\x74\xf8\x19\x61\x9b\xa0\x36\xf3\xb3\xc7\xb6\x48\xf5\xa8\x10\xc5\x62\x45\xd3\x5f\xaf\xa2\x5e\x2f\x86\xb0\x4c\xb8\x19\x54\xd7\x11" +
+	"\x93\xa1\x34\x7b\xab\x85\xb4\x10\x8f\x6a\x15\x6a\x69\x05\x7d\xd1\x06\xea\xac\xa0\x2f\xda\x49\x0b\xf1\xa8\x0e\xa5\xb4\x82\xbe\xe8" +
+	"\x04\xb5\x99\x17\x8f\x8a\xba\x48\x0b\xf1\xa8\x6e\x47\x61\x05\x7d\xd1\x03\x6a\x33\x3f\x1e\xd5\xfb\xd7\xab\x25\xf1\xa8\x6b\x7f\xbd" +
+	"\x8a\x7b\x5c\x3f\x03\xe8\x3a\x68\x96\xf8\x19\x40\x37\x48\x33\xe3\xf5\x8f\xba\xa9\x94\xef\xa5\x99\x01\x74\x0b\x34\x4b\xfc\x0c\xa0" +
+	"\xdb\xa4\x19\x06\x53\x1d\x77\x1c\x95\x38\x8c\x1b\x4c\x7f\xee\x82\x6a\x3c\xca\xb0\x67\xd1\x3d\xd2\x7c\xad\x43\xf9\xdc\xff\xeb\x55" +
+	"\xc6\xa3\xbc\x0c\xa0\x07\xa0\x59\xe2\x67\x00\x3d\x24\x95\xb3\x45\xf2\x9d\x54\xcf\x23\xa5\xa2\xc3\xcf\x00\x7a\x0c\x9a\x25\x7e\x06" +
+	"\xd0\x13\x52\x39\x5b\x54\xb1\x58\xf1\xd4\xd1\x2c\xd1\x1e\x61\x11\x0c\xec\x03\xcd\xd8\x3f\xca\xc8\x3f\x9f\x91\xe6\xeb\x4e\xca\xdf" +
+	"\xe7\x42\x33\x5a\x41\x5f\xf4\x83\x66\x95\xfe\x51\x26\x88\x06\x48\x33\xbf\x7f\xd4\xa0\x52\x5a\x41\x5f\xbc\x00\xcd\xfc\xfe\x51\x66" +
+	"\x88\x34\x43\x47\x28\xd5\x31\xec\x28\xac\xa0\x2f\x46\x40\xb3\x42\xff\xa8\x97\xa4\x85\x5a\x98\xd1\xbf\x5e\x45\xd6\x01\x23\x54\xf2" +
+	"\x33\x46\x5a\x58\x5f\xef\x15\xa8\x45\xce\xbc\x42\x33\xee\xa8\x2d\xd6\x7d\x4d\x90\xfa\xeb\xeb\x05\x93\xa0\xd6\xab\x27\x0b\xe2\x29" +
+	"\xa5\xb6\xaa\xf6\x6c\x9a\xb4\xb2\xbe\x5e\x60\xe2\x19\x50\x8b\x7a\x0b\xc9\xbd\x8a\xcd\xac\x50\x5b\x53\xff\x46\xca\xf5\xf5\x34\x73" +
+	"\x6a\x1e\xd4\xa2\xae\x2e\xd0\xba\xba\x05\x47\x61\x85\x9c\x87\xb2\xc9\x22\xa9\x85\xa8\x66\x2c\x2d\x81\x3a\x2b\x98\xb1\xb4\xac\x94" +
+	"\x56\x04\xa8\x03\x5c\x21\xb5\x7e\xf6\xd8\x2a\xa8\xb3\x82\x19\x4b\xaf\xff\x7a\xd5\xeb\x67\x4e\x5f\xbc\x21\x95\x2b\x41\xc0\xdd\xbe" +
+	"\x06\x2a\x51\xec\x20\xaf\x71\x5c\x77\x54\xfa\x99\x8b\x42\xf8\x62\x83\xd4\xfa\xd9\x63\x9b\xa0\x36\xf5\xb3\xc7\xb6\x94\xca\x2c\x02" +
+	"\x4c\x22\x8e\x8d\x69\xfa\x1b\xa8\xf5\xb3\xc7\x9a\x41\x6d\xea\x67\x8f\xb5\xfc\xed\x2a\xde\xf3\x31\xa2\x26\x3f\xad\xa4\x92\x05\x6a" +
+	"\xd8\x43\xbb\x0d\x34\x2d\xf4\x33\x6f\x77\x34\x2d\xf6\x33\xef\x20\x4d\x21\x26\x77\x91\x71\x27\x68\x9a\xc7\x7f\x25\x5b\xa8\x4b\x69" +
+	"\x5a\xd5\xcf\xbc\x9b\x34\x65\xfc\x57\xf2\x6f\x7a\x40\xd3\x3c\xfe\x2b\x19\x4b\xbd\x42\xf3\x8e\x64\x8c\x43\x5f\x23\x4d\x01\x25\xa4" +
+	"\x6f\xae\x83\xa6\xe8\x67\x1e\x6b\x3f\xf3\x1b\x8e\xc2\x0a\x39\x2f\x64\x93\x9b\xa4\xa9\x9f\x01\x74\x0b\x34\x4d\xfc\x6c\xa1\xdb\x4a" +
+	"\x69\x45\x8c\x7e\xe6\x77\x48\x53\xa8\x8d\xc5\x8a\xbb\xa0\xce\x0a\x66\x2c\xdd\xfb\xdb\x55\x93\xe6\xf9\x51\xf4\xc5\x7d\xd2\x14\x21" +
+	"\x7e\xd9\xc4\x3c\x00\x95\x9a\xcc\x38\xef\x67\xfe\xd0\xd1\x54\x72\xb4\x2a\xfd\xcc\x1f\x91\xa6\x7e\x36\xd6\x63\xd0\x34\xf1\x33\xb7" +
+	"\x9e\x28\x4d\xb1\xee\x24\x7d\xf1\x94\x34\xf5\x33\xc2\xfa\x40\x5d\x56\x25\x7d\xf1\xec\x6f\x57\x4b\xe2\x51\xcf\x49\x0d\x03\x44\x72" +
+	"\x31\xee\x07\x35\x85\x78\xd4\x80\xa3\xa6\x18\x8f\x1a\x24\xcd\xdf\xa3\x4b\xee\xcc\x0b\xd0\xca\x7b\x74\xc9\x16\x1a\x52\x6a\xaa\xe2" +
+	"\x51\xc3\xa4\x26\x8f\x47\xa5\x59\x32\x02\x5a\x79\x8f\x2e\xb9\x3e\x2f\x85\x9a\xea\x78\xd4\x28\xa9\x01\x94\xe7\xb2\x64\x0c\xd4\x20" +
+	"\x1e\x95\x6a\x3c\xea\x95\xa3\xb0\x42\x76\x9e\x6c\x32\x4e\x6a\xfc\x0c\xa0\x09\x50\x13\xf9\xd9\x42\x93\x4a\x69\x45\x8a\x78\xd4\x14" +
+	"\xa9\xf1\xb3\x90\xa6\x41\x4d\xe4\x67\x2c\xcd\xfc\xed\xaa\xd7\xcf\x9c\xbe\x98\x25\x35\x68\x6d\x2c\x9b\x24\x73\xa0\x92\x0d\x90\xe6" +
+	"\xf1\xa8\x79\x47\xa5\x9f\x79\x5a\x89\x47\x2d\x90\x1a\x3f\x1b\x6b\x11\xd4\x44\x7e\xe6\xd6\x92\x52\xc3\xfe\x51\xf0\xc5\x32\xa9\xf1" +
+	"\x33\xc2\x56\x40\x4d\xe4\x67\x8f\xad\xfe\xed\x2a\xbe\x3f\xfc\x0c\xa0\xd7\xa0\x26\xf6\x33\x80\xde\x90\x1a\x8b\xc4\x36\x39\xf9\xd6" +
+	"\x94\xca\x71\xee\x67\x00\xad\x83\x9a\xd8\xcf\x00\xda\x20\x35\x18\x4c\x76\x52\xba\xe9\xa8\xe4\xb5\xc9\xd3\x14\x52\xe9\xb6\x40\x25" +
+	"\x33\x4e\x9e\x00\x15\xa6\x4d\x7f\x07\x35\xcc\xad\x93\x4f\xf3\xdf\xaf\xb2\x5e\xcf\xcb\x00\x6a\x01\x35\xb1\x9f\x01\xd4\x4a\x2a\x7b" +
+	"\x47\xf2\x9d\x54\x4f\x9b\x52\x66\xc6\x31\x03\xa8\x1d\xd4\xc4\x7e\x06\x50\x07\xa9\x78\x48\x15\x8b\x15\x9d\x8e\x4a\x5e\x1b\x84\x65" +
+	"\xc2\x5d\xa0\x92\x19\x27\x21\x10\xd1\x90\xa4\xdd\xa4\xf9\xba\x93\xf2\xb7\x47\xa8\xa1\x15\xf4\x45\x2f\x68\x25\x4b\x51\x74\x5c\x23" +
+	"\xc5\x95\x4a\x66\x90\x24\xd7\x95\xd2\x0a\xfa\xe2\x06\x68\x25\x4b\x51\x74\xdc\x24\xc5\x75\x5c\x66\x90\xa6\xb7\x1c\x85\x15\xf4\xc5" +
+	"\x6d\x50\x83\xfe\x98\x0e\xdf\x21\xc5\xb7\x9c\xfb\xdc\xfd\xfb\xd5\x92\x7a\xbd\x7b\xa4\x58\x1b\x59\x1f\x40\xb2\xfb\xa0\x51\xa1\x5e" +
+	"\xef\x81\xa3\x51\xb1\x5e\xef\x21\x69\x9e\x99\x21\xb9\x3e\x8f\x40\x2b\x99\x19\x92\xeb\xf3\x58\x69\x54\x55\xaf\xf7\x84\x34\xcf\xcc" +
+	"\x90\x5c\x9f\xa7\xa0\x95\xcc\x8c\x24\xcb\x6c\x9f\xd0\x88\x56\x30\x43\xe4\x19\x69\x04\xa8\x99\x53\xcf\x41\x23\xd4\xeb\x25\x5a\xaf" +
+	"\xd7\xef\x28\xac\x90\x2b\xad\x6c\x32\x40\x1a\xf9\xd9\x63\x83\xa0\x51\xe0\x67\x8f\xbd\x50\x4a\x2b\x12\xd4\xeb\x0d\x91\x46\x7e\xf6" +
+	"\xd8\x30\xa8\xb3\x82\x19\x4b\x23\x7f\xbf\x9a\x45\x35\xf5\x7a\x2f\x49\x23\x34\x11\x92\x4d\xb2\x51\x50\xc9\x2f\x49\xf2\x7a\xbd\x31" +
+	"\x47\xa5\x67\x91\x28\x84\x2f\x5e\x91\x46\x7e\xf6\xd8\x38\x68\x14\xf8\xd9\x63\x13\x4a\x65\x16\x09\x26\x21\x56\x4c\x92\x46\x7e\xf6" +
+	"\xd8\x14\x68\x14\xf8\xd9\x63\xd3\x7f\xbf\x8a\x67\x55\x24\xf5\x68\x06\xd0\x0c\x68\x1c\xf8\xd9\x42\xb3\xa4\x6e\x7d\x3d\x39\xe1\x6c" +
+	"\x9a\xce\x29\x95\xbb\x3b\x3f\x0b\x69\x1e\x34\x0e\xfc\x8c\xa5\x05\xd2\x18\x83\xa9\x8e\x45\x47\x25\x0e\xe3\x52\x93\xf4\x67\x09\x54" +
+	"\xe3\x51\x19\x7b\x68\x2f\x93\x16\xfa\x99\xaf\xfc\xfd\x2a\xe3\x51\x5e\x36\xd6\x2a\xa8\xc4\xc4\x2a\x99\x5b\xaf\x49\xe5\xfa\x2b\xd6" +
+	"\xaa\x9e\x37\x4a\x99\x6b\xc9\x2c\xa4\x35\xd0\x38\xf0\xb3\xc7\xd6\x49\xe5\xfa\xab\x8a\xc5\x8a\x0d\x47\x25\xb2\x07\x61\x99\xf0\x26" +
+	"\xa8\xe4\x5a\x6a\xec\x4b\xf7\xc5\x16\x69\xa1\x9f\x79\xd3\xff\x77\x95\xf1\x28\xec\x03\x51\xdd\x0c\x1a\xe7\xef\x19\x64\x16\x2d\xa4" +
+	"\x85\x7e\xe6\xad\x4a\x69\x05\x7d\xd1\x06\x5a\xc9\x7b\x15\x1d\xed\xa4\x85\x7e\xe6\x1d\x8e\xc2\x0a\xfa\xa2\x13\x34\x0e\xfc\x7e\xe6" +
+	"\x5d\xa4\x85\x8c\xf2\xae\xff\xef\x54\x14\x04\x43\xe1\x4c\xd8\x19\x1d\x9d\x09\xb3\xa3\x47\x3a\xa3\xbd\x47\x8e\x0c\x85\xbb\x8e\x1c" +
+	"\xed\x8c\xf6\x1e\x3d\x3a\x14\xee\x3a\x7a\x64\x26\xcc\x8e\xc4\x51\x34\x1a\xce\x86\xeb\xe1\x81\xd9\x70\xf7\x81\x83\xeb\xe1\x9e\x83" +
+	"\x07\x47\xc3\xf4\xe0\x81\xf5\x70\xcf\x81\x03\xa3\x61\x7a\xe0\xe0\x6c\xb8\xfb\xa0\x89\xe3\xb1\x70\x31\xec\x8a\xf6\x2f\x86\x76\xff" +
+	"\xbe\xae\xe8\xdd\x7d\xfb\xc6\xc2\xb7\xf6\xed\xef\x8a\xde\xdd\xbf\x7f\x2c\x7c\x6b\xff\xbe\xc5\xd0\xee\x4b\x8c\xe9\x0b\xd7\xc2\xd6" +
+	"\xe8\xd0\x5a\xf8\xf6\xa1\xc3\xad\xd1\x3b\x87\x0f\xf7\x85\xc9\xe1\x43\xad\xd1\x3b\x87\x0e\xf5\x85\xc9\xa1\xc3\x6b\xe1\xdb\x87\xd3" +
+	"\x24\xe9\x0f\x17\xc2\xde\xe8\xc8\x42\xb8\xfb\xc8\xd1\xde\xe8\xdd\xa3\x47\xfb\xc3\xe4\xe8\x91\xde\xe8\xdd\x23\x47\xfa\xc3\xe4\xc8" +
+	"\xd1\x85\x70\xf7\xd1\x2c\x4d\x27\xc2\xc9\xb0\x29\x3a\x38\x19\x66\x07\x0f\x34\x45\xef\x1c\x38\x30\x11\xbe\x75\xe0\x60\x53\xf4\xce" +
+	"\xc1\x83\x13\xe1\x5b\x07\x0f\x4c\x86\xd9\x01\x9b\x65\xc3\xe1\x6a\xd8\x16\xed\x5b\x0d\xdf\xde\xb7\xbf\x2d\xda\xbb\x7f\xff\x70\x98" +
+	"\xee\xdf\xd7\x16\xed\xdd\xb7\x6f\x38\x4c\xf7\xed\x5f\x0d\xdf\xde\xbf\xc7\xda\x81\x70\x25\xdc\x0a\x0f\xaf\x84\xf6\xf0\xa1\xad\x70" +
+	"\xcf\xa1\x43\x03\xe1\xae\x43\x87\xb7\xc2\x3d\x87\x0f\x0f\x84\xbb\x0e\x1f\x5a\x09\xed\xa1\xbd\x7b\x82\x6f\x27\xc3\xab\xba\xd7\xe2" +
+	"\x4b\x6f\x1d\x3c\x70\xe9\xad\x1d\x07\xff\xfe\x4e\xbc\xe3\xef\xef\x1c\xd0\x3d\xb6\x7f\x4f\x7a\x69\x26\xfc\xbb\xce\x69\xc7\xb7\xbb" +
+	"\x8e\x1e\xf9\x76\x57\x7c\xf4\xea\xde\x1d\xf1\xd5\xbd\x47\x74\x3e\x07\xf7\x06\xef\x9d\x69\x8b\xa2\x53\xe9\x7e\xdd\xed\xe1\x47\x6f" +
+	"\xef\xd3\xbd\xbe\xef\x54\x1a\xee\xff\xe8\xed\xe8\xf0\xde\xec\xd4\x47\x9d\x51\xf8\xde\xae\x23\x3a\xdb\xe8\x4c\x76\x54\x27\x7b\xf4" +
+	"\xbd\x5d\xd1\x91\x33\x59\x78\x74\x7f\x30\x10\xfe\xfc\xe3\xe1\x7f\x59\x73\xa8\x39\xdc\xb3\x53\x1d\xb7\xf3\x5f\xf6\x90\xfa\xcd\x34" +
+	"\x87\x7b\x0e\xff\x62\xbf\x1d\x0a\xff\xd5\x1c\x1e\xfd\x39\xdb\x79\xe4\xc7\xbd\x46\x4d\x31\x3f\x67\x47\x0e\x0f\x84\xbb\x0e\xef\xfc" +
+	"\x71\xef\xd1\x5f\x1e\x8c\xfe\xba\x10\x36\xaa\x5f\xe3\xfa\xe4\xc8\xd1\xfa\x64\xc7\x91\x0b\xef\xc6\x3b\x2e\xbc\x7b\x54\x7d\xfa\xff" +
+	"\x0e\x26\xf5\xb3\xe1\x05\xf5\xc1\x8e\xbf\xa6\x07\x0e\xfe\x35\x8d\x0f\x34\xee\xd9\x11\x37\xee\x39\xa8\xfb\xff\x3f\x0e\x47\xc3\xe1" +
+	"\xf7\x4d\xe1\xbe\xff\x79\x7b\xe7\xfe\x1f\xf6\x1a\x3d\x30\xcc\xff\xbc\xbd\x5f\x8f\x8b\x9d\x3f\xec\xdd\xf7\xab\xc3\xd9\x68\xf8\x3f" +
+	"\x3f\x1c\xf8\x7e\xb7\x39\xd8\x14\xee\xd9\xa9\xfb\x7d\xe7\xf7\xbb\x0f\xee\x1b\x0e\xd3\x7d\xa6\x29\xdc\x73\xe0\xd7\x47\xa3\xd3\x27" +
+	"\xb6\xc2\xf0\xd8\xae\x43\x7a\x74\x45\xe7\xec\x61\x3d\xb8\x0e\x1f\xdb\x15\x1d\x3a\x67\xc3\x63\x47\xed\xb1\x73\xeb\x61\x74\x3a\x3d" +
+	"\x78\x68\x2b\xdc\x73\x28\x3c\xb1\xfb\x80\xfa\xe4\xc0\xe9\x34\x3c\x78\x62\x77\xf4\xde\x2f\xe2\xf7\xcf\xf6\x46\xd1\xc9\xe4\xa8\x1e" +
+	"\x78\xe1\x87\xbb\x8f\xe8\x71\x77\xe4\x64\x12\x1e\xfd\x70\x77\xf4\xfe\x2f\x92\x93\x1f\x76\x45\xe1\xfb\x6f\xed\xd3\x63\x29\x3a\x6b" +
+	"\xf7\xeb\xa1\xb4\xff\xfd\xb7\xa2\x7d\x67\x6d\x78\xfc\x97\xf1\x44\xf8\xcf\x96\xf0\xe0\x4f\xd9\xce\x03\xff\xf5\x8e\xd1\x43\xd7\xfc" +
+	"\x94\x1d\xd0\x23\x77\xe7\x7f\xbd\x73\xf0\xc4\x2f\xd3\xb1\xf0\xa7\xff\xda\xff\x4f\x6b\xf6\xb5\x84\xef\xee\xd4\xe3\x6c\xe7\x3f\xed" +
+	"\x3e\x3d\xcc\x4c\x4b\xf8\xee\xfe\x0f\xff\x5f\xdc\xb0\x12\xd6\xe9\x09\xb0\xe3\x9b\x5d\x87\x0f\x7d\xb3\x2b\x3e\x7c\x79\xcf\x8e\xf8" +
+	"\xf2\x9e\x43\x7a\xf0\x7f\xf4\xff\xec\x37\x8b\xe1\x65\x3d\xbc\xe2\x86\xb7\xf6\xef\x6b\x78\x6b\xc7\xfe\xba\x77\xe3\x1d\x75\xef\xee" +
+	"\xd3\x43\xeb\x93\xff\x30\xfd\xe1\xff\xfe\xf7\x91\x7f\xec\x36\x47\x5b\xc3\x77\x77\xea\xa9\xb1\xf3\x1f\xbb\x8f\xea\x99\x61\x5a\xc3" +
+	"\x77\x8f\xfc\xee\x3f\x92\xbe\xf0\x1f\xad\xe1\xa1\xff\x7d\x7b\xe7\xe1\xff\x7e\xc7\xe8\x69\x61\xfe\xf7\xed\xc3\x7a\x56\xec\xfc\xef" +
+	"\x77\x0e\x9d\xfc\x95\xf9\xdd\x27\x4d\x51\x78\xfc\xad\x03\x7a\x7e\x45\x5f\x65\x07\xf5\xf4\x3a\x78\xfc\xad\xe8\xc0\x57\x59\x78\xea" +
+	"\x57\xe9\xf1\xaf\x5a\xa3\xe8\x77\xc9\x61\x3d\x65\xc2\x4f\xde\x3e\xa4\x67\xcc\xa1\xdf\x25\xe1\xe1\x4f\xde\x8e\x4e\xff\xda\x5c\x5c" +
+	"\x0d\xcf\xeb\x29\xba\xe3\xbb\x74\xdf\xfe\xef\xd2\x78\xdf\x95\xbd\x3b\xe2\x2b\x7b\xf7\xeb\xe9\xf9\xd5\xaf\xb3\xef\xd6\xc2\x2b\x7a" +
+	"\x3a\xc5\x17\x93\x43\x87\x2f\x26\x3b\x0e\x9d\x7f\x27\xde\x71\xfe\x9d\xc3\x7a\x2a\x9d\x39\xf6\x7f\x9c\x23\x67\x8f\x05\xdf\xfe\x54" +
+	"\xfc\xbf\x1c\xbe\xf9\xff\xcf\xa9\x82\x5f\x95\x2a\xd0\xc3\xfb\x1b\x15\xe8\x8c\x6a\x24\x2a\x57\xae\x6f\x65\x8c\x2a\x89\xfc\x1c\xd1" +
+	"\x51\xbe\x53\x25\x3f\x96\xeb\xd0\x61\xfe\x7a\x2c\x78\x11\xd2\x12\x3d\xd5\x6a\x4c\xf9\xbb\x88\xfc\x54\x25\x51\x30\xe6\xbc\x48\xd0" +
+	"\x9a\x1a\x25\x3a\x4e\xdd\xb1\xe0\xdb\x33\x1d\x95\xb9\x16\x2e\x09\xaa\xe4\xc2\xb1\xe0\xdb\xaf\xda\x8a\x12\x32\x0f\x5e\x33\x2e\x8a" +
+	"\x86\xb6\x6d\x34\x88\x40\xfd\xb1\x60\x28\x3c\x73\xb5\x72\xb5\xcf\x25\xf2\x89\x36\x88\xc8\x57\x57\x8a\x22\x9c\xa7\xe8\xb8\x24\x02" +
+	"\x67\xae\x6c\xa3\x43\x24\x1a\x55\xa2\x23\x2a\x11\xc9\x4d\xb9\x2c\x32\x5f\xb5\x55\xc9\xf8\xc6\x5c\x11\x89\x33\xd5\x12\x05\x73\xae" +
+	"\x1e\x0b\xbe\x9d\xf2\x0e\x31\xbd\x04\x72\xae\x3a\xcc\xf7\xc7\x82\x6f\x7f\xae\x12\x28\xf8\xe5\x1f\xaa\xe2\x57\xe5\x2a\xd4\x2d\xff" +
+	"\x54\x89\xce\xa8\x56\xa4\x72\x10\xfd\x4b\x86\xa9\x16\x29\x1e\x67\xff\xa5\x6a\x7e\xdc\x46\x8b\x8e\xf4\xdf\x72\x8c\xd0\x9e\xca\x35" +
+	"\xbd\x60\xd0\x0f\x22\xf3\x73\xb5\x48\xc1\xa4\x1f\x45\x84\x36\xd5\xaa\xd1\xa1\x7e\x3a\x16\xec\x99\x0e\x3b\xf4\xfb\x87\x47\x7d\xd1" +
+	"\x41\x3f\x1f\x0b\xf6\x4c\x86\x6d\x05\x09\xdf\x3d\xff\xa3\x1a\xda\xca\x35\x88\xc0\xff\x1e\x0b\xde\x9b\x0e\xaf\xe6\xdf\x70\xb9\x40" +
+	"\x3e\xd1\xa6\xf0\x58\xf0\xde\x64\x78\xa5\x20\xc2\x69\x8a\x8a\x66\x11\x98\x0e\xaf\x94\xeb\x10\x89\x16\x27\xd1\x11\xd5\x8a\xe4\x96" +
+	"\xb4\x8a\x0c\x4d\xc9\x65\x7c\x5b\xda\x44\x62\xba\x5a\xa2\x60\x4d\x7b\x78\x2c\xd8\x73\xa6\xb2\xc3\x6a\x8f\xe8\x0e\x91\xf8\xaa\xb2" +
+	"\x43\xaa\x8f\xe7\x4e\xf9\xff\x99\xe2\xff\x0b\x43\x74\xc9\x2c\xce\x54\x76\x58\x2e\x90\xef\xb0\x6e\x91\xf8\xaa\xb2\x3b\x38\x04\xf7" +
+	"\x46\x8f\xfc\xff\xcc\x95\x72\x0d\x22\xd0\xab\x02\x1d\x51\xad\x44\x6e\xc6\x35\x11\xf9\xaa\xad\x28\xe2\xdb\x71\x3d\x3c\xf6\x7f\xdc" +
+	"\xba\xdc\x10\x89\x9f\xdb\x2b\x12\xc5\xd3\x41\x94\xdc\x14\x91\x99\xf0\xc7\xa2\x48\xe5\xb6\x53\x0f\xd2\x5b\x22\xf4\xf3\x8f\xdb\xa8" +
+	"\x51\x91\xdb\xe1\xb1\xe0\xd8\xcf\x6e\xb6\xc5\xd3\x81\x93\xb9\x23\x12\x33\xe1\x0f\x45\x89\x7c\xbe\xaa\xe5\xae\xc8\xfc\xfc\x43\xb9" +
+	"\x12\x95\xb8\xa7\x12\xed\x25\xe3\xe4\x26\xdd\x17\x11\x98\x54\x11\xa9\x32\xe9\x81\x08\xfd\xfc\xe3\x36\x6a\x54\xe4\x61\x78\x2c\xf8" +
+	"\x66\x32\xbc\x2a\x37\x76\xfe\x89\x97\x1f\x05\x8f\x44\xe0\xa7\xc2\xff\x0b\xe7\xff\x63\xf9\xff\x64\xf8\xab\x32\x05\x3a\xc2\x13\x27" +
+	"\xd0\x19\x55\x4b\x54\xae\x56\x4f\x45\xe4\xa7\xa2\x44\x3e\x4d\x1d\xa5\xcf\x8d\xf2\x63\xf5\x28\x15\x43\x9e\x85\xc7\x82\x81\x10\x96" +
+	"\xd4\x7c\xe5\xa9\x92\xe7\x2a\xf2\x53\x51\xa2\x60\x4c\x3f\x94\xfc\xaa\x54\x89\x9a\x33\x10\x1e\x0b\x06\xc3\x5f\xb6\xeb\x64\xf3\x23" +
+	"\x32\x9f\xad\x1c\x04\x83\x2a\x72\xe6\xc7\x82\x44\x95\x6b\x5e\xa8\xcc\x2f\x7f\x2c\xd7\xa2\x22\x43\x3a\x99\x5f\xb6\x45\xf9\x64\x28" +
+	"\xc3\xa3\x6d\x58\x25\xce\xfc\x50\x10\x28\x1e\x6c\x23\x2a\xf2\xcb\x1f\x4a\x75\xe8\x30\x2f\x9d\x44\x7b\xed\x30\xb9\x41\xa3\x2a\x72" +
+	"\xe6\xc7\xda\x71\x2a\xb3\x1d\x53\x99\x5f\xfe\x58\xae\x45\x47\x7a\xa5\x36\xff\xec\xed\xba\xda\xe3\x7a\x5c\x65\x66\xc2\xca\x8e\x29" +
+	"\x3b\xb0\x27\x54\xea\xe7\x1f\xb7\x51\xa4\xa3\x4d\xea\x84\x7e\xf6\x76\x5f\x2e\xc4\xfd\x37\xa5\x22\x33\x61\x65\xef\x54\x06\xcb\xf7" +
+	"\xcf\xb4\x0a\xfd\xfc\x43\xb9\x1a\x15\x99\x71\x22\xed\x51\xad\x4c\x6e\xd8\x2c\xc6\xfa\xb1\x64\xac\xca\xa4\xe7\xc2\x63\xff\xe7\x73" +
+	"\xd6\x7c\x78\xac\xea\x71\x4f\x06\x3b\xda\x11\xed\xdd\x27\xf7\xcb\x72\xc3\xbd\x40\x91\x9f\xb2\x9d\x15\x09\x79\x22\x84\xc0\xe2\xbf" +
+	"\xd1\xc1\x87\xc6\x25\xca\x4c\x87\xd9\xd1\x1d\x7b\x76\x45\xfa\x58\xa9\x22\xbf\xda\x6b\xf4\xb9\x72\xb9\x46\xe4\x45\xb8\xeb\x50\x45" +
+	"\x66\x2a\xcc\x8e\xac\x50\x46\x87\xa2\x1a\x3e\x9d\x7e\x95\x85\xab\x35\x02\xaa\xc4\x7f\x7e\x7d\x5d\x33\x4e\x3e\x15\x28\x79\xf3\x6f" +
+	"\x66\x42\x2d\x6b\x94\xd1\x81\xbe\xd9\x75\xb8\x66\xb7\xac\x53\x42\xf6\x5b\x2e\x20\x2e\xa2\xc4\xc6\xf6\x3a\x38\xcc\x26\x45\x74\x2a" +
+	"\xd5\x8f\xe3\xb2\x4b\xb6\x6a\x24\x6a\x94\x34\x45\xd5\x0e\x12\x25\xf9\x4c\x64\x9c\xe6\x1a\x91\x9a\xd9\xb6\x44\xd5\x03\x71\x2a\x54" +
+	"\xd2\x1a\x6d\x3b\x15\xe8\x68\x8b\xfe\xed\xbe\x17\x7b\xda\x6b\x44\x6a\x8f\xa6\x0e\xca\xd4\x1c\x06\x9c\x4a\x67\x8d\x44\xf5\x54\xba" +
+	"\x6a\xc6\xc9\xa7\x42\x25\xdd\xa5\xfb\x2d\x9f\xc9\x57\x59\xd8\x13\x55\x9d\x1b\x9c\x07\xfe\xdf\xbb\xbd\x06\x1e\xf6\xd7\xa2\x63\x95" +
+	"\x70\x49\x47\xb4\xf7\x88\x86\x4b\xda\xe5\x02\x8f\x70\xc9\x75\x5f\x42\x03\x2a\x5f\x65\x07\x2b\x01\x15\xf9\x36\xba\x51\x23\x52\x50" +
+	"\x22\x22\x37\x29\x72\x6c\xd7\xa1\xaa\xb0\x8c\x5c\x0d\x24\x2e\x73\xcb\x17\xf9\x61\xaf\x91\x67\x6f\x79\x72\xae\x7c\x75\xde\xae\x96" +
+	"\x28\xea\x10\x91\x3b\xa5\xe3\xf8\xf6\xdc\xad\x56\x52\x63\xce\xbd\x6a\x89\x1a\x6b\xee\x53\x62\xcf\xae\xd8\xed\xb5\x5f\x66\x3b\x9d" +
+	"\xc8\x60\xb8\xeb\xf0\x91\xe9\x30\x3b\xf0\xa0\x66\xcf\x16\x65\xce\x64\xe1\x43\x5f\x4d\x71\xa7\x50\xe4\x91\xaf\xa5\x44\x44\x6c\x7e" +
+	"\x5c\xa3\xa6\x66\xa4\x27\x35\x6a\x8a\x22\xa2\xe6\x69\xa9\x4c\xbe\x67\xc4\xa6\xbe\x9a\xbd\x5b\x10\x39\x93\x85\xcf\x7c\x25\xdc\x77" +
+	"\xe2\x44\xfc\xff\x79\xd9\xbe\xe5\xff\x65\x16\xfd\xd5\x0a\xaa\x47\x18\xa8\xd6\x50\x10\x10\x15\x83\xa5\x86\x54\x8c\x15\x4b\x5e\x94" +
+	"\x5a\x52\x91\x39\x93\x85\x43\x65\xa6\x14\x9c\x33\x5c\x66\x4c\xd1\x37\x23\xd5\x4a\x6a\x86\x79\x19\x1d\x43\xb0\x71\x2a\xcc\x0e\xea" +
+	"\x2d\x40\x25\xdc\xf8\x22\xdc\x75\x74\xe7\xaf\xf6\xee\x18\xcd\x85\x24\x22\xa9\x32\xdf\xec\x8a\xcd\x4f\xd9\x01\x27\xf2\xe3\xde\xa3" +
+	"\x63\xb5\x22\x45\x35\x3f\xee\x3d\xfa\x2a\x97\xd1\xb1\x24\xb2\x29\x6a\xfc\x91\xc6\x6b\x45\x54\xcd\x2f\xb3\x30\x97\x99\x28\xca\x14" +
+	"\xa6\xc3\x00\xe9\xe4\xbf\x33\x8b\x42\x53\xb5\x73\x2e\xe8\xf9\x61\xef\xbe\xe9\x7f\x63\x16\x65\x66\x6a\xe7\x4c\xb3\x38\xd2\xec\xbf" +
+	"\x31\x8b\x32\x73\xb9\x8c\xec\xe4\xf6\x68\xef\xfe\xaa\xa1\x7e\xb5\x77\xc7\x7c\x2e\x23\x41\x82\x8a\x1e\xdf\xf4\x85\x82\x9e\x5a\x91" +
+	"\x5f\xed\xdd\xb1\x58\x3b\x9d\xc1\x70\xd7\x91\x82\x27\x96\x6a\x65\x72\x3d\x94\x59\xae\x19\xaa\xa8\xe6\xc7\xbd\x47\x57\xb6\x9f\x0d" +
+	"\x45\x56\x73\x11\x3d\x2c\xd4\x72\xd5\xe3\x3b\xfd\x75\x89\x50\xbe\x7b\x28\xf4\xa6\x66\x30\x3a\xe2\xd8\xae\x48\x86\x5a\xcb\x05\xf2" +
+	"\xe8\x54\xee\x72\x11\xf9\x61\xef\xbe\xf5\xed\x0f\x0a\x28\xd9\xd8\x76\x14\xee\xdf\xcd\x7f\x33\x0c\xdd\xb4\xb5\xfd\x38\x54\xd3\xb4" +
+	"\xe3\xbd\xff\x23\xcc\xdf\xec\x24\x2e\x97\x4a\x48\x00\xbe\x45\x04\xe6\xab\x54\x68\x88\x3e\xd7\xd1\xea\x74\x6c\x84\x35\x4a\x24\x8a" +
+	"\xaf\x22\x6d\x4e\x64\xab\x5c\x44\xc6\x69\x77\xe3\x54\x29\xc9\xdf\x05\xa8\x96\x8e\x1d\xef\x45\xa3\x21\xed\xd1\x17\x06\x35\x06\x75" +
+	"\x42\xe6\x72\xb9\x8c\x0c\xd5\xa5\x22\xf3\xd5\x6a\x8a\x46\x75\xef\x78\x2f\xfa\x6e\x21\x74\xef\x37\xfc\x09\xe7\xaf\x37\x7a\x44\xe2" +
+	"\x1f\xeb\x05\x81\xfc\xfd\x87\xce\xa5\x57\x25\x9a\xca\x25\xe4\xc4\xbf\xb6\xe3\xbd\x68\x38\x5c\x08\x7f\xcd\x97\x28\xfe\x54\x45\xe0" +
+	"\xba\x0a\xfc\xa3\xd1\xff\xbf\x68\xc8\xa7\x79\xc3\x09\xfc\xba\x4c\x40\x14\xdc\xc4\x08\x4d\x61\xf5\x10\xf9\x24\x6e\xa9\xc8\x3f\xd6" +
+	"\x0b\x12\x45\x43\x6e\x3b\x91\xa6\x72\x11\x19\xe7\xce\x8e\xf7\xa2\xc3\x0b\x61\xa3\xbc\xc9\xa1\xa9\x34\x44\x27\x7a\xd7\x09\x5c\x2e" +
+	"\x13\x10\xa7\xdc\x93\xff\xcf\x17\x15\x14\x3d\x72\x5f\x24\xe4\x30\xab\x56\x91\x1f\x1f\x0f\x9c\xc4\x56\xa9\x84\x0c\xf2\xd0\x0d\x52" +
+	"\x54\x51\x3c\xc6\x1e\xed\x78\x2f\x3a\x0d\x43\x6a\x8e\x1e\x1d\xe5\xb1\x93\xb8\x5c\x2a\x21\xa3\x3c\x11\x81\xf9\x2a\x15\x45\x5b\x9e" +
+	"\xca\xd1\x3e\x17\x36\x55\x8e\x76\x7d\x7d\x56\xf0\x4b\x9f\xc8\x7c\xbf\x5e\x25\x52\x74\xcc\x33\x95\x69\xda\x4e\x46\x3c\xf3\x7c\xc7" +
+	"\x7b\xd1\x48\x38\x17\xfe\x5a\x65\xf2\x37\x75\x9c\xb0\x88\xf4\xab\xc8\xf7\x8d\x45\x89\xc2\x71\x36\xe0\x44\x7e\x5d\x2e\x22\x4a\x06" +
+	"\x31\x4e\x53\x58\x94\x29\xd8\xf4\x42\x85\xbe\x5f\xaf\x92\x29\x1a\x35\xe4\x84\x9a\xb6\x13\x92\xd1\x86\xe5\xd4\x9a\xf3\x4e\xcf\xda" +
+	"\xb1\x46\x44\xe4\xfb\xca\xf9\x59\x32\xd2\x4b\x15\x69\xda\x46\x44\x94\x8c\xea\x91\x3f\x57\x39\x43\x6b\x76\xde\x98\x4a\x7c\x9f\x9f" +
+	"\xa2\xb5\xfb\xee\x95\x93\xf8\x75\xa9\x84\x0c\x32\x8e\x41\x9a\xc2\x9a\x51\xf2\x89\x4c\xa8\xcc\xf7\xeb\x45\x91\xa2\x39\x93\x4e\xa6" +
+	"\x69\x1b\x19\x19\x6a\x6a\xc7\x7b\xd1\xa9\xef\xdd\x19\x52\x90\xe0\x29\x32\x2d\x02\xb3\x61\x73\x51\x22\x3f\x47\x44\xc7\x8c\x13\xd9" +
+	"\xda\x46\x44\xb4\xcc\xca\x29\xf0\xfd\x66\xe5\xc5\x6e\x71\x26\x22\x32\x27\x22\xb3\x61\x53\x95\x4c\x25\xb5\x40\x86\x9a\x77\x42\x9b" +
+	"\xdb\x0a\x89\xa6\x05\x11\xfa\x7e\xab\x4a\xc6\x37\x6a\x51\x24\x68\x54\x95\x1a\x5a\xb5\xe4\x64\xb6\xb6\x93\x11\x3d\xcb\x72\xba\x7d" +
+	"\x58\xf9\x2e\x94\xd7\xd5\x3c\x16\xf4\x80\x5a\x51\x81\xcb\xa5\x02\xa2\x60\x55\xfe\x7f\xa2\xa8\xa0\x78\x65\x78\x2d\x12\x1f\x6e\x84" +
+	"\x35\x2a\xf2\x2b\xd4\x1b\x95\xd8\x2a\x97\x90\x41\xd6\x44\xe0\x44\x95\x8a\xdc\x0e\xd5\xb1\xae\x5f\x81\xb0\x24\x7f\xf3\x5e\x30\x65" +
+	"\xc3\x89\x5c\x2e\x17\x91\x71\x36\x55\xe2\x44\x63\x51\xa2\x68\xce\x96\x1e\x92\xfb\xb7\xf2\x43\x52\x44\x7c\xd7\x34\xc5\x22\x70\xa2" +
+	"\xb9\x20\x90\x4f\x56\x1c\xd3\xec\x24\xb6\xca\x25\x44\x47\x4b\xfc\x5e\xf4\x32\xdc\xbf\xa9\x12\xf9\x44\x0a\x47\x5b\xab\x8a\x9c\x68" +
+	"\x2a\x8a\x14\x0f\xb6\x36\x27\xb3\xb9\x9d\x8c\x0c\xd5\xae\x32\xfb\xb7\x8a\x32\xbe\x41\x1d\x2a\x71\xa2\xb9\x28\x51\xb0\xa8\xd3\x89" +
+	"\x6c\x6d\x23\x22\x5a\xba\xe2\xf7\xa2\x53\xfb\x2b\xa7\x58\xf5\x28\xdd\xf2\xff\x13\x95\xb3\xb4\x66\x8c\x1e\x15\xd8\x2a\x17\x10\x0d" +
+	"\xbd\xf1\x7b\xd1\xe9\xfd\xde\x99\x55\xb3\xcf\xae\x89\xc4\x09\xef\x04\xad\xdd\x65\xd7\x55\x64\x73\x3b\x11\x19\xe7\x86\x88\xec\xdf" +
+	"\x2a\x8a\xf8\xa6\xdc\x14\x81\x13\xcd\x45\x81\x82\x2d\xb7\x54\x62\x6b\x1b\x09\xd1\x71\x3b\x7e\xcf\xa5\xab\xfc\x63\xb7\x91\xac\x05" +
+	"\xcd\x56\x11\x81\xf8\xd7\x7b\x76\x6a\xba\xca\x9d\x6d\x25\x24\xa1\xe5\xc3\xdd\xd1\x5d\x0a\x68\xc6\x0b\x25\x1a\xf7\xec\xc8\x55\xdc" +
+	"\xdb\x56\x42\x54\xcc\x85\xbb\x0f\xde\xa7\xc4\x7c\xb8\xfb\x40\x4d\xd6\x8c\x48\x3c\xd8\x76\x1a\x97\xf7\x1c\xd2\x51\x1e\xc6\xef\x95" +
+	"\x64\xde\x14\x44\x1e\x6d\xab\x84\xc3\x3c\xde\x5e\x09\x45\x9e\xf8\x4a\x28\xe1\x9b\xfb\x74\x3b\x01\xec\xb0\xbe\xd2\x31\x36\xc2\x3d" +
+	"\x87\x73\x15\xcf\x28\xa2\xfb\xe3\x70\x1a\x1e\x1c\x09\xd3\xfd\x15\x19\x99\xc7\xf3\x1a\x35\xb5\x32\xfd\x05\x99\x5c\x0f\x27\x23\xb3" +
+	"\x1d\xa8\x9e\x6d\x8d\xc4\xe0\xf6\x4a\x38\xce\x8b\x6d\x95\x50\x62\xa8\xc6\xc3\x35\x22\xc3\x35\x87\x49\x8d\xc8\x48\x41\x24\xd7\x92" +
+	"\xfb\x47\x0c\x7a\x59\xed\xe4\x5a\x91\xd1\x7f\xa3\x86\x43\x8d\x55\xab\xc9\xbf\xd4\x72\x35\xaf\xb6\x17\x91\x83\xf2\xc3\xdd\xd1\x78" +
+	"\x8d\x49\x2a\xe2\xef\xdd\x89\xf8\xbd\xaa\x6c\xaf\xef\xd2\x58\x93\xbd\xe4\xd5\x8c\xe4\x7a\x4d\x52\x22\xff\xba\x90\xdc\x22\xc9\x70" +
+	"\xca\xdf\xde\x4c\x15\x44\x6a\xb4\xc8\x6b\x99\x69\x8a\xc8\x38\x9b\xe1\x9e\x43\x55\x59\x65\xbf\xde\xb3\x63\xa6\x56\x8b\xca\xc8\x48" +
+	"\x79\xe6\xd9\x6c\x41\xa8\x44\x91\x4c\x79\x6e\xfb\xc1\x68\xd5\xfc\xbf\x19\x8c\x73\x5e\xf8\x37\x63\x51\x66\x91\x32\x73\xe1\xee\x23" +
+	"\x9e\x9e\xfd\xbb\xa3\xca\x7c\x96\x6a\x76\x72\x2e\xc3\xf9\x2c\xfb\x22\x72\x35\xad\x0c\xa5\x6a\x64\xac\x95\x5a\x4f\xd4\xcc\x67\x75" +
+	"\xfb\xa1\x72\x3d\xaf\x0b\x7a\x6a\xe7\x23\x63\xbd\xa1\x8c\xda\xa5\xfb\xf0\x65\x98\x1e\xd4\x39\x8f\x84\xe9\x01\xf3\xeb\x3d\x3b\xd6" +
+	"\x6a\x76\x73\x2e\x42\x9f\xae\xfb\x22\x3a\x92\x8a\x88\x59\xaa\x45\x4c\xdf\xa8\x9d\x4e\x2e\x94\xef\xc2\xcd\xed\xc7\xca\x15\x6d\x15" +
+	"\x14\x15\x85\x72\x45\x4d\x3b\xb7\x37\x2c\x17\x6a\xde\xb9\xed\x68\x74\x58\xcb\xce\x6d\x2d\xcb\x77\x74\xeb\xce\xed\x2d\xe3\x8e\x6e" +
+	"\xdb\x7e\xa8\x5c\x4f\xfb\xce\xf7\x5c\x3a\xe5\xe1\x34\x3e\xb4\x11\xee\x39\xa8\xe9\x94\x97\xf7\xec\xd0\x6c\xca\xf9\x70\xf7\xd1\x0e" +
+	"\x5f\x42\x13\x2e\x3f\xdc\x7d\x44\x12\x19\xf5\xfb\x5c\x12\x2e\x3b\x6b\x24\x7c\x1d\x27\x76\x47\x5d\xa5\x83\x68\xce\xa6\xea\x90\x61" +
+	"\xba\x6b\x64\xf6\xef\x36\x95\xbc\x4e\x11\xe9\xa9\x11\x91\x99\x14\x44\x7a\xb7\x1f\xe9\x65\x98\xee\x53\x99\x6b\xa5\x06\xe5\x6a\x4e" +
+	"\xec\x8e\xae\xd7\x48\x14\xb5\x9c\xd8\x1d\xdd\x28\x1d\x68\x53\xee\x84\x38\x97\x9b\x35\x22\x62\x91\x8a\xe4\x73\xb9\x45\x19\xc9\x14" +
+	"\x29\x8e\xc4\xbd\x7b\xdb\x17\x91\xb7\xce\xc5\xfd\x2f\xb7\xd4\x77\x6a\xb4\x54\xbb\xe8\xee\xce\xf7\x4a\xb2\x66\x7d\x37\xdf\xab\x91" +
+	"\x28\xe8\x10\x91\xfb\xbe\x88\xce\xa4\xca\xcf\x0f\x6a\x04\xaa\xe7\xf1\xd0\x97\xd0\x51\x6a\xbc\xfc\xa8\x46\xa4\xb8\x4b\x64\x26\x8f" +
+	"\x7d\x99\xda\xbd\x7f\x62\x77\xf4\xa4\x46\x4b\xc1\xc9\xb2\xd7\x9e\xd6\x28\xa9\x3e\x0e\xfa\x6a\x24\x6a\xbc\xf3\xac\x74\x9c\xdc\x64" +
+	"\x19\xe7\x79\x8d\x96\xea\xbd\xd2\xbf\xf3\xfd\xff\x23\x65\x79\x40\x25\x7a\xb6\x91\xd0\x74\xe2\x41\x11\x39\x57\xa5\x44\x8e\x13\xea" +
+	"\x78\xb1\xf3\xfd\x78\x2f\x46\x91\x97\xe3\x35\x83\x0c\xa9\x40\x4f\xb9\x80\x8e\x31\x2c\x12\xe7\x7a\x0b\x12\xfe\x10\x23\x32\x87\xb3" +
+	"\x17\x6a\x67\xc9\xdb\x86\x97\x2a\x50\x57\x2e\xa0\x43\x8c\x8a\xc4\xb9\xa2\x0a\xa6\x4d\x8b\x86\xb1\x9d\xef\xc7\xc7\x17\xc3\xd6\x50" +
+	"\x24\xf2\xe4\xec\x7c\x9a\x3b\xff\xeb\x9d\x83\xaf\x44\xe4\x9f\xbd\x51\x41\x42\x02\xe5\x9c\xe6\xb8\x0a\xb4\x86\xa5\x02\xa2\x61\x42" +
+	"\x26\xb1\x18\xb6\x84\xf9\x34\xaa\xaa\x17\x44\x68\x52\x84\xfe\xd9\x5d\xd9\xe1\xb9\x1a\x15\x91\x81\xa6\x54\xa4\x25\xdc\x46\x44\xb4" +
+	"\x4c\xcb\x5c\x38\x54\x3e\x99\xe2\x48\x33\x22\x83\x91\x0a\xf3\xcd\x07\x9a\x55\x89\x96\xb0\x5c\x42\x74\xcc\xc9\x54\x96\x42\xef\xf8" +
+	"\x90\xd7\x07\xf9\x7e\x93\xd9\xce\x3b\x91\x9e\x6d\x44\xd4\x3d\x0b\x22\xb3\x52\xad\xc6\x3f\x06\x16\xe5\x18\xe1\x40\x4c\x7c\x2f\x8c" +
+	"\xb3\xe4\x24\x7a\xca\x25\x74\x98\x65\x11\x59\xa9\x52\xe2\x8f\xb2\x22\xf3\x58\x0a\x2b\xc7\x49\xae\x83\x07\xca\xaa\x93\xa8\x2b\x97" +
+	"\xd0\x51\x5e\x8b\xc8\x4a\x95\x12\xff\x60\x7b\xb3\xf3\xfd\x78\x22\x3c\xdb\x9a\x57\x02\xe4\xc7\x2b\x8f\x94\x35\x95\xf8\x45\x6f\xe4" +
+	"\x4b\xf8\xc7\xda\xba\x13\x68\x0d\xcb\x04\x44\xc3\xc6\xce\xf7\xe3\xf1\xf0\xac\xab\x36\x28\x9e\x14\xb9\xf3\x36\x55\xe6\x17\xdd\x51" +
+	"\x41\xa6\x70\x04\x6c\x39\x91\x96\xb0\x5c\x44\x46\x6a\x32\x32\x95\xb3\x2d\x85\xa9\x14\x07\x6a\x56\x11\x0c\xe4\xcf\x36\x1f\xa7\xc5" +
+	"\x49\xb4\x84\xa5\x12\xa2\xa3\x55\x25\xe4\x14\xa5\x48\xe5\x90\xa6\xd1\x6d\x2a\xf3\xcf\xca\x6e\xcb\x0f\x58\xee\xb7\x76\x27\xd1\x1a" +
+	"\x96\x4a\x88\x8e\x0e\x23\x16\x2f\x86\x9e\xc9\x25\xe7\x4e\xa7\x4a\xfd\xd3\xdb\x75\xb9\xa2\xdc\xa6\x2e\x27\xd3\x12\x6e\x23\x23\xa3" +
+	"\x75\xc3\xaa\x96\x12\xab\x72\xa1\x1e\x15\xc2\x60\x85\x49\xe7\x63\xf5\x3a\x91\x96\xb0\x5c\x44\x86\xba\x66\xde\x8f\x2f\xfd\xab\x2b" +
+	"\x62\xa2\x13\x5f\xe2\x55\xea\xa6\xae\x8b\xc4\x0a\x2c\xf7\x4f\x8f\x5c\xc7\x0d\x27\xd1\x15\x95\x4a\xe8\xd1\x7f\x53\x67\xf2\xaf\x3a" +
+	"\x4e\x84\xc3\xe4\xa7\xc7\x2d\x15\x58\x09\xff\xc3\x97\xe0\xe9\x21\x13\xbd\x0d\x81\xba\x32\x01\x55\x71\x47\xa6\xf1\xaf\x3a\x7f\x12" +
+	"\x85\x21\xee\xca\xff\x31\x82\x3f\x4b\x8e\x70\xcf\xfd\xbf\xae\xec\xff\xaa\xe0\xbe\x79\x3f\x1e\x0b\xcf\xf6\x7a\x85\x66\xf9\x51\xcd" +
+	"\x43\xe9\x81\x93\xe9\xd9\x4e\x46\x15\x3d\x54\xa1\x73\xd5\x8a\xfc\x6b\xce\x23\xf3\x7e\xdc\xc0\xa1\x6a\xbe\x20\x45\xe2\xb1\x4a\xf4" +
+	"\x6c\x23\xa1\xe3\x3c\x11\x91\x73\xbd\x45\x11\x7f\x94\xa7\x3a\x91\xb3\x17\x4a\x26\xcb\xcb\x52\x9f\x13\xa9\xdb\x46\x44\xc7\x79\xa6" +
+	"\x32\xe7\xaa\xd4\x70\xdf\x89\x96\xe7\x32\x93\x7f\xa2\x44\x4f\x66\x52\x3c\x12\x55\x4b\xbf\xc8\x2c\x87\x2d\x61\x51\xa8\x70\xa8\x0d" +
+	"\x38\x99\xae\x68\x1b\x19\x55\x34\x68\xde\x8f\x5f\x85\xff\x74\x53\x2e\x1c\xf6\xf9\x8c\x5f\xa8\xc8\x72\xf8\x1f\x45\x19\x4e\x59\x0e" +
+	"\x87\x21\x88\xd4\x95\x8b\xa8\x9a\x61\x99\xce\x3f\xeb\x8a\x93\x29\x0c\x34\x22\x12\x1c\xa7\x30\x5f\x8e\xf3\xd2\x49\xd4\x95\x4b\xa8" +
+	"\x92\x51\x11\xf9\x97\x67\x34\x8f\xed\x8a\xcd\x63\x22\xb2\xe2\xef\xbc\x9a\xd3\xf4\x95\x13\xe9\x8a\xca\x45\x74\xa4\x71\x35\xfa\x5f" +
+	"\x15\x9b\x6b\xce\xa2\x09\x95\x58\xf1\xf6\x1c\xb5\xd0\xa0\x49\x48\xd4\x95\x4a\xa8\x92\x29\x99\xca\xbf\xea\x0a\x13\x29\x8c\x32\x2d" +
+	"\x02\x1c\xc4\x9f\x2a\x07\x99\x71\x02\x75\xa5\x02\xaa\x62\xd6\xbc\xef\x4a\xef\x8e\xbf\x75\x40\x4b\xda\xb4\xf4\xae\x5b\xbe\x81\xa4" +
+	"\xf4\x4e\x44\xe6\x7c\x91\xbc\x3a\x2f\x17\x39\x6b\xc3\xf9\x1a\x25\xf2\x16\x22\xaf\xdf\x13\x25\x0b\x35\x22\x32\xce\x85\x77\xe3\x5c" +
+	"\x62\x91\x12\x52\x04\x58\x23\x71\xd6\x86\x4b\xbe\x0a\x9d\xc7\x2f\xac\x29\x54\x09\x2e\xfb\x2a\x6a\x25\x96\x42\x7b\x68\xc5\x57\xa2" +
+	"\xa3\x14\x44\x64\xaa\xab\xbe\x96\x5a\x91\xb3\x36\x7c\x6d\xde\x2f\xa9\x57\x2c\xec\xb4\x37\xbe\x48\x4f\xf4\xee\xbe\x9a\x9d\xb6\x56" +
+	"\xa3\xa4\x66\xa7\xad\x53\x64\xef\x5b\x3b\x2a\xe3\xa8\xc8\x78\xf8\xd6\x41\x29\x8c\xdc\x30\xef\x6f\x57\x3a\xa9\x22\x62\xd0\xa6\xaf" +
+	"\x46\xe7\x22\x36\xeb\x74\x55\x46\xf6\xcb\x96\xaf\xa7\x44\xe6\xac\x0d\x9b\x76\x79\x6a\xf2\xbd\x5b\x10\x69\xde\xf5\x7e\x72\xf2\xc3" +
+	"\xae\x28\xfc\xff\x09\x3b\xff\x88\x3c\xdb\xff\xff\xdf\xc7\xb9\xd6\xda\x6a\xb5\x5a\xb5\xba\xd6\xba\xbb\xdb\xdc\xde\x3e\x6e\x93\x24" +
+	"\xc9\xe4\x72\x49\x72\x7b\x7b\x9b\x99\x79\xbb\xbd\x7d\xcd\xcc\x6d\xe6\x36\x33\x33\x93\x4c\x92\x4b\x92\x24\xc9\xcc\xcc\xcc\xcc\xcc" +
+	"\xcc\xcc\xcc\x24\x49\x92\x4c\x66\x92\x49\x92\x24\x49\x92\x24\x49\x92\xaf\xe7\xeb\xf8\xfd\xe3\xec\xfa\xbb\x47\xcf\xe7\xeb\xf5\x3a" +
+	"\x5e\xe7\x75\x5d\xe7\x79\x9c\xc7\x71\xa8\x68\x6c\x04\xd1\x74\x9d\x70\xcb\xeb\x25\x95\x3e\xe1\x0e\x92\x9f\x54\x77\x06\x2b\x24\xd5" +
+	"\x63\xea\x04\x98\xe6\x7c\xd6\x7b\x22\x34\xd8\x16\xd2\x17\x1f\x8d\xfa\x44\xe8\x37\x19\x7b\x18\x88\x69\xce\x67\x03\x9e\x8c\x98\xaa" +
+	"\xd3\x2a\x83\x27\xfe\xe0\x8b\x58\xd5\x37\x84\x5c\x72\x81\xa5\xa1\xb4\xbc\x74\xe8\xc4\x1f\xce\x3a\x57\x10\xff\xe4\x9f\x25\x02\xab" +
+	"\x5c\x9f\x9a\x84\xa7\x01\xe2\x99\x24\xc8\x46\x2f\x96\xbd\x97\x7f\x4e\x2f\x96\x7d\x6e\xca\x0c\x44\x85\x09\x67\x41\x6d\x75\x61\xd6" +
+	"\x8b\x13\x7f\x84\xd6\xdc\x2a\x99\xea\xc2\xac\x97\xf1\x19\x29\xa7\x57\xb1\x29\x49\x95\xd7\xb1\x29\x49\xe2\x8d\x47\xd8\xd1\xa2\x74" +
+	"\x6f\x8f\x56\x01\xf2\x4e\x22\x1b\x2c\xbf\xcc\x48\xa9\x22\x9f\xe9\x78\xdf\xfb\x90\x15\x30\x98\x0f\x16\x13\x08\x08\xd0\x47\x1f\xb2" +
+	"\x22\x02\xf3\xc9\x2b\xce\x2c\x3b\x79\x96\x12\x9b\x61\x27\x8b\xa9\x80\x9f\xe3\xc7\x41\x31\x5f\x24\x43\x5e\x34\x9e\x24\x84\xcc\x08" +
+	"\xaa\x2e\xcc\xfa\xea\x33\x2a\x68\xc9\x0c\x5b\x4c\x20\xa0\xea\xc2\xac\x11\x9f\xb1\x03\xaa\x2e\xcc\x1a\xf5\x06\xc3\xb6\x42\x5e\x63" +
+	"\xb1\x03\xa6\x90\xf1\x18\x2b\x33\xad\x89\xf8\xf1\x92\xc8\xe4\x11\xc3\x25\x99\xa9\x13\x7f\xf0\xd5\xe1\xeb\x2c\xbf\x38\xab\xe8\x64" +
+	"\x44\xab\xc3\x07\xa3\xc2\x72\x4c\xc7\xd3\xf2\xf0\x6f\x1e\x82\x5f\x3a\x9c\x91\x4b\xc8\xa7\xe3\x65\x80\x6c\xe0\x87\x8c\x44\x68\x1d" +
+	"\xba\x92\xb9\xae\x67\xf5\x7f\x78\x84\x5c\xa9\x2e\x43\x99\x39\xda\xa6\x25\x9f\xcd\x7a\xc4\x0c\x3b\x59\xa2\x11\xa8\xfc\x8c\x57\xa9" +
+	"\x2e\x3c\x4e\x39\xcf\x1d\x21\x03\x06\x09\xcd\xc7\x86\x2b\x62\x59\xf0\x00\x8a\xc5\x5c\x7a\xbf\x28\x11\xb1\xa4\x4d\xdf\xeb\xc9\xa2" +
+	"\x2c\x59\x22\x0a\x31\x45\x96\xe3\x11\x21\xb2\x22\x09\x4a\xc7\xdc\x03\x40\x56\x76\xd5\x23\x5c\x8d\xb5\x58\x0d\x39\xc2\xeb\xf1\x1a" +
+	"\x32\xd4\x8d\x13\x7f\x04\x36\x23\x50\xf9\x02\xd9\xf4\x10\xaf\x26\x5b\x12\x41\xd5\xdc\x48\x5a\xf2\xd9\xb6\x27\xe1\x0d\xf0\x4e\x3c" +
+	"\x22\x44\x76\x5d\x13\xb7\x20\x7b\x9e\x84\x7f\x5d\xec\x1f\xc1\x08\x99\x83\x13\x97\x33\x6e\xbf\x70\x48\xcc\x0e\x73\xa0\xb3\xb7\x8b" +
+	"\x8e\x69\xa8\x23\x07\xd0\x93\x74\x14\xa3\x44\x7b\x28\x74\xe6\x5c\xce\xfe\x8b\xef\xf5\x80\xd9\xd4\x80\x57\x17\x88\x1d\x66\x23\xb6" +
+	"\x53\x1a\x88\x30\xf2\x55\xc8\xa7\x9b\x07\x53\xe5\xc7\x82\x75\x3c\x64\xd4\x43\xc8\x0e\x73\x18\xb9\xe5\x03\x31\xbd\xc4\x3c\xb9\x16" +
+	"\x96\x21\xa7\xbe\x9c\xcb\xd9\x0d\xbb\xec\x81\xda\x79\xc2\xd9\xd9\x85\x74\xfa\x01\x3d\xe9\xd2\xdb\x53\x28\x1d\x62\xb0\xae\x68\x00" +
+	"\xc8\x2e\xeb\x8a\xe2\x84\x00\x0d\xe6\x5c\xce\x6e\xdc\x65\xf7\xd5\xcf\x1d\xcd\xe8\x1f\x33\x60\x9e\x74\xe8\x9f\x44\xca\x4b\x6e\x85" +
+	"\xf1\x14\xc4\x2e\xeb\x88\x62\x64\xc0\x3c\xe3\xe1\xdc\x0f\x44\xa3\x9c\x9e\x83\x79\xd2\xe1\x44\x6c\x3a\xbd\x00\xb1\xcb\x5c\xc4\x72" +
+	"\x7a\x99\x73\x39\x7b\x84\x3d\x79\x40\x1b\x6f\xd8\x01\xeb\x02\xbe\x22\x68\x87\xb9\x94\xdd\x18\xaf\x89\x7a\x92\x8e\x1c\x4a\x69\xd1" +
+	"\x88\xbd\xc9\xb9\x9c\xfd\x5f\xe1\xe7\xb7\x0f\x65\xf6\x16\xc8\x0e\x73\x18\xdb\xec\x1d\x18\xe9\xe5\xeb\x90\xd5\x7b\x1e\x50\x55\x20" +
+	"\x1e\xd5\x63\x1f\x44\x6a\x2e\x64\x35\xe2\x47\x82\x9e\x5c\x8b\x11\x22\xb3\x4f\x39\x97\xb3\x87\x59\x3d\x5d\x39\xf6\x1e\x28\x3a\xe8" +
+	"\xcf\xc4\x94\x76\x45\x16\xa4\x82\xc6\x98\x7d\x21\xa4\xbe\x2b\x8a\xd1\x01\xf3\x95\xae\x8b\xfa\xfb\xea\xba\x50\x88\x6a\x8e\x61\x42" +
+	"\x4a\x3b\xf4\xc7\x81\x74\x92\x03\x3f\x42\x44\x7d\x47\x14\x56\x01\x32\xca\x63\xb9\xef\x87\xa2\x7c\xc6\x08\x29\xed\xb0\xc3\x35\x7d" +
+	"\xc6\x89\xa8\x77\x08\xcb\x67\x02\x7d\x5a\xaf\xaf\xe1\x40\xe5\x26\x81\x94\x1a\x97\xa7\x57\xb8\x29\x10\xf5\x5d\x51\x8c\x0a\x42\xf9" +
+	"\x86\x2b\xaf\x5e\x5f\xbe\x7e\x3a\xd3\x20\x4a\x8d\x4b\xd3\xcd\xe6\x3b\x80\x7a\x07\xb0\x92\xf9\x41\x71\xe8\x2b\xd7\x37\x99\x01\x51" +
+	"\xda\x61\x47\x6a\x9a\xcc\x02\xa8\x77\x00\xcb\xe4\x27\xba\xbf\xae\x43\x77\xbf\x5c\xf4\x27\x81\x39\x00\xf5\x9d\x36\xa0\x24\xa8\x61" +
+	"\xe7\x81\xd4\x75\x86\x35\x88\x58\xc8\xb9\x9c\xfd\x95\xd5\x5d\xd5\xfb\x66\x49\x44\x2e\xc7\x5c\x24\xa2\xfe\x9a\x4d\x90\x8f\xba\x30" +
+	"\x96\x88\xa9\xbb\x16\x56\x21\x64\x99\x42\xb9\xea\x45\x22\x6d\x56\xf0\xf7\xfa\x6b\xd6\xdf\x6d\x93\x55\x10\x75\x36\x61\x59\xac\x51" +
+	"\xbb\xb7\xeb\xef\x50\xf9\x12\x90\xee\xb1\x75\x42\xb6\x8c\xef\x59\xb9\x09\x91\x66\x36\x88\x69\x4f\x47\x61\x1d\xb2\xda\xc4\x17\x64" +
+	"\xbb\xfa\x0a\xf5\x8d\xb6\x00\x6c\x31\x8b\xb0\x6d\xb6\x41\x08\x17\x4f\x83\x4c\x76\x78\x20\xfa\xeb\x53\x22\xea\x43\x6b\x57\xa4\x63" +
+	"\x23\xb2\x28\x84\xec\x11\xd2\x7e\x2d\x28\x42\x36\xfb\x39\x97\xb3\x13\x5b\xe2\x2b\xc4\x8c\x55\x36\xda\x01\x80\x6d\xd6\x69\x01\x76" +
+	"\xa3\x1d\x02\xd9\x62\x9d\x41\x0d\x72\xe9\x38\x79\x39\xbb\x61\x8b\x5d\x55\x0d\x2f\x09\xd9\x00\x9d\x00\xb6\xd9\x35\x0b\xb0\x3b\xa0" +
+	"\x0b\xc8\x16\xbb\x16\xd4\x20\x97\xf4\x49\x8a\xe3\xaa\x1b\x86\x34\xe9\xc6\xdf\x85\x89\xfc\xbb\xed\xd1\x03\x62\xcb\x26\x2c\x8b\xde" +
+	"\x93\x97\xb1\x8d\x95\x71\x61\xba\xf5\xea\x03\x81\x7a\x59\x84\x5d\xb0\x7e\x30\x5b\xac\x33\xac\x42\x46\x03\x27\x71\x51\x6d\x31\xe3" +
+	"\xda\x74\xd3\x19\x24\x64\x9b\x5d\xb3\x11\x3b\xa3\x21\xa1\x73\x2d\xac\x43\x5e\x4f\x79\x38\xfa\xf2\x74\x9d\x9e\x01\x90\x46\xe1\xca" +
+	"\x3d\x3f\x79\x39\xc3\xfe\x5e\x2f\x4e\x5e\x16\xbb\x8c\x01\x29\xeb\x8a\x0a\x4a\xf4\x3e\x63\x62\x32\xee\xa5\x62\xb0\x13\x99\x8f\x3c" +
+	"\x28\x38\xf7\xca\x96\x51\xbb\x95\xb5\x9f\x56\xd3\x82\xaf\x7d\x64\x98\xe5\x94\x59\x1b\x9a\xbd\x39\x79\xd9\xd9\xf3\x4c\x21\x23\x2c" +
+	"\xe7\x1c\xf6\x3c\x7b\x6b\x21\x14\x0c\x31\xa5\xa7\x23\x2e\x53\x55\x90\xf5\x4e\x31\x3b\xec\xf4\x59\x07\x22\xa1\xaa\x82\xac\xf7\x81" +
+	"\xcc\x6d\xa5\x07\x05\xe7\x3e\x1c\xe1\x26\x23\xfa\x68\x0b\x21\x31\x2c\xa9\x32\x0a\xf8\xc9\x52\x71\x81\x07\x05\xe7\x3e\xdb\x12\x68" +
+	"\x1a\xb9\xe6\x4a\x6a\x7c\xb1\x34\x88\x50\x83\x40\x79\xdf\x2f\x28\xfd\x1a\x90\xb1\x46\xea\x7e\x41\xe9\xb0\x62\xa8\x36\x6a\xa4\xcc" +
+	"\xac\x47\xbc\x78\x15\x22\x02\x1e\x8d\x27\x64\x30\x63\x7e\x59\x2c\x95\xfb\x05\xa5\xe3\xf1\xc3\x2d\x55\x26\x7c\x15\xc5\x08\x95\xc9" +
+	"\xa3\x46\x5b\xa6\x34\x75\xc4\x40\x8a\x9c\xbe\x1d\x81\xc8\x70\xa6\x03\x4d\x63\x0f\xc3\x83\x82\x73\xdf\x7d\x21\x9b\xa9\x2a\xc8\xfa" +
+	"\x11\x53\x1d\x5c\x30\x52\x67\xe6\xe4\x65\x67\xfb\x3e\x6c\x04\xa5\xb6\xef\xc3\x05\x3e\x6b\x22\x9d\x51\x41\x19\xed\xf0\xd7\x85\x1f" +
+	"\x71\x62\x87\xbf\x9f\x1e\x61\x89\xd4\x9f\x8e\xe6\x24\x21\xde\x2f\x91\x3b\x41\xe9\x6f\xbd\x79\x93\xb0\xf7\x11\x24\xa6\xfe\x74\xb4" +
+	"\xe0\x21\x96\x4a\xfd\xe9\x68\x31\x98\x8d\xe5\xb3\x14\xcc\xc6\xf2\x59\xf6\x10\xd7\x67\x45\x12\x89\x9c\x63\x4e\xb0\xc3\x2c\xa7\x1c" +
+	"\xc8\xaa\x87\xb8\x35\x59\x33\x09\xb2\x29\x3d\x7d\x9c\xd7\x95\x44\xb6\xd9\xe9\xe2\x75\x8f\xb1\x6a\x0f\x64\x23\x88\xe8\x58\xc0\x6c" +
+	"\x7a\x8c\x15\x0c\x90\x2d\x89\x20\x69\x8c\x10\x8c\x90\xb3\x88\x76\xdb\xfd\xbb\x5b\x93\x1d\x13\xa0\x84\x91\x8e\xe5\xb1\xeb\x21\x2a" +
+	"\x54\x52\x41\x1c\x7b\x41\x46\x06\x02\x62\xdf\x23\xac\x50\x80\x1c\x78\xc5\x77\xb2\x39\xf4\x00\x37\x9d\x8e\x53\xa1\xd1\xb1\xd2\xe9" +
+	"\x3c\x15\x5b\x79\x15\x49\x57\x90\x31\xd3\x49\x9f\xba\xec\x6c\x7f\x39\xc2\x72\x12\xf4\x1d\x7b\xac\xaa\x20\xbb\x78\x07\x4f\x5d\x2c" +
+	"\xe6\xaf\x9c\x72\xce\x60\x8f\x4c\x89\xf4\x48\x64\x9b\x9d\x3e\xa7\x65\x4c\xa4\x57\x22\x62\x6d\xa2\x61\x24\x77\xda\xec\x3b\x22\x18" +
+	"\xc9\xf4\xbb\x32\xff\xcd\x39\xa6\x09\x18\x0d\x78\x2a\x1e\x32\x68\x21\x52\x45\x6e\xfa\x09\x91\x21\x2f\x21\x97\x78\x1a\x1b\x08\xf6" +
+	"\xa8\x43\xa8\xcf\xe2\x03\x91\xc8\x73\x53\xc4\x75\xc1\xf6\x72\x41\x89\xce\xa8\xa0\x94\x46\x07\x12\x2f\xe3\x24\x24\xf0\xca\xd2\x48" +
+	"\xe4\x30\x7f\x6c\x5e\xbb\x1a\x1e\xf1\x46\x12\x54\x0f\x25\xa2\x22\x81\xca\x5b\x2f\x58\x9f\x79\x17\x1f\x8c\x1c\xe1\xf7\xb1\xc1\x48" +
+	"\xe2\x83\x49\xa0\xf4\xea\xe9\x93\xdc\x1f\xf0\xa3\x37\xc0\x84\x98\x22\x9f\xe2\x45\x24\xf2\x39\x76\x88\xc5\xf0\x7c\xf1\x6c\xdc\xe2" +
+	"\x7f\x8d\x95\x90\xc4\xf0\xa9\x1a\x6c\x25\x70\x93\xde\xbb\x47\x45\x68\x81\x36\x66\x55\xb1\x95\x2e\x2d\x5d\x1c\x89\x47\xb0\x2e\x11" +
+	"\xef\x14\x8f\x4a\x04\x6f\xd4\x2b\x04\x8b\x1b\xa5\xc8\x98\x45\x60\x49\xbb\xda\x84\x9a\x8c\x6a\x72\xa3\x71\x89\xdc\xc9\xcd\xc6\xf8" +
+	"\xf9\xc4\x84\x24\x28\x12\x25\x62\xae\xb3\x9c\x94\x08\xf9\x28\x15\x13\x99\x8a\x57\x11\x3e\xdf\xe2\x45\x04\x31\x6d\x69\x20\x61\xfc" +
+	"\x94\xd1\x45\x81\xcf\xf7\x23\x98\xea\xc2\xe3\xf4\xc2\xf6\x0f\xaf\x70\xa4\x63\x86\x3b\x23\x11\x94\xc5\x58\x85\x8f\x47\xf6\x28\xca" +
+	"\x6c\x6c\xed\x05\xf0\xd3\x02\xa4\x82\x0a\x03\x1e\x73\x5e\xa8\x1e\x32\x1f\xaf\x22\x7c\x16\xe2\x45\x04\xb1\xe8\xa6\xe2\x96\x75\xc9" +
+	"\xcb\xc5\x25\x96\x2d\x42\x69\x28\x17\x64\xb3\xe2\x05\xe2\x33\xab\x47\xe8\x08\xab\x35\x4b\x46\x66\x2c\x63\x81\xc8\x7a\x2c\x21\x2f" +
+	"\x8c\x0d\xcf\x06\x1a\xaa\xa7\x21\xb2\x79\xaa\x46\xbf\xc0\x8e\x69\x5a\x7a\x65\xed\x7a\x61\x96\x7c\xa9\x6d\xcb\x04\x30\xb9\xca\x8a" +
+	"\x73\xb3\xf5\xab\x73\x58\x96\xb6\xed\x69\x58\x48\x6d\x6e\xb4\x23\x89\x44\xce\x31\xfb\xad\x37\xba\xcf\x82\xcf\xae\x89\x60\xde\x99" +
+	"\x7c\x30\xad\xc3\x11\x18\xed\x79\x32\x36\x53\x9b\x1b\xed\x7b\xb1\x78\x4e\x07\xc1\x8c\x6c\xa7\x43\x4f\xc6\x73\xea\xc8\x15\x48\x43" +
+	"\x0e\x7f\x05\x00\x2b\x19\x30\x9b\xa5\xb7\xb5\xef\xf4\x10\xb3\xb8\x20\xba\x4c\xc2\x2e\x0c\xa9\xd4\xe6\x46\x69\x0f\x71\xab\xdb\x1d" +
+	"\xb4\xd1\x22\x30\xea\xf1\x18\x4b\x05\x48\x6f\xae\x5b\x17\x24\x64\x21\x7d\x1e\xa2\x9c\x68\x90\x20\xd3\x6f\x32\x34\x90\x60\xa8\x74" +
+	"\x22\xde\x01\x8f\xf0\x8a\x3b\x18\x34\x52\x22\xf0\x19\xf2\x10\x5b\x05\xcc\x53\x2f\x6b\x2f\xa5\x67\xf1\xc5\x53\x32\xcf\x4d\xc6\x8f" +
+	"\xa6\x36\x37\x7a\xe1\x11\x5e\x4a\x2f\x83\x46\x56\x4a\xaf\x72\x6b\xf4\xc4\x25\xde\x0e\xc2\x2f\x9e\xe3\x77\x72\xf9\xaf\x5a\xbc\x5c" +
+	"\xf0\x5a\x12\x58\xb2\x4a\x8f\x4a\x09\xc1\xa3\x52\xdc\xb9\x00\x79\x63\x8a\x58\x84\x14\x79\x1b\x6b\x43\x1a\x78\x88\xf9\x4e\x22\xea" +
+	"\xd8\x06\x3b\x14\x30\xef\xfd\x58\xf0\x33\x42\x0b\x55\x17\x66\x7d\xf0\x82\x51\x88\x8c\xe6\xa3\x25\x83\x57\xca\xd4\x3c\x90\x92\xf9" +
+	"\x64\xca\xd8\x88\x94\xf9\x1c\xef\xa4\xb2\xfa\x22\x19\xca\x2a\x10\x0e\xd2\xfa\x6a\x0a\x51\xe6\xb6\x17\xde\x38\x19\x8e\x49\xbd\x38" +
+	"\x37\xd2\x42\x23\x16\x14\x50\x02\x34\xea\xb9\xc9\x3a\x37\xe4\x30\x0a\x7a\xcc\x97\xf1\x86\x62\x3c\x76\xcc\xa5\xca\x84\xa5\xe2\xb7" +
+	"\x05\x98\xc9\xf8\x41\x87\x0c\x12\x9f\x8a\x0d\x57\xd5\xe6\x9b\x89\x90\x11\x7e\xc3\xea\xe1\x84\xd3\xb4\x3f\xe6\x04\xa1\x7e\x72\xcc" +
+	"\xbf\x5b\x4c\x40\xa8\xba\x30\xeb\x87\x17\x8e\xaa\xb0\xf2\x9a\x39\x62\xac\xa4\xce\xec\x11\x43\x25\x99\x9f\xb9\x35\xce\x02\x6d\x73" +
+	"\x0d\x3d\x3e\x6d\xe6\x38\x51\x1d\x24\xf0\xc8\x67\x1e\xc0\x1d\x5b\x01\xcf\x8d\x94\xc2\x42\x6e\x4d\xce\x30\x93\x26\x78\x66\x66\x2d" +
+	"\xd4\x87\xc9\xa2\x40\xaa\x83\x08\x5c\x96\x88\xb8\x73\xc3\x02\x2c\x9b\x65\xc4\xb1\xc4\x86\x22\x2b\x12\x68\xe8\x2f\x8c\x15\xce\xa4" +
+	"\x59\x98\x81\xd1\x2a\x90\x3b\x8e\x8a\x7a\x0e\x46\x4e\x6b\xb9\x35\x39\x8d\x4b\xec\xba\x7a\xfb\x52\x8a\x20\x16\xbc\x97\xba\x0e\x60" +
+	"\x99\xdd\xb0\x00\xfa\x61\xae\xa2\xdd\xe0\xc8\xf5\x30\x02\x91\xcd\xdc\x9a\x9c\xc4\x12\xe3\x07\x83\x98\xa1\xca\x77\x68\xb7\x00\x2c" +
+	"\xb3\x01\x0b\x50\xef\xd0\x92\xcb\x36\x47\xfa\xc3\x08\x44\x76\x10\x87\x74\x51\x81\x98\x36\xbb\x20\xa4\x8d\x1d\xaa\xf2\xd9\xe3\x4c" +
+	"\x7f\x0c\x03\x99\xfd\xdc\x9a\x9c\xff\x2e\xb1\x1b\x78\xc0\x28\x23\x91\x35\x23\x8d\x03\x0e\x54\x87\x00\x0c\xcc\x21\xfe\x7e\xc7\xfa" +
+	"\x7f\x6b\xfc\x3b\xf2\x6a\x72\xbe\x32\xe1\xe0\xb6\x10\x11\x9d\x82\xa8\x0e\x11\xb0\xe8\x22\xe0\x8e\xa5\x60\x79\xa4\xf3\x28\xc6\xa1" +
+	"\xc8\x0d\x52\x37\x47\x37\x47\xd2\x2c\x88\xc0\xa5\x07\xc4\x1d\x5b\xc3\x6e\xb0\xde\xbc\x9a\x9c\x51\x56\x73\x5d\x7f\x66\xe1\x8d\x5a" +
+	"\x19\x29\xaa\xd9\x47\x44\xed\x0d\x9b\xb0\x5b\xac\x9f\x33\xd7\x63\x18\xc8\x0c\xe4\xd5\xe4\xfc\x55\xd3\xaf\x9b\x5d\xfa\xc8\x51\x1b" +
+	"\x04\x50\x3b\x60\x03\xf6\xd8\x0f\x11\xd2\x1f\x83\x40\xe4\x29\x05\x22\x6d\x54\x24\xa6\xcf\x33\x42\x6a\x07\x1c\xc4\x76\x7a\xce\xa1" +
+	"\xfe\x38\x08\x42\x2f\xf2\x6a\x72\x1a\x6b\xf4\x65\xe5\x16\xee\x25\xfe\x5e\xab\xaf\x4c\xbf\x6c\xaf\x88\xb8\x1e\x26\x20\xf1\x3a\xaf" +
+	"\x26\x27\x51\xa3\xaf\x29\x37\x97\x37\xf8\x7b\xad\xbe\x2c\xfd\x44\xde\x12\xd1\x1f\x26\x20\xf1\x0e\x31\x08\x8b\x60\xbd\xde\x03\xa8" +
+	"\x1d\xb0\x01\xdb\xe4\x03\x21\xfd\x31\x08\x5c\x3e\x52\xbb\xd7\x76\xa9\xb9\x0c\x8b\x40\xab\x7e\x22\xa2\xb8\x3f\x32\x09\xf4\xaa\x94" +
+	"\xf8\xcc\x81\x2e\x16\x02\xa0\xf0\x25\xaf\x26\x67\x84\xd5\xf2\xcb\xc1\x0e\x43\x5d\x10\x5f\x89\x29\x1e\x8c\x2c\x46\x5d\x12\xf0\x19" +
+	"\xe6\x48\x9a\x85\x11\x38\x8d\x50\x28\xb5\x69\x2b\x14\xdb\x68\x94\x10\x61\x64\x46\xab\x7c\xc6\x38\x91\x66\x41\x02\x1a\xe3\x68\xf7" +
+	"\x15\xe3\x1b\x93\xee\x72\x65\x8b\xd1\xa7\xc4\x04\x47\xaa\xc3\x08\x44\x26\x41\xdc\x75\x34\xac\x4f\x9a\xa9\x3c\x7c\xdf\x49\x1f\x35" +
+	"\xd9\x62\xf9\x7c\x13\x4c\x75\x98\x81\xd1\x34\x21\x77\x6f\xd8\x84\xe5\xf4\x1d\xb1\xac\x98\xdf\x9b\x4a\x45\x7f\x22\xfd\xe0\x50\x9a" +
+	"\xc5\x40\xf0\x9a\x01\x73\xd7\xd5\x51\xc5\x23\xb3\xd9\xbc\x9a\x9c\x86\x55\xd6\xc5\xd0\x95\x2a\x1e\xab\xe7\x7e\x02\xb9\xdb\x1f\x59" +
+	"\x84\xd9\x73\x73\x04\x74\xb1\x20\x80\x40\xe6\xd1\xf9\xab\x2c\xcd\x54\xeb\x6b\x17\x15\xed\x02\xa0\xbb\x83\xfa\xf2\x50\x32\xaa\x19" +
+	"\x16\x09\x49\xb3\x18\x04\x56\x4b\x88\x45\x5a\xa9\x60\x6c\xa7\x65\x30\xc2\xc9\x8a\x57\x19\xad\x10\x91\x66\x61\x02\x1a\xab\xd4\x97" +
+	"\x28\x9c\x6c\x4c\xbf\x70\x6b\xc4\xdc\xd5\x17\xab\x57\xb9\x75\x4e\x74\xb1\x20\x01\x8d\x0d\xba\xce\x56\xc5\xd7\x57\x5c\xed\x36\x89" +
+	"\xba\x6b\x5c\xb0\x7e\x4e\x5b\x9c\x49\xb3\x18\x06\x3a\xdb\x22\xab\x74\x20\x2b\x05\xed\x10\x24\xcc\xc2\xf5\xdb\xe5\x48\x9a\x85\x11" +
+	"\x58\xed\xe5\xd5\x3a\x87\x7e\x61\x05\x00\xcd\xae\xe0\x71\x37\x6d\x28\xbe\x2f\x19\x6c\xd2\xab\x19\x1c\x0b\x22\x91\x83\x23\x64\xe4" +
+	"\xc1\x20\x87\x92\x69\xcb\x3b\x6e\xab\x48\xa2\xe3\xb4\x43\x98\xa7\x8f\xa4\xf2\x58\xe7\xe9\xa0\x4b\x97\x78\xca\x4c\xc1\x76\x79\x0c" +
+	"\x44\x14\x02\x9b\xb4\x6b\xe3\xa5\xd3\x1d\x17\x88\x94\xe8\x09\xba\xa8\x74\x11\x48\xef\x69\xb7\x6a\x66\x36\x20\xfa\xe2\x45\xae\x15" +
+	"\xf0\x5d\xbc\xfb\x25\x82\x40\xfe\x77\xb2\x58\x1f\x94\x88\x29\x1e\xec\x4a\x3e\xe0\x12\x9e\xc6\xa0\x24\xc8\x46\x89\xc8\x48\x60\x33" +
+	"\xe4\x21\x6e\xac\x4f\x5d\x1b\x1d\x88\x14\x79\x16\x1b\x89\x14\x79\x7e\x24\x91\xca\x63\x2f\x5c\xc0\xef\xa2\x97\xb1\xa1\xca\xb1\x79" +
+	"\xe5\x11\xde\xf8\xbe\x76\x7d\x54\x20\x52\xe4\x8d\x25\x52\x74\x32\xf2\x9b\xe4\xad\x44\x68\x80\x15\x62\x75\xe3\xbb\x78\x19\x99\xd0" +
+	"\xfb\xd3\xb5\xce\x4a\x73\x2c\x73\xc0\x92\x4e\x5a\x6c\x86\x57\x15\x3e\x28\x04\x87\x36\xd0\x34\x29\x2d\xa8\xc0\x26\xe2\x1c\xaa\x2a" +
+	"\xc8\xfa\x68\xe9\x04\x98\xfb\x05\xa5\x9f\x2c\x06\x13\xb2\x6a\xd9\x2d\x2d\x38\xc3\x27\xc2\x67\xc5\x90\x99\x5c\xbc\x4b\x5e\x72\x3d" +
+	"\xec\x17\x4b\xc7\x47\xf0\xe3\x25\x3e\x2d\x89\x0c\x1f\x95\x96\xb4\x1a\x39\x22\x2d\x29\x34\xaa\x18\x9c\x37\xa1\xd6\x1b\x63\x63\x6e" +
+	"\xa5\x33\xe6\x33\x76\x40\x78\x89\xc5\xf7\xb2\xcb\x53\x55\x90\x35\x91\x29\x9e\xaa\x82\xac\x49\xc5\x50\x3c\x01\xa1\xfb\x05\xa5\x53" +
+	"\x01\xc8\x56\xba\x5f\x50\xfa\xcd\x86\x68\xc4\xfe\x77\xf2\x18\x65\x26\xbe\x9a\xa6\x03\x88\x32\x13\xcc\x77\x6f\x30\x20\x22\x81\xfb" +
+	"\x05\xa5\x3f\xe2\x07\x54\x10\x33\x7e\xfd\x4c\x8d\x07\x05\xe7\x66\x7d\xc2\x12\xc1\x6f\x98\x18\xc4\x48\x67\xee\x88\x71\x12\xc8\xbc" +
+	"\x3f\x04\x56\xc2\xd8\xae\xe5\x88\x51\x12\xc8\x62\xa0\xfe\x6e\xdd\x96\x4e\xd7\xea\xf7\x88\xf1\x86\x10\x0e\xc1\xc0\x0c\x2d\x2d\xfc" +
+	"\xc3\x9b\x7b\xcb\x92\x28\x3a\x79\x8c\xde\x0f\x52\x6f\xd6\x72\x04\x47\xbb\xac\x78\x2a\x36\x83\xc3\x86\x4c\x19\xbc\xec\xe5\x19\xad" +
+	"\x99\x22\x3e\x31\xc7\xf2\x4a\xd6\x8f\x46\x10\xca\x86\x44\xf0\x9b\xcb\x47\x92\x79\x6c\xd3\x13\xf1\x13\xda\xf2\x54\xbc\x84\xb6\x4d" +
+	"\x19\xdc\x51\xb8\x3e\x3b\xa6\x86\x07\x20\x9d\x5d\xaf\x26\xca\x05\xcb\xef\x20\xb2\x67\x22\x54\xd9\xca\xbc\xe3\x34\x0f\x4f\x0b\x06" +
+	"\x29\xd8\x7d\x8f\xb1\x65\xc0\x1c\x78\x23\x84\x68\xe4\x2b\xa5\x70\x3a\xf4\x54\x2c\x02\x22\x1d\xf9\xae\x08\x8c\x28\x18\x21\xd2\x99" +
+	"\xef\x8a\x58\x04\x44\xba\xf2\x43\x63\xa8\x7c\x50\x97\xb4\xa9\xe2\x23\x38\x6e\xc8\x14\x41\x6d\x61\x83\xda\x8a\xbf\xf7\x98\x7f\x27" +
+	"\x05\x94\x4d\x29\x20\x8e\x5e\x0f\x31\x35\x40\xf4\x79\x61\x38\x2e\xfd\xf9\xa1\xc1\xb1\x5c\x06\x3c\xc4\x75\x19\xcc\xaf\x0d\x9c\x24" +
+	"\x64\x9e\xf2\x34\x04\x62\xde\x21\xe8\x37\x83\x42\x9e\x02\x59\x64\xd7\x82\x22\x78\x0b\xe9\x59\x7e\xed\xa9\x9f\x4c\xda\xd0\xab\x66" +
+	"\x9e\xcf\x73\x62\xe6\x5d\xc6\x76\x7a\x21\x84\xae\x85\x85\xe0\xf5\x92\x07\xd3\x15\x79\xd1\xe0\x8d\x27\xb2\x7a\x05\x64\xde\x45\xd4" +
+	"\x5b\x51\xc4\xbc\x06\xb3\xc8\xd2\x61\x19\x18\xbd\xc9\xaf\x3d\x35\xcb\x1e\xf3\x78\x8d\x65\xb0\xff\xe4\xeb\x13\xa5\xde\x12\xb3\xc0" +
+	"\xaa\x1c\x48\x06\x4c\x0f\xf9\xdf\x11\xf4\xd8\x65\xa4\x10\x31\xef\x11\xce\xe3\x0e\x1d\x8d\x42\xf4\x05\xfd\x01\xcc\x02\xbb\x6f\x33" +
+	"\xb0\x32\x96\x3f\x83\x79\x7c\x3f\x46\x86\xac\x3e\xf1\x70\x3a\xa2\x40\x3c\xda\xec\xb3\xc8\xec\x7e\x20\x33\xad\xf5\x85\xa8\xc7\x2e" +
+	"\x64\x1b\x7e\x45\x4c\x29\xdd\x60\x78\xb7\x4c\x16\x88\xc6\x62\x18\x40\xd2\x06\xec\xbe\x18\x01\x91\xba\x16\x94\xc0\x58\x8d\x52\xdf" +
+	"\x08\x0f\xf5\x2a\x9d\x65\x32\x46\x48\xd2\x41\x6c\x9b\x71\x62\x52\xd7\x6c\xc6\x34\x9a\xa0\x40\x8c\xce\x92\x80\x6a\xac\x49\x10\x49" +
+	"\x87\xb0\x7b\x6f\x0a\x48\x2a\x1d\x16\x81\xcb\xb7\xfc\xda\x53\x3f\x58\xdb\x55\xb5\xf8\x1c\xbd\x29\xfb\x85\x5c\xa6\x89\x58\x64\x55" +
+	"\x16\x22\x23\xa5\x71\xfe\x4e\x48\x5b\x55\x50\x84\x88\x1f\xf9\xb5\xa7\xfe\xd7\xd6\x11\x81\x90\x57\x80\xdd\x05\x33\x20\x16\xd9\x7d" +
+	"\x93\xb0\x3b\x60\x16\x44\xdb\xfd\xa0\x04\x99\xfc\xe4\x61\x74\x44\x5e\x1c\xda\x66\x4e\x64\x73\xdf\xcb\x46\xeb\xcc\x13\xd3\x76\x3f" +
+	"\x2c\x43\x56\x0b\x84\x3c\xd6\x65\x53\xcd\xa8\xea\xb6\x48\xc8\x82\x51\x37\xd5\xd5\xaa\x2c\x4b\xc4\x3c\xae\x0a\xcb\x10\xb2\x8c\xac" +
+	"\x1f\xeb\xca\x29\x42\xe7\xb4\x02\x64\x41\x97\x2e\x70\xf5\xac\x02\x79\x7c\x3f\x2c\x42\xc4\x1a\x0f\xc5\x28\x5e\xc0\x69\x5d\xe4\x74" +
+	"\xdf\xcf\x49\x2b\x6d\xe4\xd7\xea\x75\xff\xbe\x10\xb9\x6d\xe6\xd7\x9e\x9a\x61\x49\x62\xec\x2b\x44\x33\x5b\xc4\x54\x76\x46\x16\xa4" +
+	"\x74\xd0\xbd\xdb\x84\x24\x3b\xa3\x18\x1d\x30\x3b\x14\x4e\xf2\x81\x0a\x47\x21\x6a\xfa\x74\x97\x90\xca\xb4\xce\x5d\x3a\xc9\xcb\x64" +
+	"\x8f\x88\x64\x3a\x0a\xab\x00\xd9\xe7\xb1\x3c\xf0\x43\x51\x3e\x07\x84\x54\xa6\xed\x70\x4d\x9f\x43\x22\x92\x0e\x61\xf9\x74\x14\xd4" +
+	"\x9e\x2a\x5a\x64\x57\x71\xbe\xbc\x79\xa1\xa8\xc6\xeb\x04\x30\x6f\x03\xf6\x87\x4f\x17\x88\x45\x76\x2d\x24\x81\x28\xd2\x05\xb5\xa7" +
+	"\x2e\x0b\x0b\xef\x9b\x91\x3c\xba\x41\xcc\x3b\x84\x6d\xd2\x03\x44\x98\x78\x22\x70\xe9\xe5\x51\x74\x45\x6e\x18\xea\xd3\xab\x0f\xc4" +
+	"\xbc\x43\xa8\x62\x10\xd2\x0f\x04\x5f\xae\x21\x11\xb8\x0c\x20\x8c\x39\x76\x5f\x85\xa1\x9f\x87\xe8\x56\x1b\x04\xf4\xb8\x53\xbf\x41" +
+	"\x6d\xb7\x2c\x74\x86\xb8\x4e\x67\x14\x27\x04\xe8\x69\x41\xed\xa9\x3f\xe6\xd8\x03\xf5\x32\x89\x66\x54\x13\x3c\x03\xf3\x38\xad\x5f" +
+	"\x38\x51\x5e\x32\xe4\xe7\x20\xe6\x98\x8b\x28\x19\x30\x2f\x78\x38\x0f\x02\xd1\x28\xa7\x97\x60\x1e\xa7\x9d\x88\x4d\xa7\x57\x20\xe6" +
+	"\x98\x8b\x58\x4e\xaf\xc1\x24\x75\xfd\x02\x57\xea\x1b\x20\x95\x46\x69\x64\x5b\xab\xc2\xbc\x05\x91\xec\x8c\x62\x54\x10\xcb\x3b\x64" +
+	"\x9d\xd4\xa5\x53\x84\xca\xe7\x3d\x88\x4a\xa3\x2c\xee\xd5\xf3\x01\x40\xd2\x01\xac\x64\x3e\x52\x1c\xba\x6a\xbe\xc9\x27\x10\x95\x69" +
+	"\x3b\x52\xd3\xe4\x73\x41\xad\xf3\xb6\xbd\x6b\xf2\xa5\xa0\x4e\x9f\xe2\xfd\xa8\x28\x9b\x95\x9e\x3e\x9e\xe8\x8d\x8a\xce\x9e\xfd\xc6" +
+	"\x4e\xd1\xbb\xc0\x5f\x5d\x42\x9d\x64\x2c\x89\x61\x93\xc0\xd9\x64\x8e\x08\x5e\x5c\x1e\x31\x19\x1c\xb6\xe6\x1b\x8d\x7a\x08\x9c\x28" +
+	"\x16\x71\x2a\xf0\x98\x49\x58\xc7\x37\xf3\x68\xf1\x3e\xf0\xb8\xc7\xe0\x57\x84\x3c\x37\x98\x90\x89\x0c\xf1\x82\x99\xf4\x18\x2b\x18" +
+	"\x20\x53\xa1\xd2\x29\xa3\xba\xd3\xd1\x37\x17\x80\xc4\xdf\x45\xc7\xe4\xdf\xa7\x83\x71\x28\x05\x94\xed\xbb\x44\x9a\x4e\x95\x3a\x19" +
+	"\x63\x17\x6f\xa8\xfc\xf0\x10\x37\x8e\x19\x93\x40\x1c\xe2\x77\x15\x6d\x24\x4f\x15\x99\x75\x09\x33\x52\x00\x3f\x43\x80\x0e\x03\xc8" +
+	"\x9c\x8b\x58\x61\x80\x98\x0f\x26\x63\x0e\xf0\x82\x47\x78\x5d\xb2\x18\x4a\xc6\xb2\x59\x8a\x8d\x55\x8d\xee\x72\x08\xb1\x06\x77\x45" +
+	"\x12\x65\xa7\xb2\x62\x22\x59\xf5\x10\xdb\xa8\xee\x74\xb4\x66\x22\x81\xae\xc7\x10\xaf\x17\xd4\x89\x53\x9a\xb0\x36\x80\x8e\x5f\xa5" +
+	"\x73\x1b\xb2\x4b\x4f\x47\xb4\xe3\x38\x4e\xee\xdb\x50\x90\x38\xf4\x86\x23\x38\xd2\x86\x90\x87\x45\x67\x37\x6d\x1d\x9f\xb9\x54\x74" +
+	"\x6c\xcb\x67\xe8\x6c\x24\x58\xc9\x33\x5c\xb6\x15\x03\x2b\x9c\x25\x6a\x1f\xd6\x84\xb3\x06\x77\x6c\x9d\x00\xf4\xb0\xe8\xec\xee\x11" +
+	"\x66\x32\xa0\xbd\x23\xcc\x64\x62\xfb\x47\x99\x49\xa1\x03\x4b\x88\x8a\xa8\x18\x0a\xe8\x52\xd1\xb1\x43\x5b\xc8\x86\xa4\x50\xc7\x19" +
+	"\x09\x61\x51\x82\x8e\xfa\xc9\xe9\x32\x95\x59\xe7\x19\x77\x34\x14\x22\x83\xee\x8a\x47\x64\x38\xe9\x33\xe1\xfa\x28\x99\x4b\x45\xc7" +
+	"\xba\x3d\x19\xbc\x88\x83\x78\x9b\x4e\x31\xf8\xf4\xf8\x22\x92\x90\xd1\xf6\x2a\x84\x32\xa2\xfa\x89\x97\x60\xb0\xd7\x39\xc6\xb3\xef" +
+	"\x8c\x37\x0c\x92\x90\x43\xde\x1f\x8f\x88\x50\x06\xce\xf8\xe3\x64\xa9\xe0\x18\xa2\xd8\x7c\x64\x55\x86\xe2\x13\x92\x45\x79\x7a\x44" +
+	"\x42\xd2\xe8\x59\x7c\xb8\x72\x88\x9e\xc7\x23\x32\x98\x17\x67\xea\x9c\xe5\xd1\x38\x18\x83\x0e\xbc\x95\xbf\x0c\x5f\x5a\x48\xd9\x29" +
+	"\x46\x67\x5e\x9c\xbd\xad\x7f\x3b\xbe\x92\x04\x5e\x51\x52\x1a\x06\xf0\x5a\x02\x58\x70\xab\x25\x7a\xc4\x71\xe1\xb4\xf2\xf1\xcd\x11" +
+	"\x91\x48\xe6\xad\xa5\x13\x72\x7a\x67\xa9\xfc\xe7\x54\x89\x47\xbc\x0f\xfb\xe0\x14\x60\x89\x7c\x90\x88\x78\xa3\xca\x27\x3e\xc6\x07" +
+	"\x22\x63\xfd\x14\x1f\x89\x44\x3e\x5b\x2a\x28\x0b\x0e\xb9\xe0\x29\x5f\x2a\xca\xa6\x15\x7a\x5f\x2c\x19\xc5\x98\x32\x5f\x8f\x90\x91" +
+	"\xcc\xf0\x99\xba\xc0\x32\x78\x85\xd4\x9f\x8e\x46\x3c\x15\x97\x18\x75\x87\xd9\x5c\x49\x5f\x7f\x3a\x1a\xf3\x42\x75\x80\xf1\xf8\x20" +
+	"\x90\x2e\xe2\x9c\x88\x8f\x42\x22\x93\x16\xe2\xc6\x81\x9a\x4d\x05\x7d\xd4\xf8\x41\xe4\x5b\xac\x88\x24\xa6\xe3\x23\x91\x3e\xdf\x63" +
+	"\x13\x96\x22\x3f\xe2\x45\x24\x32\x73\xa6\x2e\xee\xc0\x70\x71\x80\xf6\x2c\x88\x7a\x87\xc0\xe4\x83\x04\x7e\x02\xa8\xeb\x89\x91\xa0" +
+	"\x6f\xfb\x39\x42\xfe\xf6\x09\x39\x1f\x3e\x0f\xa0\xde\x06\xe4\x9a\x3a\xfc\x7d\x01\x7f\xaf\xbb\x1d\x16\x20\x8b\xc5\x33\x75\x79\x65" +
+	"\x22\x11\xac\xc8\xf2\xf2\x58\x02\x50\x6f\x03\x66\x1a\xcb\xf8\x7b\x5d\x8f\xf5\x77\x3b\x8b\x95\x33\x75\x79\xdf\x58\x69\x6f\x54\x72" +
+	"\x27\x37\x5b\x85\xa1\x8e\x8e\x81\xc8\x2a\x21\x75\x0f\x2d\x82\x54\xf4\x21\x35\x6b\xc4\x94\x3e\x0c\xab\xd0\x39\x36\xeb\x67\xea\xf2" +
+	"\xbe\xb3\xd2\x3e\x72\xa2\x03\x68\x24\x23\xa3\xdd\x20\xa2\xee\x91\x05\xa8\x70\x49\x64\x93\x90\xd2\x47\x41\x0d\x22\xb6\x38\xd1\xeb" +
+	"\xdb\xa8\x84\xb6\x09\xa9\x7b\xe8\xfb\xe8\x68\x77\x88\x29\x7d\x18\x56\x21\xa7\xdd\x33\x75\x79\x53\xac\xae\x2f\xd2\x5f\x29\x56\xc0" +
+	"\x48\x69\x8f\x98\x7a\x97\x31\xc7\x68\x9f\x90\xba\x9e\x38\x19\xea\x84\x03\x0e\xfd\x1d\x60\x64\x3b\x1d\x12\x52\xef\x20\x66\xc3\x75" +
+	"\x14\x82\xa8\xbb\x1d\x23\x42\x46\x9d\x85\x75\x79\xff\x96\x39\x79\x2d\x03\x95\x2e\x10\xf5\x0e\x61\x26\x94\x06\x50\xd7\x63\x03\x76" +
+	"\x3a\xdd\x85\x75\x79\x57\xda\x7b\xf5\xe5\x65\x9f\x30\x05\x95\x1e\x20\x5b\xec\xa1\x8d\x38\xa3\xd4\x0b\xa8\xfd\x61\x8c\x0c\x21\x7d" +
+	"\x85\x75\x79\x4d\xed\xfc\x12\x31\x0e\xa1\x32\xda\xae\x1f\xc4\x16\x7b\x64\x13\x2a\x60\x52\x19\x00\xd3\xee\x20\x56\xdf\x0d\x12\xd1" +
+	"\x1b\xf0\x51\x29\x0d\x01\x11\x29\x69\xc4\x49\xe9\x29\xa0\xf6\x87\x31\x32\x84\x3c\x2b\xc4\xc5\xd6\x6e\x5c\xb3\xbe\xd7\x73\x62\xb6" +
+	"\x98\xbe\x22\x43\x66\x2f\x88\x6a\x7f\x18\x23\x44\x6e\x2f\x0b\x71\x25\xb4\x1b\xd7\xad\x57\xc1\x57\x84\x6c\x31\x7d\x59\x06\x4a\xf8" +
+	"\x9a\xa0\xf6\x47\x61\x19\x42\xde\x70\xa4\x37\xf2\x19\x95\xd8\x5b\xe1\xf5\x30\xe0\xa5\x83\x7e\x57\x58\xa7\x4f\xb2\xf2\x85\xc8\xed" +
+	"\x7d\x61\x5d\xde\x34\xdb\x66\x97\xe8\x7a\xd0\x94\xbc\x64\x08\xfa\x40\x50\xfb\x6d\x87\x41\x48\xea\x9a\xf9\x28\x84\x6e\xc7\x08\x11" +
+	"\xf4\x09\x57\xc5\x36\x7b\xa8\xae\x0a\x85\xe8\x88\x3e\x83\x69\xef\xd6\x57\x8e\x1d\x35\xe9\x7c\xe1\x3a\xdd\x51\x8c\x10\x41\x5f\x39" +
+	"\x74\xc9\x67\x54\x62\xc3\x40\xda\x6f\xdb\x84\x95\xd6\x08\x88\x6d\xe6\x20\x56\x52\xa3\xb8\xfe\x76\x98\xf1\x45\x8a\x85\x39\x6a\xdc" +
+	"\xd1\x88\x63\x40\x76\x5d\xc4\xfc\xbc\x18\x07\xb1\xc3\x7a\x62\x44\xc8\x68\x82\x33\x7f\xfb\x88\xfc\x70\x9b\x04\xb1\xeb\x10\x32\x58" +
+	"\x00\x53\x00\x76\xd8\xed\xb0\x04\xb9\x7c\x2b\xac\xcb\x2b\x93\xe9\xc8\x85\xfd\x56\x36\xd3\x20\x76\x1d\xc2\x4c\xe6\x3b\x00\x99\x8c" +
+	"\x27\x41\x2e\x3f\xa8\x37\x77\xd9\x25\xd5\x9c\x66\xa8\x34\x38\x33\x84\x3c\xb9\x6d\x11\xd6\xe0\xcc\x0a\x91\xdb\x41\x11\x42\x7e\x16" +
+	"\xd6\xe5\xfd\x67\x97\x7f\x02\x98\xa1\xea\x7e\x9b\x03\xf1\xa4\x3b\x32\x09\xbb\xdb\xe6\x41\xec\xb2\xee\x28\x28\x42\x36\x0b\x1c\xb9" +
+	"\xe4\x12\x2a\x99\x45\x00\x4f\x6e\x9b\x7f\xb7\x52\x59\xc2\xdf\x77\x99\x05\x58\x89\x2c\xd3\xf5\xb5\x6b\x5e\xa8\x9e\xc9\x0a\x31\x4f" +
+	"\x8c\x4b\xd0\xf3\x59\x15\x32\xb7\xc3\x32\xc4\xac\xa1\xe7\x77\x8d\xab\xd4\x2f\xda\x3a\x90\x27\xc6\xf5\xe7\x57\x6d\x03\xc8\xae\x79" +
+	"\x8d\x5a\x32\xe4\xb4\xc9\x99\x4b\x1e\xa2\x52\xda\x02\xf1\xe4\xb6\x05\x58\x09\x6d\x17\xd6\x19\x5b\x21\x38\x12\x44\xec\x14\xd6\xff" +
+	"\x32\xc6\xda\x1e\x95\xdd\xcb\xcf\xa6\xc3\xfa\xca\x27\xd8\x89\x73\xc7\x2b\xf2\x59\x62\x92\x9d\x48\x90\xcd\xae\xc9\xe0\x0c\x36\xce" +
+	"\xdc\xcb\x3f\xa7\x99\x3d\xc9\x60\x43\xe0\x90\x10\x4e\x0f\xdc\xf7\x84\x26\xd9\x89\x72\x62\xf0\x01\x0f\xe4\xc0\x43\x30\xf3\x08\x2b" +
+	"\x49\x1c\x4a\x62\x93\xe5\x97\xd1\xf9\x68\x5c\x45\x32\x88\xa6\xa3\xc8\x63\xc4\x04\xa6\x42\x3a\x2d\xc4\x0f\x06\x32\x5d\x3e\x63\x46" +
+	"\x03\x24\x5d\x14\x5b\xbf\x71\x76\xa2\x8c\x0e\x44\xec\x2e\x8a\xad\x9f\x62\x7a\x8a\xe2\xeb\x47\xd0\xef\x67\x8e\xf5\x9a\x3a\xe4\xa5" +
+	"\x12\xa7\x71\xf8\xfd\xcc\xb1\xbe\xf8\x70\x24\xd2\xef\x27\x45\x32\x28\x8e\x1a\xa9\x01\x1f\xb2\xc7\x1c\x79\x0d\x5a\x50\x20\x20\x40" +
+	"\x43\x3e\x64\x87\x04\xe8\xa9\x17\xb6\x12\x92\xc3\xfe\x2c\x3e\x33\x89\x3c\xf7\x83\x56\x99\xc9\xf1\x7a\x71\x44\x62\x92\x79\x79\x44" +
+	"\x5e\x92\x79\xe5\x8d\xa9\xea\x1e\x75\x4d\xbc\x36\x19\xbb\x51\x15\xf3\x46\x32\x74\xdd\x04\x84\x90\xd8\xdb\xa2\x7a\xe7\x68\xea\x8a" +
+	"\xfc\x6c\x7d\x34\x35\x36\xe4\x7e\x67\x22\xf8\xfc\xa3\xf3\xad\x6f\x9e\x39\x26\x0f\xaf\x7e\xef\x69\x98\x00\x24\x3e\x48\xe2\xcc\x89" +
+	"\x2c\x3a\x3f\x96\x5c\x70\x8c\x72\xd9\x04\x3b\x91\x20\xe6\xa3\xc9\x28\x15\x8a\x84\x98\x96\x7c\xf6\xc9\x93\xb1\x11\xc8\x7c\xf6\x18" +
+	"\x2f\xa1\x2f\x41\x27\x23\xa1\xaf\x9e\x86\x9b\xd0\xb0\x24\xf0\x6b\xda\x0e\x76\x8c\x9d\x28\x43\x55\x46\x82\x55\xd1\x08\x64\x46\x4d" +
+	"\x19\x2a\xad\x58\xc8\xc5\x11\xec\xfd\x3d\x66\xca\xf8\x48\x4b\x3e\x1b\x37\x45\xc8\xc8\x25\x26\x4c\x0d\x9f\x40\x24\x93\x26\xa2\x52" +
+	"\xa6\x11\x12\x45\x99\x0a\x16\x45\x11\x10\xf9\x16\xf4\x51\xb5\x47\x3a\xd3\xa6\x8a\x8f\xb4\xe4\xb3\xef\xa6\x08\xf9\xb8\xc4\x0f\x53" +
+	"\xc3\x27\x10\xc9\x8c\x27\xe2\x8d\xcf\xac\xa7\xe2\x8f\xcf\xcf\x60\x42\xaa\x70\x48\x68\x2e\x98\x90\x42\x5a\xf2\xd9\x7c\x30\x21\x93" +
+	"\x58\x28\xaa\xe7\x47\x11\x89\x37\x48\xe8\x24\xa2\x43\x71\x80\x38\x9d\xa1\xb2\xe8\x12\xe3\xec\x44\x39\x9d\xbc\xaa\x4f\x2b\x8a\x15" +
+	"\x01\xd1\x9c\xcf\x96\x25\x40\x67\x26\x29\x11\x1c\x13\xf5\xfb\x99\xe3\x74\x28\xcc\x8a\x87\xc8\x33\x91\x64\x20\xab\x92\x80\x0b\x4e" +
+	"\x55\xf2\x34\xd6\x5c\xc2\x95\x58\x8f\x95\x90\xa9\x6c\xc4\x49\x88\x4c\x36\x8b\xea\x9d\xa3\x9b\x48\xe2\x40\x9c\x1b\x4e\x61\x6c\x79" +
+	"\x0c\x44\x14\x02\x9b\x6d\x89\xe0\x64\x0b\xcf\x07\x22\x3b\x41\x11\x2a\x2a\x56\x7b\x42\x64\x37\x1e\x91\x2a\x7b\xee\xc8\x8c\xb1\x13" +
+	"\xe7\x34\x02\x95\x7d\x17\xf1\x44\x0e\xe2\x45\xc4\xc2\xd3\xc3\x58\x0d\x19\x6b\xc7\x59\x77\x78\x49\x44\x66\x0c\xa4\xd3\x43\xdc\x9a" +
+	"\x74\x49\x82\xaa\x76\xe6\x44\x14\x28\x7e\xda\x52\x51\x8c\x69\xd4\x1d\x8f\x48\x95\x9e\xb3\xee\xf8\x78\x48\xaf\xa5\xa2\x10\xab\xb6" +
+	"\x7d\x47\x30\x52\xa7\xff\x6c\x7d\xfe\x24\xbb\xd7\xc9\xb0\xe6\xaa\xfc\x51\x51\x36\x55\x06\xeb\x0b\xe8\xc3\x00\xf3\x49\x03\x1c\x39" +
+	"\x8c\x41\xe8\x3c\x88\x41\x62\xd6\x99\xa3\x83\x1d\x72\x94\xce\x10\x31\xf7\x7e\xf7\x64\xb0\x3f\x11\x8c\x9e\x72\xe0\x56\x10\x20\x9b" +
+	"\x67\xc2\xc6\xd6\x90\x67\x57\x40\xe3\xf9\xd9\xfa\xfc\x3f\x45\x3a\x58\xfb\xe3\xa7\xf3\x82\x88\xc3\x18\x82\x6c\x5e\x02\x59\x67\x8e" +
+	"\x8a\x95\xcc\x2b\x8a\xa4\x62\x4f\xe5\xcb\x6a\x72\x4b\x50\x13\x79\x28\xf1\x6b\x02\x5a\x3a\x2c\x40\x1d\x5b\x8c\x40\xde\x70\x62\x2f" +
+	"\x4c\x40\xe3\xed\xd9\xfa\xfc\x09\x56\xb1\x4f\x84\x3a\x64\x59\xc5\x0a\xe4\x1d\x21\x2d\x9d\x36\x42\x2a\x2a\xd6\xf7\x9c\xd9\x8f\x63" +
+	"\xa0\xf3\x81\x98\x8a\x3d\x9b\x31\x13\xfa\x48\x44\x4b\x87\x4d\x58\x19\x7d\xe2\xc8\x5e\x0c\x02\x95\xcf\x94\xf4\x3f\xba\x4f\x70\x70" +
+	"\x88\xca\x08\x65\xf9\xc2\x89\xc3\x30\x41\xe3\xf3\x95\x90\x35\xa3\xdb\xe4\x11\x26\x4a\x65\x98\x90\x7f\x7e\x77\x45\x64\x9f\x8c\xf0" +
+	"\xbf\xdf\x0a\xfd\x9d\x3c\x46\x85\x87\xa5\x60\x76\xda\x18\x9a\x44\x24\x22\x4f\x40\xb1\x12\x19\x27\xe0\x30\x0c\x90\xc7\x04\x88\x35" +
+	"\x66\x6b\x58\x69\x4c\x9e\xad\xcf\xff\xbf\x7b\x7b\x4c\xbe\xfe\xa0\x9a\x55\xd6\x73\x0a\xc0\x26\xeb\xb0\x09\x6b\x54\xbe\x71\x64\x2f" +
+	"\x06\x81\xca\xf4\xd9\xfa\xfc\x7f\xdd\xdb\xd7\xc7\x63\xdb\x17\x05\x90\xef\x40\x36\x59\xa7\xc3\xd8\x9d\xf6\x83\x43\xfb\xb1\x10\x94" +
+	"\x66\x00\xdd\xdb\x73\x18\x33\xa9\x59\x10\x32\x29\x47\x46\x66\xf5\x93\x33\x7b\x71\x0c\x74\xe6\x90\x79\x85\x4e\xdc\x6d\xe9\x79\xfc" +
+	"\xbd\x45\xd7\xce\x6b\xe8\x05\x02\xf6\xc2\x00\x14\x16\x11\x45\x85\x91\xaf\xb4\x50\xd9\x2e\x81\x68\x31\xca\xa6\x34\x54\xd5\x96\x09" +
+	"\xd9\x8f\x43\xe0\xb3\x02\xa4\x62\xcf\x46\xcc\x54\x56\x01\xb4\x74\xd8\x80\x95\xcb\x1a\x11\x7b\x31\x04\x34\xd6\x41\x6c\xb0\x5b\x8a" +
+	"\x40\x3b\x92\x86\xba\x2a\x36\x80\xac\xb1\x9b\x16\x22\xaf\x0a\x68\x6c\x72\xe0\x56\x10\x20\x89\xad\xb3\xf5\xf9\x67\x36\xd8\x01\x05" +
+	"\x22\x9b\x5e\xc5\x41\xc8\x36\x90\x35\xb6\x67\x21\x10\x91\x91\xee\x70\xe0\x20\x08\x90\xc4\x2e\x08\x91\x8b\x04\xec\x54\xf6\x40\x88" +
+	"\x54\x4c\x09\x99\xc9\x3e\xff\xfb\xad\xd0\xdf\xc9\xe2\xe0\x6c\x7d\xfe\x38\xbe\x3a\xe9\x89\x88\xba\xb0\xd4\xe8\xa3\x4b\x0f\x39\x73" +
+	"\x18\xc7\x90\x50\x47\x31\xa0\x75\xe6\x2a\x59\x9f\x04\x9d\x04\xdd\xfb\xdd\x17\x92\x1f\x48\x5d\x9c\xb8\x15\x26\xc8\x29\x2d\x9c\x1c" +
+	"\x15\x99\x16\x54\xba\x8b\xeb\xf3\x5b\x65\x52\xfe\xf7\x23\x90\x1e\x42\x0e\xe3\x10\x72\xea\x05\xb3\xce\x5c\x1d\x2b\xa5\xbe\xe2\xfa" +
+	"\xfc\x31\xd6\x72\x4b\x3f\x51\x52\x2d\xa9\x22\xee\x27\xa6\xf9\xa6\xcd\xc8\x80\x31\x4e\x03\x9c\xb8\x15\x26\x48\x64\x10\xc1\xb4\x1c" +
+	"\xe8\x58\xac\xd6\x27\x64\x08\x48\xf3\x9e\x8d\x98\x2d\xf7\x94\x80\x83\x30\x40\x12\xcf\x40\xb4\xdc\xb2\x00\x3b\x99\xe7\x20\x9a\x6f" +
+	"\x5a\x84\x99\xca\x0b\xfa\xfb\xad\xe0\xdf\xc9\xe2\x25\xe5\xba\xc1\x8c\x64\xfd\xc6\x7e\x45\xd0\x1a\x33\x6a\xe6\xf6\xf6\x6b\x81\xdc" +
+	"\x0a\x23\xe4\xf5\x06\xc1\xe0\x3a\x55\xe1\x28\x2b\x95\xf2\x5b\x30\xb8\x50\x2d\xc6\x2c\xdb\x3b\x4e\x1c\x84\x09\x12\x79\x0f\x44\xe6" +
+	"\x24\x09\x3b\xa5\x0f\x40\x64\x46\xa6\x88\x4c\xe8\x23\x07\x6e\x05\x01\x72\xf9\x54\xdc\x10\xb7\xb4\x5c\x2e\xd6\xfe\x0c\xe4\xb1\xb3" +
+	"\x6e\x1c\x4f\xa8\x14\xf1\x05\xc4\x1c\xbb\x14\x10\xb9\x5a\x50\x42\x4f\xf9\xbe\x72\xa4\x2f\xf2\xd6\x96\xd3\xa3\x39\x92\x19\x06\xf3" +
+	"\xb8\x2f\xd2\x4e\xe2\x0c\x43\x8d\x8c\x00\x99\x63\x8f\xc2\x2a\x78\xe6\x38\x5a\xdc\xf0\xcb\x04\x13\x19\xd1\x53\x37\xbd\xb6\x5c\xc6" +
+	"\x3b\x46\x8c\x48\x89\x86\xd9\xcf\x69\x5c\xe8\x5c\x0a\xe8\xc8\xa4\x26\x10\xcd\xbc\x2e\x1e\x3d\x4f\xb3\x17\xcc\x4f\x02\x69\xd3\xc5" +
+	"\x53\x8f\x09\x15\x31\x05\x62\x9e\x5d\x0a\x88\x48\x9f\x6f\x1c\xe9\x8b\x7c\x86\x97\x06\x4e\xd3\x80\xda\xfa\x22\xdf\x4a\x33\xdf\xc1" +
+	"\xcc\xb3\x47\x31\x3a\xa8\xdf\x0f\xca\x5b\x24\xa5\x1e\x44\xab\xf2\x41\x65\x86\x10\x91\x94\xf5\xc8\x5b\x21\xb3\x42\xe5\x92\xaf\x22" +
+	"\x93\xfa\x59\xdc\xf0\x4b\xeb\x1c\xfb\x5b\x1e\x52\x18\xe8\xbc\x39\x20\x8f\x6d\xc2\x1a\xa4\x79\x00\x73\xec\x12\x3d\xd2\x74\x44\xa4" +
+	"\xcf\x02\x67\xfa\x22\x25\xa3\x7a\x06\x4f\xbb\x49\x67\x11\xcc\x63\x1b\x51\x0f\xcd\x89\x58\x02\x31\xc7\x1e\x69\x27\x4b\x05\x95\x5b" +
+	"\x2e\x6e\xf8\x65\x8c\x89\x8c\x62\x3a\x6f\x85\x18\x9e\x52\x4c\xe3\xad\x0a\x99\x4b\xfa\x39\xad\xdf\x79\x6b\xc5\x0d\xe1\x43\xf6\xd5" +
+	"\x79\xbe\xeb\x20\x70\x7c\x37\xed\x22\x60\x3d\x88\x50\x7b\x23\x6c\x70\xc6\x96\xd1\x08\xe9\x6c\x16\x37\xe8\x73\xf6\xf1\x06\x3e\xdd" +
+	"\x2d\x63\x35\xb8\xba\xe9\xde\x22\x04\xe7\xe0\xcb\xad\x11\xac\xdb\xfb\xe3\xf7\x0b\x4a\xb7\x8b\x1b\x9c\xb3\xf6\xbd\x5b\xf7\x9d\xe2" +
+	"\x06\xeb\xb0\x7d\xcf\x66\x17\x80\x74\x71\xee\xdb\x85\xcd\x1e\x47\x6c\x11\xfb\xce\x7e\xbf\xb8\xc1\x38\x37\xfe\xcf\x13\x09\x7b\x4f" +
+	"\x04\x7a\x42\x70\x00\x86\xce\x8d\x17\x8f\x4e\x88\x21\x1d\xb5\x99\xc1\x21\x67\xfa\xa3\x18\x86\x84\x3a\x4a\x1a\x8c\x73\xe3\xcd\x6d" +
+	"\x0a\xd4\x43\xa7\x4e\x42\x70\x9e\x3b\x16\x21\x89\xfd\x03\xcc\xd2\x75\x09\xe0\xba\x07\x48\x89\x74\x09\x52\x12\xcf\x33\x64\x24\x96" +
+	"\x49\x37\x08\xe1\x61\xc7\x2a\x5d\x7a\x38\x71\x3d\x40\x48\x91\xde\x92\x06\xe3\xcc\x78\xf1\x28\x48\xed\xdd\x40\x43\xd4\x07\x64\xd3" +
+	"\x28\x9c\x6e\x28\x59\xb7\x7e\x8e\xf4\x47\x61\x84\x64\x06\x4a\x1a\x8c\x33\xe3\xc3\x4d\x37\x48\xcc\xa6\xae\x9b\xd7\x73\x43\x82\xb8" +
+	"\xee\x13\x52\xe4\x69\x49\x83\x3e\x35\x5e\x3e\x01\xb5\x6c\x9e\x81\x10\x2e\xfa\x19\xa9\x59\xb9\xe7\x25\x0d\xf6\x63\x36\xbf\xe7\x5e" +
+	"\x94\x34\x18\x4b\x8e\xcd\xd3\x79\xb3\x2e\x15\x65\xd3\xd2\xaa\x97\x84\x60\xc5\xf1\xe3\xbc\xe3\xea\xe0\x5d\xb9\x82\x8b\x56\xe4\xbc" +
+	"\x2a\x69\x30\xd6\x1b\x5b\x2a\x12\x79\x5d\xd2\x60\x2c\x37\x6e\x3d\x51\xa6\x0f\x13\x56\xcb\x0a\xdf\x80\xc1\x72\x63\x71\x8d\x10\x42" +
+	"\x2b\x32\xd4\x49\xd4\x6f\x81\x88\xd5\xbf\xbe\x0a\x36\x02\x7d\x57\xd2\x60\x2e\x36\x36\x83\xd1\x4e\xef\x45\x4e\xf7\xbd\x9c\x94\xce" +
+	"\x07\x42\x1e\xdf\x8f\x91\x81\xd5\xc7\x92\x06\xbd\x68\x16\x04\x9f\x8d\xc3\xb2\x4d\x14\x0f\xab\xe1\x3f\x11\x82\x55\xb3\xea\xd8\x62" +
+	"\x82\xac\xf2\x7d\x26\xa8\x4d\x30\xb6\x90\x64\xbe\x94\x34\x84\x57\xce\xaa\x2d\x11\xbe\x82\xc0\xca\x59\xa8\x48\x84\x57\x4f\x1e\x7e" +
+	"\x3c\x0c\xa4\xcd\x26\xac\xd3\x91\x47\x4a\x1a\x8c\xa5\xb3\x14\x89\xe7\x33\x2a\x52\x32\x4e\x62\x96\x8b\xed\x94\xce\x18\x31\xc2\xc9" +
+	"\xd7\x41\x2c\xe3\x25\x0d\x7a\xe9\x6c\xb0\xf1\x26\x88\x58\x30\x6b\xe7\x75\xde\x24\x31\x8f\x8d\xd2\x29\x19\x89\x4c\x95\x34\x04\x56" +
+	"\xce\xca\xf3\xa7\xc9\xe9\x1b\x90\x05\xa3\x74\x46\xe7\xc9\x78\xa7\xc1\x3c\x76\x10\x25\x03\xe2\x7b\x49\x83\xb1\x74\xd6\x8c\x45\x3b" +
+	"\xfd\x10\x39\xdd\xf7\x73\x52\x3a\x33\x25\x0d\xee\x31\xd6\xa6\x0e\xac\x66\x4b\x1a\xc4\x43\xe0\x79\x96\xc7\x97\xf3\xc8\x8f\x3c\x9a" +
+	"\x5f\xc4\x0b\xf6\x3f\x39\x23\x1e\xcb\x79\x08\xfd\xc6\x9e\x23\x06\x77\x7d\x5c\x08\x77\xa9\xad\x27\xb2\xf0\x3b\x5c\xe9\xcc\x13\x73" +
+	"\xef\x77\xdf\x4a\xde\x81\x2e\x70\xe2\x96\x67\xa4\x4e\x1f\x5e\x14\x3e\xbf\x7b\x36\x52\x63\xa9\xa4\x81\x3f\x06\x06\xa0\x6f\x51\xcd" +
+	"\x84\x96\x4b\x1a\x02\xcf\x81\xad\x7c\x56\x80\xac\x33\x57\x86\x36\x0c\x57\x3a\xab\x14\xcc\x3f\x02\xa2\xe2\xa9\x27\x7d\x92\x59\xe3" +
+	"\x8c\x51\x3c\x0b\xa1\xc9\xd9\x75\x62\xd6\xcc\xe2\xc9\x9b\x18\xe5\xb5\x41\xcc\x3f\x46\xf1\xf8\x86\x49\xc6\x53\x80\x4d\x8e\xe8\xea" +
+	"\x69\x42\x9e\xbc\xbd\x25\x9c\x74\xf9\xac\x93\xb5\x51\xbf\x6d\x64\x2e\x73\x92\xf7\x53\x56\x4a\x3b\x25\x0d\xe1\xa7\x9b\x2a\xa1\x5d" +
+	"\x10\x6b\xcc\x11\xb1\x8b\xb7\x57\xd2\x60\x3e\x43\x31\x47\x9b\x3f\x26\xb8\x54\x74\x6c\x9f\x33\x87\x71\x0c\x0d\xd5\x01\x41\x18\x2b" +
+	"\xfc\xe2\x34\x5b\x42\xe9\x1c\x96\x34\x18\x4f\x50\x4c\x19\x99\x75\x47\x69\x83\xf1\x04\x25\xd8\x7a\x9d\x84\xac\xb3\xdf\x5d\x1b\xa9" +
+	"\xd1\x55\xda\x60\x3c\x3f\xf9\x7f\x27\x4b\x75\x5f\xc9\x40\xd2\x84\x88\x7c\x7c\x84\x7c\xba\xc1\xc8\x74\x14\xc3\x8b\x27\x75\x7a\x4a" +
+	"\x1b\x9d\x0d\xc8\xf0\x21\x42\x47\x5b\xc9\xd7\xef\x7a\x81\x2c\x98\xfb\x8f\xa9\x8f\x22\x89\xf4\x01\x99\x63\xb7\x43\x2a\xe2\x90\x99" +
+	"\x7e\x8e\xf4\x46\x9a\x91\x9b\xea\x61\x19\x1e\x54\x06\x80\x2c\xb0\x5e\x71\x57\x26\x10\xb9\x32\x11\xc4\x20\x88\x39\xd6\x1d\x23\x82" +
+	"\x45\x85\x43\xa5\x8d\xbf\x4c\x32\x99\x11\xf5\xb7\xde\xab\x4e\x84\xfb\x94\x18\x99\x92\x66\xac\x9c\x9e\x09\x21\x9e\x14\xad\xe7\xb3" +
+	"\x36\xbd\x83\xd9\x73\xc4\x93\xd4\xc5\xc3\x12\x47\x5d\x3b\xdc\xfe\xbd\x00\x91\xd2\xb5\x53\x0b\x18\x15\xf1\x12\x44\xf2\x76\x40\x43" +
+	"\x98\xbc\x22\xa0\x37\xf2\x09\x7a\x27\x8d\x44\x5e\x83\x49\x19\x95\xb3\x56\x7d\x12\xf2\x06\x48\xb2\x3b\x4e\x06\xe9\xbc\xa5\x94\x45" +
+	"\x3e\x6a\x91\xa3\xaa\x1c\x54\xde\x11\x22\x12\xb2\x57\xdc\x2a\xe6\x3d\x31\x22\x25\xb5\xb6\xd7\xac\xdb\x87\xd2\xc6\x5f\xce\xcc\xb1" +
+	"\xbf\xa3\x2b\xa7\x8a\xd1\x98\x46\xbb\x40\x24\x95\xc7\x3e\x82\x58\x70\x08\x6d\x93\xca\x63\x9f\x40\xcc\xb1\xdb\x01\x0d\x14\x0e\x8b" +
+	"\x2d\x3f\x73\xa4\x37\x52\x8c\xdb\x72\x5f\x40\x2c\x38\x84\xd9\x71\x5f\x01\xa0\xe3\x82\x12\xe4\x32\x5c\xda\xf8\xcb\xff\x21\x19\xb9" +
+	"\x32\xd4\xeb\xb7\x11\x10\x0b\x2e\x61\x65\x33\x0a\x04\xd9\xc8\xb5\xa3\x76\xaf\xc1\x67\xac\xb4\x91\x1f\x37\x2c\xd7\xcf\x60\x9b\x30" +
+	"\x5a\x3f\xa3\x36\xc4\x1a\x2f\x6d\x34\x0e\x1c\x2e\x3b\xc5\xe4\x1b\xfe\x44\xd0\xd2\xb2\x09\x20\x75\x9d\x71\x2a\xc4\x4c\x96\x36\xe2" +
+	"\xb0\xe0\xab\xfa\x04\x45\xf3\x24\x5f\x6c\x65\x35\x55\xda\xe8\x1e\x39\x2c\x37\x90\xd2\xcb\x8a\x88\xa9\xe3\xeb\x7d\xd4\xee\x4e\xf6" +
+	"\xba\x22\x0a\xe6\xaa\x1f\x8b\xf4\xf9\x5e\xda\xa8\xcf\x1c\x96\xf9\x58\x2e\x3f\x40\xd4\x5d\x0b\x48\x48\x62\xa6\xb4\x31\x70\x7a\x2e" +
+	"\x10\xb5\x43\xd4\x6c\x69\x63\xe0\xfc\x5c\xb1\x87\x14\x96\x7b\xfd\x2c\x6d\x0c\x9c\x9e\x2b\x35\x40\xcc\x95\x36\xe2\xe4\xdb\xab\x6a" +
+	"\x1b\x2a\x6b\x89\x1b\xb9\xcc\x03\xc1\xd1\xb6\x72\xb7\x2b\x42\x74\x3a\x38\x37\x11\xc8\x96\x8b\xa8\x9a\xd5\x9f\x8e\x16\x79\x24\xfa" +
+	"\xfc\x5c\x6b\x65\x20\xf9\x2c\x95\x36\xda\x47\xe8\x7a\x36\xcb\xa5\x8d\xf6\x11\xba\x5a\x44\x10\x2b\x28\x2b\x8a\xa6\x0a\x6b\x57\x0d" +
+	"\xfb\xcd\x95\x36\x9a\x87\xe8\x0a\x44\xee\xbc\x85\x9a\xac\x81\x40\xd5\xc2\x22\x40\xd6\xa9\x49\xb6\x98\xd1\x6b\x76\xe1\x92\x79\x6c" +
+	"\x83\x18\x79\xb4\x6d\x4c\xe5\x36\x85\x90\xd1\x6e\x5e\xed\xb6\x78\x3c\xba\xdf\x9c\xe2\x61\xcf\x39\x20\xd2\x4a\x21\x96\xd3\x4e\x69" +
+	"\xa3\xb3\x8e\xcd\xab\xde\x6e\x69\xa3\xbb\xcf\x92\x3c\xc5\x91\x8c\xf0\xbb\x7b\x8f\x98\xd4\x35\xbd\x71\xa5\xfc\xcc\x96\x2a\xfb\x44" +
+	"\x24\xc5\x4e\x4c\xd6\x59\x90\x12\x39\x28\x6d\x34\x36\x5a\x3a\x73\x22\x92\x9f\xb4\x74\x48\x07\xdd\x49\x1c\x02\xc1\x46\x4b\xf0\xc1" +
+	"\xbb\x22\x64\x83\xb5\xed\xfa\x00\xc5\x73\x50\x49\xc7\xa8\xc0\xa7\xf3\x1c\x85\xd2\x15\x39\x19\x59\x4e\x5d\x04\x49\x2b\x2b\x25\x25" +
+	"\x94\x26\x46\x7a\x85\x8f\xec\xec\x3e\xd7\xc8\xb7\x70\x91\x27\x3e\xea\x27\xaf\x72\x98\x7a\x80\x60\x0b\x17\x79\x56\x23\x21\xaa\x78" +
+	"\x58\x90\xd8\x0b\x64\xde\x45\x74\xf5\xc0\xf4\x9d\x6b\x0c\x6c\xe3\x82\x58\xb0\x8b\x0b\xf6\x9f\x3b\xd7\x18\xd8\xc4\x85\x8a\x27\x0f" +
+	"\x85\x1c\x00\x32\xef\x20\xea\x00\x4b\x10\x83\x3c\x92\x2e\x7d\x84\xa5\x6b\x33\x04\x82\x6c\x54\xb0\xe6\x66\x31\x10\x79\xca\x45\x1c" +
+	"\xc4\xf2\x79\x06\x24\xa9\xcb\xe6\xf7\xdc\x73\x10\x29\x5d\x12\xab\xe3\xe0\xf2\x02\x40\xd2\x00\xac\x86\x03\xf1\x12\xe9\x26\x75\xc5" +
+	"\xfc\x36\x78\x05\x22\xa5\xeb\xe1\xf4\x1b\x44\x5e\x03\x49\xa6\xc3\x22\x94\xcb\x1b\x0a\xc4\x28\x99\xef\xf3\x16\x48\x2a\x1d\x79\xd9" +
+	"\x68\x95\x77\xe7\x1a\x9d\x23\x3d\x3d\xa3\xf7\xe7\x1a\xf9\x92\xd3\x33\x27\xb2\x68\x3b\x06\xf9\x25\xa5\xe7\x51\x3e\x00\xa9\x37\x11" +
+	"\xb9\x6e\x4e\x21\x1f\x81\x60\xb9\x26\x10\x5a\x9f\x67\xaa\xd0\x1b\xfd\x9f\x08\xf9\xdb\xf6\x51\xeb\x60\xe0\xf3\x19\x44\xfd\xdf\x9e" +
+	"\x8d\x02\xbe\x00\xa8\xbb\x6d\x9b\x90\x84\x3c\x22\xf3\xeb\xb9\x46\x7b\xdd\xa9\x97\xcc\xf0\xb9\x46\x7f\xe1\x69\xa7\x9a\x1c\x41\x1c" +
+	"\x23\xe7\x1a\xed\xb5\xa7\x5e\x2e\xa3\xe7\x1a\xb1\x1e\x51\x2c\x25\x0c\x94\x0d\xcb\x87\x88\xc1\x72\x43\xb5\xff\x82\x4c\x48\x31\xe3" +
+	"\xc4\xc8\x15\x87\x31\x85\x9b\xe0\xd0\xdf\x8e\x97\x2a\x5d\x2a\x8f\x4d\x12\x52\xff\xb7\x6f\x25\x89\xa9\x73\x8d\xee\x0a\x4a\xaf\x78" +
+	"\xdf\xce\x35\x1a\x2b\x28\x13\x39\xcc\x8a\x05\x2a\xd3\x20\x64\x4a\x38\xaa\xcf\xa9\x5e\x2a\x8f\x7d\x07\x22\x33\xf2\x44\xc8\xe7\x07" +
+	"\x86\x91\x16\x2b\xc9\x91\xc6\x0a\x12\x62\xb0\x58\x09\x43\x30\x03\x64\xd7\x45\xd4\x72\x25\x20\xb3\x40\x76\x98\xd1\x73\xa6\x0a\x7e" +
+	"\x65\xff\xe4\x84\x6e\x28\x3a\x4e\xca\xee\xb9\x39\x30\xbb\x2e\x63\x75\xdd\x3c\x90\x1d\xa6\xdb\xce\x90\x11\xbf\xe7\x17\xce\x35\x06" +
+	"\x96\x5f\x99\x09\x2d\x82\xd8\x75\x08\x5e\x39\x89\x2c\x01\xd9\x61\x3d\x41\x11\xe4\xb3\x7c\xee\x8a\xbd\x8f\x8b\xbd\xbd\x0a\x4a\xbb" +
+	"\x02\xa4\xd2\xde\xea\x05\x8f\xbb\x34\xb1\x0a\x22\xf5\x30\x66\xcf\x19\x9a\x7e\x5d\x23\xa4\xc7\xf6\xc1\x8f\x75\x3d\x84\xeb\x40\x2a" +
+	"\x7b\x3c\x1f\x4d\x6c\x80\x48\x3d\xb2\x7d\x94\x08\xad\x31\xd8\x3c\x77\xe5\x97\x7f\x89\x1d\x81\x68\xea\x2a\x90\xd0\x16\x98\x4a\xc1" +
+	"\x60\x79\x85\x9f\xd1\x36\x90\xd4\xc3\x18\x15\xca\x68\x07\xc1\x2c\x32\x5d\x17\xfe\x75\x69\xed\x81\xb3\x0b\xa6\xcd\x41\xb0\x70\x42" +
+	"\x11\x7b\x20\x16\x99\xae\x9d\xad\x42\x4e\xfb\x9c\xd1\x95\xe1\x8c\x5d\xbd\x03\x40\x6d\x0e\xa3\x96\x56\x90\xd7\x21\xf7\xd2\xf5\x73" +
+	"\x74\xa8\x80\x1d\x65\x57\x7e\xf9\xd7\xa2\xb8\x39\xd3\x8b\x46\xec\xbc\x3a\x01\xb5\x19\x15\x54\x0b\x54\x14\xd2\x05\x64\x91\xe9\x12" +
+	"\xda\x32\x94\x58\xba\xec\xca\x2f\xe3\x2c\xd5\x1d\xc9\x87\x36\xce\x60\xd5\x9d\x8e\xba\x09\xa9\x34\x10\x6b\xac\xea\x4e\x47\x3d\x44" +
+	"\xa4\x1e\xea\x87\x43\x81\xc1\xea\xe5\x50\x8f\xed\x44\x99\x8b\x0b\xa1\x8f\x88\xca\x1e\xcf\x48\x5e\x29\xfd\x04\xa4\x1e\x39\x3e\x52" +
+	"\x83\x8a\x37\x50\x76\xe5\x97\x31\x99\x50\xa8\xfd\xea\x4e\x47\x83\xc4\x88\x8c\x02\xed\x87\xe7\x2d\x84\x88\x94\xe2\xfa\xef\x69\xd9" +
+	"\x95\xe8\xca\x1d\xfe\x16\xbf\xbd\xe7\x91\x5a\xdb\xf0\x0c\xc8\x12\xeb\xe4\x8c\xdc\xcf\x48\x2d\x91\x38\xfe\xb0\xe8\xec\x73\x8e\x1c" +
+	"\xc6\x20\x24\xf3\xa2\xec\x4a\xd4\x74\xe7\x40\x2f\x0a\xb1\xb7\x3c\x22\xe6\x25\x98\x25\xd6\xa1\x17\x7d\x10\x44\x8b\x20\xd4\x0e\x38" +
+	"\xaf\x38\xe4\x2a\x69\x88\xa4\x5e\x83\xba\x73\xe8\x40\x56\x62\x6f\x80\x50\x62\xb6\x9b\x99\xd9\x5b\xce\xb8\x3a\x76\x6a\xef\xca\xae" +
+	"\x44\x53\xac\xf8\x50\x6f\x7a\x84\x45\x24\xd8\x4e\x47\xad\xf1\x79\x4f\x48\x4d\xa7\xde\xce\xc8\x5e\x2b\x04\xaf\x0f\x9c\x11\x32\x1e" +
+	"\x82\x25\x15\x1f\xcb\xae\x44\xd3\xac\xf8\x80\x10\xb5\x6a\x49\x6e\x44\x04\xe2\x13\x11\x35\x62\x53\x1e\x7b\xed\x93\x7c\x59\xf0\x33" +
+	"\x67\x4c\x15\x13\x81\xcc\x17\x42\x8a\x0f\x7d\x23\x15\xcb\x57\x42\x6a\x3a\x5d\x27\x2b\xa7\x61\x0e\x99\x3a\x5e\x52\x23\xe8\x9e\x62" +
+	"\xa3\x07\x6d\x2b\x54\x78\x14\x48\x8d\x6e\x41\xdf\x68\x8c\x88\xc3\x18\x82\xc6\x7b\x1c\x63\x59\x6c\xf4\x8d\xf4\xd1\x5d\x33\x01\xa4" +
+	"\xc6\xe8\x3f\x5d\x18\xd9\x7e\x93\x84\x98\x2a\x16\x42\x4e\x53\x60\x8a\x0f\x7d\x27\x1d\xcc\x37\x20\x35\x46\xef\xd9\x01\x23\xa5\x69" +
+	"\x42\x4c\x15\x3f\xa7\xef\x65\x57\x8c\xbd\x46\xd0\x33\x72\x4f\x0d\xf1\x98\xec\x07\x01\x75\x0f\xe9\xe0\x60\xfa\x31\x82\x8d\xba\xcf" +
+	"\x76\x9b\x9f\xa0\x33\xc4\x94\x9a\x8c\xa1\x42\x1f\x7d\xb3\x65\x57\x8c\x9d\x46\x68\xb9\x8f\x40\xe8\xc5\x11\xfc\x04\xfb\x49\x48\xdd" +
+	"\x23\x52\xe1\xf1\xc2\x09\xf7\xf0\xc4\xd0\x67\xdb\x1c\x41\xa5\x02\xf2\x74\x88\x99\xe7\x4c\xaf\xef\x25\x93\x5a\x20\xa2\xee\xa1\x63" +
+	"\x65\x25\xb5\x48\x8c\x48\xca\x53\x21\x64\xa9\xec\x0a\xdf\x32\x43\xf4\x0c\x3d\x51\xc0\x69\x92\xfa\xd9\xec\x32\x10\xec\x2f\x01\x04" +
+	"\xd5\x73\x77\x46\x80\xce\x0a\xa0\x76\x87\x51\x3a\x64\xb5\x5a\x76\x45\x6f\x99\x81\x60\x68\xf7\x23\x30\xf8\xd8\xa7\x87\xb3\x6b\x40" +
+	"\xac\x3d\x33\xd4\x06\x49\xea\xab\x61\xbd\xec\x8a\xda\x33\xc3\x53\x21\x62\xa3\xec\x8a\xde\x33\xc3\x42\x74\x52\x9b\x65\x57\x9c\x4d" +
+	"\x33\xc8\xc9\x4e\x6a\x0b\x50\xfb\xc3\x18\x1d\x4a\x6a\xbb\xec\x8a\xb1\x69\x86\x55\x40\x31\x50\x3b\x44\x6c\x31\xa3\xb5\x8c\x02\xca" +
+	"\x71\xd8\x25\xaa\xdd\x85\xa4\x10\x79\xed\xd1\x70\xb6\x1b\x0d\xa8\x02\x92\xfb\x04\xec\x13\xb2\xc5\x8c\x0e\x0c\x94\xf0\x80\xa8\x76" +
+	"\xa3\x03\x2d\x21\x62\x0e\x39\xd3\x1b\x30\x93\x2d\xd8\x51\x7e\x45\xec\x99\xe1\x98\x59\x99\x75\x12\xd5\xfe\x30\x60\xa6\x98\xae\xf2" +
+	"\x2b\x62\x7e\x16\x53\x11\xf4\x54\x9e\x5e\xcf\xc0\x71\x16\xf2\x6b\x28\xcd\x19\xbe\xc8\xc3\x47\xf0\x92\x64\x37\x21\x78\x87\x9f\xcf" +
+	"\x00\x3c\x2a\x12\x6f\x8a\x1c\x1a\x5f\x67\x3d\x04\xdd\xeb\x08\x79\xc9\x6f\x87\x5e\xce\xec\x05\xbc\xe4\x7b\x9f\x7d\xc2\xab\xc3\xf5" +
+	"\x3a\x30\xbe\x65\xfa\xcb\xaf\xe4\x4f\xa8\xbc\xf4\x4b\xfc\x66\x5e\x03\x9c\x11\x8b\x57\xf0\x1b\xc5\x42\xe0\x35\x48\xc8\x26\xf3\x74" +
+	"\xac\xc4\x86\x28\xa2\x0a\x01\x51\xd4\x78\xbf\x96\x26\x35\x05\xf2\x94\x23\x46\x0d\x4d\x02\x56\xcf\x88\x68\x91\x22\xf8\x81\x57\x9c" +
+	"\x9b\x6d\xf9\x3c\x27\xa4\xa2\x23\xe0\xc3\x77\x77\x7c\x54\x94\x78\xc1\x99\x3d\xdf\x88\x10\x38\xbd\x24\xa4\xc5\xa8\x1f\x39\x51\xf9" +
+	"\xa4\xcc\x2b\x4a\x5c\xa6\xa4\xde\x7d\x36\x53\x7a\xcd\x11\xa3\x7c\x26\x01\xa3\x37\x44\xc8\x94\xb8\x88\x9b\xd3\xdb\xf2\x2b\xf9\xff" +
+	"\x77\x8f\x2f\x62\xb0\xc7\x9b\xcf\xae\x3e\x2c\x3a\xfb\x8e\x90\xfd\x38\x84\x0e\xf6\x7f\x0f\x46\xae\x21\x71\xda\x4f\xea\x7c\x00\x73" +
+	"\xaf\x23\xa0\x23\xbb\xe6\x23\x11\x7b\x31\x04\x19\x7d\xe2\x46\x7a\x65\x87\xdf\x7b\x9f\xcb\xaf\xe4\xff\x0b\x29\xe1\xbe\xc1\xee\x3c" +
+	"\x19\xca\x17\x42\xf6\x99\xba\xb5\xb0\x11\x72\xfa\x0a\x66\x93\xb9\x3a\x76\x4e\xc3\xe5\x4d\x99\x66\xf8\x46\xca\x9b\x32\xcd\xf0\x8d" +
+	"\x02\x49\x75\xc7\xa8\x80\x18\x2b\x6f\xca\x38\xc1\x37\x5e\xde\x94\x61\x82\x6f\x82\x88\xd4\x6d\xa3\x7f\x95\x8a\x40\x26\x39\xd2\x1b" +
+	"\x39\x4e\x56\x4a\x53\x04\xc9\x9c\x2c\x2b\x15\xf1\x37\x62\x64\x52\xda\xcc\xcc\x6a\xba\xbc\xe9\xe8\x79\xbe\xef\x00\x8e\x9e\xe7\xfb" +
+	"\x01\x64\x81\x75\x07\x35\x68\x26\x70\xa6\xbc\x29\xd3\x3c\xdf\x6c\x79\x53\xa6\x79\xbe\x9f\x40\x16\x5c\x44\xd7\x0e\xcc\x1c\x67\x7a" +
+	"\xf5\x76\xb4\x6e\x42\xf3\x20\x28\x21\x25\x83\xc9\x4f\x14\x4e\x86\xbb\xc0\xc3\x75\x10\x2b\xa3\x45\xe4\x9c\xd2\x65\xf3\x47\x68\x09" +
+	"\x44\x52\x17\xc5\xe9\x39\xf8\x2c\x03\x49\x75\x87\x45\xc8\x66\x05\x91\xa4\x74\xdd\xfc\x96\x5b\x05\x91\xd4\x35\xb1\x1a\x0e\x12\x6b" +
+	"\x00\x52\x06\x60\xf5\x1b\x88\x75\x22\x8c\x92\xf9\xc9\x6c\x00\x49\x76\x47\x9e\x8d\x0e\x75\x13\x48\xca\x44\xbc\x6c\xb6\xca\x9b\x9c" +
+	"\x13\x69\xc4\xc3\x48\x7d\xfa\xd1\x36\x90\x3a\xe7\xd0\x9a\xb3\xb7\xc5\xcb\x7e\x34\x8b\xb7\x03\xa4\xbe\xd3\x38\x1b\xc7\x54\xa1\xb9" +
+	"\xcf\xdd\xf2\xa6\xec\x84\x38\x60\x07\x93\x92\xbe\xcf\x1e\x88\x3a\x83\x90\xb3\xa3\x1a\xd9\x07\x52\xdf\x19\x16\x21\x9b\x03\x8a\x44" +
+	"\xcf\x6c\xea\x49\x58\x39\xe3\x78\x08\xa2\xee\xaa\x97\x8d\x02\x3a\x12\x90\xd0\xd3\x9a\xfe\x3c\x6e\x67\xa2\x09\x33\xca\x1d\x7c\xee" +
+	"\x3a\x50\xb6\x64\x1e\xeb\x22\x46\x4e\x81\x07\xea\x96\xcc\x63\x69\x62\xe4\x1c\x78\x4c\xe1\xba\x13\x4d\xd9\xff\x95\x5e\x6a\x5e\xd9" +
+	"\xb4\xea\x49\x34\x65\x9a\x6c\xef\x4d\x34\x65\x9a\x6c\xef\xe3\xc1\x5c\x75\x72\x52\xb5\xc3\x0c\x53\xa2\x29\xc3\x5c\xfb\x40\xa2\x29" +
+	"\xe3\x5c\xfb\x20\xea\xbb\xcd\x8c\x86\x32\xe7\x62\x69\x08\x86\x80\x6c\xb9\x08\xd5\x4e\x22\x4f\x81\x6c\x33\xa3\xe7\xdc\x19\xdd\x67" +
+	"\x89\x26\x4c\x2d\xeb\x86\xf2\x6c\x9e\x27\x9a\x32\xcd\xb6\xbf\x48\x34\x1d\x3d\xdb\xfe\x92\xc7\xa1\xfb\xc9\x98\x84\x95\x1d\xf5\x2a" +
+	"\xd1\x94\x69\xbe\xfd\x75\xa2\x29\xd3\x7c\xfb\x9b\x44\x13\x4e\x40\xba\xaa\x26\x47\xf5\x23\x4d\x59\xfe\xb7\x89\x26\xe3\x6c\x28\x89" +
+	"\x58\xb3\x91\xef\x80\xe0\x6c\xa8\x80\x8a\x30\x7a\x9f\x68\x12\xa7\x43\x81\xd1\xb3\x9a\xf8\xfc\x91\x32\x1f\x12\x4d\xde\xe9\x50\x9e" +
+	"\xd7\x47\x21\x64\xcc\x1b\xdb\x73\x9f\xf5\xa7\xa3\x4f\x3c\x9e\x2e\x3d\xe7\x6b\x4d\x5b\xc2\xeb\x73\xa2\xc9\x3c\x1e\x4a\x20\xe6\x9c" +
+	"\xe4\x17\x10\x8b\x2c\x1d\x23\x82\xea\x7d\x05\x92\xba\xea\xcf\x2c\xab\x09\xc7\x61\x10\xc9\xab\xf6\xc4\xb2\x4a\x07\xb3\x78\x23\x20" +
+	"\x52\xd7\x02\x1a\x22\x99\x51\x4a\x58\xb8\xa8\x63\x0d\x54\xdd\xa0\x31\x96\x68\xca\x38\xdd\x3e\x9e\x68\xca\x30\xdd\x3e\x41\x91\x74" +
+	"\x45\x7e\x28\x7a\xce\x71\x32\xd1\x94\x69\xbe\x7d\x2a\xd1\x94\x69\xbe\xfd\x5b\xa2\xc9\x3e\x28\xc5\x6b\xba\xe9\x44\x93\x7d\x52\x8a" +
+	"\xd7\x07\xdf\x13\x4d\xf6\x49\x29\x76\xcb\x61\xf6\xf3\x47\xa2\x09\xb3\xca\xce\x3c\xba\xd9\x71\x33\x89\xa6\x4c\x33\xed\xb3\x89\xa6" +
+	"\x4c\x33\xed\x3f\x79\x28\xf6\x2c\xba\xd5\x6d\x73\x89\xa6\xa3\xe7\xd9\xe7\x13\x4d\x99\xe6\xd9\x17\x12\x4d\x19\xe6\xf8\x16\x13\x4d" +
+	"\x19\xe7\xf8\x96\x88\xa9\xbf\xad\x77\x2d\x57\x2a\x12\x59\x4e\x34\x65\x9a\xe4\x5b\x49\x34\x65\x9a\xe4\x5b\x05\x52\xdf\x13\xa7\x42" +
+	"\xc1\xac\x11\xf3\xb7\x8f\xc8\x8c\xd6\x01\xd4\xdd\xb6\x6d\xac\x7c\x36\x40\xd4\xdf\x0e\x48\x48\x62\x33\xd1\x94\x69\x86\x6f\x2b\xd1" +
+	"\x94\x69\x86\x6f\x1b\xc8\xae\x8b\xa8\xba\xd5\x9d\x8e\x76\x12\x4d\x99\x66\xf8\x76\x81\x1c\x35\xc3\xb7\x07\x60\x97\xf5\x84\x35\x40" +
+	"\xec\x73\xe2\x6f\x05\x50\x18\xaa\x66\x70\x39\xe0\x2e\xb7\x2d\xc4\x4a\xe6\x10\xc4\xae\x43\xe8\xaa\xd5\x9d\x8e\x3a\xce\xa3\x4d\x76" +
+	"\x99\xd1\x6d\x76\xd9\x70\x92\x34\x31\x3b\x62\x17\xbf\x98\xba\x75\x09\x21\xa3\xe1\xbc\xca\xa5\xcf\x37\x61\x37\x40\xa3\xe3\xec\xd2" +
+	"\xa5\xf2\x58\x37\x90\x1d\x66\x74\x9c\x5b\xba\x1e\x10\x28\x5d\x58\x04\x48\x2f\x47\x74\xbf\x39\xc5\x4b\xe5\xb1\x3e\x20\x32\x25\x85" +
+	"\x58\x19\xf5\x03\x91\x09\x39\x2a\x02\x19\x38\x9f\xcc\x34\xbf\x37\x78\x3e\x99\x79\x7e\x6f\x08\x50\x9b\xc3\x28\x1d\x7a\x86\xf6\xf4" +
+	"\x7c\x32\xd3\xec\xde\xb3\xf3\xc9\x8c\xb3\x7b\xcf\xc1\xb4\x3d\x0c\xab\x10\xf1\x82\x88\x9e\x80\x91\x4e\xea\x25\x18\x24\x65\x3b\xd9" +
+	"\x49\xbd\x02\xd4\xf6\x28\x46\x87\x92\x7a\x7d\x3e\x79\xf4\xfc\xde\x9b\xf3\xc9\x8c\xf3\x7b\x6f\x89\xa9\x34\x19\x43\x85\x90\x77\xe7" +
+	"\x93\x99\xa6\xf7\xde\x9f\x4f\x66\x9e\xde\xfb\x40\x50\xa5\x80\x3c\x1d\xaa\xdf\x47\xce\xf4\xf8\x5e\x32\xa9\x4f\x44\xa4\x1e\x39\x56" +
+	"\x56\x52\x9f\x89\x11\x49\x79\x2a\x84\x7c\xa1\xbc\xdb\x74\xf1\x74\xd7\x08\xa3\xaf\x44\x2c\x32\xa3\x34\x46\xf7\x49\x9d\x61\xa2\xda" +
+	"\x5c\x48\xed\x6c\x08\x66\x84\xc2\x69\x33\x0a\xa8\x46\x53\xbe\x8f\x3e\x4a\xc8\x22\x33\x2a\x18\xe8\xbf\x31\xa2\xda\x8c\x0a\x5a\x42" +
+	"\xc4\x8c\x73\xa6\x27\x60\x26\x33\x9b\x10\x66\x46\x0d\x8d\x16\x94\x51\x4f\x12\xd5\xf6\x28\x60\xa6\x32\x9b\x3a\x9f\x14\xc7\x65\xa8" +
+	"\xb7\x6e\xf4\x39\x35\xe2\xf1\xd7\x37\xce\x1c\xe8\x09\x49\x0b\xc1\x04\xdf\x34\x21\x4b\xcc\x3c\x60\x05\x8c\x35\x51\xf8\x9d\xa0\x3b" +
+	"\x9d\x21\x2f\xf9\xf4\xf0\x07\x67\x0e\x03\x5e\x72\x67\xbb\x19\xe1\xd5\xe9\x7a\x99\x33\xad\xb3\xe7\x93\xd1\xb4\xca\x4b\x4f\xc7\x9a" +
+	"\x79\xfd\xe4\x8c\x31\x71\x69\x21\xf0\x9a\x23\x64\x89\x79\x3a\x56\x62\xf3\xe7\x93\xd1\x95\x3b\x1d\x81\x69\x6f\x35\xcf\xb7\x40\xc8" +
+	"\x41\x1c\x42\xf3\x7c\x8b\x60\xe0\x25\x67\x90\x8c\x12\x4a\x9d\x25\x30\x77\x3a\x03\x3a\x32\xf3\x65\x22\x0e\x63\x08\x32\x5a\xe1\x46" +
+	"\x9d\x31\x13\xec\x50\x59\x3d\x9f\x8c\x9a\xee\xb8\x53\xe7\x56\x4a\x6b\x84\x18\x53\x97\x36\x42\x4e\xeb\xe7\x93\x99\xa7\xe0\x37\x68" +
+	"\x40\x8b\x45\x8d\xad\x39\x5b\x59\xe1\x4d\x8e\x18\x2d\x68\x12\x18\xa9\x2d\x22\xe4\x4c\xb5\x9a\xb3\x35\x87\x69\x9b\x90\xe2\xce\x80" +
+	"\x8f\x9a\x25\xdd\xe1\xcc\xa1\x6f\xa4\x26\x98\x77\x09\xa9\xe9\x74\x9c\xac\xc9\xd6\x3d\xea\x1b\x99\x92\xda\xd9\xd2\x4c\x69\x9f\x23" +
+	"\xe6\xb4\xb9\x41\x20\xa5\x83\xf3\xc9\x8c\x93\xef\x87\xe7\x93\xfc\xb5\x46\x31\x92\xf6\x24\x29\x3d\x5b\xed\xa8\x48\xe6\x5d\x29\x35" +
+	"\x10\x31\x71\xa9\x89\x4e\x10\x75\x8f\xd4\x8c\x63\x60\xa6\xb5\x8b\x10\x3d\xb9\xa9\x67\x48\xd5\x43\xb5\x34\x90\xd2\x5e\xcf\x47\x13" +
+	"\xdd\x20\xea\xf4\xcc\xa6\x2d\x42\x1f\x7e\x3d\x15\xc9\xbc\x26\xf1\x62\x63\x60\xd6\x97\x8c\x7a\xc1\x94\x0a\xc6\x9c\x8a\xd5\x48\x1f" +
+	"\x90\xba\x47\x31\x2a\x94\x51\x7f\x45\x12\x53\xd9\x7d\x6a\xba\xd1\x71\xc2\x42\x25\x42\x4a\x0d\xc4\x32\xc2\x42\x25\x22\xc4\x2c\x75" +
+	"\x5c\xed\x86\x38\x64\xcc\xaa\xab\xbc\xc5\x43\xdc\xa7\x15\xc9\xa3\xe7\xdd\x9f\x55\x24\x33\xce\xbb\x3f\xaf\x48\x62\x1a\x5b\xcc\x69" +
+	"\x06\x6a\x97\xca\x63\x2f\x88\x11\x19\x05\x6a\x97\xca\x63\x2f\x2b\x92\x99\x27\xde\x5f\x61\x24\xb7\x98\x6e\x2a\xfd\x86\xbd\x9a\x81" +
+	"\x7e\x0d\xa6\xdd\x41\xd4\x0c\x2b\x46\xe9\x0d\x88\x2d\xa6\x1b\xcf\x56\xa1\x61\x7a\xcb\x19\xdd\x56\xce\x94\x39\x59\xbd\xab\x48\x66" +
+	"\x9a\x7a\x7f\x5f\x91\xcc\x3c\xf5\xfe\x01\x6d\xb3\x25\xee\x6d\x02\x53\xbe\x64\xf6\xb1\x22\x99\x69\xf2\xfd\x53\x45\x32\xe3\xe4\xfb" +
+	"\xe7\x8a\x64\xa6\x79\xbd\x2f\x15\xc9\x8c\xf3\x7a\x5f\x39\x23\x64\x3c\x04\x32\xc3\x15\xc9\x0c\xf3\x7a\x23\x15\xc9\x8c\xf3\x7a\xa3" +
+	"\x9c\x31\x55\x4c\x04\x46\x63\x84\x54\xec\xf9\x46\x2a\x96\x71\x42\x5a\x3a\x5c\x27\x2b\xa7\x89\x8a\xa4\xb1\x37\xa0\xb2\xb2\x92\x9a" +
+	"\xac\x48\x66\x9a\xd9\x9b\xaa\x48\x66\x9a\xd9\xfb\xc6\x91\xbd\x18\x84\xa6\xed\xa6\x2b\x92\x19\xa7\xf6\xbe\x57\x24\x33\x4f\xed\xfd" +
+	"\xe0\x90\xab\xa4\x21\x92\x9a\x01\x75\x6f\xcf\x81\xac\xc4\x66\x81\x50\x62\xb6\x9b\x99\xd9\x4f\xce\xb8\x3a\x76\x6a\x73\x48\x5f\x6c" +
+	"\x15\x17\x68\x41\x20\xf3\x15\x49\x7b\xdf\x3b\x6f\xb4\x16\x88\xd8\x8b\x21\x28\xe0\x45\x04\x53\x61\x24\x2e\x7d\x74\xda\x4b\x40\x5a" +
+	"\x8c\x02\xea\xee\x92\xf5\x5b\x26\xc4\x54\xb1\x10\x72\x5a\x01\x53\xb1\xe7\x3b\xe9\x60\x56\x81\xb4\x18\xc5\xf3\x53\x5a\x23\xc4\x54" +
+	"\xf1\x73\x5a\xaf\x48\x65\xdc\x5a\x68\xa3\x22\x95\x69\x6b\xa1\x4d\x20\x6d\x8f\x62\x54\x40\x6c\x55\xa4\x32\xed\x2c\xb4\x5d\x91\xca" +
+	"\xb4\xb3\xd0\x0e\x21\x6d\x97\x02\x2a\x12\xd9\xe5\x48\x5f\xe4\x33\x3a\xa5\x3d\x61\xf5\xc8\xb3\x52\x01\xef\x13\xd2\xf6\x28\x46\x06" +
+	"\x39\x1d\x54\xa4\x32\xec\x2d\x74\x08\xe2\xe8\xbd\x85\x3a\x2e\x40\xc4\x26\x94\x08\x80\xce\x0b\xa9\x4c\x1b\x0b\x75\x5d\x48\x65\xde" +
+	"\x58\x28\x4d\xd0\x63\xc1\xd8\x42\x92\xe9\xe6\x0c\xdf\x2e\x89\x46\xc9\xcf\xa9\x47\x98\x19\xbb\xcd\xcb\xc9\x5a\x15\x73\x2f\x31\x22" +
+	"\x2b\x5f\x07\x69\xf5\x21\xef\x36\x5d\x3c\xdd\x32\xea\xf7\x4f\x3f\x90\x79\xa3\x7a\x46\xe7\x49\x99\x01\x30\x6d\x0e\xa2\x64\x40\x0c" +
+	"\x52\x2c\x6d\xba\x7e\x5e\xe3\x0d\x11\x31\x6f\x96\xcf\xeb\xbc\xa7\xc4\xb4\x19\xd5\x53\x32\x12\x79\xc6\x91\xbe\xc8\x73\xd2\x29\x3d" +
+	"\x17\x56\x8f\x7c\x2b\x15\xf0\x8b\x0b\x29\x63\x37\x7f\x4f\x07\x39\xbd\xbc\x90\xca\xfa\xc9\xfe\x11\x5b\x40\x59\xdb\x0f\xa9\x2d\xa0" +
+	"\x5e\x71\x86\x6f\x01\xe5\x23\xb4\x7b\xd0\x6b\x62\x36\xe4\x5e\x52\xe6\x1e\x44\x4a\xe7\xcd\x85\x54\xd6\xff\x93\x56\xd8\x33\x46\x6f" +
+	"\xab\x24\x91\xb7\x84\xe8\xcd\xa6\x6c\x82\x9c\xde\x01\xd9\x60\xae\x8c\xbd\x6b\xd5\x7b\x0a\xe7\x9f\x6a\x3f\x2b\xb9\x25\xcf\x07\x4e" +
+	"\x5c\xf7\x72\x52\x7b\x19\x7d\x14\x29\x55\x7b\x19\x49\x8d\x4f\x44\xdc\x13\xa1\x90\x8d\xdc\xf5\x40\x45\xf2\x99\x33\x46\xf1\x2c\x84" +
+	"\x36\x10\xfa\x42\x8c\xda\x88\x0b\x56\x72\x0b\x6d\xa5\xf3\x15\x69\xdf\x73\xb6\xea\xb2\x9c\x86\x89\xb0\x37\xea\xb2\x7d\x46\x2e\xa4" +
+	"\x32\xee\xf7\x35\x4a\xb1\xdc\x33\x2a\xa7\x37\x3c\x12\x69\x8f\x5d\x48\x65\xda\xee\x6b\x5c\x64\xa4\x6b\xe7\x6d\xbd\x34\x71\x21\x85" +
+	"\x7d\xaf\xba\x98\xbf\xf1\x95\xda\x08\x6a\x92\x33\xfd\x51\x0c\x43\xe3\x34\x45\x90\xdc\x8a\xcb\xea\x3c\xa9\xf3\xed\x42\x2a\xeb\x7f" +
+	"\xd2\x4a\x6d\x5d\x65\x59\x4d\x13\xd2\x1f\xc5\x20\xe4\xf4\xfd\x42\x2a\xe3\x9e\x5f\x3f\x28\x9a\x7f\xaa\xfd\x88\x65\xe2\x33\x17\x52" +
+	"\x99\x36\xfc\x9a\xbd\x90\x3a\x7a\xc3\xaf\x9f\x17\x52\x98\xa5\xbc\x2a\xc7\x5a\x3f\xee\x52\x1b\x1e\xcd\x01\x69\x13\x73\x90\x12\xb1" +
+	"\xb6\x44\x9a\x07\xb1\xc8\xaa\x02\x22\x72\xcb\x9e\x85\x0b\xa9\x53\xb3\x4c\x18\x39\x9b\x26\x49\x99\x45\x62\x84\x53\xcc\xee\x4b\x4b" +
+	"\x42\xa7\x2a\xa0\x23\xbd\x96\x79\x38\x1d\xaa\x81\xf5\xb6\x49\xea\xad\x8d\x15\x30\x6d\xc6\xe6\x55\xf2\x03\x4d\x23\xab\x40\x16\xd9" +
+	"\xfd\xb0\x0a\xf6\xec\x59\x03\xb1\xa0\x8b\xa7\x5f\x34\x52\x3b\x1e\xad\x03\x79\x7c\xd5\xdf\xdd\x4a\x11\x1b\x20\xc4\x5e\x5c\x8e\x88" +
+	"\x4c\x68\x93\x92\x16\x46\xf6\xae\x49\x52\x65\xeb\x42\x2a\xd3\xae\x5f\xdb\x17\x52\x99\x76\xfd\xda\xe1\xb1\x74\x44\x7e\x30\x7a\xa7" +
+	"\xad\xdd\x0b\xa9\x8c\xfb\x7e\xed\x5d\x48\x65\xda\xf7\x6b\xff\x42\xea\xd4\xff\x16\xd9\x55\x79\x09\x04\x3a\xef\x00\x48\x9b\x4d\x58" +
+	"\xdd\x70\x08\x40\x6e\xc5\xe5\x88\xc8\x9c\x3a\x2a\x53\xb4\xb9\x15\xc9\xc4\x74\x5e\x67\x65\x2a\xd3\xb6\x5f\x5d\x42\xc6\xd8\xba\xca" +
+	"\xef\xbc\x74\x25\xc5\xa3\x77\xa6\xd2\x3d\xa3\x36\xdb\xea\xae\x4c\x65\xd8\xf6\xab\xa7\x32\x95\x69\xdb\xaf\xde\xca\x54\xa6\x1d\x86" +
+	"\xfa\x2a\x53\x99\x76\x18\xea\x17\x88\xde\x81\x5d\x11\x52\x65\xa0\x32\x95\x61\x8b\xa1\x41\x10\x19\xb6\x18\x1a\xe2\x8c\x2d\xa3\x11" +
+	"\xd2\x79\x0a\xe6\x9f\x5b\x8a\xf0\x12\x7a\x06\x40\xe6\xa3\x10\x52\x91\x09\x3d\xe7\x88\x2d\xc2\x09\xa9\xf2\xa2\x32\x95\x69\x7f\xa1" +
+	"\x97\x95\xa9\xa3\xf7\x17\x7a\x25\x80\x5b\x1e\x20\x25\x5e\x57\xa6\x32\x6e\x2f\xf4\xa6\x32\x95\x71\x7b\xa1\xb7\x9c\x39\x8c\x63\x48" +
+	"\xe8\x1d\x20\x91\x91\x62\xac\x8c\xde\x83\x10\x09\xd9\x2a\x32\xa5\x0f\x9c\xb8\x15\x20\xa4\xc8\x47\xca\xfa\x1f\xa3\x72\xfe\x18\x7d" +
+	"\x22\x66\x4d\x97\xce\xeb\xb9\xcf\x82\xb8\xe5\x13\x52\xe4\x0b\x62\xf9\xc7\x28\x9e\x6a\x3a\x75\x36\xce\x57\x20\x6b\x46\xed\x74\x43" +
+	"\xc9\xd2\x0d\x73\xe4\x30\x06\x21\x99\x11\x30\x22\x23\x42\xbc\x84\x46\x41\x88\x7c\x14\x61\x55\x6e\x8c\x13\xb7\x02\x84\x14\x19\xaf" +
+	"\x6c\x8e\xfe\xb3\xca\x6e\x96\x2c\xb3\xdc\x72\x4c\xf9\x26\x46\x59\x4e\xa2\x7c\x40\xa8\xe0\x11\xcf\x04\x47\x6e\x85\x90\x1b\x85\xf4" +
+	"\x7c\x7c\x12\xc8\x0a\xbb\x49\xcf\x44\x15\x42\x4f\xad\xa5\xca\x14\x57\xd9\x67\x5a\xe6\xaf\x9c\x63\xa4\x22\x9f\x25\x7d\xe3\xc8\x61" +
+	"\x0c\x02\xa3\x69\x6e\xb4\xcf\xb4\xd3\x5f\x39\xc7\xe4\x11\x54\x10\xf9\x5e\xd9\x1c\x7d\x67\x32\x23\x7a\x0c\x4f\xc1\x94\xdf\xd0\xdb" +
+	"\x41\xff\x10\x0c\x4f\x89\x9e\x89\x6a\x46\xa4\x34\x43\x8c\xcc\x49\xeb\x58\x49\xcd\x56\x36\x47\x65\xab\xec\x26\x1e\x54\x66\xfd\x95" +
+	"\x53\xee\x17\xef\x27\x27\x6e\x05\x08\x18\x61\x08\xe6\x80\xac\x38\x22\x96\xcd\x3c\xb7\xd9\x67\x0a\x71\x4b\xb7\xc0\x89\xc3\x30\x41" +
+	"\xed\xb2\x08\x64\xc5\x11\x31\x2b\xb7\x54\xd9\x1c\x35\x21\x19\x79\x62\x93\x57\xb7\x65\x4e\xdc\x52\x47\x43\xd9\x55\x83\xcb\x0a\x90" +
+	"\x15\x57\xc4\xca\x66\x15\x63\x58\xab\x3b\x0e\x13\x32\xba\x66\x78\xce\xb2\x46\xc4\xad\x00\x21\x06\x67\x1d\x40\x8d\x6e\x37\x75\xd0" +
+	"\x95\x92\xd8\x00\x51\xbb\xcf\x7c\x0d\xfd\xe0\x68\x93\x98\xc3\x38\x06\xfd\xb6\x05\xa4\xc6\x68\x37\xeb\x8c\x2a\x72\xda\xa6\x46\x11" +
+	"\xf9\xa8\x69\x1f\x55\x36\x20\x3b\x1c\x31\xda\x4d\x21\x22\xa1\x5d\x22\x44\x46\xfa\xb8\x2c\x2b\xa5\xbd\xca\xe6\xac\xa2\x75\x36\x18" +
+	"\x45\x8d\x39\xea\xc6\x06\x9f\x4e\xe5\x8d\x39\xec\x6c\x73\x3e\xdb\x07\xb0\xc9\x86\x2c\x80\x9e\x72\x81\x48\x2c\xb3\xdc\xd2\x03\x8e" +
+	"\x0c\x86\x11\x88\x1c\x56\x36\x67\xfd\xb1\xce\xae\xe3\xcd\x32\xfa\xb1\xa6\x3f\x6e\xe5\x81\x4a\x1d\xbf\x36\x67\xfd\xb1\xc9\x6e\x10" +
+	"\x32\x10\x15\xda\xc7\x25\x91\x4f\x27\x47\x1c\x15\x42\x84\x48\xd7\xaf\x94\xcd\x75\x15\x88\x67\x93\x06\x01\x1b\x93\xa0\x58\x95\x4d" +
+	"\x37\x47\x6c\x11\x8e\x08\x91\x9e\x5f\x9b\xb3\xfe\xd7\x3c\x28\x6e\x79\xf0\xe6\x9f\xd8\x34\xbf\x7c\x94\xe5\x94\xd3\x1d\x4f\x2f\x90" +
+	"\x96\xa1\x48\x1d\xdc\xc4\x6a\x73\xf9\xf3\x60\x62\x12\xb5\xb9\x51\x1f\x21\x83\x71\x08\xc9\xf4\xff\xda\x9c\xf5\x83\x35\xf3\x5b\x1e" +
+	"\xaa\x1c\x9e\x43\xea\x8c\xc0\x0c\x10\xd3\x72\x83\x74\xa8\x74\x24\x24\x2b\x57\x9b\x1b\x0d\x72\xe2\xba\xde\x8e\x53\x11\x52\x64\x08" +
+	"\xc1\x08\x1f\x95\x91\x65\xf3\x14\x44\xcb\x0d\x3f\x5a\xe9\xf2\x8c\x80\xeb\x01\x40\x4a\x3c\x07\xb1\xce\x8c\xc2\xb9\xdd\xf6\x02\x04" +
+	"\xba\x4d\xa9\xd8\xed\x56\x9b\x1b\xbd\xe4\xc8\x60\x0c\x02\x95\x57\x94\xef\xba\x38\x85\x2a\xa6\xe1\x5e\x13\xb4\xc9\x8c\xba\xd9\x2d" +
+	"\x57\x9b\x1b\xbd\x11\x8c\x51\x39\xaf\xe7\xde\x22\x1e\xe9\x85\xbc\x7d\xab\x77\x40\xa4\x93\x42\xac\xe2\xbd\xe7\xc8\xf5\x10\x22\x54" +
+	"\x3e\xfc\xda\x9c\xd3\xb8\xc4\xae\xe3\x2d\xe7\xc4\x60\x54\x78\x96\xe6\xc9\xf1\xb9\x41\x3e\x78\x7a\xf0\x11\xc8\x32\xbb\x41\xc8\x50" +
+	"\x54\x98\x20\xa4\x7c\x28\x2a\x2c\x97\x46\x9f\x38\xe2\xa8\x70\x44\xca\x7c\xfe\xb5\x39\x27\xb1\xc4\xfa\x23\xf6\xc7\xc9\xb3\xc7\xfe" +
+	"\x73\xaa\xa4\xf4\x3f\xa7\xb2\x68\x62\xba\xf8\x8f\x93\x11\x7f\xf2\x02\x64\x99\x0d\x58\x08\x3e\x7c\x40\xc0\xe7\x2b\x07\xfa\x83\x00" +
+	"\x49\x0c\x23\x12\x72\x51\xa1\xc0\xe6\xe6\x99\x63\x0a\x19\x01\x42\x2e\x2a\x21\xc7\x66\x94\x13\x8e\x88\xe5\x33\xf6\x6b\x73\xce\x28" +
+	"\xab\xb9\x5e\xbe\xc2\x72\x4b\x08\x91\x9f\xb7\x72\x7c\xc6\x89\xa8\xbd\x51\xbe\xca\x72\xcb\xc9\x86\x3e\x4a\x55\xd9\x56\x59\x6e\x62" +
+	"\x82\x33\xd7\x39\x43\x2a\x8a\x11\x32\x93\xbf\x36\xe7\xfc\x55\xd3\x1f\x91\x11\xbd\xe0\x0f\x9f\x7d\xb5\xfb\x76\x73\x3e\x9b\x02\x52" +
+	"\x3b\x10\x91\x0a\x21\xe2\x33\x9b\x08\x32\xfa\x46\x48\x7f\x0c\x82\x70\xa7\x29\x14\x69\x64\x65\xa4\x98\xef\xc4\x48\x27\x9d\x93\x65" +
+	"\xf5\x83\x43\xfd\x91\x93\x94\x69\x36\x83\x02\xd7\xe8\x76\xb1\x6a\x87\x61\x9c\x05\x50\xab\x5b\xce\x2f\xdd\x4f\x22\x0c\x09\xab\x70" +
+	"\xd0\x98\x43\xb3\xd4\xe8\x5e\x71\x0a\x07\x64\x1e\x48\xad\xee\x37\x3f\x9b\x05\x22\xfa\xc3\x04\x75\xc2\x22\x02\xa9\xe9\x8f\xbc\x64" +
+	"\x34\xb2\x04\xa4\xd6\x68\x38\xdf\x67\x99\x10\x53\xc5\x33\x5a\xf9\xb5\x99\xcf\xfb\x94\x9d\xca\xa2\x37\xaf\xe4\xe7\x2d\xbd\xf4\x52" +
+	"\xba\xc2\x72\x13\xab\x84\x1c\x70\x04\x4f\x98\x2d\x84\xee\x62\xd6\x80\x34\x9b\x2a\xe2\x6b\x46\xab\xac\x03\x69\xb9\x69\xfb\xc8\x63" +
+	"\x4c\xc8\x67\x83\x88\x5b\xb6\x0d\x11\xf8\x95\x00\x9b\x4d\x10\xcd\x37\x3d\x17\x25\xb1\xf5\x6b\x73\xfe\x99\x16\x7d\x32\x92\x9f\xcc" +
+	"\x36\x11\x07\x61\x82\x72\xd9\x01\xd1\x6c\x68\x20\x95\xc1\xa8\xb0\x5c\x8b\xec\x22\x8e\x0d\x66\xe4\x2b\x1f\xde\x61\x2e\x92\x22\xd9" +
+	"\xe3\x88\x51\x35\x13\xc1\x6f\xab\x7d\x10\x6b\xae\x88\x3a\x1d\x06\x22\x07\x5c\x44\x27\xac\x1f\x46\xaa\x94\x0f\x39\xa3\xcb\x66\x30" +
+	"\xe2\xd7\x55\x47\x15\x39\x39\x32\x56\xe1\x3a\xab\x9a\x71\x20\x94\xce\xda\x4b\xa8\x8b\x13\x07\x41\x02\xf9\xa4\xab\x9a\x03\xc7\x52" +
+	"\x51\xe1\xa4\x46\x77\x55\x33\x8e\x34\x12\x07\xe2\x04\xda\xad\x26\x37\xea\xe1\x8c\x38\x12\x27\xa6\xdf\x7a\x09\xc2\x81\x44\xea\xf0" +
+	"\x6a\xbb\xe3\x6a\x72\xa3\x3e\x62\x5a\x6e\x3a\x5e\xaa\x78\x35\xb9\x51\x3f\x47\x8c\x73\x7e\xfc\x9e\x1b\x20\xa6\xf9\xa6\xef\x24\x55" +
+	"\x06\xab\x9a\xf3\x5b\x65\x52\xb4\xf2\xd4\xcd\x69\x88\x88\x83\x18\x82\x32\x7a\x0a\x44\x26\x44\x22\x76\xdb\x61\x75\x75\x55\x0b\xee" +
+	"\x0f\x6f\xca\x33\xe9\x13\xc3\x2c\x87\x7f\x3a\xe1\x9e\x98\x06\xe9\x39\x47\x7e\x0f\x20\x37\x0a\xe9\x35\xad\x17\x20\xee\x8a\x5b\x07" +
+	"\x49\xe0\x25\x2d\xa5\xf1\x92\x6b\xec\x31\x25\x82\xbb\xad\x21\xeb\x02\x78\xc5\x99\x8e\x18\xe6\xf8\xa5\xa2\x63\xaf\x81\xdc\xdd\x13" +
+	"\xb7\x0e\x02\x51\x9b\x85\x90\xd3\x9b\xaa\x96\xe8\x1b\x13\x19\xe1\x45\x17\x1e\x2e\x6e\x1d\x64\x30\x6f\x05\xf2\xbb\x8f\x88\x84\xde" +
+	"\x11\x21\x32\xa2\xf7\x84\xfc\x94\xde\x57\xb5\x44\xff\x5e\x65\x37\xf1\xde\x19\xdd\xfa\xf9\x95\xfb\xc0\x91\xdf\xf9\x1b\xe4\x36\x23" +
+	"\x9c\x3e\x02\xb9\x6b\x8b\x58\x36\x9f\xb8\xcd\x1e\x53\x88\xaa\x0a\xa6\xd9\x89\xf9\xcc\x99\x0e\xa6\x8d\x2c\x08\xa5\xfb\x02\xe6\xae" +
+	"\x2d\x03\x23\xad\xf2\xb5\xaa\x25\x9a\x62\x22\xa3\x70\xe5\x86\x05\xc2\x33\x0a\x96\x6e\x84\x10\x9e\x51\x4c\xe5\x46\x31\x8a\xcb\xba" +
+	"\xe7\xf4\x4f\x30\x42\x70\x77\x37\xc6\x91\xdf\x03\x88\xf0\x19\x07\x71\x47\xf7\x1c\x89\x64\x3f\x39\x5d\xa6\x34\x26\xb8\xc6\x1e\xf3" +
+	"\x45\x78\xbb\xc0\x68\x92\x43\x1d\x71\x10\x4a\x37\x05\xe6\xce\x1e\xf3\xbd\xb4\xce\x37\xea\x18\x91\x14\x0a\x23\x7f\x87\xa9\x70\xa6" +
+	"\x05\xf1\xbb\x47\x88\x8c\xbe\x13\x20\x52\xa2\xd2\x41\xc3\x4a\xe9\x47\x55\x4b\xf6\x7f\x77\xd8\x83\x73\x4f\x4e\x1f\xc7\x77\xbc\x5e" +
+	"\x6e\x40\x8b\xa5\xf0\xa8\x78\x06\xc8\x93\xb4\x58\x66\x29\x99\xe3\x77\x72\x4b\x09\xa1\xe5\x8f\xb3\x60\x76\x58\x3a\x8a\xd1\x21\xe8" +
+	"\x67\x55\x4b\xf6\x08\xdb\x61\x55\x80\x12\x69\x56\x78\x5c\xae\xa1\xc0\x5a\xa7\xec\x34\x2b\x2c\x9f\x23\xe4\x09\x5f\x6a\x49\x3f\xb2" +
+	"\x80\xc0\x2b\xeb\x52\x51\x36\xa9\xcc\x0b\x95\x6b\xbe\x8a\x44\x16\x78\x34\x55\x7e\x30\xca\x68\x11\x88\xf0\x51\x88\xe5\xb3\x04\x42" +
+	"\xd8\x38\x22\x12\x59\xae\x6a\xc9\xfe\x6b\x8b\x3d\xe0\xbf\x4f\xe5\x52\x4b\x20\xe7\x86\x59\x4e\x39\x8a\xb7\x02\xa4\x3d\x1d\x95\xdf" +
+	"\xcd\xcd\x96\xc4\xf1\xbb\xb9\x09\x02\x68\xff\xcb\x55\x10\x5b\xcc\x46\xb4\x08\x31\x6b\x55\x2d\xd9\xa3\x6c\x8b\x55\x91\x13\x25\xcd" +
+	"\x5f\x5c\x33\x6b\xb7\x4e\x50\xfb\x35\xe8\x50\xe9\x08\x81\x19\xe5\x04\x9d\x0d\xa1\xc3\x19\x5b\x46\x32\x9b\x3c\x9e\x2a\x9d\x94\xda" +
+	"\xef\x44\x59\x6d\x81\xe1\x4e\x8a\xb0\x8c\xb6\x01\x6c\x31\x9b\xd0\xc5\x83\xcf\x0e\xea\xbb\xa5\x3b\x4f\xa7\x2d\x1b\x6f\x17\x44\xbb" +
+	"\xd1\x78\xaa\x78\x6a\xf3\xd0\x3d\x20\x5b\x46\xdf\xd9\x2a\xc4\xec\x53\xc3\x6c\x19\x6d\xe7\xd7\xee\x80\x98\x76\xa3\xef\xfc\x9c\x0e" +
+	"\x85\xce\xb5\x80\x8e\x64\x3a\x7e\xa3\x78\x54\xe7\x05\x6a\xd7\x09\x44\x38\x29\xc4\x32\xea\x02\x21\x7c\x1c\x11\x89\xa4\x7f\x6b\xc9" +
+	"\xf9\xca\x96\x58\x35\xdf\x21\xfc\x41\x81\x78\x53\x5c\xdc\x47\x1c\x7f\x50\x70\xae\x9b\x90\x3b\x37\x10\x0a\x2d\x25\x26\x02\x17\x3f" +
+	"\xde\x2b\xa0\x07\x33\x3d\x1c\x11\x22\x36\x23\x54\x7a\x7f\x6b\xc9\xf9\xef\x12\x4b\x33\x62\xe4\x6d\x27\x3d\xc5\x2b\x1f\x61\x39\xe7" +
+	"\x8e\x57\x15\x64\xf5\x81\xb9\x33\xa4\xc6\x40\x7e\xc8\x10\x40\x46\xfd\x44\x38\x22\x0a\x81\xc6\x80\x48\x48\x30\x94\x91\xb8\x79\x55" +
+	"\xcc\x20\x31\xc2\x88\xa7\xe4\x39\x0d\x71\x46\xca\x78\x10\xbc\x9e\xfe\xd6\x92\x33\xcc\x56\x58\x35\xfa\x17\x4e\xf2\x19\xab\x4c\xfa" +
+	"\x19\x01\x77\xf9\xed\x2b\x19\xc9\xef\x36\x2a\x1d\xee\x59\x9e\x73\xa4\x3a\x80\x08\x91\x17\xbf\xb5\xe4\xfc\xb5\xc2\xd2\x4c\x5e\x03" +
+	"\xfa\x19\x2b\x3d\xb1\x42\x24\x2f\xc1\xdc\x1d\x12\xb7\xa6\x92\x81\x0a\x21\x74\x4f\xf9\x8a\x98\x34\x8b\x61\x20\xf3\x5a\x24\x94\x66" +
+	"\x6e\x46\x0a\x79\x43\x88\xb4\xb2\x02\xd6\x56\x6f\x39\x94\x66\x31\x10\xbc\xde\x51\x81\x57\x8c\xce\x73\xab\xf7\x9e\x88\xbb\xba\xf1" +
+	"\xbc\xe2\x7d\xe0\x84\xd1\x77\x6e\xf1\x3e\xa2\x61\x56\xcc\xb6\x33\xaa\x27\xc7\xf1\x13\xa0\xbb\xba\xef\x74\x61\x40\x90\xd3\x67\x42" +
+	"\x5c\x19\xc5\x40\xe5\x8b\x48\x28\xcd\xbc\x8c\x14\xf3\x95\x18\x61\x65\x05\xac\xad\x86\x39\x93\x66\x7e\x56\x4a\x67\xe4\xb7\x16\xbe" +
+	"\x4f\x94\x7a\x0a\x2d\x3f\xd2\xf8\x3a\x2d\x9c\x08\x49\xc8\x36\x13\x0c\xbd\xa3\x2c\x56\xe7\x2b\x66\x8c\x98\xf6\x6e\xfe\xb2\xb8\x27" +
+	"\x43\xeb\x52\xc7\x39\x73\xc9\xb7\xc2\x52\x43\x7a\xd1\x79\x42\x58\x5d\xf2\xad\x14\x33\x49\x4c\xfb\x6d\xcf\x09\x6f\x9a\x91\xd1\xd4" +
+	"\x6f\x2d\x79\xff\x11\x29\xe1\xe7\x0e\xbd\xbe\x6d\xa5\xf4\x0d\xc4\x36\x73\x10\xda\x7d\x42\x31\xd3\x60\x44\x46\xbe\x0a\x19\x7d\xff" +
+	"\xad\x25\x6f\x9a\xb5\x3f\xd4\x8b\x2e\x64\x2c\xfc\x3d\x71\x1c\x07\x49\xc8\x36\x7b\xa8\x96\x6e\xc8\x84\x14\x32\x43\x48\x7b\x77\x14" +
+	"\x23\x43\x56\xb3\x1c\xba\xe4\x33\xaa\x30\x3f\x85\xd5\x25\xcf\x4a\x21\x73\x84\xb4\xdf\x0e\xa8\xc8\xe2\xcd\xff\xd6\x92\xf7\x6f\x91" +
+	"\x12\x7e\x43\xeb\xb4\x65\xbc\x0b\x20\x44\x46\x8a\xe0\xb5\x93\xc8\x22\x10\x99\x91\xaf\x42\x19\x2d\xd1\x38\x3e\x31\x3a\x4f\xfe\xb0" +
+	"\x52\x43\xb0\x4c\xc8\xae\xd9\x79\x72\xcf\x63\xc5\xac\x10\xf3\xc4\xe8\x3c\x4b\x86\x36\x68\x59\xe5\x8c\xd1\x79\x65\x23\x2c\x87\xd6" +
+	"\x32\xa8\xd2\xac\x09\x2f\xa3\xf5\x08\xb2\xea\xb7\x4e\xd0\x13\xdd\x7b\x5a\x07\xf5\x83\xd7\x06\xda\x46\x64\x25\x97\x2b\x5b\x49\x6d" +
+	"\x02\xd8\x65\x36\x61\xb7\xde\x16\x10\x91\x92\xa7\x41\x19\x6d\xff\xd6\x9a\xf1\x6e\x68\xe7\xb7\xd6\x0c\x77\x43\xbb\x44\x38\x22\x0a" +
+	"\xc1\x6d\xc1\xde\x6f\xad\x99\x6e\x86\xf6\x7f\x6b\xcd\x74\x33\x74\xc0\x11\x21\x62\x33\x42\xe5\x50\x18\x89\x60\x70\x77\x41\x19\xdd" +
+	"\x28\xcc\x52\xc1\x74\x54\x83\x11\x19\xd1\xcd\x83\x9f\x52\x27\x67\xa4\x8c\x07\x21\xa9\xae\xea\xd6\x8c\xf7\x43\xe9\xea\xd6\x8c\xf7" +
+	"\x43\xdd\xc4\x74\xc4\x31\xb0\xea\xa9\x6e\x3d\xfa\x86\xa8\xb7\xba\x35\xd3\x0d\x51\x1f\x47\x7e\x0f\x20\x42\xa4\x5f\xb8\x74\x30\xd7" +
+	"\x46\x05\x32\x40\x88\x4c\xc8\x52\xd1\x09\x0d\x72\xa8\x23\x0e\x42\x46\x43\xd5\xad\xd1\xbf\x97\xcd\xce\x33\xaa\x27\x2b\xfc\x14\xd0" +
+	"\x1d\xdd\x7a\xba\x30\xea\x9d\xf8\x67\x84\xb8\x32\x8a\x81\xca\x73\x1a\xcb\x65\xa3\xf9\xdc\xea\xbd\x20\xe2\x8e\xee\x3d\xaf\x78\x2f" +
+	"\x39\x61\xb4\x9e\x5b\xbc\x57\xc2\xa5\x83\x79\x36\x2a\x92\xd7\xc4\x88\x84\x2c\x15\x9d\xd0\x1b\xce\x74\x30\xdf\x4a\xe9\xbc\xad\x6e" +
+	"\xcd\x1e\x65\x4f\xf8\xed\x99\x75\x33\xa9\x6e\xcf\xde\x11\xb2\x23\x6e\xe1\xac\xbb\x49\xc5\xbc\x27\xe6\x09\xbf\x41\xf3\x65\xe8\x66" +
+	"\xf1\x43\x75\x6b\xf6\x5f\xc2\x09\xbf\xf5\xf4\x2d\xb2\x54\xf9\x08\x62\x87\x39\x88\x7d\xb3\xf8\x09\x8c\x30\xf2\x55\xc8\xe8\x33\x0f" +
+	"\xa6\xca\xcf\x49\xdd\x60\x7c\x11\x39\x55\xf9\x39\x29\xe6\x2b\x31\x4f\xae\x79\x29\xa9\xdb\xdf\xe1\xea\x56\xdc\x89\x3f\xf0\xef\xc4" +
+	"\xd5\xfd\xd9\x08\x21\xe2\xe1\x81\x5d\x3b\x89\x8c\x12\x22\x1f\x1e\xf8\x32\x64\x35\x56\xdd\x9a\xfd\xdf\x27\x0f\xfc\x7b\x71\x25\x33" +
+	"\x5e\xdd\x9a\xe9\x29\xc5\x44\x75\x6b\xc6\xa7\x14\x93\x3c\x9a\x2a\x3f\x18\x55\x98\xa9\xea\xd6\x4c\xcf\x28\xbe\x55\xb7\x66\x7a\x46" +
+	"\x31\x4d\xe5\x6d\x37\x3a\x4f\x7e\xf3\xab\xb1\xfe\x4e\x88\x7c\x78\x40\x4e\x72\x3b\x28\xc5\xfc\x20\x46\x3c\x3d\xf0\x65\xe8\x4e\x70" +
+	"\x06\x3d\x23\x9c\xbc\x07\x0c\x28\xcc\x6c\x75\x6b\xa6\x87\x14\x3f\xab\x5b\x33\x3c\xa4\x98\xe3\x91\x18\x6d\xa7\x6f\x5b\x65\x5d\xe6" +
+	"\x45\x46\x46\xdf\xf9\x37\xda\x0b\xd5\xad\x99\x1e\x52\x2c\x56\xb7\xe6\xfc\xb5\xc4\x6e\xc8\x68\xf4\x62\x6b\x75\xe3\xba\xc4\x91\xea" +
+	"\x00\x22\x6e\x20\x96\x41\xdc\xb9\xa1\x6e\x9a\xfc\x9b\xdf\x95\xea\xd6\x9c\x61\x26\x7c\xec\x3b\x68\x89\xac\x0a\xa4\xda\x47\x84\xcd" +
+	"\x1a\x11\xc2\x27\xe6\x2e\x7b\x1d\xa1\x2c\xb1\x21\x55\x5e\x7d\x07\x4d\x77\x5e\x70\xda\xe0\x4c\x9a\x85\x99\xe3\x55\x05\x59\x9b\x40" +
+	"\xee\x18\xf7\x8a\xf2\xf3\x4e\xab\x6c\x55\xb7\xe2\x66\xfd\x86\xbc\x4c\x02\x95\xdb\xe6\x48\xb5\xba\x21\xf2\x4b\xb7\x03\xe4\x8e\x2d" +
+	"\x62\x25\xb4\x5b\xdd\x4a\xf7\xea\x84\x84\x2b\xb7\x27\x10\x7d\x97\xe8\x95\x6e\xbf\xba\x35\xd3\xf3\x89\x03\x44\x82\xca\xa9\x58\x54" +
+	"\x55\xd4\x0d\xff\x21\x67\x9c\x67\x0b\xf6\x8d\x62\xc7\xc5\xd6\x0c\x0f\x28\x3a\x2f\xb6\xe2\x66\xfd\x86\x7f\xaf\xae\x6e\x5a\xbb\x38" +
+	"\x52\x1d\x40\x44\x46\x69\x10\xe2\xa9\x81\x22\xac\x1b\xdf\xee\x8b\x68\x17\xe1\x63\xdd\x3b\x4b\xa2\xe7\x62\xeb\xd1\xcf\x26\x7a\x2f" +
+	"\xb6\x66\x7a\x36\xd1\x87\x38\x56\x8c\x86\xd3\xa1\xea\x7b\xfd\xfe\x8b\xad\x19\x1f\x4f\x0c\x5c\x6c\xcd\xf8\x78\x62\xf0\x62\x6b\xa6" +
+	"\xfb\xa0\xa1\x8b\xad\x99\xee\x83\x9e\x0a\x95\xdb\xbe\x8a\x44\x9e\x5d\x6c\xcd\x74\x23\xf4\xfc\x62\x6b\xc6\x1b\xa1\x17\x60\xb6\x59" +
+	"\x77\x14\xa3\x43\xd0\x4b\x0e\x5d\xf2\x19\x95\xd4\x2b\x20\x22\x27\x85\x58\x39\xbd\x06\x21\x52\x72\x44\x24\xf2\xe6\x62\x6b\xe6\xdb" +
+	"\xa0\xb7\x17\x5b\x33\xdd\x06\xbd\x13\x3a\xb7\xd5\x0a\x73\x2d\x23\x99\xf7\x17\x5b\x33\xdd\x09\x7d\xb8\xd8\x9a\xe1\x4e\xe8\x23\x88" +
+	"\x5d\x66\x23\x5a\x84\x98\x4f\x9c\xb9\xa4\x8d\x28\x16\xab\x7a\x9f\xc1\xf0\x3b\x3b\x45\x58\x19\x7d\x01\xb0\xcb\x6c\x42\x17\x0f\x3e" +
+	"\x5f\xa9\x67\x76\x8d\xce\xf3\x6b\x37\x4c\xcc\x13\xa3\xf5\x7c\xab\x11\xa1\x73\x3b\xa0\x23\x99\x51\x8c\xe5\xae\x6e\x3e\x9d\xb6\xec" +
+	"\xbd\x31\x10\x4f\x8c\xde\x53\xc5\x53\xdb\x92\x8e\x03\xd9\x35\x5a\xcf\x56\x21\x66\x82\x33\xaa\xf3\x02\xb5\x9b\x04\x22\x52\x52\x88" +
+	"\x95\xd1\x14\x08\x91\x90\x23\x22\x91\x6f\x17\xff\xcc\xf4\x2a\xea\xf4\xc5\x3f\x8f\x7e\x15\xf5\x3b\x07\x0e\x83\x00\x49\xfc\xb8\xf8" +
+	"\x67\xa6\x57\x51\x67\x2e\xfe\x99\xe9\x55\xd4\x59\x8e\x38\x2a\xf4\xd1\xab\x64\x7e\x82\xa1\x7c\x14\x24\x6e\x68\x55\x30\x73\x40\x28" +
+	"\x1f\x65\xe5\x24\x34\xcf\x09\x47\xc4\xca\x68\xe1\xe2\x9f\x99\xde\x46\x5d\xbc\xf8\x67\xa6\xb7\x51\x97\x08\x39\x8c\x41\x20\xb2\x7c" +
+	"\xf1\xcf\x0c\x2f\xa3\xae\x5c\xfc\x33\xe3\xcb\xa8\xab\x9c\xb9\xa5\xf7\xa5\xd7\x8c\x90\x59\x23\x44\x66\x64\x39\xa9\x60\xd6\x89\x91" +
+	"\x29\x69\x2f\x2b\xa7\x0d\x0e\x1d\x32\xc7\xcc\xcc\x6a\x13\x1d\x53\xab\x1b\xc6\x29\x1e\xfa\x61\x0b\x48\x8d\x6e\x3a\xdf\x68\x9b\x88" +
+	"\xc3\x30\x41\x83\xb4\x83\x71\xac\xd5\xfd\x62\x95\x0e\xc0\x2e\x80\x1a\xdd\x73\x7e\xe5\xf6\x88\x30\x24\xac\xba\xe1\x64\xec\x7d\x10" +
+	"\xb5\x87\xcc\x73\xd1\x71\x1c\x00\xa9\x31\x1a\xce\x4f\xe6\x90\x10\x53\xc5\xcb\xa6\xe3\xd2\x9f\x59\x7f\x34\x0f\x45\xea\x45\x5e\xf9" +
+	"\x66\x87\xfe\xe1\xd7\x49\xc8\x20\x47\xd4\x4b\xad\x12\xa1\xb7\x4d\xbb\x80\xb4\x98\x2a\xe2\xe5\x0e\xad\x92\xbe\xf4\x67\x56\x51\xb3" +
+	"\x7e\x31\xd9\xf7\xe9\x26\x62\x30\x4c\x90\x4d\x0f\x88\x16\x43\x43\xbe\xb3\xaa\x45\x7a\x11\x49\xb3\x7e\x2f\x59\xbf\x19\x2b\x5f\x18" +
+	"\xee\x23\x42\xbf\x96\xec\xbf\x3b\xdb\x0f\xa2\xe5\x86\x97\x8c\x92\x18\x00\xb0\xce\x8c\x7c\xcd\x37\x63\x09\x19\xe4\x88\x51\x35\xf7" +
+	"\xe5\xd9\x21\x10\x9b\xae\x08\x7c\x94\xc8\x53\xe4\xbb\xee\xbc\xcd\x6d\xd9\x3c\xe3\xc4\x60\x90\x80\xcb\xf3\x4b\x7f\x66\x7a\x21\xfc" +
+	"\x05\x02\x59\x77\xdf\xe5\xb6\x8b\xf6\x92\x33\xba\x6a\xfe\x6b\xb3\xaf\x2e\xfd\x99\xe9\x95\xf0\xd7\x97\xfe\xc4\xfb\xd1\x43\xfc\x8d" +
+	"\x62\xbf\xdd\xfe\x3f\x63\x6f\x1f\x12\x75\xda\xf6\xff\xef\x39\x57\xdb\xd5\x96\xb5\x7b\xef\xd5\xed\x77\x77\x89\x88\xc5\xb5\xb4\xa7" +
+	"\x69\x32\x7b\x72\x6b\x2e\x89\xb8\x89\x90\x61\x18\x86\x61\x18\x62\x89\x25\x96\x88\x45\x22\x16\x89\x08\x91\x61\x10\x11\x11\x11\x89" +
+	"\x88\x88\x88\x88\x88\x88\x88\x08\x89\x08\x89\x88\x88\x08\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\xf9\xf1\x3e\xce\xe7\x87\xe9" +
+	"\xf3\xfb\xbb\x17\xef\xf7\x79\x1c\x73\x34\xce\xe7\x73\x9c\xe7\x71\x62\x27\xea\x43\xce\x88\x5d\xc7\x65\xea\xed\x11\x41\x67\xc5\xc6" +
+	"\xe3\x40\xc5\x25\xb6\xc4\x1e\x57\x9d\xdb\x50\x90\x5e\x6a\x93\xb4\x94\x81\xd5\x13\x22\x7a\xcb\x10\x64\xf4\x14\x88\xf4\xb1\xb6\x49" +
+	"\x2b\x95\x67\xb4\x96\x33\x59\x27\x26\x95\xbe\xc4\x96\xd8\xf3\xaa\x73\x91\xfb\xc2\x5f\x54\x9d\x8b\xd8\x17\xfe\xb2\xea\x1c\x1e\xe3" +
+	"\xb2\x6a\xc3\xaa\xbe\x45\x4a\x22\xaf\x38\x92\x09\x21\xe2\x63\xea\x07\x32\xc9\xc4\x83\x84\x44\xac\x4d\xc7\xaf\xab\xce\x6d\x7a\xc3" +
+	"\xa4\x93\xde\x99\x8c\x2f\x17\xc9\xbc\x11\x8c\xb1\x4f\xd7\xde\xe0\x7c\x66\x1b\x7b\x4b\x8c\xf4\xd2\x3a\x96\xd9\x00\xd6\x33\xc1\x7a" +
+	"\xf4\x3e\x5c\x6b\xfb\x32\xbc\xde\x71\xa4\xbb\x0c\x82\xe2\x7b\x0f\x04\x5b\x8f\x75\x58\xe2\xc5\x9f\x14\xf9\x50\x75\x6e\xd3\x2f\x13" +
+	"\x2c\x2b\xf7\x9b\xfa\xc9\xfb\xc8\x89\x4c\x80\x90\x3b\x5f\x3f\x01\x99\x74\x44\xac\x70\x3e\x57\x9d\xdb\x74\x62\xc2\xdd\xd3\x6d\x66" +
+	"\xee\x0b\x27\xf4\x06\x5b\x3b\x6f\xb0\x19\xac\x3a\x17\xb5\x31\x7c\x08\x2b\x41\xda\xd4\x52\xdc\xac\x0d\x73\x42\xef\xb0\xb5\x93\x06" +
+	"\x9f\x91\xaa\x73\x5f\xdf\x16\x3e\x8a\xb4\xc6\x75\xc5\xa9\x9d\xbe\x6a\xb7\xf0\x18\x11\x99\x00\x21\x8a\x60\x1c\x40\x22\x6b\xef\x8f" +
+	"\x56\x91\xe0\x61\x71\x82\xca\x44\xb8\x50\x95\xc8\x3f\x53\x0a\x99\xac\x3a\x17\xb1\x29\x7c\xaa\xea\x5c\xe4\xa6\xf0\x69\x2c\x25\xde" +
+	"\x13\xf3\x17\xab\xb7\x0b\xcf\x10\xd3\x5d\x8e\x41\xad\xcd\x56\x9d\x8b\xda\x15\x3e\x57\x75\x2e\x6a\x43\xea\x7c\xd5\xb9\xa8\x0d\xa9" +
+	"\x0b\x1c\x71\x54\xf0\xed\x2e\x45\x16\xab\xce\x7d\x7d\x43\xea\x52\xd5\xb9\xa8\x0d\xa9\xcb\x1c\x59\x0b\x23\x10\x59\x01\x81\x68\x24" +
+	"\xe0\x05\xb3\x0a\x02\xc1\x98\x04\x69\xa8\x60\xd6\x38\x62\x8b\x70\x44\x88\xac\x57\x9d\x8b\xdc\x89\xda\xfa\xdb\xb9\x88\x9d\xa8\x6d" +
+	"\x9c\xb8\xa8\x87\x81\x28\x42\x8a\x14\x7f\x3b\x17\xb5\x15\xb5\xf4\xdb\xb9\xa8\xad\xa8\xed\x84\xac\x95\x43\x68\xb9\x1d\x60\xce\x5e" +
+	"\xb4\x8d\xac\x80\x3a\x41\x9c\xf9\xc3\x17\x91\xf1\x74\x11\x70\x31\x00\x48\x89\x6e\x8a\x78\x8e\x19\x89\xf3\x3f\xa1\x1e\x82\x66\x98" +
+	"\x91\x39\xbb\xe0\x70\x4b\x92\x60\x8c\xdc\x79\x15\xd7\x87\xe5\xcc\x31\x23\x79\x6e\xc9\xdd\x02\x81\x92\x53\x4b\xb6\x6b\x0e\xbb\x78" +
+	"\x39\xb2\x56\x06\x81\xca\x1d\x20\x32\x26\xc4\xed\x87\x74\x17\x88\x8c\x48\x21\x56\xf2\xee\x71\xe4\x62\x08\x11\x2a\xf7\x7f\x3b\xcf" +
+	"\x7f\x00\x1f\xdb\xc4\xcf\xcd\x63\x2e\x14\x89\xe8\xdd\x17\x0f\x88\x29\x31\x8c\x2f\xe7\x7f\xbe\x2d\xe6\xdb\xe6\xef\x2b\x1f\x02\xd9" +
+	"\x61\xca\x24\xb6\xd0\xac\x10\xad\xf2\xe8\xb7\xf3\x1b\x0e\x88\x5f\xc0\x18\x76\x11\x30\x7a\x4c\x48\x89\x85\x11\xf8\x3c\x01\xb1\x43" +
+	"\x88\x60\x60\xbb\xef\xf3\x14\x4b\x39\xd3\xe3\x44\x84\x1f\x1b\xfa\x0b\xea\x19\x31\x45\x27\x22\xc5\xe0\x5d\xe0\x73\x20\x3b\x7a\xfc" +
+	"\x88\xb4\xca\x0b\x20\x33\xcc\x88\x5a\xe7\x17\x3f\x40\x29\xa6\x97\x1c\x32\xb2\x67\x43\x88\xea\x15\x98\x2b\xae\x0e\xa6\x9f\x29\x99" +
+	"\x7e\x04\x3e\x23\x7e\xc8\x22\x37\xfc\xac\xb3\x65\xf5\x9a\x33\x25\x16\x66\xe0\xf4\x06\xc8\x15\x23\x7f\x84\x58\x4e\x6f\xb1\x98\x19" +
+	"\x66\x44\xce\x17\x6c\x67\x70\x80\x53\x46\x0a\x1d\x0a\x39\x7c\x07\xe8\x8a\xab\x04\x37\x2d\xf4\xfe\xb7\xf3\x1b\x86\xf0\xa3\x58\x0d" +
+	"\xbe\x70\x0a\x23\xb1\x25\xf6\x81\x33\x25\xa6\x07\x68\xf8\xa5\xf1\x91\xa0\x1d\xa6\x90\x55\x1b\x89\x2d\xb1\x4f\xbf\x9d\xdf\x30\x2c" +
+	"\xbd\x02\x35\x88\x1f\x30\x1c\x11\x56\xe1\x22\xfc\x42\x8c\x70\x0a\x54\x61\x62\x4b\x6c\x90\x16\x73\xa6\xc7\x89\x8a\xb2\x23\x7e\x5c" +
+	"\x0c\x71\xa4\xe8\x06\x25\x19\x24\x70\x98\x98\x1d\x3d\x7e\x4c\xf2\x27\xca\xc8\x6f\xe7\x37\x1e\x5b\x66\x57\xf1\x13\x86\xde\x58\x53" +
+	"\x6b\x8e\x5e\x7c\xf1\x0e\x1f\x26\x5f\x8c\x02\xba\x59\xa4\x9f\x42\x78\xef\x4d\x0c\x26\x45\xea\x8b\x1d\xc6\x80\x2c\x33\x87\xd1\x3a" +
+	"\x34\x38\x64\xfc\xb7\xf3\x1b\x4f\x2c\xb3\x66\x76\xe0\x3b\xd1\xfb\xa4\x37\x68\x98\x8e\x8e\xdb\x4d\xa8\xc9\x37\x01\xe6\x26\xbf\x21" +
+	"\x85\x7e\xaa\xd0\x0b\x32\x98\x01\x21\x99\x49\x2e\xd3\x1a\x2b\xa3\x43\xd0\x14\x5f\x50\xb3\x13\x98\xe5\x35\x0d\xe6\x66\xab\xbd\x66" +
+	"\xcb\x6a\x06\xc4\x32\x73\x10\xdb\x69\xf6\xb7\xf3\x1b\xfb\x59\xfd\x55\xfd\xd6\x1f\x23\x44\x79\xe8\xc7\x36\x31\xca\xe0\x1c\x31\x95" +
+	"\xc5\x98\x6c\x1d\xe0\x4e\x04\xbc\x91\x96\x77\x3c\xcc\x13\x50\x6f\x00\xa6\x06\x88\x85\xdf\xce\x6f\x7c\xc3\xea\x9b\xc9\x86\xa2\x26" +
+	"\x1b\x5a\xca\x20\xfb\x8e\xb7\xf0\x16\x09\xaa\x6c\x25\x19\x4a\x9f\x34\x22\x06\x3a\x4b\x84\xd4\x0b\xc4\xd7\x01\xb3\xcc\x57\xd3\xec" +
+	"\x84\x64\x7b\xad\x10\x54\xd9\xea\xc5\xa4\x64\x56\x89\xa8\x6f\xb5\x83\xb2\x9c\xd6\x90\xe0\x7a\x5d\x7e\x3a\x75\xaa\xfa\xd6\x81\x54" +
+	"\xea\xca\x92\x36\xba\xae\x5a\xab\x21\x62\x10\x96\x08\x7d\x90\x6d\xd5\xe7\x37\x9e\xa8\xd7\x95\x17\x88\xa7\x08\xa4\xd2\xa8\x3c\x3b" +
+	"\x1e\xa8\x94\x80\x88\x3b\xa5\x02\x32\xe4\xd4\x4e\x8b\xd1\x75\x17\x70\xea\x00\x52\xd9\xea\x45\xa4\x45\x3a\x41\xd4\x1b\x84\xef\xd3" +
+	"\x55\x7d\x7e\xd3\x2b\x96\x28\x32\xea\x98\x53\xd8\x3b\xb6\x6d\xa4\x37\x76\x7c\x4c\xd7\xd5\xef\xff\x5f\x37\x31\xdb\xbb\xf9\xde\x48" +
+	"\x5c\x04\x84\x67\x03\xb4\xd7\x08\xc1\xcf\xf1\x1e\x8e\x48\x19\x8f\xc1\xd7\x48\x6f\xf5\xf9\x4d\xaf\x59\xa2\xc4\x74\x5b\x1d\x33\x95" +
+	"\x7f\xee\x33\xfe\x32\xf4\x11\xb3\xbd\x37\xa6\x9a\xf3\x52\x46\xbe\x4b\xb9\xc5\x09\x4b\xc5\x40\xf0\xcd\x78\x9b\x16\x93\x28\x39\x31" +
+	"\x99\x3e\x77\x08\xd9\xde\xeb\x87\x24\x7d\xee\x72\xa2\x14\x88\x48\x8a\xdc\xab\x3e\xbf\xe9\xd8\x34\x2b\x32\x99\x5f\xda\x85\xf5\x73" +
+	"\x8f\xf1\xd5\x79\x1f\xc8\x65\xfe\xf8\x47\x17\x42\x11\xb2\xb1\x72\x6b\x0c\x5f\x9d\xf4\xce\xe1\x01\x21\x45\x16\x46\x90\xb8\x87\xd5" +
+	"\xe7\x37\x9d\x98\x66\x25\x46\x35\x85\x88\xe9\xf5\x38\x4f\x1c\xde\x80\x60\x31\x8f\x00\x5d\xee\xd5\x45\x45\x0c\x64\xf4\x8b\x94\xc7" +
+	"\xc4\xb8\x3a\x8a\x81\xcc\x13\xac\x86\xbc\xac\x98\x2c\xab\xa7\x60\x2e\xf7\x06\x82\xd2\x56\xcf\x88\x29\xb1\x32\x0c\xac\x9e\x53\x86" +
+	"\xa7\x99\x51\x7b\x7e\x02\x5f\x10\x74\xd9\xa8\x3d\x25\x24\x5f\x3b\xbc\xe4\x88\x59\x7b\x16\x03\x99\x57\x54\x35\xd3\xcc\x2c\x1b\x23" +
+	"\x89\xe2\x03\xed\x27\xea\xb2\x51\x7d\x3a\x41\xa2\x2c\x5e\x73\xc4\xd3\x91\x0c\x02\x7b\x23\x02\x2b\xb9\x81\x99\x5e\x6f\x09\xba\xdc" +
+	"\x1b\x08\x4c\x7a\x0d\x70\xa4\x14\x0a\x4c\xca\xbc\xab\x3e\xbf\xf9\x0b\x6b\x69\xd6\x2f\xbe\xf8\x7c\xcc\x1d\xdb\x98\xfa\x03\xf5\x9e" +
+	"\x98\x11\x26\x20\xfc\x0e\x20\xa8\xb2\xd5\xfc\x33\xf6\x81\xa8\x96\x36\x9a\x3a\xe5\x2b\xd1\xa5\x4a\xd5\xe7\x37\x0f\x4a\x33\x9a\x26" +
+	"\xc7\xe7\x7a\x99\x66\x9f\x88\x19\x61\x2e\x64\x9b\x7d\x26\x4a\x98\xe1\xf7\x8d\x25\x04\xaf\x2f\x7c\x39\x57\x03\x81\xa9\xbf\x87\x83" +
+	"\x22\xb0\xab\x6e\x60\x45\xf3\xaf\xe6\x10\x51\x2d\xa5\x40\x60\xf2\x96\xad\x61\x42\x76\x1a\x59\xc4\x8d\x52\x98\x23\xa6\x56\x3c\x42" +
+	"\x48\xd2\xc8\x21\xab\xdc\xfa\xad\x1d\xd5\x28\x31\x3b\x8d\x14\x9a\x32\x30\x1a\xa3\xb8\x85\x91\xba\x69\xcb\x32\x1a\x27\x44\x18\x71" +
+	"\xc4\x33\x9a\x20\x46\x18\x21\x7d\xae\xcf\x24\x5f\x89\x91\x3d\x49\xf0\x5d\x43\xc8\xcb\x14\x31\x49\x23\x79\x14\x11\xe5\x4e\x41\xd3" +
+	"\x04\xed\x34\x92\x67\x09\xc1\x6b\xa6\xfa\xfc\xe6\x7d\x2d\xcd\xf4\xf3\xda\xca\x2e\xcd\x83\xa3\xf5\xce\x02\x19\x61\xcd\xea\x27\xb8" +
+	"\x51\x7f\x0a\x9a\x03\xd4\xd2\x16\x2b\x23\x44\xfb\xbb\xe6\xab\xcf\x6f\x3e\xd0\xd2\x4c\x8f\x16\x76\xfd\x29\x99\x05\x20\x23\xcc\x61" +
+	"\x1c\xaf\x45\x40\x2d\x6d\xea\x39\xc6\xd6\x21\xab\x25\x5a\xce\x55\x7f\x35\xaa\xb0\x96\x41\x8c\xb0\xab\x4e\x54\x56\xf1\xad\x80\x69" +
+	"\x29\x05\x82\x02\x42\x46\xab\xd5\x4d\x51\x0f\x4c\x6b\xd5\x4d\x51\x0f\x4c\xeb\x84\x94\x58\x18\x81\x48\xeb\xee\xa6\xe8\x87\xa5\xb6" +
+	"\xdd\x4d\x91\x0f\x4b\x45\x62\x5c\x1d\xc5\x40\xa6\xb4\x9b\x62\x2a\x3a\x31\x59\x56\xed\x60\xae\xf4\x04\x82\xd2\x56\x1d\xc4\x14\x59" +
+	"\x19\x06\x56\x9d\xbb\x9b\x22\x9f\x95\xba\x76\x37\x45\x3d\x2b\x75\x73\x44\xca\x78\x0c\x32\xd8\xb3\xbb\x29\xf2\x61\xa9\x77\x77\x53" +
+	"\xc4\xc3\x52\x1f\x27\x2c\x15\x03\x41\x4c\xb7\x68\x31\x67\x8a\x4e\x4c\xa6\xcf\x6d\x42\x76\xf4\xf8\x21\x49\x9f\x3b\x9c\x28\x06\x22" +
+	"\x92\x22\x77\x09\x99\x61\x46\xf2\xfc\xe2\xbb\x47\xd0\x15\x23\x79\xea\x43\x90\x03\x66\xee\x73\xc4\x4c\x9e\xc5\x40\xe6\x01\x85\x3d" +
+	"\xc3\xcc\xb8\x8d\x02\x14\x2b\x7a\x48\xd4\x15\x23\x7d\xba\xb8\x44\x5c\x8f\x38\xe2\xe9\x48\x06\x81\x3d\x16\x81\x15\xdd\xc0\x4c\xaf" +
+	"\x27\x04\x5d\xe9\x09\x04\x26\xbd\x9e\x72\xa4\x18\x0a\x4c\xca\x3c\xdb\xdd\x84\x9d\xb6\x57\xf5\x44\x10\xf5\xb0\xa9\xbe\x06\x9e\x13" +
+	"\xb3\xcc\x04\xa4\x9f\x36\xad\xe7\xbb\x17\x44\xdd\x2c\xea\xe7\x2e\x4b\x09\xcf\x54\x2f\x77\x37\x6d\xec\x97\x66\xfa\x89\xd4\x32\x7b" +
+	"\x45\xcc\x32\x73\x21\xdb\xac\x9f\x28\x61\x66\x3d\xb7\x4a\xaf\xd7\x7c\x39\xcd\x81\xc0\xd4\x5f\xa2\x37\x22\x30\xe3\x81\xd2\x7e\x8c" +
+	"\x26\xea\x2d\x51\x37\x5b\x03\x81\x81\x81\xd9\xc0\xee\xa6\x8d\x27\x6e\x5e\xf5\x1f\xd8\xf5\x23\xdc\x3b\x20\x78\xcb\x20\x7f\xe2\x1a" +
+	"\x29\x54\xd0\x7b\x40\x37\x8b\xfa\xf9\xcb\x16\xa2\x87\xab\x0f\xbb\x9b\x36\x1e\xbb\xe9\xbc\xad\xb0\xbd\x3e\xee\x6e\x8a\x7e\xa3\xf1" +
+	"\x69\x77\x53\xd4\x1b\x8d\xcf\xb4\x9c\x66\x7f\x35\x2a\x37\x5f\x76\x37\x45\xbe\xce\x18\xdc\xdd\x14\xf5\x3a\x63\x88\x52\x5c\x69\x54" +
+	"\xa1\x7c\x1e\x54\x9f\xf8\x30\x21\xe2\x15\x83\x7e\xf0\xb4\xaa\x62\x84\x18\xf1\x8a\xc1\x93\xc1\x07\x35\x4a\x75\x23\x8c\xa8\xb8\x3c" +
+	"\xa3\xb1\xdd\x4d\x91\xef\x32\xc6\x77\x37\x7d\xfd\x5d\xc6\x04\x5f\x89\x51\x7d\x92\xd0\x8f\xc0\x93\xbb\x9b\xa2\xdf\x66\x4c\xed\x6e" +
+	"\x8a\x7a\x9b\x31\xbd\xbb\x89\xcf\xa7\x91\x9f\x81\xfd\x10\x4c\xcf\x69\x33\xc4\x14\xf5\xc3\x93\xcd\xe0\x9b\x60\x16\xc8\x76\x53\x46" +
+	"\x3c\x31\x6a\x95\xb9\xdd\x4d\x9b\x8e\x25\xc4\x03\xa1\xf7\xb4\x4d\x46\xf3\x84\x14\x59\x18\x81\xcf\x02\x88\xed\xc6\x53\xa5\xef\xb3" +
+	"\x88\xa5\x24\x8c\xc7\x41\xfd\x08\xac\x9e\xd1\x96\x88\x31\x1e\x07\x6d\x06\x5f\xda\xcb\x40\xb6\xf7\xfa\x11\x69\x95\x95\xdd\x4d\x78" +
+	"\xac\xef\x36\x1e\xd9\xed\x98\x30\x11\x8f\x33\xe2\x11\xad\x4c\xf6\xd6\x08\x12\xaf\x19\x02\xe9\xc3\x50\xbc\xdd\x4d\x78\xb2\x17\x4f" +
+	"\x83\x7e\xfa\xce\x6c\x63\xad\x7b\x9a\x22\x5f\x68\xb4\xed\x69\x8a\x7a\xa1\x51\xdc\x83\xc5\x24\xcc\x17\x11\x2a\x39\xe2\xf1\xac\xc4" +
+	"\x91\x92\x1b\x94\xf9\x94\xd7\xbe\xa7\x29\xe2\x7d\x46\xc7\x9e\x26\x3c\xdc\x1b\x45\xa3\x47\x53\xaa\x77\x04\x9d\x1c\x32\x8a\xcf\x86" +
+	"\x10\x54\x17\x98\xcb\xae\x8e\x7a\x50\xc6\x47\xd5\xbd\xa7\x09\x0f\xf7\xdd\xb1\xf0\x0b\x0b\xb2\xea\xe1\x4c\x91\x85\x19\x38\xf5\xee" +
+	"\x69\x8a\x7a\xa9\xd1\x87\xc5\x4c\x33\xa3\x70\x9c\x57\x16\xe4\x75\x8b\x53\xee\x0b\x09\xbb\x04\x6f\xef\x69\x8a\x7c\xb1\x71\x67\x4f" +
+	"\x53\xe4\x33\xd3\xdd\x3d\x4d\x5f\x7f\x66\xba\x47\x40\xd2\x00\x4c\x0d\x10\xf7\xf7\x34\x45\x3f\x31\x3d\xd8\xd3\x14\xf5\xc4\xf4\x90" +
+	"\x90\xa4\x40\x7c\x1d\x30\x8f\xf8\x6a\xae\x3a\x21\xd9\x5e\x8f\x09\xda\x59\xf2\x62\x52\x32\x4f\x88\x48\x96\xec\xa0\x2c\xa7\xa7\x7b" +
+	"\x9a\xa2\x1f\x98\x9e\xed\x69\x8a\x7a\x60\x7a\x0e\x64\x84\x39\x8c\xd6\xa1\x87\x9d\x17\x7b\x9a\x22\x9f\x99\x5e\xee\x69\x8a\x7a\x66" +
+	"\x7a\x05\x64\x44\x5c\x66\x1c\xd0\x21\xaf\x7e\xbe\xa0\xab\x4e\x60\x96\xd7\x6b\x30\x2d\x25\x7b\xcd\x96\xd5\x1b\x10\x23\xcc\x41\x6c" +
+	"\xa7\xb7\x60\x92\x3a\x83\xba\xfa\x54\x02\x07\x80\xec\xd4\xc9\x91\x9f\x94\x4e\xcd\x3b\x10\x49\x83\xb0\x44\x68\x2d\xef\x11\x76\x52" +
+	"\x27\x2f\x50\x12\x1f\x80\xec\x34\x92\x67\x97\x04\x54\x3e\x02\x49\x96\x62\x65\x64\xc8\xe9\x13\x2d\x46\xa7\x2e\xe0\xf4\x19\xc8\xce" +
+	"\x92\x17\x91\x16\xf9\x02\x22\x69\x10\xbe\xcf\xe0\x9e\xd4\x37\xe7\x46\xd8\x25\x39\x8e\x5b\xbf\x18\x50\xf7\xc4\x0c\x01\x69\xb9\xa4" +
+	"\xae\xe9\xf1\x6f\x92\x19\x06\x31\xc2\xaa\x02\x22\xf2\x9a\x93\x11\x8e\x74\xc5\x14\xa3\xee\xb4\xc1\xdd\x39\x64\x34\x0a\xa6\x45\xdc" +
+	"\xc1\x23\x19\x0c\xe2\xd7\xc8\x18\x90\x11\x7d\xab\x90\xbe\x19\x47\xdd\x2c\x3b\xbe\x27\xf5\xcd\x3b\x26\x42\x72\x6e\xb5\x91\x0b\x9e" +
+	"\x20\x46\xc4\x44\xfb\x00\xfc\xa0\x26\x85\x4e\x55\x40\x47\x46\x35\x85\xe5\x8c\xea\xec\xe9\xab\x66\xd4\x35\x31\xd3\x40\xae\x5f\x72" +
+	"\x2e\x39\xda\xb1\x8d\x29\x62\x06\x84\xb8\xc0\xc8\x11\x91\x3e\xb3\x1c\xe9\x8a\xf9\x8c\x71\xe3\x12\x20\xff\x56\x26\x79\xed\x2e\x79" +
+	"\xcd\x73\xaf\x7f\xca\xe8\x50\x02\x17\x28\x70\x11\x95\xbe\x60\x55\x25\x10\x3a\x8b\x7b\x52\xc6\xc5\x4c\xd6\xfd\xaa\x8a\x59\x12\x3a" +
+	"\x55\x01\x1d\x19\xd8\xf2\x9e\x14\xae\x3a\xba\x24\x27\x73\x07\xca\x6f\x05\x48\x8b\x4d\x58\x1f\xd4\x2a\x80\x11\x56\xa5\x46\x84\x07" +
+	"\xca\x6f\x8d\x33\xf6\xad\x4b\x3c\x6e\x79\x73\xf7\xfa\x9e\x54\xf8\x5e\x26\x09\xb4\xd6\x90\xc6\x3f\xda\xc7\xd4\xa0\xd4\xb5\xd5\xa4" +
+	"\xe8\x96\x23\x5a\x6d\x99\xda\x2b\xd6\xa4\xf4\xcd\x4c\xe1\xd2\x2b\x09\x99\x2a\x7d\xc7\xad\x5f\x7b\xed\x35\x29\x7d\xd9\xa9\x79\x3a" +
+	"\x57\xdd\x75\xda\x51\x93\x72\xee\x3a\x95\x9b\xdf\xe4\xc5\x76\x9d\x9c\x58\x0f\x13\x24\xd2\x55\x93\x0a\x5d\x75\x2a\x8f\x50\x13\xd3" +
+	"\x5d\x93\x8a\xb8\xea\x14\x27\xb6\x39\xe4\x2a\x69\x88\xa4\x7a\x41\xfd\xb5\xee\x40\x56\x58\x7d\x40\x28\x2c\xdb\xcd\x0c\xec\x16\x67" +
+	"\x5c\x1d\x3b\xb4\xdb\x35\x29\xe3\xc2\x53\xa4\x50\x5e\x43\xaa\x2e\x3c\xbd\x43\x48\xbc\x4d\x9f\xa5\xc6\x4f\x4c\xfb\xc2\xd3\xbb\x9c" +
+	"\x11\x32\x1e\x82\x5d\x3a\xf7\x6a\x52\xe5\x2e\x3c\xc5\x51\x6a\x20\xf7\x09\x89\x8b\xe3\xd6\xce\x8d\xa7\xea\xdc\x36\x87\x4c\x1d\x8b" +
+	"\x81\xd0\x43\x62\xb6\xaf\xfb\x5e\x6a\x39\x8f\x08\x89\xb7\xb9\x5e\x56\x58\x8f\x39\x64\xea\x78\x71\x3d\x41\xfd\x6c\x37\x6a\xd0\xb6" +
+	"\x42\x92\x9f\x02\x89\xeb\x1a\xf4\x8d\x9e\x11\xb1\x5e\x86\xa0\x8f\xfc\x39\x3e\xce\xed\x46\xe9\x48\x1f\x5d\x38\x2f\x80\xc4\x8d\x12" +
+	"\xd4\x99\x91\x15\xf8\x92\x10\x53\xc5\x42\xc8\xe9\x15\x98\xed\xeb\xbe\x93\x5e\x4c\x3f\x90\xb8\x51\x7e\xf6\x82\x11\xd2\x6b\x42\x4c" +
+	"\x15\x3f\xa6\x37\x35\xa9\xc8\x3d\x57\x6f\x6b\x52\x51\x7b\xae\x06\x6a\x52\x51\x7b\xae\xde\xd5\xa4\xa2\xf6\x5c\xbd\x27\xa4\xc4\xc2" +
+	"\x08\x7c\x3e\xd4\xa4\xf4\x9e\x2b\xfa\x45\xe2\xf9\x7c\xac\x49\x45\xee\xb9\xfa\x44\x8c\xf1\xee\xda\x66\xbe\xdd\xf5\xfd\x86\xcf\x35" +
+	"\xa9\xa8\x3d\x57\x5f\x6a\x52\xd1\x7b\xae\x06\x6b\x52\x91\x7b\xae\x86\x6a\x52\xa1\x3d\x57\xd7\x2b\xb4\xcc\x30\x02\xb7\xf6\x5c\xc9" +
+	"\x6d\x7a\x0a\x19\xe1\x88\x4e\x9f\xda\xc9\x27\x7d\x46\x6b\x52\xba\x81\x60\x5e\x03\xa5\x34\xc6\x6a\x52\xff\x3f\x36\x5c\x8d\x73\xca" +
+	"\x7d\xf7\x6f\x27\x70\xa2\x26\x15\xea\x21\xc8\xeb\xad\x48\x68\xb2\x26\x15\xb9\xe1\x6a\x8a\x33\x25\x46\x17\x7b\x95\xa9\xbf\x69\x82" +
+	"\xc4\xab\xfd\x40\x01\x26\xb6\xc4\x66\x6a\x52\x51\x1b\xae\x66\x39\x22\xac\xc2\x15\x38\x57\x93\x32\x9a\x08\x7e\x09\x26\xb6\xc4\xe6" +
+	"\x6b\x52\x65\x37\x5c\xe1\xcd\x02\xac\x16\x38\x53\x74\xa3\x52\x10\x52\xb8\x58\x93\x2a\xd7\x45\x90\x3a\x4b\x35\xa9\xaf\x4e\x4c\xc0" +
+	"\xf3\xe1\x72\x4d\x2a\x34\x32\x41\xbe\xa1\xa4\x5f\xfa\x2b\x35\xa9\xe8\xc1\x8e\xab\x35\x29\x77\x68\x02\x5e\xab\x5a\x07\xff\xd7\x6a" +
+	"\x52\xc6\xd4\x04\xeb\x2d\xa5\x1a\x9b\xb0\x5e\x93\x72\x47\x3b\x2a\x1d\xc9\xb4\xd6\xa6\xa2\x66\x3b\xb6\x01\x11\x4e\x0a\xb1\x8c\x8a" +
+	"\xb5\xa9\xa8\xd9\x8e\xa5\xda\x14\x1f\x9b\x20\xcf\x3e\xeb\xb1\x09\xf2\xe9\xb0\xbd\x36\x15\x1e\x9b\x80\x07\xbf\x45\xb6\x75\x7b\x47" +
+	"\x6d\xaa\xdc\x68\x47\x89\x74\xd6\xa6\xc4\xd0\x04\xf5\x92\xd2\x1f\x9a\xd0\x55\x9b\xd2\x43\x13\x90\x3a\x7f\x68\x42\x77\x6d\x2a\x72" +
+	"\xb2\x63\x0f\x5f\xce\x2e\x1d\x92\x3f\x9d\xb0\x17\xcc\xd7\x26\x3b\xf6\xd5\xa6\xa2\x26\x3b\xde\xaa\x4d\x7d\x6d\xb2\x23\x52\x77\xbb" +
+	"\x36\x15\x35\xda\xf1\x4e\x6d\x2a\x72\xb4\xe3\xdd\xda\x54\xe4\x68\xc7\x7b\xb5\x29\x63\xb4\x63\x38\x7b\xf7\x6b\x53\x91\xa3\x1d\x1f" +
+	"\xd4\xa6\xa2\x46\x3b\x3e\xac\x4d\x45\x8c\x76\x7c\x04\x42\xf8\x38\x22\x12\x79\x5c\x9b\x12\xaf\x28\xe5\xe8\x04\xff\x15\xe5\x93\xda" +
+	"\x94\xf1\x8a\x92\xde\xb2\xbb\xaf\x28\x9f\x72\x44\xca\x78\x0c\x64\x9e\xd5\xa6\xc4\x3b\x4a\x58\x39\xef\x28\xe5\xf0\x84\xe7\xb5\xa9" +
+	"\x72\x2f\x29\x81\xc0\xeb\x05\x47\x2c\x1d\x93\x81\xcc\x4b\x5a\x4f\xa2\xe4\x84\x65\x59\xbd\x22\x46\x58\x59\x4b\x56\x56\xfd\x1c\x29" +
+	"\x05\xc2\x52\x32\xaf\x6b\x53\xce\x4b\x4a\x79\xb6\x59\xbe\xa3\x7c\x53\x9b\xd2\xef\x28\xcd\x19\x0a\xea\x15\xe5\x5b\x22\x8a\x2c\x48" +
+	"\x40\x62\xa0\x36\x15\xf1\x76\x72\xd7\xf7\x1b\xde\xd5\xa6\x42\x6f\x27\xe5\x00\x05\x72\x7a\x4f\x8c\xab\xa3\x18\xe4\xee\x43\x6d\xca" +
+	"\xd9\x76\x25\xc7\x8f\x29\xe4\x63\x6d\x4a\xef\xba\x32\xd7\xab\x8d\x3e\xd5\xa6\xf4\xa6\x2b\x0f\x81\xcf\x67\x4a\x2e\x32\xa7\x3e\x24" +
+	"\x2f\x75\x5f\x6a\x53\xc6\x96\x2b\x53\x46\xee\xb8\x1a\xe4\x84\x59\x79\x26\x82\xe4\x0d\x51\xc1\x4c\xcb\xa9\x1d\x6e\xfa\xe4\x07\x39" +
+	"\x5c\x9b\x0a\xee\xb8\x92\x23\x15\xe1\x36\xc2\x19\x4f\xc9\x9a\xbb\x38\x2a\x02\x2b\x39\x81\x59\x6e\x63\xb5\x29\x63\xcf\x95\xb9\x6c" +
+	"\x65\x36\xce\x91\x52\x20\x34\x25\x33\x51\x9b\x32\x2e\x52\xb4\x5e\x50\xaa\x9b\x14\x27\x6b\x53\xee\x4d\x8a\x78\x82\xb6\xae\x02\x9c" +
+	"\x22\xa6\x65\x57\x48\x47\x32\xd3\xb5\xa9\xd0\x4d\x8a\xf2\xc5\x2b\x79\xcd\x80\xf9\xca\x4d\x8a\xf8\xe2\x9a\x05\xd3\xd2\x5c\x46\x86" +
+	"\xfe\xa8\xce\xf1\xe5\xb4\xc6\x02\xeb\xd1\x66\xf3\x22\xb0\x66\x3f\x30\x2d\xb5\x40\x50\x4b\x73\x39\x25\xf2\x5b\xac\x4d\xe9\x1b\x15" +
+	"\xc1\xf8\x37\x2a\x2e\x11\x82\xdb\x1b\xd5\x1b\x77\xff\x46\xc5\x65\x82\xae\x0b\xc6\x16\x92\xcc\x4a\x6d\x2a\x7c\xa3\x22\xda\xa7\x70" +
+	"\x5a\x05\x30\x2e\x76\xad\xd9\x17\x2a\xca\x06\xc2\x1a\x90\xeb\x36\x21\x35\x28\xa0\x75\xbe\x90\x56\xfd\xe6\xdf\xb3\x69\xdd\x0b\x44" +
+	"\xfa\xf0\x80\x70\xff\x23\xb2\x27\x65\xda\x88\x11\x46\x9e\x0c\x39\x15\x09\x69\xd1\xa9\xf3\x0b\xb0\x44\xc8\x98\x99\x3a\xbf\x02\xdb" +
+	"\x09\x6a\x31\x52\xa7\x85\x24\xd3\xb1\x37\xb5\xb9\xd0\xa2\x53\xa7\x2b\x47\xb6\x9e\x3b\x41\x8c\x19\xb9\x33\x0a\x50\xae\xb8\x0b\x4c" +
+	"\x8b\x83\x48\x15\x8a\xa9\x9b\xaf\xa5\x35\xe6\x07\xa5\x9c\x7a\x44\x50\xcd\x81\xa0\x94\x50\x2f\x41\x2d\xcd\x81\xa0\x14\xd3\xb7\x37" +
+	"\x85\xa1\xa7\xd7\xf4\x24\x03\xfa\x3b\x8d\x9f\x90\xff\x69\xd8\xcc\xe8\x9d\xdd\x2d\x62\x30\xd5\x94\x20\x3c\x8c\x13\x84\x09\x96\x0a" +
+	"\xba\xbd\x37\x65\x0c\x6a\xf5\x84\x68\x4c\xcc\x1d\xce\x54\x05\xcc\xd4\xc8\x8f\xbb\xc2\xac\x2a\x60\xa6\xa0\x7b\x7b\x53\x7a\x54\xab" +
+	"\xad\x23\xe7\xcd\xdc\xdf\x9b\xa2\x59\xad\x72\x84\x22\x8d\xa4\xb1\xc2\x7a\x00\x62\x91\x39\x08\xed\x0d\x51\xcc\xc3\xbd\xa9\xc0\xac" +
+	"\x56\x33\xa6\x47\x7b\x53\xc6\xa8\x56\x6b\x2d\x34\x64\x87\x64\x1e\x13\x24\x26\xfa\xd8\x31\x69\xe8\x09\x41\x72\xa6\x8f\x1d\x96\x9e" +
+	"\xd7\xf3\x74\x6f\xca\x98\xd7\x1a\xce\xe1\xb3\xbd\x29\x77\x50\x91\x97\xc2\xe7\x7b\x53\xc6\xa4\xa2\x70\x0e\x5f\xec\x4d\x05\x46\xb6" +
+	"\x5a\x91\xbd\xdc\x9b\xfa\xea\xa8\x22\x04\xf6\x0a\x8c\x8c\xcb\xd7\xa1\xd2\xe8\xa7\x4f\xf4\xa6\x51\x87\x6a\xa8\x8c\xfc\x2c\x5e\x13" +
+	"\xb2\x6c\x96\xa1\x1a\xda\x2a\x99\x37\xc4\x88\xb9\x3e\x9e\x0c\x8d\x83\x79\xcb\x11\xa3\x08\xf5\x54\x19\x99\x9c\x01\x61\x65\x14\xa1" +
+	"\x3f\x70\xe7\xdd\xde\x94\x1e\x56\x64\xeb\xc8\xd1\x33\xef\x51\x3e\x22\x28\x6b\xca\x90\x5c\xef\x07\x00\xcb\xcc\x26\xec\x12\xfc\xb8" +
+	"\x37\x15\x9e\x54\x24\x03\xfa\xb4\x37\xb5\xed\x3d\xfb\x5b\x5c\x2a\x6b\x5d\xd3\xaa\x2e\x95\xfd\xcc\x99\x55\xa6\x2e\xc1\xb5\x10\x1c" +
+	"\xed\xfc\x42\xc8\xbc\xbc\x9c\x56\x5e\xd5\x4a\x27\x6b\x95\xce\x20\x41\x7f\xb7\x06\xbc\x70\x56\x12\xb7\xa3\x0e\x71\x64\xc5\xb7\x92" +
+	"\x07\x2e\x87\x85\x53\xab\xe3\x84\xd9\x19\x52\x65\x64\x6f\x6a\xdb\x3b\x15\x14\x9d\x9c\xd5\xd7\xc2\xca\xd5\x8c\x72\x88\x47\x45\x6f" +
+	"\xe7\x6d\x06\x66\x63\xc4\xcc\x33\x4f\xc9\x0e\x6c\x9c\xd6\xb4\x43\x50\x14\x18\xce\xb6\xaa\xeb\x7b\xc1\x4c\x70\xc6\x48\xa2\x85\xc0" +
+	"\x6d\x92\x90\xb3\x52\xe6\x9f\x1f\xe9\x1d\x9f\x6d\x35\x45\xcc\x8e\xd6\x80\x15\xbd\x3b\x46\xf8\xd3\x9c\x59\xf1\xad\x08\x81\xd5\x0c" +
+	"\x21\x67\x8d\x24\x92\xd5\x9a\x7a\x4d\xfd\xcf\x8f\xbf\xcc\x52\xf0\x32\x2a\x75\xfe\xd8\x8a\x6a\x8e\x33\x46\x12\x2d\x04\x56\xf3\x84" +
+	"\xc8\xa8\xb8\x8c\x17\xd6\xc2\xde\xd4\xb6\xda\xbf\xdb\xe8\xe0\xb6\xf5\xb1\xab\x5b\x66\x17\x89\x58\x2d\x43\xd0\x64\x9d\x25\x20\xf8" +
+	"\xb0\x88\xb1\x4a\x50\xaa\x2c\x03\xf9\xbb\xd5\x57\x91\xa5\xb3\x42\xc0\x4a\x18\x20\x97\x55\xee\xd2\x6a\xbb\x98\xe5\xb7\xb6\x37\xb5" +
+	"\x6d\x0f\x82\x69\xd8\xbc\xdd\x29\x3e\xb9\x8e\x75\x42\x56\x39\x62\xd7\x9e\x0a\xa7\x75\x5f\x6a\xdb\x9e\x79\xe6\xea\xd8\x01\xb5\xed" +
+	"\x4b\x47\x75\x7e\x8b\x40\xc6\x98\xd3\xfa\xa5\x5f\x65\xba\x57\x05\x66\x84\xfd\x19\x90\x49\x7d\xff\xbf\xdb\xeb\xb6\xc6\xda\x39\xd1" +
+	"\xe9\xb7\x7e\xf1\xf7\x8e\x44\x3a\x80\x8c\xb1\x4e\xbb\xf5\x8b\x5f\x15\x0a\xe9\x04\x32\xc2\xda\xc3\x2a\xf0\xe9\xda\x97\xfe\xe6\xbd" +
+	"\x6c\xfc\xd2\x27\xe0\x37\xdf\xba\x89\x91\x21\x69\xc8\x8e\xa9\x47\x28\x51\x50\x3f\x77\xc4\x7e\xdc\x6e\xb5\xdf\x60\xd6\x8b\xe5\x24" +
+	"\x55\xf2\x58\xdd\x56\x23\x77\xe8\x90\xf6\x01\x68\xd4\xa9\xc3\x36\x00\xed\x02\xe2\x16\x88\xe4\x9f\xbe\x84\xb0\xb8\x4d\xff\xde\x19" +
+	"\xf3\x00\xfa\xab\x4c\x2d\xd6\x3b\x40\x1a\x8d\xb4\x91\x0b\xd2\xa6\x90\xbb\x40\x92\xed\x65\x54\x10\xca\x3d\x8a\x36\xa9\xd3\x46\x88" +
+	"\xcc\x1a\x6c\xee\x13\x21\x82\xe1\x88\x17\xcd\x03\x62\x92\x3a\x65\x4a\x45\x84\xf3\x70\x5f\xfa\x9b\x1f\x46\xd8\x25\xd4\x24\x75\x4f" +
+	"\xfd\x7a\x7b\x04\x64\xcc\x41\xb4\x0d\x90\xc7\x40\x46\xd8\x9f\x01\x15\xf8\xe0\x0f\xd3\x13\x8e\x74\xc6\x14\xe3\xd5\xdb\x53\x20\x63" +
+	"\x0e\x62\x95\xdb\x33\x10\x28\xb7\xa0\x08\xfd\x45\x7f\xbe\x2f\xfd\x4d\x2d\x02\xfa\x69\xf3\x86\x32\xe5\xf6\x02\xc8\x98\x8b\xd8\x11" +
+	"\xbd\x04\x83\x88\xc0\xf8\xb5\x06\xa7\x57\xfb\xd2\x51\x17\x7e\xf5\x73\xe4\x62\x00\x11\x67\xfc\x5f\xef\x4b\x1b\x77\x53\x4b\x84\x3a" +
+	"\x64\x4a\xe5\x0d\x57\x59\x65\x4a\x46\x3e\xc3\xa3\xb1\x4a\xc8\x5b\x8e\xac\x87\x11\x34\x20\x07\xf6\xa5\xcd\xbb\xa9\x73\x9b\xac\x19" +
+	"\x6f\x24\xf2\x6e\x5f\x5a\x5c\x4e\x2d\x27\x2e\xea\x31\xec\x72\x2d\xef\x05\x73\x51\xfd\xd0\xb1\x46\xb5\xc3\xe9\xc3\xbe\xb4\x77\x37" +
+	"\xb5\x1f\xd4\xc7\x7d\x69\xfb\x72\x6a\x3f\x7b\x9f\xf6\xa5\xed\xdb\xa9\xed\xec\x61\xd2\xd8\xe7\x7d\x69\xfb\x76\x6a\xdf\xe8\x0b\x37" +
+	"\xb2\xc7\xf9\xd9\xd9\x1b\xe4\xc8\x7a\x18\x21\xa3\x21\x6e\x64\xab\x58\xd9\x1b\xde\x97\x76\x2e\xa8\xf6\x92\x37\xc2\x11\x3d\x5d\xcd" +
+	"\xce\x1d\x7c\x46\xf7\xa5\x9d\xb1\x80\x7e\x44\x63\xf8\x28\x13\xaa\xee\xd4\x34\x19\x35\x8e\x7c\x9c\x80\x8b\x3e\x20\x3e\xa1\x09\xfc" +
+	"\xbb\x98\xa0\x87\x7a\xd2\x63\xe0\xa4\xc2\x24\x88\xc4\x2a\xf3\x24\xf4\x9f\xb3\x29\x42\xd6\xcb\x20\x28\x84\x69\x10\x71\xa3\xe2\xe4" +
+	"\x64\x1c\x2d\x32\x43\xb5\x92\xd0\x15\x27\x07\xd6\xa8\x95\xcc\x72\x42\xd7\x9b\x22\x44\x30\x73\xfb\xd2\x65\xe7\x01\x4a\x91\xf9\x7d" +
+	"\xe9\x0d\x17\xfe\xee\x8e\xc9\x77\x41\xf2\x47\x80\x7a\x6b\xbc\x00\x60\x9e\x89\xf6\x93\x35\x8c\x4c\x77\x9f\x38\x63\xab\x68\x04\x2f" +
+	"\xe5\x96\xf6\xa5\x37\x0c\xb3\xbf\x33\xaa\x17\xa6\x7e\x06\xc8\x61\x40\xcb\x44\xcc\xb3\x5f\x55\x4b\xcd\x9a\x45\xf6\x6d\xf3\xf7\x95" +
+	"\x2b\x02\xc9\xc8\xc6\x9c\x26\x84\xc8\x2a\x56\xc2\x5d\xd4\xbd\xd2\xa6\xc9\x1a\xfe\x5d\x7a\x38\x37\x4f\x0b\x93\x75\x8e\xd8\x1a\xd6" +
+	"\x2d\xc1\xad\xfb\xd3\x1b\x0a\x57\xba\xf5\x08\x32\xbb\x5f\x49\xef\x70\xdb\xc0\xcc\xb1\x22\x53\x43\xc8\x08\x22\x1d\xd5\xf8\x2c\x72" +
+	"\xa8\x3b\x56\x0e\x22\xa9\xd2\xfe\xf4\x86\x41\x76\x85\x0f\x22\xb3\x9a\xb1\x6a\x0e\x59\x3b\x21\x73\xec\x57\x12\x92\x1d\x5d\x33\x75" +
+	"\x1d\x82\xc8\xf8\x84\x14\xe9\xc4\x6a\x84\x8d\x5a\x8b\x65\xd3\x05\x42\xba\xd8\xcb\x95\x3e\xdd\x1c\xc9\x84\x10\x29\xd3\x03\xe6\x6f" +
+	"\x23\x7f\xaa\xe8\xe4\xb4\xa6\x5e\x10\xf3\x66\xf6\x74\x45\xc9\xe4\xf5\x71\xa6\x3b\x56\x86\x81\xce\x2d\x8a\xfa\x6f\x23\x75\x5e\x45" +
+	"\xdc\x26\x64\xde\x48\x9d\x57\x76\x77\x04\x92\x09\x20\x42\xe5\x2e\x16\x23\x7c\xd4\xd5\xd2\xa6\xcd\x3d\x00\xd2\x45\x11\x56\xea\xee" +
+	"\xef\x4f\x47\xdd\x4f\xfd\x60\x7f\x1a\x5d\xca\xab\xf4\xb2\x19\x53\xac\xa8\x91\xf9\x9f\x8e\xd8\x8f\xbc\x4d\x86\xd1\xed\x0f\xf7\xa7" +
+	"\x8d\x1e\xae\x64\xec\xb1\xf7\x8f\xc0\x50\x0f\xb7\x8c\x10\xf5\x44\x1f\xef\x4f\x8b\x26\x2e\xa8\xf0\xe8\xfb\x27\xfb\xd3\x46\x13\xd7" +
+	"\x1a\x7d\x7f\xe9\x47\x71\x13\x82\x90\x49\x07\x64\x24\xf3\x8c\x2f\x68\x57\x20\x30\x65\xf5\x7c\x7f\x5a\x37\x71\x25\x62\x39\xbd\x00" +
+	"\x21\x8d\x1c\x15\xc9\xbc\xdc\x9f\x0e\x0c\xbf\x07\xa2\x86\xdf\xbf\xda\x9f\x0e\x77\x71\xe5\x0e\xe8\xfe\xfd\xe9\x40\x17\x57\x69\x00" +
+	"\x79\xbd\x3f\xed\x8d\xbe\xc7\x3b\x1f\x2b\x79\x6f\xf6\xa7\x75\x17\x17\xb9\x53\x23\xa3\x29\xa4\xfa\xad\xb1\xb7\xfb\xd3\x6e\x13\x57" +
+	"\xa9\x08\x64\x60\x7f\xda\xb9\x9d\x8f\x08\x2b\x75\xef\xf6\xa7\xad\x1e\xae\x6b\xf3\x7e\x7f\xda\x6e\xe1\x6a\x09\x41\x7c\x40\x6a\x17" +
+	"\x8c\xca\xd3\x21\xcb\xc2\xfb\xb8\x3f\x6d\x74\x70\x05\xa2\x3b\xb8\xf5\x5b\x63\x9f\x40\x2c\x98\x65\x67\xab\x60\x29\x9f\xa9\x5a\x16" +
+	"\xcc\xa2\xf3\x13\xf7\x65\x7f\xda\xe8\xe0\x06\x33\x37\x28\x74\xd2\x21\x1d\xc1\x0c\xf1\xf5\xe8\xaa\x0b\xa4\x6e\x78\x7f\x5a\x77\x70" +
+	"\x25\x62\x1a\x8d\x00\x90\x3e\x8e\x88\x40\x46\xf7\xa7\xdd\xd9\xf7\xe6\xf8\x40\xf4\xbf\xc6\xf6\xa7\x9d\xd9\xf7\x72\x1f\xa1\x1c\x1e" +
+	"\x38\xce\x09\xa1\x61\x23\x42\x64\x62\x7f\x3a\x34\xf9\x1e\xfb\xb8\x54\x33\x6a\x12\x4c\xd9\xc9\xf7\xd8\x45\x43\x80\xa3\x61\x75\x53" +
+	"\xa7\x45\x38\x82\x09\xde\xcc\x37\x43\x8c\xf0\xf1\x2f\xdd\x43\x40\xb3\x1c\xf9\xea\xc5\x7c\x73\xfb\xd3\x62\xf8\x3d\xaa\x5b\x76\xe9" +
+	"\xcc\xc4\xcd\xef\x4f\x97\x9b\x7e\x2f\x47\x08\x2e\x70\xe4\x2b\x37\xf3\x2d\xee\x4f\x8b\xd1\xf7\x72\x82\x20\x75\x0c\x79\xe6\x64\x2f" +
+	"\x74\x69\x7f\xda\x98\x7d\x2f\x19\xd5\x2e\xa5\x6d\x4a\xcb\xfb\xd3\xc6\xd5\x7c\x1e\x03\x99\x15\x11\x52\x89\x79\x31\x29\x66\x95\x18" +
+	"\xe9\x65\x2d\x59\x7b\xad\xed\x4f\x47\xde\xcd\xb7\xbe\x3f\x1d\x71\x37\x5f\xeb\x81\x74\x99\xbb\xf9\x64\xfa\xda\x38\x61\x14\x9e\x9b" +
+	"\xbe\xe2\x81\x74\xf0\x6e\x3e\xab\xf0\x4a\x07\xd2\x51\x77\xf3\xb5\x13\xe2\xca\x58\xbd\xd4\x8e\x03\xe9\xc8\xbb\xf9\x3a\x0f\xa4\x23" +
+	"\xef\xe6\xeb\xe2\x4c\x89\xf9\x51\x29\x9d\xee\x03\x69\xa3\x6f\x8b\x8d\x57\x72\x8f\x35\xbe\x1d\x78\x83\x89\x90\x31\xb6\x8b\x7e\xb4" +
+	"\xf2\x06\x93\x78\x34\x57\x0d\xb1\xde\x03\x69\xa3\x6d\x6b\xc9\x48\xa4\xef\x40\xda\xe8\xda\xfe\xf0\xef\x18\x75\xc4\x80\xe8\x46\xea" +
+	"\x2d\x30\xe8\xda\xc2\x49\x31\xbf\x74\xc6\x7e\xfc\x0f\x41\xf8\x0a\xbe\x7d\x20\xad\xbb\xb6\x9e\x0c\x88\x3b\x07\xd2\x66\xd3\xd6\x5c" +
+	"\x8d\xb6\xba\x2b\xa2\x6a\xf6\xa3\x52\x42\xf7\x0e\xa4\x8d\x96\xad\xa7\x03\xaf\xfb\x07\xd2\xba\x61\x0b\x82\x6f\xf0\x47\x9f\x15\xf9" +
+	"\x4b\x56\xb0\x07\x44\xa0\x5f\x8b\xdf\x3c\x64\x44\x8c\x95\xbf\x87\x04\x5d\x17\x8c\xad\x23\x99\x47\x07\xd2\xe1\x7e\xad\x3c\xb1\xf9" +
+	"\x18\x00\xfa\xa8\x10\x91\x04\x4f\x1e\x10\x2c\xf7\x09\x90\xeb\x36\xa1\x34\x00\x3c\x3d\x90\x36\xfa\xb5\xb4\x10\xd7\xe6\x99\x08\xa8" +
+	"\xd9\x08\x48\xf6\x6b\xa5\xcc\x73\x62\x84\x91\x2f\x03\xe4\xc5\x81\xb4\xee\xd7\x06\x0b\xef\x25\x11\x63\x66\xe6\xbc\xc2\x7b\x45\x4c" +
+	"\x8b\x91\x38\x25\x23\x91\xfe\x03\xe9\x40\xb3\x16\x75\xa7\x26\x3e\xbd\x06\x32\x66\x64\xce\xa8\x3b\xb9\xde\x37\x60\x5a\x1c\x44\xc9" +
+	"\x80\x78\x7b\x20\x6d\x74\x6b\xcd\xb5\x68\xa7\x01\x11\x53\xb3\x1f\x93\xd2\x79\x47\x4c\x4b\xb3\x1f\x93\x42\xde\x1f\x48\x57\x34\xd4" +
+	"\x75\xc5\xf0\x2e\x61\x7b\x67\xec\xc7\x9f\x71\x0e\x18\x7f\xf6\xf5\xb1\x9c\x0f\x40\xea\x4d\xa4\xb1\x82\xba\x56\x1a\xf9\x08\xa4\xae" +
+	"\x83\x23\xb4\xd7\xde\x54\xa1\x66\xdc\x27\x42\x2e\xd9\x3e\xd8\xb9\x85\xee\x21\xbd\x4f\xfb\x0c\xa2\xfe\x92\x67\xa3\x80\x2f\x00\xea" +
+	"\xfe\xb4\x4d\x48\x42\x36\x17\x07\x0f\xa4\x2b\x7e\x42\x34\xb5\xff\xfe\x99\xde\x14\x78\xc1\x0c\x81\xa8\x37\x08\xc4\x82\xde\x98\x46" +
+	"\x86\x81\xd4\x75\x68\xc4\x8b\x65\xe4\x40\xba\xe2\x03\xab\xeb\xe2\xdd\xc7\x40\xda\x1a\x2b\xd8\x28\x31\xf5\x5d\x74\x51\x4a\x20\x6d" +
+	"\x8d\x15\x6c\x8c\x90\xba\x0e\x21\x13\xce\xdb\x38\x87\x2e\x39\x56\x2a\x73\x8d\x15\x6c\x82\x90\xfa\x4b\x9e\x93\x04\x26\x09\xa8\xfb" +
+	"\xd3\xf1\xb1\x52\x37\x75\x20\x5d\x71\x5e\x86\x84\x37\x53\xd6\x52\xa0\x32\x0d\x42\x04\x44\x80\x9d\xba\xc6\x0a\x36\x03\xa2\xae\xa3" +
+	"\x8c\x06\xd9\xcc\xe2\x33\x5c\x62\x46\x35\xc9\xc7\x1b\x75\x14\x67\x0e\xc8\xb2\x8b\xa0\x12\x14\x32\x0f\x64\x89\x19\x05\x67\xaa\xd0" +
+	"\x0b\xdc\x05\x8e\xe8\x72\xa2\x4e\xaa\x5d\x71\x8b\x60\x96\x5d\xc6\xaa\xb9\x25\x20\x4b\x4c\x17\x9d\x21\x23\x3b\xb2\xcb\x07\xd2\x15" +
+	"\x3f\x2d\x31\x5d\x54\x5e\x48\x2b\x20\x96\x1d\x82\x5a\xb2\x0a\x59\x05\x42\x21\x05\x44\x28\xa2\xb5\x03\xe9\x6d\x7b\xce\xae\x30\x35" +
+	"\x4f\xd9\x1a\xea\x4b\x6f\x06\xd7\x09\x59\x63\x6a\x9e\xb2\x3f\xf7\xb7\xf5\x60\x7a\xdb\x9e\x33\xa6\x8a\x18\x1e\xac\x55\xda\x80\x9c" +
+	"\xd5\x73\x9b\xf5\x4c\x5f\x39\xea\xb8\x48\x84\x1e\xdb\xac\x89\x5f\xff\xe7\x5b\x3a\x6a\x51\x02\x71\xe6\x0f\xcf\x45\x49\xb4\x1f\x4c" +
+	"\x6f\xfb\xe1\xac\x1e\xdb\xec\x07\xd3\x41\xc4\x5a\x98\x20\x93\x4e\x10\x67\x0c\x0d\x39\x73\x5e\x8b\x74\x61\x1d\x73\xcc\x88\x57\xbe" +
+	"\xca\x51\xe3\xa1\xbb\x39\x62\x64\xcd\x44\xe8\xb5\x6d\x0f\x90\x19\x57\x05\xf1\x28\x95\x5e\xae\xa2\x23\xa6\x51\x26\x76\xda\xfa\x38" +
+	"\x63\x8c\xbb\xd6\x0c\x12\x07\xab\x5b\xdc\xca\xd1\xb1\x52\x77\x1b\x61\xcf\x39\x23\xaf\xad\x90\xee\x70\x62\x2d\x48\x50\x44\x77\x0f" +
+	"\xa6\x03\x63\xb3\x29\x77\x52\xe4\xde\xc1\x34\xa6\x55\xaf\xf0\x29\xd2\x81\x8a\x8b\x6f\x89\xdd\xe7\x8c\x18\x96\x5c\xa6\xe4\x1e\x10" +
+	"\x84\x59\xd3\xf2\x54\x9c\x5d\x73\xf1\x2d\xb1\x87\x84\x9c\xfd\xc3\xb1\x52\xd9\x8b\x6f\x89\x3d\x3a\x98\x2e\x3b\x3c\x5b\x56\xdd\x63" +
+	"\x62\xce\xfc\xe1\x19\x49\x91\x27\x07\xd3\xdb\xfe\x4f\x86\x84\xce\x8f\x17\xd1\x53\x22\xd6\xca\x10\x14\xcf\x33\x20\x22\x1c\xd2\xb0" +
+	"\xcb\x2e\xbe\x25\xf6\xfc\x60\x06\xfd\xcc\x4b\xd4\x46\xc7\x54\x66\x7d\xac\x59\x7e\x27\xbf\x38\x98\x31\xda\xaf\x12\xe1\xdd\x23\x81" +
+	"\xbc\x04\x82\xee\x6b\x40\x45\xf4\xdd\x5e\x71\xa4\x33\xa6\x19\xf4\xc3\x8a\x62\xb3\x07\x8c\xfa\x0f\x66\xcc\xf6\xab\x40\xe8\x27\x89" +
+	"\x44\x5e\x03\x41\x3f\x2c\xac\x82\x3e\xe2\x9b\x83\x19\xd1\x7f\x55\x1b\x0c\xf8\x6a\x52\xfa\xcf\xcc\xdb\x83\x19\xaf\xff\xea\x05\x35" +
+	"\x20\x84\xfe\x54\x5b\x0c\xb4\x8e\x08\xea\x1d\x96\x93\xd4\xc9\xa3\x66\xa3\xca\x1d\x7a\x96\xef\x41\x34\xea\xdc\xe1\x00\xb5\x76\x01" +
+	"\xf1\x01\x44\xf2\xcf\x80\x86\x30\xf9\x48\x40\x67\xcc\x27\xe8\xe2\x62\x12\xf9\x74\x30\x63\x74\x60\xa5\x0f\x65\x4e\x31\x9f\xc1\x24" +
+	"\xdb\xcb\xe9\x20\x77\x5f\x28\x64\x11\x90\xdd\x84\x95\xcb\x1d\x24\x44\x44\xc4\x11\x2f\xa4\x21\x62\x44\x4c\x48\x9c\x52\x11\x21\x0d" +
+	"\x1f\xcc\xd8\x4d\x58\xaf\xe8\x46\x0e\x66\xec\x1e\xac\xf7\xf1\x8c\x1e\xcc\xd8\x2d\x58\xbb\xe4\xf0\x27\x7c\xec\x60\x26\xd0\x82\x35" +
+	"\x4b\x6e\xfc\x60\x26\xd0\x81\x35\x2b\x6e\x02\xc4\x08\x6b\x0f\x6b\xd0\x2f\x85\xc9\x83\x19\xb4\x4e\x2f\xa9\x2d\x17\x5e\xbd\x4d\x81" +
+	"\x18\x73\x09\x2b\x9c\x69\x20\x08\xe7\xd4\xe6\x40\xad\xc1\x66\xe6\x60\x46\xdc\x84\xab\x7e\x60\xf1\x9b\x14\x2b\xb7\xc6\xe4\xce\x8d" +
+	"\x59\xce\xf0\x93\x99\x3e\x82\x26\xd5\x1c\x21\x38\x26\xcb\x7f\x64\x5d\xfb\x51\x5c\xd9\xb8\x62\xec\x00\x99\x27\xe8\xaf\xb6\x90\x97" +
+	"\x3c\xdc\xba\xc0\x99\xf5\x80\x97\xec\xc1\x2e\x0a\xaf\x36\xd7\x4b\xf4\x11\x21\xb3\x74\x30\x13\xfb\xa4\xc2\xd2\x07\x69\xcd\xb0\x96" +
+	"\x39\x23\x0e\x9c\xa2\x29\x66\x21\xb0\x5a\x21\x64\x82\x79\x3a\x56\x5c\xab\x07\x33\xb1\x86\xbf\x5a\xa9\xa9\x69\x2f\x99\xbe\x11\xe1" +
+	"\xb5\x46\xc8\x5a\x39\x84\xfe\x26\xad\x83\x81\x97\x3c\x2c\x6a\x64\x50\xea\xb4\xc6\xa1\xd3\x16\xd0\x91\x91\xb7\x11\xb1\x5e\x86\x20" +
+	"\xa3\x62\x9c\x8c\xf4\xb9\x55\x2f\x7d\xa5\x78\x26\x76\x0a\x11\xc9\xfe\xa9\xce\x8c\x5c\x49\x3b\x21\x6b\x4c\x75\x61\x6d\x84\x8c\x3a" +
+	"\xc0\x4c\x30\x57\xc7\x0e\xa9\x33\x8e\x8f\x73\xbb\x48\x31\x2d\x59\x9d\x80\x15\x09\xee\xe2\x88\x51\x80\x26\x81\x0f\xaa\x9b\x88\xb8" +
+	"\x14\xc1\x41\x65\xd9\xbe\x94\x9f\x52\x0f\x21\xdb\xdb\x02\x3e\xd4\x49\x45\xf2\x7a\x39\xb3\xee\x1b\xa9\x76\x6c\x1f\x21\x71\xa3\xf8" +
+	"\xac\x7e\x2c\x54\x6e\xc5\x51\x35\x32\x22\xd5\x90\x35\x23\xba\xcd\x11\xa3\xf6\x4c\x02\x11\xdd\x21\x42\x46\x64\x77\x64\xa5\xca\xdd" +
+	"\x78\x26\x70\x39\x94\x3c\x9b\x89\x26\xd0\xbd\x78\xe6\x6b\xb7\x43\xe1\x45\xda\x7d\x8e\xf4\x86\x11\x88\x3c\x88\x67\xf8\xdd\x50\xf2" +
+	"\x5c\xa6\x7f\x37\xd4\x43\x20\xf3\x2c\xab\x8e\x65\x5a\x8d\x31\x7a\x8b\xf6\x88\x23\x8e\x8a\xd9\x18\x7b\x8c\x95\xc0\x47\x2e\xc4\xb3" +
+	"\x79\x02\x02\x36\x26\xa1\x1a\x63\x64\xf3\x94\x23\xb6\x88\xd5\x18\x7b\x16\xcf\x38\xb7\x42\x59\xc7\x31\xa9\x7b\xf9\x1c\x4c\xf8\x5a" +
+	"\x28\xf9\x4a\xfa\x05\x21\xbd\xe5\x10\x92\x79\x19\xcf\xb8\xf7\x42\xc9\xe3\x98\xaa\x51\xfa\x2a\x9e\x31\xee\x85\x92\xc7\x31\x55\xea" +
+	"\x12\x5b\x62\xfd\x9c\xc8\xe8\xf7\x18\x8a\x90\x22\xaf\xb1\x98\x33\x19\x3b\x24\xcb\xe6\x0d\x88\xb3\x59\x7f\xb5\xd2\xe5\x2d\x01\x99" +
+	"\x00\x20\x25\x06\x40\xcc\x32\x23\x73\x5e\xbd\xbd\x03\x82\x7a\x53\x32\x76\xc1\x25\xb6\xc4\xde\x73\xa4\xb7\x0c\x82\x42\xf8\x40\x01" +
+	"\xcf\x32\x23\x6f\x7e\x2d\x7c\x24\x68\x9e\x19\x89\xb3\x8b\x0e\xd7\x21\x08\xc6\x48\x9d\x57\x75\x9f\xb1\x1e\xe9\xa5\x5a\xa9\x96\xd5" +
+	"\x17\x20\xd2\xc9\xe9\xb6\x0a\xa7\xc1\x78\x26\xaa\x21\x3b\x14\xcf\x44\xce\x72\x1c\x26\x46\x8e\x57\xd4\x87\x58\x9d\x41\x7a\x44\x89" +
+	"\xf1\x8a\xbe\x12\x5e\x2e\x8e\xc6\x33\x91\xb3\x1c\xc7\x88\x59\x66\x2e\xe4\x0c\xd3\x23\x4a\x98\xd1\x40\x33\x4b\x09\x66\x13\x7c\x3d" +
+	"\xcd\x81\xc8\xd4\xa9\x88\x49\x11\x99\x31\x4f\x4f\xbd\x6e\x50\xd0\x54\x3c\x13\x35\xcb\x71\x3a\x9e\x89\x9a\xe5\x38\x13\xcf\x44\xcf" +
+	"\x72\x9c\x8d\x67\xbe\x3a\xcb\x11\x07\x77\xe6\xe2\x19\x9a\xe5\xf8\xe3\x77\xff\x72\x52\xa8\x64\xe6\x81\x2c\x33\x87\x71\xbc\x16\x00" +
+	"\xc1\x0b\x90\x9d\x41\xe5\xb5\x18\xcf\x44\x0c\x73\x5c\x8a\x67\x42\xc3\x1c\xcd\xfc\x2d\xc7\x33\x5f\x9b\xe5\x88\x98\x56\xe2\x99\xa8" +
+	"\x59\x8e\xab\x84\x58\xb3\x1c\xe5\xbb\x2e\xc5\xac\xc5\x33\x11\xb3\x1c\xd7\xe3\x99\xa8\x59\x8e\xad\x87\x32\xee\x2c\x47\xcf\xa8\xed" +
+	"\x50\xc6\x98\xe5\x88\xe4\xb9\x46\xc5\x43\x99\x72\xc3\x1c\xd5\x79\xb0\xd2\xa1\x8c\x3b\xcc\x51\xbc\x24\xd4\x67\xc6\xda\x0f\x65\xca" +
+	"\xcd\x72\x04\x03\xab\x8e\x43\x19\x3e\xcb\x51\x5e\xed\x26\xb7\x51\xf1\x5d\x24\xb8\x72\xad\x93\x90\x22\x53\x57\xbb\x59\x08\x3a\x60" +
+	"\x5d\x20\xb6\x9b\x22\xe2\x08\xaa\x16\xe9\x3e\x94\xb1\x27\x39\x7a\x36\x3d\x44\x14\x59\x90\x80\x4b\x2f\x80\xed\x42\xc2\x1a\x43\xa8" +
+	"\x34\xfa\xb0\x90\x44\xaf\xb1\x10\xb9\xf9\x8c\x1a\x87\xc4\xdc\x22\xa6\xe4\x84\xa3\x0f\x8f\xe2\x4a\x04\x20\xdb\x7b\xfd\x78\xb4\xca" +
+	"\x9d\x43\x19\x63\x8e\xa3\x9f\x38\xec\x8a\x39\x94\xf1\xc6\x38\xba\x31\xdd\x23\x46\x1c\x5c\x0e\xa4\xee\xcc\x36\x76\xff\x50\xc6\x99" +
+	"\xe2\xe8\x1a\x3d\x38\x94\x71\x87\x38\xba\x3e\x0f\x0f\x65\x8c\x03\xd2\x7e\xf2\xce\x6c\x63\x8f\x0e\x65\x8c\x19\x8e\x76\xf2\xc4\x5f" +
+	"\xb1\xc7\x87\x32\xde\x0c\x47\x93\xa1\x2b\x11\x88\xd9\xde\xeb\x47\x24\x55\x9e\x22\xbb\x34\x57\x51\xa6\x57\xdd\x82\xac\xae\xf9\x7b" +
+	"\xc6\x19\xa3\xe8\x2c\x06\x21\x3d\x07\x72\xd9\x55\xc1\x63\x81\x52\x79\x81\x8a\xf1\x27\x38\x9a\x46\x2f\x39\x52\x64\x41\x04\x3e\xaf" +
+	"\x0e\x65\xf4\xd9\x68\x7f\x7e\x23\x44\xfa\xb1\x14\x9a\xdf\x68\x47\x64\x17\xde\x6b\x4e\x19\x95\xe7\x50\x48\xdf\x1b\x40\x97\x5d\x25" +
+	"\x3d\xbf\x11\x42\x6f\x0f\x65\x36\x0f\xb3\x24\xef\xcc\xda\x13\xf4\x54\x87\x6c\x80\x98\xc6\xb4\x9e\xd0\x21\xf6\x9a\xcb\x8d\x1b\xef" +
+	"\x08\x48\xa6\xd5\xc4\x67\xad\x21\x88\xf7\x87\x32\x9b\x2f\x24\x8b\xba\x2d\x6b\x0f\xa4\x23\x9b\x0f\x60\x1a\x4b\x31\xd5\x96\xa5\x2d" +
+	"\xed\x78\x31\xa0\xf6\x02\x7d\x04\x92\x2c\x95\x93\xc1\x5a\x3e\xf1\xb5\x14\x63\x4e\x44\xb6\xd7\x67\xa2\xa4\x99\x19\x93\x12\xfa\x42" +
+	"\x88\x34\x0b\x0c\xd1\xc3\xd6\x98\x43\x99\xcd\x07\x46\x59\x4a\x0d\x77\xd1\x53\xd5\x64\x63\x76\x08\xc8\x38\x4b\xcb\x01\x31\x56\x57" +
+	"\x96\xf6\x5a\x0d\x83\x18\x75\x08\x9d\x3d\x20\x23\x87\x32\x9b\x7f\x1c\x65\xc5\x18\x1e\x0f\x64\x47\x55\x0d\x42\x84\xcd\x28\x88\x71" +
+	"\x56\xb2\x08\x9e\x3c\x39\x2a\x71\x0c\xc8\x28\x2b\x85\x45\x68\x29\xe3\x7c\x29\x45\x3d\xad\xc6\x33\x9a\x00\x32\x6e\x0c\x65\x94\x5d" +
+	"\x59\x25\x32\xc9\x45\x6c\xc2\xf6\x99\x02\x92\xd4\x69\xf3\x6b\x6e\x1a\x44\xa3\xca\x89\x55\x71\x50\x98\xc1\xbf\x27\xf5\xbf\x5b\x05" +
+	"\x07\x60\x16\xd1\x26\x75\xc6\x02\x45\x30\x07\xa4\x51\xe7\xc3\xa9\x37\xa8\xcc\x03\x49\x96\xca\xa8\x50\xb8\x0b\xb4\x12\x23\x65\x01" +
+	"\xa7\x45\x30\x8d\xa5\x98\x1b\x8e\x56\x59\x02\x91\x34\x08\xdf\x68\xf9\x50\x26\xaa\x1b\xbb\x02\xa4\xd2\x44\x1a\x2b\xe8\x80\xa0\x46" +
+	"\x56\x81\xd4\xfd\xa3\x5a\x56\x96\x08\x1d\xd4\x5c\x23\xa2\xd3\xf1\x41\x53\x8b\x1f\x3f\x81\xca\x3a\x98\xca\x4e\xdf\x48\x23\xad\x09" +
+	"\xc8\x5c\xb3\x8d\x94\x0a\x9d\xe5\x6c\x4b\x64\x2a\x4e\x89\x8e\x2c\x9d\x79\xf4\x23\x2a\x02\xa9\x14\x08\x5e\xa4\xf8\x11\x95\x80\xd4" +
+	"\xfd\x13\x16\xa1\x88\xda\x13\x99\x8a\x8f\x68\xc9\x62\x83\x77\x20\x75\x8d\x15\xac\x83\x90\x4a\x13\xb1\x8c\x1a\x2b\x58\x27\x21\x75" +
+	"\xff\xd0\x96\xf6\x32\xb9\xeb\xe2\x4c\xa7\xe3\xd4\x6e\xbc\xdd\xec\x26\xa4\xb2\xd3\x77\x92\x44\x0f\x11\x75\xd7\x1c\x23\x29\x42\xa9" +
+	"\xeb\x4d\x64\x2a\x3e\xcb\x90\x02\xb9\x6b\xac\x60\x7d\x84\x88\x90\x02\xb9\x6b\xac\x60\xb7\x08\x11\x21\x95\x49\xde\x6d\x7c\x8e\x0b" +
+	"\x66\xc7\x55\xed\xfe\x53\xfd\xc9\x3b\x60\x6e\xb8\x08\x7e\x9f\x2b\xe4\x2e\x90\x05\xa6\x0b\xcf\x52\x21\xa7\x7b\x1c\x31\xaa\x8a\x33" +
+	"\x76\xe9\xdd\x07\x75\xc3\x85\xd4\xe1\x5e\x32\x7b\xc0\xcd\x74\xf1\x39\x42\x94\xc2\x87\xa8\x9b\x05\x66\x94\x9f\x1a\x4c\xa3\x56\xfd" +
+	"\x08\xcc\x0d\xa3\xfc\xd4\x99\x65\x85\x3c\x06\xb2\xc0\x74\xfd\x59\x2a\x14\xd8\x93\x44\x86\x37\x67\xe5\xc9\x3d\xfb\xe0\x24\x9d\x41" +
+	"\x78\x4a\xcc\x9a\x3e\xb8\x67\x33\xbc\xb7\x04\xe6\x8c\xa9\x23\x5b\x65\x4a\xe6\x39\x90\xb3\x7f\xd8\x4e\xaa\x53\x06\xa3\x17\x44\x5c" +
+	"\xb4\x7d\xd4\xc5\xa2\xb0\x79\x09\xe2\xcc\x1f\x9e\x8b\x92\x78\x95\xc8\xd8\xed\x59\x3f\x9c\x7e\x42\xd6\xca\x20\x14\xcd\xeb\x44\xc6" +
+	"\x6f\xd0\xe2\x45\x93\x56\x79\x83\x95\x50\x33\x53\xae\x05\x9d\x48\x75\x70\x92\x16\xf3\x96\x33\x46\xe6\x2c\x06\x6f\x0b\x07\x80\xcc" +
+	"\xb8\x32\xaa\x43\x0b\x95\x77\x5c\x45\x07\x6d\x5c\xb8\x2a\xc3\x7e\xcf\x19\x9d\x3a\xff\x52\xd6\x0f\x40\x66\x5c\x19\x2b\x79\x1f\x13" +
+	"\x99\x40\x83\xd6\x0a\xe9\x13\x47\xd6\xc2\x08\x7c\x3e\x27\x32\x81\x0e\xad\xba\x64\x16\xab\xfd\x92\xc8\x98\x1d\xda\x40\xd9\xc5\xb7" +
+	"\xc4\x06\x13\x19\xaf\x45\xeb\x7f\x52\x43\x44\xc9\xfb\x80\x03\x95\x17\xdf\x12\x1b\x4e\x64\x8c\x26\xad\x5f\x7a\xf1\x2d\xb1\x91\x44" +
+	"\xc6\x6d\xd2\x7a\xb5\x37\x9a\xc8\x18\x37\x1c\x7b\xd5\x87\xf3\xd5\x89\x8c\xd1\xa5\x45\xdf\xcd\x8b\x6a\x3c\x91\x31\xda\xb4\x1e\x42" +
+	"\x46\x13\x89\x4c\xb9\x2b\x8e\xa5\xcc\x64\x22\x1b\xd5\xa8\x9d\x02\x22\xa6\x09\x2b\x02\x7b\xa8\x54\x8f\x09\xc0\x08\xab\x0a\x69\xc8" +
+	"\x79\xae\x33\x9c\xe9\xd2\x6d\x42\xd5\xf0\x52\xdf\xd4\xb3\x60\xd4\x84\x64\x81\xe8\x09\xc9\x8d\x15\x6c\x0e\x04\x06\x24\x87\x45\x36" +
+	"\x56\xff\xf0\xaf\xf9\x44\x56\x0c\x48\x06\x43\x9f\xa3\xd7\x37\x5b\x20\x46\x84\x44\x1f\x92\x17\xd3\xa2\x90\xa9\x0a\xc9\xc8\xa0\x96" +
+	"\xb0\x9c\x51\x23\x79\xfc\x67\xad\x4a\x1e\x1a\x9b\xcb\x89\xac\x9e\x90\x2c\x11\x6c\x14\x54\xc4\x0a\x88\x51\x56\x15\x52\x91\x4e\xab" +
+	"\x9c\xe9\x8a\x05\x20\x3d\xff\x78\x0d\xd4\xf5\xae\x98\x6f\xa6\x99\x75\x30\xa3\xec\x9f\x72\x42\xc8\x61\xeb\x61\x04\x2f\x03\xa3\xe0" +
+	"\xe9\xf7\xad\x4c\x21\x74\xda\x0e\x67\x8d\x19\xc9\xc8\xa1\xdc\x97\xa8\x90\xa2\x90\xa9\x0a\xc8\xc8\xc0\x4a\x87\xb3\xf6\x88\x64\xaf" +
+	"\xfc\xda\x0f\x67\xad\x09\xc9\xee\x27\xd5\x81\x7f\xc7\x80\x64\x2a\x72\x5b\x42\xba\x74\x1e\xce\x06\x06\x24\xe3\x73\xfa\x65\x80\xfd" +
+	"\x9b\x6c\xba\x0e\x67\xc3\x03\x92\x25\xd0\x0d\x60\x84\xfd\xa3\x7d\x2c\x0d\xe4\xad\xe7\x70\xd6\x1d\x90\xec\x96\x5e\x2f\x21\x3c\x9c" +
+	"\x70\xe5\xf5\x09\x91\x2a\xfd\xf5\xe0\x57\xde\xad\xc3\x59\x9c\x53\xfd\xe3\x7f\x27\xd9\x96\x9f\x37\xfc\xb2\x89\xe9\x1b\x1b\xe4\x77" +
+	"\xc8\x6d\x8e\x54\x87\x90\xec\xff\xd0\x10\xdc\x3b\x40\x2e\x8b\xf3\x8b\x92\x40\x0b\x4a\x6a\xdc\xe5\x1a\x2b\x4c\x8b\xc8\x79\x5e\x6a" +
+	"\xab\xc7\x3d\xce\xb4\x96\x63\x70\x1a\xff\x3e\x98\xcb\x2b\xe2\x04\xa3\x40\xd4\x84\x5b\x38\x3d\x38\x9c\x8d\x7d\x64\x32\x22\x34\xd5" +
+	"\xd4\x0b\x0c\xb9\x98\x87\x02\xa9\x0e\x20\x22\xa0\x47\x84\x88\x88\xe8\x57\xa5\x17\xd2\xe3\xc3\xd9\xd8\xf9\x69\xf6\x87\xdc\xc4\xe7" +
+	"\x27\xee\x09\x27\xaa\x69\x54\xb1\x83\x08\x9f\xa7\x40\x2e\xdb\x1a\xa6\xc9\x33\x6e\xb2\xc2\x14\xa1\x52\x22\xbf\x78\x9f\x73\x44\x4c" +
+	"\x44\xf6\x19\xa4\xed\x05\x98\xcb\xb6\x8a\x1a\xab\x0c\x91\x97\x87\xb3\xb1\x0f\x4c\x44\x13\x4c\xda\x2b\x41\x54\xab\x11\xcf\x5e\xd2" +
+	"\xfa\x09\xe1\xd1\x84\x73\xf6\x1a\x1f\xdf\xa4\x51\x6d\xd4\xe9\xd5\x49\xc3\x9f\xe2\x37\x9c\xa9\x0e\x31\xc2\xe8\x2d\x90\xbf\x74\xb9" +
+	"\xa9\x13\x29\x4a\x64\x80\x8b\xac\xb0\x80\x0a\x15\x13\x51\xef\x38\xd5\x5a\x96\x42\xee\xde\x03\xfa\x6b\x85\xf9\x6e\x5a\xe8\x03\x95" +
+	"\x8b\x0c\x4c\x4e\x08\x57\xe9\x03\xf2\x51\x20\xd5\x3e\x22\xa2\xfa\x44\x84\x08\xcb\x1a\x55\xae\x44\x3e\x1f\xce\x06\xba\xb4\x99\xff" +
+	"\xd9\x40\xaf\xf8\xf0\x8a\xea\xcb\xe1\x6c\xa0\x4b\x8b\x5f\x0d\x40\x7e\x99\x64\x5b\x2a\x07\x0f\x67\x03\x5d\x5a\x89\xd0\xfb\xbb\xa1" +
+	"\xc3\x59\xde\xa6\xfd\x65\xd3\xbf\x78\xab\x6b\x80\xfd\xfb\x67\xde\xc8\xaa\xfe\xe1\x5b\x62\x86\xc1\xcc\xb3\x2c\x31\x18\x5f\x45\x8c" +
+	"\x6a\x99\xc1\x69\x84\x23\x8e\x0c\x21\x52\x65\xf4\x70\xd6\x6e\xd4\xfa\x46\x63\x87\xb3\x76\xa7\x16\x08\xfd\x56\x52\x46\xe3\x87\xb3" +
+	"\x76\xa7\x56\x23\x52\x65\xe2\x70\xd6\x68\xd5\x9e\xfb\xf7\x2f\xf2\xa7\x92\x9e\x4b\x38\x09\xe4\x6c\x9f\xda\x95\xae\x76\xc5\x11\x82" +
+	"\xc6\xe6\x14\x11\xbd\x65\x08\x6a\x8e\x4e\x1f\xce\xba\x7d\x5a\xb9\xa9\x8d\xd6\x02\x66\x86\x98\xb3\x59\x35\x42\xcc\x6d\xd3\xce\x72" +
+	"\x20\xa3\x76\xad\x2b\x40\x4a\xcc\x1d\xce\xea\x2e\xad\x8c\xc6\x32\x99\x07\x71\x36\xeb\x2d\x55\x7a\x2c\xd0\xbf\x67\xfc\x7f\x97\x02" +
+	"\x8b\x87\xb3\x66\x8f\xf6\xdc\xbf\x7f\xf1\xaa\x6d\x09\x08\xaa\x4d\xaa\xd8\xd5\x96\xd8\x12\x5b\xe6\x44\x6f\x98\x20\x91\x95\xc3\x59" +
+	"\xaf\x45\xeb\x57\xc1\x2a\x51\xf3\x4c\x27\xcd\xae\xb7\xc4\x96\xd8\x9a\x40\x74\xda\xfc\x7a\x5b\x3f\x9c\x35\x5a\xb4\x88\xc9\xb7\x6a" +
+	"\xad\xa3\x35\xab\xd4\x39\x05\x97\xd8\x12\x6b\xe3\x44\x26\x40\x48\x91\x62\x5d\x76\x63\xae\xae\x55\x9d\x3b\x52\x6f\x25\x54\x07\xa8" +
+	"\x04\xa2\xbe\x4d\x1c\x5c\x04\x62\xf6\x91\xe8\x75\x59\x3b\x90\xba\xb6\x32\x22\x84\x74\xd4\x65\x37\xbe\x62\x75\x29\xdd\x5f\x10\x2f" +
+	"\x25\xe4\x3b\xd5\x4e\x02\xea\xf9\xa1\x45\xbb\x33\xa6\x66\x0c\x77\x11\x53\x97\x56\x27\x97\x94\x88\x24\xba\x69\x25\x29\x6f\x21\xd2" +
+	"\xa5\x07\xff\x2e\x4c\x54\x2c\x96\x47\x2f\x88\xba\xb4\xaf\x20\x81\xbe\xba\xec\xc6\x5f\x16\xc4\x4d\xf7\xea\x78\x2b\x4c\x54\xc7\xf1" +
+	"\x16\x90\x45\xd6\x66\x21\xb2\x6d\x49\x73\x6d\x6f\x83\x58\x70\x08\x25\x42\xc8\x9d\xba\xec\xc6\x63\x0b\x2c\xa5\x5a\xa8\xea\x1d\x04" +
+	"\x05\x83\xce\xe6\x5d\x20\x8b\x2c\xad\x5a\xb1\x84\xe8\x78\x20\x73\x8f\xcb\x38\x8c\x4a\x1a\x90\xfb\x7c\x31\x29\xb5\x16\x6b\x52\x2f" +
+	"\x45\xf4\x80\x47\x94\xb6\x10\xdb\xe8\x21\x90\x05\x07\xb1\x47\xf5\x3e\x42\x6e\x91\x3a\x99\x5d\x3b\x75\xc9\x0a\xf6\x18\xc4\x22\x33" +
+	"\xaa\xcd\x4b\xdd\x13\x20\x48\x5d\x50\x84\x90\xa7\x54\x27\x0b\x4c\x57\x9b\x9d\xbb\x64\x05\x7b\x46\xc8\x22\x33\xea\x2d\x10\xd3\x73" +
+	"\x21\xa4\x2b\xce\xcf\xde\x0b\xbe\x9e\x54\x60\x44\xb1\xf0\x7a\x09\x42\x5a\x29\xc4\x76\x7a\x05\x46\x18\x39\x2a\x12\xe9\xaf\xcb\x6e" +
+	"\x3a\x31\xc1\x32\xaa\xf5\xa3\x36\xb1\xd1\x7e\x0b\xfc\x6f\x7e\x0d\x64\x92\x65\x55\x07\x89\x10\xea\xd1\x6d\xa8\xfe\xe1\x5b\xfa\x23" +
+	"\xf4\x86\x33\x8e\x0c\x67\xa4\xce\xdb\xba\xec\xa6\x5f\x26\x44\x63\x4c\x9e\x72\xa5\x4d\x6c\xaa\xa7\x35\x00\x64\x92\xf5\x58\x88\xec" +
+	"\x8b\x91\xd1\x3b\x4e\x74\x07\x09\xd2\x78\x8f\xa5\x4c\x98\x9d\x3e\x79\x52\x56\x21\x1f\x80\x90\x8d\x0a\xc9\xf5\xf9\xc8\x11\x47\xc5" +
+	"\x32\xfa\x54\x97\xdd\xf4\x86\xc5\x33\xba\xcf\x2d\x76\xb0\xc9\x9d\x2a\x9f\x09\x48\xf0\x73\xb2\x76\xe3\x92\x12\x87\x7d\x56\x5f\x38" +
+	"\x93\x31\xba\xee\x8a\x11\x32\x83\x75\xd9\x4d\xf9\x78\xb7\x3a\xf9\xae\xf6\xaf\xa9\x2e\xea\x10\x88\x44\x8f\x38\x26\x0b\xc4\x6c\xc5" +
+	"\xd2\x7e\xae\x61\x42\xba\xcb\x20\x58\xed\x08\xad\x44\xf8\x58\xf1\x28\x64\x94\x10\x69\xa4\x23\xb2\x9c\xc6\x38\xd4\x1d\x73\x42\x32" +
+	"\xbd\xc6\x91\xdd\xb8\xae\x16\x2b\x73\xf8\x10\x27\x00\x24\x74\xc9\xf9\x89\x9b\x24\xc2\x90\xb0\xd2\x06\x8d\x29\x94\x4a\x5c\x57\x8a" +
+	"\x93\x37\x20\xd3\x40\x12\xba\xdc\xfc\x68\x66\x88\xe8\x0e\x13\x54\x06\xb3\x58\x48\xbc\x3b\xe6\x05\xa3\x91\x39\x20\x09\xa3\xdc\x7c" +
+	"\x9f\x79\x42\x4c\x15\xcf\x68\xa1\x2e\xeb\x76\x60\xe5\xa0\x2e\x35\x52\x76\x91\x98\xc6\xb4\xba\x2e\x57\x36\x90\x64\x7f\x75\xa9\x2e" +
+	"\x6b\x74\x60\x71\x59\xae\x16\x11\xc8\x72\x5d\xd6\x68\xc1\xfe\xdf\xbf\x7f\x92\x3b\xc4\xf5\x95\xa9\x2b\x40\xd0\x14\x15\x03\x5b\xd4" +
+	"\xac\x2f\x35\x53\x60\xb5\x2e\x6b\x34\x60\x5d\x11\xac\x64\xad\x2e\xeb\xf5\x5f\x3d\xa3\x75\x82\x84\x93\x15\x90\xd2\x69\x3d\x92\x35" +
+	"\xdb\xaf\x2a\x24\xd3\xab\xed\x48\x16\x9d\xc6\x14\x7e\x8c\x53\xe6\x68\xfc\xb0\x4e\x1d\xfe\x1a\x15\xc1\xa0\xff\x0a\x06\x2a\xd6\x18" +
+	"\x63\xfa\xb2\x2c\x01\x19\x75\x11\x9d\x3c\x30\xed\x47\xb2\x81\x0e\x2c\x16\x83\xbe\x28\x8c\x3a\x8e\x64\x03\x1d\x58\xec\x37\xd7\x17" +
+	"\x12\x1c\xc9\x06\x3a\xb0\x42\x84\x88\x2e\xbe\x94\x62\x4c\x87\xe4\xfa\x74\x03\x21\x1f\xb5\x5c\xb3\x03\x0b\x95\x1e\xae\xe2\x20\x52" +
+	"\x85\x8c\x7a\x81\x24\x75\xe6\xfc\x9a\xeb\x03\xd1\xa8\x93\x62\x95\x1c\x24\x6e\x01\x48\x1a\x80\x55\x71\x20\x6e\x1f\xc9\xda\x3d\x58" +
+	"\xaf\x10\xee\x1c\xc9\xfa\x2d\x58\x5d\x71\x10\xb9\x7b\x24\x6b\xb7\x60\xad\x2a\x00\x71\x8f\x16\x62\xe4\xcc\xf7\xb9\x0f\xa4\xb1\x14" +
+	"\xf3\xa2\xd1\x2a\x0f\x80\x24\x4d\xc4\x33\x7a\x78\x24\x8b\x29\xc0\xd7\xe8\x79\x97\x36\x1d\xcb\xc1\x1c\x74\x2c\x04\xaf\xb9\x1e\x11" +
+	"\x82\x21\xbf\xc4\x50\x3f\x48\xfc\x2a\x51\xcc\xe3\x23\x59\x63\x72\xb1\x27\x43\x07\x1c\x9e\x70\xa6\xca\xb7\x52\xe3\x60\x9f\x0a\xab" +
+	"\x2a\xdf\x4a\x31\xcf\x88\xb9\xf1\xa7\xe7\xa4\x8e\x38\x3c\x3f\x92\xa5\xb1\xc5\xd0\xd0\x03\x87\xcd\x90\x5e\x80\x58\x64\x0e\x42\x57" +
+	"\xfd\x2a\xe6\xe5\x91\x6c\x60\x6c\xb1\x15\xd1\xab\x23\x59\x63\x6e\xb1\xb9\x16\xde\x92\xab\xfe\xe1\x5f\xfd\x84\x2c\xb2\x6b\xf4\x42" +
+	"\xc6\x8a\x48\x31\xaf\x8f\x64\xcd\xa1\xc5\x9e\x0e\x79\xbd\xe1\x50\x95\xcf\xa8\xcc\xbc\x15\x5e\x55\xbe\x97\x62\x06\x8e\x64\x8d\x91" +
+	"\xc5\xc1\xf4\xbd\x3b\x92\xd5\x13\x8b\x7f\xd9\xa4\x87\x9a\xa8\x05\xbf\x07\x21\x63\x52\x08\x4f\x9f\x64\x3e\x1c\xc9\x1a\x03\x8b\x3d" +
+	"\x19\x72\xfa\x48\x1f\xe5\x4d\xa3\xf8\xd4\x60\x5e\xf9\x29\x7c\x22\x64\xd9\x2c\x3e\x35\xb0\x58\x32\x9f\x8f\x64\x8d\x81\xc5\x9e\x0c" +
+	"\x1d\x49\xe2\x88\x51\x7b\x6a\x40\x90\x4a\xcd\xa0\xb0\x32\x8a\x8f\x20\x2b\x7f\x43\x47\xb2\x7a\x60\xb1\xad\x23\x4f\x25\xa1\x6e\x44" +
+	"\x4c\xf2\x5c\xab\x15\xd2\x08\x80\x65\x66\x13\x76\xed\x8d\x1e\xc9\x86\xe7\x15\xcb\x78\xc6\x8e\x64\x23\xe7\x15\x8f\x73\x66\x5d\xcf" +
+	"\xbf\xb5\x10\xde\x32\x22\x66\x56\x4e\xf6\x95\x73\x5c\xf1\xfa\x40\xe9\x4c\x12\xf3\x77\xb5\x6f\x85\x37\x5e\x38\x96\x30\xc5\x89\x8b" +
+	"\x9e\x91\xea\x81\x4d\x0b\x9f\x6a\xcf\x46\x6a\xcc\x1c\xc9\x6e\x3b\x27\x03\x42\xfb\x4f\x4d\xc9\x52\x0b\x99\x25\x84\xc7\xe3\x13\xe4" +
+	"\x33\x07\x64\x96\xb9\x32\xd4\x04\x53\x3a\xf3\xb4\x98\x2b\x02\xa2\x05\xcb\xa1\x69\x8a\x59\xe0\x8c\x91\x3c\x0b\x41\xc3\x72\x91\x90" +
+	"\x19\x33\x77\xb2\x07\xab\x64\x96\x88\xb9\x62\xe4\x4e\x0d\x4e\x93\x81\x2f\x73\x44\x27\x4f\x13\xa2\x03\xbb\x22\x8c\x74\xf2\xac\x16" +
+	"\x2c\x44\x56\x11\xb7\x8c\x48\x36\x4f\xad\x80\xd6\x88\xd0\xc9\xf3\xc2\x59\x07\x30\xc3\x1c\x0d\x3b\x73\xad\xf5\xd9\x6d\x03\x28\x3b" +
+	"\xea\xad\x5a\x1f\xb5\x1e\xc5\xcb\x99\xf5\x72\x0c\x7d\x4e\x45\x82\xf0\x41\x51\xdb\xc3\xaa\x3b\x29\x54\x22\xe6\xef\x6a\x5f\x47\x46" +
+	"\xdd\xce\x89\x8b\x01\x42\x16\x5e\x87\x30\xaa\xf6\x7c\xa4\x48\x67\x7d\x76\xdb\xff\xc9\x90\xd0\x45\xd3\x65\x25\x57\xd2\x45\xc8\x7a" +
+	"\x39\x84\x22\xea\x06\xa3\x02\x52\x10\xcf\x9f\x14\xea\xa9\xcf\x7d\x53\xdb\xd8\x1e\x93\xc7\xf3\xec\xf3\x95\xf4\x9d\xd8\x0b\x64\xa7" +
+	"\x81\xd4\x6d\xa5\xfb\x34\x35\xd1\x07\xa2\xf1\x9a\x3a\x05\x68\x8b\xd0\x3e\x90\x5b\x84\x74\xd8\x3e\xf8\x0d\xa2\x07\x13\xdc\x06\xb2" +
+	"\xb3\xc3\xf3\xd1\xc4\x1d\x10\x8d\xff\xd8\x3e\x4a\x04\x5f\x53\x77\xeb\x73\xdf\xec\x41\x38\x72\x6b\x40\x20\x9e\x7b\x60\x76\x0a\x06" +
+	"\x1d\x30\x3f\xa0\xfb\x40\x1a\xaf\x95\x51\xa1\x80\x1e\x60\x2d\xe3\x4c\xa7\x85\xda\x98\xea\x50\x2f\x39\x3d\x04\x73\xdd\x41\xd0\xa1" +
+	"\x54\xc4\x23\x10\xe3\x4c\xa7\xce\x56\x21\xa7\xc7\x9c\xd1\x89\xe1\x8c\x9d\xbc\x27\x80\xae\x3b\x8c\x6a\xbc\x92\xd7\x53\xee\xa5\xd3" +
+	"\xe7\xe8\x20\x7f\xcf\x10\xf8\xb8\x38\x15\xaa\x1a\xa6\x76\x54\xcf\xc1\x5c\x37\xf2\x27\xfb\xae\x8a\x78\x01\x62\x9c\xe9\xfc\x59\x22" +
+	"\x14\xd4\xcb\xfa\xdc\x37\x03\xac\xb1\x9d\xda\x9d\x81\xc2\xab\xdb\x1a\x7b\x45\xc8\x4e\x03\xb1\x3e\xa7\xba\xad\xb1\x7e\x22\x1a\xaf" +
+	"\x51\x81\x97\xab\xbc\xd7\x1c\xea\xb0\x9d\x28\x6a\x74\x56\xf1\xb7\xeb\x0d\x21\x3b\x3b\x3c\x27\x45\xbc\x25\xa2\xf1\x1f\xc7\x49\xa9" +
+	"\x20\x77\x03\xf5\xb9\x6f\xde\xca\x90\x42\xc5\x87\x83\xd5\xc4\x88\x98\x02\xc5\x57\xb7\x35\xf6\x9e\x10\x11\x54\xb9\xea\xfb\x50\x9f" +
+	"\x8b\x1a\x59\xfc\x91\x23\xd5\x01\x44\x36\x8e\xea\x73\xba\xfb\x2a\x09\x6a\xbb\x49\x8d\xcf\x5c\x63\x45\xcd\x86\x55\xf3\x74\xf5\x7c" +
+	"\x87\x2f\x9c\x69\x2d\xc3\xe0\x0b\x6d\xb0\x3e\x67\x74\x5f\x05\xa2\xbb\xaf\x50\x19\xaa\xcf\x89\xf6\x2b\xf6\xf4\xa1\x91\x28\xb7\x75" +
+	"\xab\x80\x86\x05\x52\xed\x23\x22\xa0\x91\xfa\x9c\xd1\x7d\xc5\x2e\x3a\x3f\xa4\xd1\xfa\x9c\xdd\x7e\xf5\x33\x37\xc6\x91\x6a\x35\x44" +
+	"\xc5\x4f\xdd\x78\x7d\xce\xea\xbf\x7a\x36\x13\xf5\xb9\x40\x03\xb6\x4f\xee\x1d\x82\xcd\x24\x47\xc4\xf1\x4a\x8f\x41\xe2\xa6\xea\x73" +
+	"\xe1\xfe\xab\xd4\x98\xae\xcf\x39\xfd\x57\x2f\x6d\x33\x02\xa9\x56\xa7\x45\xbd\xbc\xcd\xd6\xe7\x74\x03\x36\x9c\xb6\x39\x7c\x84\x93" +
+	"\xba\xe0\xa8\x75\xaa\xd3\x86\x4e\xe5\x3c\x47\xaa\x03\x88\xf0\x59\xa8\xcf\xe9\xfe\xab\x24\x36\x56\x6e\x8d\x29\x8d\x45\xae\xb1\xc2" +
+	"\x7c\x11\xdd\x35\x5d\xe2\x50\x6b\x39\x08\x99\x5b\xae\xcf\x19\xdd\xd7\xdc\x26\xe7\x6c\x30\x99\xad\x50\xb9\x88\xa0\xd4\x21\x64\x95" +
+	"\x3c\x20\xab\x02\xa9\xf6\x11\x11\xd3\x5a\x7d\xce\xe8\xbe\x5a\xa7\x9d\x95\xca\x7a\x7d\x2e\x3c\xb5\x58\xbe\x0e\x6d\x3d\x9a\x13\x53" +
+	"\x8b\xe5\x2b\x1f\xd5\xee\x92\xa7\x1a\xda\x38\x62\x8b\x28\x82\x5e\x72\x15\x8f\xe6\xf4\xd0\x62\x3c\x5c\x53\x03\x0a\x3f\x0a\x54\x77" +
+	"\xa9\x44\x08\x26\x0a\xcb\xfd\xf6\x56\x37\xec\xdb\xe6\xef\x2b\xdb\x8f\xe6\x9c\xa9\xc5\x8a\x90\x2a\x1d\x47\x73\xd6\xd8\x62\xcf\xa6" +
+	"\x13\x80\x74\x71\x1a\xab\xc2\xa6\xeb\x68\xce\x9e\x5b\xec\xb7\xc2\xba\x8f\xe6\x8c\xc1\xc5\xe7\xfe\xfd\x0b\xfd\xc8\xdc\xb8\xb3\x82" +
+	"\xe9\x69\xc3\x3d\x60\x68\x70\xb1\x68\xa9\xe9\xed\x82\xea\x2c\x4d\x2f\x67\xba\x63\x65\x18\x6a\x7b\xf6\x1d\xcd\x19\x63\x8b\x91\x3d" +
+	"\x1a\xfb\x02\x37\xd5\x1a\xbd\x45\x0c\x26\x0a\x5f\xd9\xb6\x11\xa9\x21\xc4\x4c\xde\x6d\x41\x64\x7c\x42\x8a\xdc\x39\x9a\xd3\x73\x8b" +
+	"\xe5\x62\x2c\x9b\xbb\x20\x84\x8b\xbd\x5c\x69\x73\x8f\x13\x99\x00\x21\x45\xee\x1f\xcd\x19\x53\x8b\x45\x03\x55\x4f\x2d\x46\x7e\x1f" +
+	"\x00\x99\x37\x72\xa7\x4b\x4a\x1d\x43\xe2\x48\x77\x2c\x8c\xd0\x87\xfd\xe8\x68\xce\x98\x5a\x1c\xae\xbb\xc7\xc4\xcc\xeb\xcc\x79\x65" +
+	"\xf7\x44\x10\x19\x9f\x90\x22\x4f\x8f\xe6\xf4\xd4\x62\xd9\x3d\xb5\x6c\x9e\x81\x10\x2e\x4e\x7f\x55\xd8\x3c\x3f\x9a\x8b\xea\xc0\xbe" +
+	"\x38\x9a\xe3\xe7\x29\xe5\x35\x83\xee\x79\xca\x5f\xff\x67\xc3\x4b\x30\x37\x8b\xea\x9c\x92\x7a\xe6\xd6\x6f\xc3\x5e\x01\x59\x66\x0e" +
+	"\xa3\x65\xe8\xd5\x5c\xff\xd1\x9c\x73\xa0\x52\x3e\x79\xab\x03\x95\xaf\x8f\xe6\xf4\x81\x4a\x73\x62\xb1\xba\x36\xec\x0d\x88\x65\xd1" +
+	"\x00\xf5\x55\x88\x79\xcb\x57\xd3\xec\x04\x65\x39\x0d\x80\xb9\xd9\x6a\x2f\xd8\x72\x7a\x07\x62\x99\x39\x88\xed\xf4\xfe\x68\x6e\x63" +
+	"\x3f\xab\xe7\x13\x8b\xc9\x2a\xb6\xb3\xe2\x5b\x1e\xb7\x1c\x59\xfc\xe1\x68\xce\x38\x51\x89\xf3\x6f\xe2\x98\x23\x1d\xc4\x40\x5f\xf8" +
+	"\x23\x11\xf5\x06\x61\x89\x00\xf9\x74\x34\xc7\x0f\x54\xaa\x91\xc5\x64\x44\x8b\x19\x64\xdf\xf1\xd1\xbb\x9f\x8f\xe6\x8c\x13\x95\x48" +
+	"\x9f\x3a\x51\x29\x87\x20\x7e\x21\xa4\x5e\x20\xbe\x0e\x98\x41\xbe\x9c\x66\x27\x28\xdb\x6b\x88\x20\xe1\x65\x46\xa5\x64\x86\x89\xa8" +
+	"\x6f\xb5\xa3\xb2\x9c\x46\x90\xe2\x7a\x5d\x7c\x3a\x79\xb2\xf6\x46\x41\x54\xea\xba\x92\x2e\xba\xaa\xc6\x40\xd4\x1b\x84\xa5\x41\x9f" +
+	"\xe4\x38\x6a\xa6\x5e\xd7\x5d\x20\x9c\x09\x20\x95\x46\xe1\xd9\xe1\x40\x65\x12\x48\x7d\x6b\xac\x8c\x0c\x39\x4d\xd1\x62\x74\xe1\x05" +
+	"\x9c\xa6\x81\x54\xb6\x7a\x11\x69\x91\x19\x10\xf5\x06\xe1\xfb\xcc\x1e\xcd\x89\xa9\xc5\xf2\x50\xa5\xd5\x8e\xc5\xb7\xd9\xdc\xd1\x9c" +
+	"\x9e\x5a\x8c\x2e\x34\x11\xd8\x92\xa5\x9a\xb1\xf3\x1c\x11\x22\x36\x23\x54\x16\x8e\xe6\xc4\xd8\x62\xd9\x3e\x56\xcd\x58\x79\xa6\x72" +
+	"\xf1\x68\x2e\x3c\xb5\x58\xee\xa5\x5a\x22\xc0\x91\x90\x04\x14\x96\x45\x34\x02\xb1\x86\x16\x0b\x64\xe5\x68\xce\x98\x59\x4c\x4b\x75" +
+	"\x6d\x56\x39\x22\x45\x5c\x06\x46\x6b\x47\x73\x62\x64\xb1\x28\x49\xfa\xed\x67\x66\x6d\xfd\x68\xce\x18\x59\x6c\x1d\xa8\x54\x1d\xc5" +
+	"\xd6\x63\x39\x63\x66\xb1\xcd\x08\x99\xb6\x63\x39\x31\xb4\x58\xf4\x62\x69\x1f\x20\x4f\x9b\x3c\x4c\x59\x3c\x96\x33\x66\x16\x9f\xdf" +
+	"\xec\x1c\xa6\x24\xab\xd2\xb1\x9c\x31\xb3\xd8\x63\x20\xd3\x4e\xab\x91\x5e\x56\x50\x8a\xe9\x38\x96\x33\x67\x16\x5b\x4b\xd6\x66\x9d" +
+	"\xc7\x72\xe6\xd0\x62\x9f\x82\x5d\xd7\xb1\x9c\x98\x5a\x2c\x3f\x2a\x2f\x87\xdd\xc7\x72\x7a\x6c\x71\x38\x85\x3d\x1c\x31\x2a\xcf\x4b" +
+	"\x61\xef\xb1\x9c\x18\x5c\x2c\xcb\xc6\xc8\xa1\xf8\x3c\xfb\x8e\xe5\x02\x73\x8b\xb1\x5c\x39\x6d\xe5\x16\x11\xae\x88\x44\x10\xd0\x6d" +
+	"\x11\x50\x89\xf9\x11\x49\xe6\xce\xb1\x9c\x31\xb5\xd8\x5a\xae\x72\xba\xcb\x99\x12\x0b\xc4\x24\x75\xee\x1d\xcb\x45\x4d\x48\xbd\x4f" +
+	"\xc8\x88\x3b\x68\x95\x3a\x69\x0a\x7a\x40\x50\x4b\x5b\x99\x89\xad\xe8\x64\x3e\x3c\x96\xdb\x3c\x28\xad\xa8\x87\xa5\xf6\x3e\x2b\x99" +
+	"\x47\xc4\x8c\x30\x17\xb2\xcd\x1e\x13\x25\xcc\xf0\x43\xd0\x16\x82\xd9\x13\xbe\x9e\xab\x7e\x5c\x6a\xae\xc0\x53\x11\xd7\x55\x27\x2e" +
+	"\x79\x8a\x93\xa0\x67\x04\xb5\x94\xfc\xb8\x80\xc0\xe9\x39\x11\x3b\x8d\x0c\xa2\xef\x66\x0f\xe8\x25\x24\x69\x24\x50\x9d\xe5\x54\xcc" +
+	"\x4b\x62\x76\x1a\x09\xb4\x64\xe0\xf4\x8a\xc2\x16\x4e\x94\x1b\xcf\xa9\x9f\x10\xe1\x64\x1f\x3b\x55\xcc\x6b\x62\x84\x93\x3c\xa9\x69" +
+	"\x19\xbd\xe1\x6b\x31\x92\x27\x11\xfa\xeb\x4f\x89\x79\x4b\x4c\xd2\x48\x9e\x7d\x9c\x93\xa0\x01\x82\x76\x1a\xd9\xb3\x84\x10\xd4\xbb" +
+	"\x63\xb9\xcd\xfb\x5a\x9a\xd5\x4c\x20\x6f\x2e\xf4\x7b\x00\x23\xac\x59\x0d\x16\xd2\xc5\x27\x91\x0f\x40\x5a\xda\x62\x61\x11\xda\xc6" +
+	"\xf6\xf1\x58\x6e\xf3\x81\x96\x66\x75\x80\x55\x57\x8c\x14\xf9\x04\x62\x84\x39\x88\x6d\xf4\x19\x4c\x4b\x9b\x3a\xc1\x6a\xab\x90\xd1" +
+	"\x17\x5a\xcb\x55\x6f\x29\xaa\xa2\x06\x01\x8c\xb0\xab\x76\x3c\x56\xd1\x0d\x01\x69\x29\xf9\xf1\xa8\xc3\xc5\xc3\xc7\x72\xc6\x01\x4b" +
+	"\x0c\xbb\x12\xc7\x1e\xe9\x1d\x15\x8e\x11\x8c\x10\x51\xc7\x9b\x4a\xb4\x2d\x19\x7f\xf9\x69\x1f\x96\xee\x2a\x11\x53\x69\x32\xa6\x0c" +
+	"\xde\xaa\x8d\x1d\xcb\x19\x07\x2c\xd1\xdf\x92\x88\x3a\x18\x31\x4e\x48\xdd\x3f\xba\x4b\x46\x4e\x1d\xea\xe5\x1c\x8e\x07\x4e\x10\x54" +
+	"\x29\x20\x4f\x87\x5a\x69\x93\x9c\xe9\xf4\xbd\x54\x54\x53\x84\xd4\x5d\x73\xbc\xac\xa8\xa6\x89\x11\x51\xf9\x32\x88\x6a\xe6\x58\x8e" +
+	"\x9f\xaf\x14\x73\xd6\xec\x56\x24\x1d\x5e\x99\x05\x82\xe3\x95\x40\x42\xc7\x2b\xab\x7f\xf8\xd7\x1c\x98\x1b\x0e\xa2\x64\x60\x34\x7f" +
+	"\x2c\xa7\xcf\x56\x9a\xcd\x55\x3a\x5a\x09\x9f\x85\x63\x39\xe7\x68\xe5\xf9\xcd\x1b\x78\xf2\xe4\xd1\xca\x45\x20\x37\xfe\x09\x6a\x50" +
+	"\xe2\x96\x08\xe8\xf4\x5d\x74\x38\xcb\x40\x10\x8e\x65\x63\x45\xb3\x02\xe4\xc6\xb5\xb0\x08\x82\x59\xa5\x72\xb9\xa1\x8b\x4e\xc7\x2b" +
+	"\x3f\x9f\x35\x42\x16\x44\x2f\xd3\xcd\x9b\x4c\xff\x3a\x51\x37\x5c\xc8\xea\xc8\xb7\x1e\xc7\xa7\x78\xc3\x28\x3c\xb9\x1e\x84\x0d\xaf" +
+	"\x36\x22\x16\x98\x51\x78\xea\x82\x10\x95\x9a\x22\x41\x37\x8c\xba\x33\x65\x08\x29\x71\xa4\xd3\x77\x52\x61\xb5\x0b\x2b\xa3\xee\x74" +
+	"\xfe\xe4\x8a\x3b\x08\xba\x71\xcd\xb7\x52\x48\xe7\xf1\x9c\xe8\xd2\xe2\xd4\x14\x5d\x24\x48\xcf\xc8\x78\xff\x20\x27\xef\x75\x1d\xcf" +
+	"\x19\x5d\x5a\x0f\xe1\xbd\x25\x62\x66\x99\x14\x42\x17\x0b\x3a\xd4\x2e\x13\x3a\x3d\xc4\xfc\x5d\x1d\xb0\x92\x9d\xae\x5e\x8e\x5c\xf4" +
+	"\x9d\xd4\x18\xe3\x3e\xe1\x54\xed\x1b\x49\x95\x5b\xc7\x73\xbc\x51\x2b\xaf\x69\xa4\x3e\x97\x19\xd2\xed\xe3\xb9\x40\x9f\xd6\x0c\xe8" +
+	"\x0e\x88\x59\xe6\x8a\xd0\x06\x6f\xa9\x72\x97\x96\x72\x45\x30\x94\x3c\xd5\xb3\x14\xc8\xbd\xe3\x39\xa3\x49\xeb\x12\x34\x91\xf0\x3e" +
+	"\x21\x33\x66\xea\x54\x93\x56\xa8\x3c\x20\xe4\x8a\x91\x39\x7a\xd1\x64\xf6\x68\x1f\x72\x44\x67\x4e\x13\x72\x8e\xf1\x23\x61\xa4\x33" +
+	"\x67\x0d\x32\x86\xca\x63\x44\x2d\x03\xb2\x7a\xb0\x62\x25\x4f\x8e\xe7\xc2\x3d\x5a\x19\xcd\x53\x00\x33\xcc\x91\xb0\xd2\xf6\xec\x78" +
+	"\xce\x6c\xd1\x5a\x1f\xb3\x1a\x58\xf8\xfc\x78\xce\xec\xd1\xfa\x10\x15\xc3\x0b\xa2\xf0\x31\xc9\x4e\x8d\xae\x3a\xa9\xf4\xf2\x78\xce" +
+	"\x68\xd2\x5a\x42\x32\xec\x57\xc7\x73\x46\x97\x36\x5c\x77\xfd\xc2\xaa\xda\x73\x92\x2a\xaf\x8f\xe7\x8c\x36\xed\x85\xef\x2a\x75\x55" +
+	"\xc9\xb5\xbc\x39\x9e\x33\xda\xb4\x1e\x42\x31\xbd\x05\x23\x43\x52\x0c\xcf\xa0\xd4\x19\x38\x9e\x8f\x1c\x83\xfb\xee\x78\x3e\x6a\x0c" +
+	"\xee\x7b\x20\x8d\xed\xe5\x64\x80\x7c\x38\x9e\x8f\x1c\x83\xfb\xf1\x78\xfe\xeb\x63\x70\x3f\x11\xd0\xf8\xa7\x2a\x3c\xad\x21\x88\xcf" +
+	"\x9c\xe8\x8c\x39\x3e\x76\x48\x5f\x88\x92\x31\x99\x4e\x6a\xbd\x83\x84\xc8\x98\x94\x97\x15\xd3\xd0\xf1\x7c\xc4\x30\xdc\xe1\xe3\xf9" +
+	"\xa8\x61\xb8\x23\x40\xc6\x58\x7b\x58\x84\xda\x9e\xa3\xc7\xf3\x51\xd3\x70\xc7\x8e\xe7\x23\xa6\xe1\x8e\x83\x18\x73\x08\x9d\x3b\xf8" +
+	"\x4c\x70\xa4\x33\xa6\x8d\xdc\x80\x26\x81\x8c\x18\xdd\x69\x0a\x28\xc5\xef\x54\x27\x9f\x29\xee\x63\x13\x76\x3c\xd3\x08\xb9\x51\xa7" +
+	"\x2d\xf0\x09\xcd\x00\x49\xea\xa4\x38\x35\x07\x95\x59\x20\x8d\xed\x65\x54\x68\x2d\x73\x58\x4b\xa3\xce\x9b\x5f\x72\xf3\x20\x92\x2a" +
+	"\x29\x56\xc1\x41\x61\x01\xff\xde\xa8\xff\xdd\xaa\x37\x00\x8b\x04\x18\x29\x0b\x04\xb3\x04\x26\xd9\x1e\x73\x5d\xf4\x42\x97\x8f\xe7" +
+	"\xa3\x76\x4b\xac\x1c\xcf\x47\x0d\xbf\x5d\x3d\x9e\x8f\x18\x7e\xbb\xc6\x89\xf5\x30\x41\x7f\x46\xd6\x8f\xe7\x23\x87\xdf\xb6\x9e\xc8" +
+	"\x47\x0f\xbf\x6d\xe3\x90\xab\xa4\x21\x92\x2a\x82\xfa\x6b\xdd\x81\xac\xb0\x4a\x40\x28\x2c\xdb\xcd\x88\xab\x9d\x23\xae\x8c\x15\x58" +
+	"\xc7\x89\x7c\xd4\x00\xdc\xce\x13\xf9\xa8\x01\xb8\x5d\x1c\x11\x2a\x2e\x01\x91\xee\x13\xf9\x88\xf9\xb7\x3d\x44\x7c\x7d\xfe\x6d\x2f" +
+	"\x67\x4c\x15\x13\x81\x51\x1f\x21\xdb\xd7\x7d\x23\xb5\x96\x5b\x84\xc4\xdb\x5c\x27\x33\xa2\xdb\x9c\x31\x65\xdc\x90\xee\x9c\xc8\xc7" +
+	"\x1a\xb6\x1b\xb5\x67\x1b\xe1\x73\xbc\x0b\x24\xae\x4b\xcf\xb3\xb9\x47\xc0\x7a\x18\xa0\x0f\xe8\x3e\x3e\xc4\xed\x46\xbd\x48\x17\x5d" +
+	"\x2d\x0f\x80\xc4\x8d\xba\xd3\x49\x91\x65\xf7\x90\x10\x53\xc5\x42\xc8\xe9\x11\x98\xed\xeb\xbe\x93\x5e\xcc\x63\x20\x71\xa3\xe6\xbc" +
+	"\x80\x9e\x10\x61\x8a\x78\x11\x3d\x3d\x91\xdf\xb0\xef\x4c\x5f\x4c\x3d\x11\xdb\xe3\x78\xe9\x5d\xd6\x33\x62\x7a\xf5\x23\xb1\xcd\x50" +
+	"\xe3\xee\x39\x98\xb3\xa6\x8e\x38\x7e\xa9\x65\x5e\x9c\xc8\x6f\xf8\xf1\x8c\x3e\x66\x1b\x70\x7a\x49\x48\x6f\x19\x84\x8c\x5e\x01\x39" +
+	"\x6b\xa8\xc8\x41\xb9\x5a\xa5\x1f\x6b\x39\xa3\xa7\x21\xeb\x71\xbc\x72\x4c\xf1\x6b\x22\xf4\x30\xe4\xc0\xc0\x5e\x10\x67\xb3\x5e\x38" +
+	"\x4a\xe2\x2d\x80\x59\x66\x44\x6c\x8d\xe3\x25\x66\x80\x33\x46\xe6\xfc\x91\xbd\x40\xe6\x5d\x19\x38\x29\x95\xf7\x88\x78\xd6\x39\x9f" +
+	"\x6c\x1b\x7d\xe0\x48\x6f\x18\xc1\xd1\x99\x8f\x27\xf2\x81\x43\xce\x6a\x32\x30\x44\x3e\x61\x29\xb3\xee\x14\x69\x3b\x71\x9f\x39\xe3" +
+	"\x8c\x91\xb6\xc7\xf5\x02\xf9\xfa\x30\xea\xc1\x13\x79\x1c\xf8\xed\xe3\x1d\xda\x50\xd9\x25\xb6\xc4\x86\x38\x24\xce\xd2\x96\xab\xbb" +
+	"\x61\xa2\xe4\xac\xe8\x40\xe5\x25\xb6\xc4\x46\x4e\xe4\x37\x14\xa4\x9b\x9a\xcf\x6c\x99\x8d\x12\xd2\x5b\x0e\xa1\xca\x1b\x03\x23\x9d" +
+	"\xac\x19\xcd\x4a\x66\x9c\x56\x73\x26\xeb\xc4\xa5\x52\x98\xd8\x12\x9b\x38\x91\x77\x0f\x3b\x7b\xc5\x37\x49\xcc\xd7\x86\x52\x4f\x9d" +
+	"\xc8\x47\x0f\xbe\x9d\x3e\x91\x8f\x1c\x7c\x3b\x03\x66\x99\xb9\x90\xd1\x32\xc3\x99\x90\xd9\x13\xf9\xa8\xe1\xb7\x73\x27\xf2\xba\x57" +
+	"\x1b\x1e\x7e\x3b\x7f\x22\xef\x36\x6b\x4d\x19\x5a\xcf\x02\x5f\x4f\xb3\x13\x98\xe9\xb4\x08\xe4\x66\xab\xb3\x62\xcb\x69\x09\xc8\x32" +
+	"\x73\x19\xcb\x69\xf9\x44\x3e\x72\xfc\xed\xca\x89\xbc\xd1\xac\x0d\x8d\xbf\x5d\x3d\x91\x37\x9a\xb5\x44\x98\x2a\x40\xd6\x4e\xe4\x23" +
+	"\xa7\xdf\xae\x9f\xc8\x1b\xbd\xda\xe0\xf4\xdb\xd6\x93\x79\xb7\x57\x6b\xca\xc0\xa9\xed\x24\x2d\xa6\xd9\x09\xc9\x72\x2a\x9e\xcc\x1b" +
+	"\x9d\x5a\x33\x24\x25\x53\x22\x44\x38\xe9\x98\x4c\xa7\xf6\x93\x79\x6a\xd5\xca\x4f\x49\xa7\x4e\x55\x5f\x07\x90\x4a\xa3\xb0\xa4\x8f" +
+	"\x2e\xab\x4e\x20\xf5\x26\x62\xc9\xd0\xc7\xd4\x75\x32\x6f\x77\x6b\xbd\x88\xba\x4f\xe6\x75\xb3\xd6\x4f\x1d\x44\x7a\x4e\xe6\x9d\x66" +
+	"\xad\x15\x10\x90\x5e\x5a\x8b\xae\x3b\xdf\xa7\x0f\x44\x65\xab\x1f\x90\x16\xb9\x05\xa4\xde\x44\x3c\x9f\xdb\x27\xf3\x51\x03\x70\xef" +
+	"\x9c\xcc\x47\x0d\xc0\xbd\xcb\x11\xa9\xe2\x31\x90\xb9\x77\x32\x1f\x39\x01\xf7\x3e\x31\x5f\x9b\x80\xfb\x80\x13\x96\x8a\x81\xa0\x8f" +
+	"\xf5\x90\x16\x93\x28\x39\x21\x99\x3e\x8f\x08\xd9\xde\xeb\x87\x24\x7d\x1e\x73\xa2\x14\x88\x48\x8a\x3c\x39\x99\x8f\x98\x7f\xfb\xf4" +
+	"\x64\x3e\x6a\xfe\xed\x33\x42\x8a\x2c\x8c\x20\x6f\xcf\x4f\xe6\xa3\x67\xdf\xbe\x38\x99\x8f\x9c\x7d\xfb\x92\x18\x57\xc7\xea\x9f\xbe" +
+	"\xc2\x6a\xc8\xcb\x0a\xc9\xb2\xea\x07\x73\xb9\x37\x10\x94\xb6\x7a\x4d\x4c\x89\x95\x61\xb0\xe2\x37\x94\xe0\x69\x66\x54\x9e\x97\xbf" +
+	"\xb7\xc4\x5c\x36\x2a\x4f\xe9\x20\x3b\xf8\x9c\x06\x38\x62\x56\x9e\xc5\x20\x83\xef\xa8\x66\xa6\x65\xb7\xd6\xcd\xa1\xf8\x38\xdf\x13" +
+	"\x75\xd9\xa8\x3d\x9d\x1f\x51\x14\x1f\x38\xe2\xe9\x98\x5d\xd4\x8f\x22\xae\x92\x1b\x97\xe9\xf5\x89\xa0\xcb\xbd\x81\xc0\xa4\xd7\x67" +
+	"\x8e\x94\x42\x81\x49\x99\x2f\x27\xf3\x9b\x2f\x8c\xb3\x94\x1a\x21\xac\xf7\xac\xcb\x03\xf6\x83\x40\x46\x59\x4a\x8d\x10\x0e\x8c\xec" +
+	"\x05\x32\xce\xd2\x21\x15\x71\x98\x76\xf8\x64\x7e\xf3\x30\x93\x4e\x7a\xf4\x2f\x9d\x2b\x15\x32\x23\xc4\x48\x2b\xcd\x58\x5e\xa3\x42" +
+	"\x48\x0f\x57\xb6\x07\x04\xd7\x6f\x8d\x8d\xf1\xe5\x14\x63\x7a\x3d\x72\xcf\xba\xfc\x13\x36\x0e\x04\xc7\x46\x75\x54\xde\xdc\x5e\x20" +
+	"\x38\x36\x1a\x56\x41\xbb\x71\x12\x48\xa3\x4e\x9e\x9a\x87\xab\xa6\xf2\x4e\x81\x48\xa6\xec\xf1\xcb\x2a\x1c\x6c\x75\x99\x06\xd1\x98" +
+	"\x0e\x68\x88\x68\x66\x28\x60\xe1\xa2\x1a\xb5\x2a\x6f\xd0\x98\x3d\x99\x77\x4f\x44\x7b\x3e\x73\x27\xf3\x5f\x9f\x49\x3d\x4f\x0b\x29" +
+	"\xc6\xfc\x95\xe8\xb1\xbc\x0b\x27\xf3\xc6\x91\x68\x19\x8f\x6a\xd3\x92\xd1\x22\x98\xc6\x52\x39\x1d\x58\x2d\x9d\xcc\xe3\xe4\x6f\x4a" +
+	"\x9e\x50\xf5\x8b\x6e\x19\xc4\xa8\x43\xe8\x80\x92\x15\x6c\x05\x04\x8e\x29\xfb\x1a\x72\xa2\xf1\xea\xc9\x3c\x0e\xfe\xea\xa9\xc9\x5e" +
+	"\xc5\xad\x9d\xcc\x47\x8d\xa3\x5e\x3f\x99\x8f\x18\x47\xdd\xda\x40\x2b\xd1\xa7\x76\xad\x3a\x81\x46\x5b\x43\x3e\x70\x16\xda\x2c\xb6" +
+	"\x22\x88\x71\x56\x0a\x6b\x90\x4d\xa9\x21\x1f\x31\x06\xb7\xbd\x21\x1f\x39\x06\xb7\x83\x98\x4a\x93\x31\x54\x08\xe9\x6c\xc8\x47\x8d" +
+	"\xc1\xed\x6a\xc8\x47\x8e\xc1\xed\x26\xa6\x52\x30\x9e\x0c\x31\x3d\x0d\x79\xa3\x49\x6b\x32\x32\xa6\x5e\x22\xea\xae\x39\x4e\x56\x4c" +
+	"\x7d\xc4\x88\x98\xac\x05\x2b\xe4\x56\x43\x3e\x72\x06\xee\xed\x86\x7c\xf4\x0c\xdc\x3b\x80\x6e\x38\x8c\xd2\x21\xaf\xbb\x0d\xf9\xa8" +
+	"\x11\xb8\xf7\x1a\xf2\x4e\x9f\x56\x4e\x3e\x51\x8d\xc4\xfb\x0d\x79\xd5\xa7\xf5\x44\x88\x78\xd0\x90\xd7\x8d\x5a\x0b\xd1\x31\x3d\x6c" +
+	"\xc8\x3b\x9d\x5a\x35\xfa\x44\x2f\xf8\x11\xa0\x1b\xd7\xca\xe8\x50\x4c\x8f\x1b\xf2\x46\xb7\xd6\x4e\xa0\xf8\xa4\x9e\x10\xb2\xc0\x8c" +
+	"\xd2\x32\x12\x28\x3f\x88\xa7\x44\xdd\x70\x21\x29\x44\x66\xcf\xe8\xf3\xbc\x61\x14\xa0\x5a\x91\x6c\xd7\x3e\x27\x64\x81\x19\x15\xe8" +
+	"\xa7\xf0\x05\x41\x37\x8c\x0a\xb4\x74\x28\x89\x2f\x1b\xf2\x46\xbf\xd6\x62\x64\x60\xaf\x84\x97\x51\x83\x7a\x80\x8c\x5a\x74\x3f\x51" +
+	"\x37\xae\x05\xcc\x14\xf3\xba\x21\x1f\x35\x0f\xf7\x4d\x43\x3e\x6a\x1e\xee\x5b\x8e\x38\x2a\xe8\x39\x4a\x91\x81\x86\x7c\xc4\x38\xdc" +
+	"\x77\x0d\xf9\xa8\x71\xb8\xef\x39\xb2\x16\x46\x60\xf3\x81\xdb\x5c\x54\x80\x17\xcd\x47\x10\x88\xc6\x24\x48\x43\x45\xf3\x89\x23\xb6" +
+	"\x08\x47\x84\xc8\xe7\x86\x7c\xe4\x14\xdc\x2f\x0d\xf9\x88\x29\xb8\x83\x9c\xb8\xc8\x8f\x62\x21\x6d\x8a\x90\x22\x43\x0d\xf9\xc8\x29" +
+	"\xb8\xc3\x0d\xf9\xa8\x29\xb8\x23\x84\xac\x95\x43\x48\x66\x14\xcc\xd9\x8b\xb6\x93\x15\xd1\x18\x88\x33\x7f\xf8\x22\x32\xa0\x71\x02" +
+	"\x2e\x06\x00\x29\x31\x41\x21\xcf\x31\x23\x73\x7e\xc1\x4d\x12\x34\xc3\x8c\xd4\xd9\x25\x17\xdf\x12\x9b\x12\x8c\x91\x3c\xaf\xe6\xa6" +
+	"\xb1\x9c\x39\x66\x64\xcf\x2b\xba\x19\x20\x28\x3a\xb5\x66\xbb\xea\xe2\x5b\x62\xb3\x1c\x59\x2b\x83\x60\xc1\x73\x40\x64\x50\x08\xdc" +
+	"\x8f\x69\x1e\x88\x0c\x49\x21\x56\xf6\x16\x38\x72\x31\x84\x08\x95\xc5\x86\x82\xd1\xa8\xc5\xbc\x6d\xf1\x8b\x49\x6f\x84\x59\x02\x82" +
+	"\x9e\xa6\x68\xa9\xab\xa1\x20\x84\xa0\x7d\xba\xdc\x50\x30\xda\xb4\xae\x08\x88\x95\x86\x82\xdb\xa5\x95\x33\x3d\x70\x9d\x38\x75\xb3" +
+	"\x56\x89\x49\xfe\xa9\x76\x08\x58\x4d\xb3\xba\xad\xb1\xb5\x86\x82\xd1\xa5\x25\x42\x89\x08\x64\xbd\xa1\xe0\xb5\x69\xbd\x80\x5a\x7f" +
+	"\x2f\x88\x2e\xad\xeb\xa4\xd6\xdb\xf6\x7b\xc1\xec\xd2\x2a\x2f\x33\xa6\xe2\xef\x85\x40\x97\x16\x04\xbe\x53\xf1\x87\xa9\xf4\x7b\x21" +
+	"\xd0\xa5\xc5\x0f\x2f\x7c\x5b\xd2\x0d\xdd\xed\xbf\x17\x02\x5d\x5a\x21\x42\x44\xc7\xef\x05\xf4\x3d\x2f\xe1\x76\x67\xca\xdc\xff\x0e" +
+	"\xb1\xef\xfe\xa3\x53\x07\xa3\x4e\x30\x23\xec\x4f\x62\xb0\x58\x62\x54\xee\x20\xd3\xc5\x65\x1c\x44\x27\x0f\x4c\x37\x67\x3a\x63\xda" +
+	"\xcb\x0d\xa9\x07\x08\x85\xa4\x74\xcc\x46\x2d\x54\x7a\xb9\x8a\x83\x48\x15\x8a\xa9\xef\xf7\x82\xdd\xa8\xf5\x3e\xa3\x5b\xbf\x17\xfc" +
+	"\x3e\xad\x2e\x3a\x88\xdc\xfe\xbd\x60\xf7\x69\xad\x0f\x08\xc4\x1d\xac\xa4\x51\x67\xce\xaf\xb9\xbb\x20\x92\x3a\x29\x56\xc9\x41\xe2" +
+	"\x1e\x80\x46\x03\xb0\x2a\x0e\xc4\x7d\x22\x8c\x9c\xf9\xc1\x3c\x00\x92\x6c\x8f\x79\x36\x7a\xa9\x0f\x81\x34\x9a\x88\x17\xcd\xa3\xdf" +
+	"\x0b\xb1\x9f\xa6\x45\x8f\xd5\x3a\x7d\xa9\x5a\x91\x8f\x81\x4c\xb1\x55\x0b\x91\xcd\x4a\x3a\x5c\xf9\x84\x13\xeb\x41\x82\x34\x9e\xfe" +
+	"\x5e\x88\x9d\x9a\x66\x17\x55\xfb\x49\xbd\x6f\xa0\x6f\x1e\x20\xcf\x80\x4c\xb1\x3f\x54\x0f\x8b\x10\x6a\x97\xa9\xf3\x97\xcf\x39\xe3" +
+	"\xc8\x70\x46\xea\xbc\x00\x33\x6d\x76\x57\xb1\x1a\x1c\x56\x54\xab\x79\x09\x84\x22\x52\x5e\x6e\x48\xaf\x38\xe2\xa8\x58\x31\xf5\xff" +
+	"\x5e\x88\x35\x25\xd6\xd5\xf9\x4b\xf5\xa6\x4b\x75\x22\x5f\x83\x88\xaf\xea\xe3\x97\x56\xd3\x8d\xfe\x88\xbf\x21\x64\xbd\x0c\x82\xaf" +
+	"\xe4\xb7\xbf\x17\x62\x9f\x59\xe2\xa2\xfa\x99\x25\x5f\x12\xca\xef\xec\x01\x02\xe2\xfc\xec\xa5\xdd\x67\x54\x67\x2f\xdf\x71\xe6\xa2" +
+	"\xfe\x8d\xa5\x19\x21\xf3\x9e\x10\x11\x8f\x65\xa4\x96\xf2\x81\x10\x19\x90\xdf\x68\x24\xab\x8f\x1c\x5a\x67\x8e\x97\x19\xd3\x27\x14" +
+	"\x4c\x42\xd7\x8b\x93\x3a\x7c\x8c\x9f\x81\xc4\x75\xd1\xf9\x46\x5f\x88\x58\x0f\x13\xf4\x09\x0d\xe2\x43\x4c\xe8\x6a\xb1\x32\x07\x60" +
+	"\x08\x40\x5c\x97\x9c\x9f\xb8\x61\x22\x0c\x09\x2b\x6d\xd0\x18\x01\x91\x58\x67\x9e\x8b\x5e\xc7\x28\x90\xb8\x51\x6e\x7e\x30\x63\x84" +
+	"\x98\x2a\x5e\x34\xe3\xbf\x17\x36\x0c\xb3\x2b\x45\xa6\xdf\x67\xc8\x8d\x68\xdb\x87\xd8\x77\x95\xdf\xee\xfa\x7e\xc3\x04\x67\xf8\xf9" +
+	"\x58\x1f\xe1\x2d\x25\x62\x70\x5a\x54\xbd\xd1\x90\xd7\xf2\x2b\x9d\xa9\xdf\x0b\x1b\x2e\x48\x2b\xf4\x08\xe9\x8f\xbc\x65\x35\x4d\x88" +
+	"\x3e\x89\x6b\x13\xe4\x34\x03\x64\x8e\xb9\x32\xf4\xd3\x46\xe9\xcc\xd2\x72\xae\xfc\xea\x47\x25\x4f\x2b\xce\x71\x22\xe3\xc5\xa4\xba" +
+	"\x64\xf3\x22\xa4\x5f\xbd\x88\xa4\xc6\x02\x11\x7f\x8b\xa5\x50\x66\xd4\x51\x50\xb9\x92\x45\xce\x18\xc9\xb3\x10\xbc\x19\x5c\x22\x64" +
+	"\xde\xcc\x9d\x6c\xd2\xaa\x80\x96\x11\xb5\x74\x92\xfd\x55\xcb\x68\x85\x08\xfb\x10\xb3\x65\xb3\x0a\x60\x9e\x39\x1a\x76\xde\xd6\x68" +
+	"\x29\x7f\x1b\x79\x53\xd3\x4f\x64\xd0\xeb\x1c\xd1\x89\xd3\x84\xe8\xd0\xb6\x9e\x2a\x88\x93\xd0\x2a\x1c\xb3\x45\x0b\x91\xb6\x53\x05" +
+	"\x1c\x09\x2e\x32\xea\x64\x5a\xf9\xe7\xfd\x85\x5d\xdf\x6f\x28\x72\xa6\x3b\x56\x86\xa1\x0f\xa9\x44\x10\xca\x81\x9a\x99\x56\xdd\x49" +
+	"\xa1\xf6\x53\x85\x0d\x05\xe9\x85\x57\x60\xba\xaa\x24\xd2\x41\x48\x77\xac\x0c\x42\x56\x9d\x60\x94\x93\x82\x78\x02\xa5\x50\x17\xad" +
+	"\xe7\xca\xaf\xfe\x9a\x65\xe8\xdd\xa7\x0a\xc6\x69\xe8\x60\xe5\xf5\x88\xa0\x7e\xf5\x62\x92\x22\xbd\xa7\x0a\xb8\x4a\x95\x9f\x04\xb5" +
+	"\x8e\xae\xaa\x83\xa0\x7d\x84\x2c\x31\xc1\xc8\xb3\xab\x78\xab\xa1\x98\x5b\xc4\xdc\x2c\xe9\x23\x9c\x96\x0c\xbd\xe8\xba\x7d\xaa\xb0" +
+	"\x31\x2f\x9c\x30\xa1\x53\x9f\xc7\x95\x2a\x77\x40\x2c\x31\x07\xa1\x7d\xee\x8a\xb9\x0b\x46\x18\xf9\x2a\x64\x74\x8f\x2f\x66\x97\x1f" +
+	"\x13\x5e\xfe\x51\x2b\xee\xbe\x88\x69\x97\x1f\x93\x62\x1e\x10\x73\x33\xed\x85\xa4\xde\x10\x3e\x3c\x55\xd8\xf8\x9a\xdd\xbc\xaa\x5f" +
+	"\xfa\x4b\x84\xda\x82\x08\xe9\x11\x21\x4b\xec\xea\xff\x5b\x62\x5b\xff\x63\x19\x29\xe6\x31\x31\x37\x4b\xb1\x32\x3a\xe4\xf5\xe4\x54" +
+	"\x61\x63\x4e\x58\xfd\xeb\xa7\xcd\x4c\xc7\x2d\x65\x9e\x82\x90\x4e\x0a\xe1\xd9\x93\xcc\x33\x30\xd2\xc9\x97\x21\xa7\xe7\x7c\x39\xbb" +
+	"\xfc\xd5\xa8\xd4\xbc\x10\x51\xed\xf2\xa3\x52\xcc\x4b\x62\x6e\xa6\x03\x32\x32\x7f\xaf\x28\xc3\x37\x8c\xe2\x93\x9b\xe1\xd5\xc7\xdd" +
+	"\x4f\xc8\x82\x59\x7c\x60\xac\xe2\x7b\x4d\xcc\x0d\xa3\xf8\x2c\x19\xbc\x99\x7e\x83\xaa\x11\x46\x72\x72\xb4\xe5\xf3\x16\xc0\x02\xb3" +
+	"\x09\xbb\xf2\x06\x80\x08\x17\x4f\x03\x26\xef\xf8\x3a\x8c\xba\x53\x27\x16\x54\x56\xde\x8b\x78\x8c\xc2\x53\xf7\x7f\x29\xe8\x03\x41" +
+	"\x37\x74\xe5\x69\x1d\xf1\x52\xff\xe3\xa9\xc2\xa6\xfc\x04\xcb\x92\x0a\x3e\x47\xbe\x47\x6f\x55\x9c\xa2\xc7\xce\x8b\x4f\x1c\xf9\x35" +
+	"\x84\x88\xb3\x8a\x9f\x81\xfc\xc5\x0f\x8d\x2a\x02\x8d\x4c\xa9\xf1\xe5\x54\x61\x53\x3f\x93\x3e\xe8\x64\xaa\xbd\x80\x12\x19\x14\xc8" +
+	"\xaf\x01\x44\xd8\x0c\x11\x22\x7c\xa8\xdb\xef\x19\x0d\x63\x25\x13\xac\x2f\xa6\x57\xdb\xb4\x79\xc3\xff\xae\x9a\x03\xfa\x47\x38\x53" +
+	"\x62\x65\x18\x7c\x6b\x8e\x82\xf9\x4b\x1e\x4e\x15\x88\xba\x64\x16\x2a\x63\xa7\x0a\x38\x19\x9c\x95\x67\x2f\xfd\xc4\x8d\x73\xe2\x57" +
+	"\xaa\x6d\x07\x11\x11\x4d\x00\xf9\xcb\xd6\x30\xc3\x99\x3c\x55\xa0\x93\xc1\x59\x79\xbd\xac\x97\xb6\x29\x41\x70\x97\x60\xda\xa6\x4f" +
+	"\x15\xf4\x49\xe8\x60\xd6\x66\xb0\x0c\x64\x4d\x2d\x44\x25\x44\x6e\xf4\x99\xe5\x48\x89\xe9\x68\x2c\x06\x49\x9b\x3b\x55\x08\x9f\x84" +
+	"\x96\x22\xf3\xc8\xea\x94\x51\x6d\xd4\xdb\xd4\x49\xc3\x86\xab\x05\xce\xfc\x1a\x62\x44\x40\x8b\x40\xc4\x19\x65\x45\x60\xab\xa0\x12" +
+	"\x59\xa2\x4a\x91\x4e\xc8\x8a\x7c\xae\x51\xc8\xb2\x40\x7e\xf5\x11\x61\xb3\x72\xaa\x60\x9c\x85\x46\xe2\xe4\x48\x1e\x25\xb2\x8a\x95" +
+	"\x4c\x99\xe5\xa6\x57\xab\x0f\x16\xaf\x71\xaa\xc4\xca\x51\x48\xde\xfa\xa9\x82\x71\x1c\x5a\x32\x70\xd3\x42\xad\xa7\x0b\x51\xdd\xd8" +
+	"\x36\x20\xd7\x9d\x66\xec\xb7\x57\xb6\x29\xa0\x08\x60\x9c\xed\x0a\x69\x5c\xfa\xf1\x67\xda\xc9\x52\x3a\x5d\xd8\x3c\xa4\x9a\xb1\xb4" +
+	"\xc7\xcc\x6b\x8d\xb5\x13\x23\x9c\x68\xb3\x9a\x67\xd5\x21\x64\x76\x85\x64\xa4\x57\x27\x5f\x4f\xab\xee\x07\xaa\xd6\x96\xda\x7c\xd3" +
+	"\x05\xe6\x7a\xab\xdd\x8c\x45\x50\x8a\xe8\x06\x31\xce\x9a\xcb\x88\xe0\x6b\xb7\x07\xc8\x98\x88\x09\x22\x7c\xb7\xbd\x4a\x1e\x7a\x93" +
+	"\xbd\x60\x5a\x74\xf6\xd4\x91\x4b\x45\xf4\x81\x18\x63\xbb\x42\x2a\x32\xa4\x5b\x14\xb7\xb4\x52\xc7\x2e\x55\xf6\xe0\x74\x9b\x18\x61" +
+	"\x65\x1d\xbb\x54\xc8\x1d\x21\xb3\x2b\x20\x23\xad\xee\xf2\xe5\xb4\xc6\x02\xeb\xa1\xc8\x49\xe9\x1e\xa8\x96\xd6\x98\x1f\x97\x66\xee" +
+	"\x83\x19\x63\xcd\xe5\x84\x90\xc2\x07\xa7\x0b\x9b\x0b\xe3\x2c\x25\x07\xaa\xf8\xe5\xf7\x10\xc4\x75\x1b\x30\x4b\xe2\x11\xfe\x7d\x9c" +
+	"\xed\xa2\xdf\x91\x8e\x84\x8c\xe9\xf1\xe9\xc2\xe6\x2f\x4c\xd8\x84\x8b\xef\x09\x21\xdc\x27\x5c\x7b\x4f\x85\xc8\x2e\xbd\xa5\xd0\xaf" +
+	"\xbd\x67\x7c\x35\xad\x6a\xe2\x8d\xae\x1a\x39\xa2\xe3\x39\x90\xeb\x36\xa1\x8e\x2f\x03\x78\x01\x60\x5c\x9c\x21\xf7\x35\x90\xb6\x97" +
+	"\xa7\x0b\x15\x1f\x58\xfd\x25\xbd\xc9\x5d\x6c\x92\x92\x27\x45\x5e\x11\x50\xc7\xc7\xe2\xda\x8d\x59\xbc\xe2\xa5\x73\x13\xfd\xc4\xd4" +
+	"\xff\xa9\x2e\x4e\x52\x22\x92\x78\x7d\xba\x50\x71\xbe\xbe\x2b\x26\x07\x88\xa9\x2d\x6a\xaa\x2d\xfb\x06\x44\x5d\x87\x98\x8a\x9b\xdf" +
+	"\x64\xdf\xda\x4a\x36\x6f\x81\xd4\x77\x94\x11\x21\x64\x80\x90\x4b\x1e\x21\xa3\x79\x87\x7f\x17\xc1\x28\x13\x2b\x96\xf7\x20\xea\xff" +
+	"\xf4\x15\x24\xf0\xe1\x74\xa1\xa2\x61\x99\x5d\x52\xed\x58\xb5\x6f\x92\x4c\xf0\x36\xf5\x23\x90\x25\xf6\xa7\xea\xb4\x12\xa2\x73\x86" +
+	"\x37\x83\x9f\xc0\x2c\xbb\x8c\x4a\x1a\x90\xcf\xa7\x0b\x15\x3f\x2d\x33\x7e\x21\xa9\x1a\x87\x8b\x70\x54\x33\xf6\x0b\x90\x25\xd6\x61" +
+	"\x21\xb2\x91\x48\xaf\x17\x07\x41\x2c\x3b\x84\x12\x21\x64\x88\x23\x97\x14\x41\x2b\x51\x69\x43\x44\xc3\x40\x10\x91\x89\xd8\x01\x8d" +
+	"\x00\x59\x76\x10\x9d\x3a\x20\xa3\x54\x27\xcb\x4c\x57\x9b\x9d\xbb\xc6\x0a\x36\x46\xc8\x12\x33\xea\x2d\xe0\x35\x2e\x84\x74\xc5\xf9" +
+	"\xd9\x9b\xc0\x07\x89\xec\xc9\x8f\xd2\xce\x5e\x63\x05\x9b\x04\xb1\xc4\x8c\x9a\xf3\xb2\x37\x05\x04\xd9\x0b\x8a\x10\x32\xcd\x11\x55" +
+	"\x73\x46\xdc\x22\xa6\x19\x10\x32\x24\x85\xd8\x11\xcd\x82\x11\x01\x39\x2a\x12\x99\x3b\x5d\xe0\x1d\xd8\x9f\x36\x6f\xa0\x26\x20\x1d" +
+	"\xa3\xe4\x3d\x4b\x79\xd6\x72\x1e\x0c\x9a\x96\x60\x56\xd9\x0f\x95\xd6\x51\x4b\x7a\x83\xbb\xc0\x11\x47\x86\x9a\x89\x52\x65\xf1\x74" +
+	"\x21\xd0\x83\xbd\xf8\xc3\xbf\xa8\x8f\x05\x62\xe9\x74\x21\xd0\x83\xc5\xbe\x69\x35\x12\x70\xf9\x74\x21\xd0\x83\x95\x08\xbd\x4f\x5b" +
+	"\x39\x5d\xb0\x9b\xb0\x7e\x40\xab\xa7\x0b\x76\x17\x16\x08\x6d\x71\x57\x01\xad\x9d\x2e\xd8\x5d\x58\x8d\x48\x95\xf5\xd3\x05\xb7\x0d" +
+	"\x2b\xaf\x8b\x52\x07\x2d\x5b\x93\x60\xce\xfc\x81\xe3\x8f\x94\x3a\xb7\x0b\xdb\xc6\x81\x8b\x7a\x64\xa6\x04\xa4\x44\x31\x59\x30\x9a" +
+	"\xb0\xe8\x5d\x88\x9d\xe2\xfc\x28\x09\x90\x12\x90\x33\x2b\xea\x90\xa5\xba\xf7\x4a\x5d\x00\xd8\x4e\xc4\x5a\x19\x82\x96\xda\x91\x2c" +
+	"\xe8\x0e\xac\xb4\xb1\x82\xe9\x04\x71\xe6\x0f\x4f\x43\xc6\xd2\x45\xff\x7e\xd1\xff\x77\x29\xd0\x9d\x2c\x78\xfd\x57\xff\xd3\xe9\x21" +
+	"\x6a\x86\xe9\xa4\xd9\xf5\x16\xdf\x12\xeb\x15\x88\x4e\x9b\x5f\x6f\x7d\xc9\x82\xd9\x7f\xbd\xf0\x5d\xa5\x57\x70\xb7\x80\xa0\xe0\xe4" +
+	"\x92\xed\x82\x8b\x6f\x89\xdd\xe6\xc4\x5a\x98\x20\x91\x3b\xc9\x82\xd1\x7e\x85\x8d\x1f\xd2\x5d\x30\x22\x22\x85\x58\xb9\xbb\xc7\x89" +
+	"\x8b\x01\x42\x8a\xdc\x4f\x5e\x88\x6a\xfb\x3c\x00\xb2\xd3\x44\xe4\x00\x56\x85\x3c\x04\xd2\x78\x2d\xdc\x81\xa2\x4d\x19\x8f\x88\xe8" +
+	"\x70\x7c\xd0\xc7\xe2\x7f\x12\x61\xf4\x18\xcc\xce\x0e\xdf\x48\x23\x4f\x80\x34\xfe\x63\x1b\x29\x15\xda\x6b\xf2\x34\x79\xc1\x19\x68" +
+	"\xec\x45\xf4\x0c\xc8\x4e\x81\xa0\x16\xfc\x88\x9e\x03\x69\xbc\x16\x16\xa1\x88\x5e\x60\x29\xe3\x66\x97\x51\xfd\x34\x52\x6d\xc6\x97" +
+	"\x60\xae\xbb\xc8\xb7\x3b\xb6\x31\x85\xbc\x02\x32\xce\x74\xee\x2c\x15\x72\xea\xe7\x88\x91\x18\x77\x9e\x31\x56\xfc\x1a\xd4\x75\x17" +
+	"\x82\x97\x66\xde\x80\x19\x67\x3a\x7f\xee\x40\x63\x24\xf0\x6d\xf2\x82\x3b\xd1\x58\xde\x4d\xa2\x56\x3d\x00\xe6\xba\x91\x41\xba\x9b" +
+	"\xc4\x0a\xec\x5d\xf2\x82\x33\xd2\xd8\x54\xa1\xc0\xde\x27\x2f\x18\x23\x8d\xfd\xea\xc3\xe9\x6a\x42\x76\x9a\x88\xf5\x59\xd5\x6d\x8d" +
+	"\x7d\x24\xa4\xf1\x9a\x9e\x20\xee\x7f\x58\x9f\x92\x17\x8c\x91\xc6\x76\xfd\xe1\xcf\x63\xdd\xd6\xd8\xe7\xe4\x05\x63\xa4\xb1\x55\x7e" +
+	"\x82\xf8\x42\x44\xe3\x3f\x8e\x91\x14\xa1\xea\x1b\x4c\x5e\x70\x47\x1a\xbb\x21\x0d\x11\x22\x42\x0a\x94\x1f\xee\x1f\x20\x44\x84\x54" +
+	"\xa6\xfe\x46\x92\x17\x02\xdd\xd8\x1e\x31\x46\x80\xbe\x5b\x47\x93\x17\x02\xdd\x58\x3c\x56\x03\xc1\xb7\xeb\x18\x27\x6c\x11\x45\x90" +
+	"\xc8\x78\xf2\x02\x7a\xa4\x17\xd5\xe6\x36\xeb\x36\x52\x42\x26\x80\xa0\x1d\x2b\xe7\x90\xe8\x21\x5b\xf2\xab\x68\x92\x23\x8e\x8a\xee" +
+	"\x8e\x41\x66\x0a\x0c\x45\xa4\x20\xb3\x1b\x0b\x64\x1a\x08\x45\xa4\xac\xdc\x90\x66\x38\xe2\xa8\xd8\x31\xcd\x26\x2f\xf0\x76\xac\xbc" +
+	"\x8d\x94\xfa\x70\x94\x3b\x39\x4a\x7f\x0e\x88\x6c\x5f\x12\xb2\x63\xdb\x46\xae\x02\x84\xda\x97\xf3\xc4\xac\x97\x63\xd0\xbd\x5c\x48" +
+	"\x5e\x40\xa7\xf4\xa2\x9e\x42\x62\x35\x16\xb1\x01\x26\x79\xc1\x6d\xc9\x42\x45\x25\x0f\x46\x4b\xc9\x0b\x46\x4b\x96\x54\x14\x23\x64" +
+	"\x96\x09\x91\x31\x59\x4e\x6a\x31\x2b\xc4\xc8\xa0\xb4\x97\x1d\xd5\x2a\xa7\x44\x58\xda\xcd\x8a\x6b\x2d\x79\xc1\xef\xca\xea\x04\x22" +
+	"\xc9\xeb\xc9\x0b\x76\x57\xd6\xb7\x6a\xfd\xef\x05\xbb\x2d\x6b\xfb\x40\xa5\xed\xbf\x17\x62\xa7\x12\xba\x6e\xac\xf4\x01\x28\x02\x88" +
+	"\xeb\xda\xf3\xb3\x57\x22\xc2\x90\xb0\x72\x07\x8d\x76\x10\x89\x75\xe6\xb9\xe8\x75\x74\x00\x89\x1b\x85\x17\x08\xa7\x93\x18\x53\xc6" +
+	"\x8f\xa7\xeb\xbf\x17\xf8\xc9\x59\x79\x99\xab\xfc\x6d\xc6\x5f\x50\x61\x06\x61\x37\x21\x25\xa6\x2e\x73\xb5\x90\x6f\x9b\xbf\xaf\xec" +
+	"\x01\xb1\xc3\x14\x49\x6c\xa1\xf1\xb1\x5a\xa4\xf7\xbf\x17\x36\x1c\x10\xc7\x66\xd1\x3e\xf3\x6d\xfa\x88\x28\xb1\x20\x01\x97\x5b\x00" +
+	"\x76\x08\x09\x4c\xa9\xf5\x5d\x6e\x63\x21\x67\x7a\x9c\x68\xf0\x1b\x84\x1a\x6c\x34\x20\xf2\x0e\x31\x45\x27\x1c\xc5\xe0\xe4\xc7\x5d" +
+	"\x20\x3b\x7a\xfc\x78\xb4\xca\x3d\x20\x33\xcc\x88\x59\x6d\x8e\x53\xc3\x1b\xef\x73\xc6\xc8\x9c\xc5\x20\xa6\x07\x40\xae\xb8\x2a\x98" +
+	"\x2f\xa2\x54\x1e\x22\xec\x19\x71\xe6\x55\x4d\x24\xb6\x8c\x1e\x71\xa4\xc4\x82\x08\x7c\x1e\x83\xb8\x62\xe4\x4e\xcd\x46\x56\x22\x4f" +
+	"\xb0\x94\x19\x66\x44\xcd\x57\x6b\x67\xef\x29\xa7\x8c\xf4\x39\x14\xf2\xf7\x0c\xd0\x15\x57\x49\xcd\x7d\x26\xbb\xe7\xff\xbd\xb0\x61" +
+	"\x08\xa7\x67\xd1\xe1\x0d\x54\x5e\x62\x4b\xec\x05\x47\x4a\x4c\xbf\x4f\x63\xf1\xff\x8f\xb0\xf3\x8f\xac\x6b\xdd\xfa\xfd\x99\x6b\x77" +
+	"\xf7\x76\xef\xe6\xf4\x9c\xdd\xdd\xb7\xfb\xbc\x47\xd5\x56\xb5\x5d\xd7\x75\x55\x45\xbd\xae\xd7\x2b\x7b\xe5\x47\x93\x34\x49\x73\xaa" +
+	"\xb6\xd7\x75\x2c\xd3\x34\x4d\xb5\x55\x55\x6d\xb5\xc5\x56\x4b\x2c\x11\x11\x11\x11\x51\x55\x55\x55\x55\x55\x55\x55\x15\x51\x55\x55" +
+	"\x55\x51\x51\x55\x11\x11\x11\x11\x11\x11\x11\x11\x11\x51\x71\x7d\xc7\xf3\x8c\xf1\xfc\x9a\xeb\xac\xbf\xfb\xf1\x1d\xcf\x18\x73\x74" +
+	"\x65\xad\xf9\x7d\x9e\xf1\x78\x4d\xf1\x82\x98\x63\xb6\x8c\xd3\x15\xa7\x0f\x16\xc6\x7f\x2e\xed\x9b\xe6\x48\x61\xef\x9d\x3e\x58\x98" +
+	"\x50\x84\x0e\x94\xdb\x7c\x2f\x09\xd1\x71\x72\xba\xef\xf4\xc1\xc2\x2b\x5a\x4a\xd3\x88\x97\x11\x55\x06\x37\x2b\x9e\x3e\x58\x78\xad" +
+	"\x10\x3d\x26\xdc\xeb\x3e\x7d\xec\xe8\x0d\x31\xc7\x46\xc2\x8c\x58\xe5\xed\xcf\xa5\x5a\xb3\x54\xdf\x01\xa9\xb7\x10\xfd\x4e\xc8\x10" +
+	"\xef\x41\x9c\xe9\x2d\x54\x19\xeb\x4a\x66\xdf\xe4\xcf\xa5\xfd\x7f\xd7\x07\x0e\x61\x6a\xe5\x04\xfa\x00\xa4\xde\x42\x10\x08\xce\x98" +
+	"\x41\xa6\x80\x9c\xe9\xad\xa2\x42\x81\x3e\xd2\x62\xba\x9d\x84\xf0\x13\x5e\x5e\x9b\x7e\x02\x50\xdf\xed\xa7\x23\xff\xfe\x19\xff\x7e" +
+	"\xe6\x82\x9b\x0c\x29\xb0\x9d\x38\xfd\x73\x69\xff\x78\x74\xa6\x5c\x90\xf3\x55\x5e\x36\x38\x8b\x44\x4c\x7d\xb9\x20\xbe\x0b\x07\x12" +
+	"\x64\x96\x10\xbe\xbc\xb7\x5a\xe1\xe6\x7e\x2e\xed\xff\x6f\x8e\x05\x07\x21\x8c\x35\x0f\x84\x43\x11\xe2\x56\xae\xa1\x2e\x5a\x00\x72" +
+	"\xa6\xb7\x9a\x0a\x55\x6e\x51\xad\xa7\xdb\xcd\x4a\x6a\xd7\x50\x17\x2d\x11\x51\xdf\x1d\xe4\xc4\xc0\xf2\xcf\x25\xff\x9e\xe0\xa0\x7a" +
+	"\x2b\xa8\xef\x66\x64\x1a\x0a\xfe\xa6\x3b\x36\x7a\x15\xc8\x86\x87\x50\xed\x98\x58\x03\xb1\x19\x59\x3d\xe7\xa8\xc0\x25\x5d\x47\xaf" +
+	"\x6c\xea\xc3\xd5\xec\xa3\xba\x71\x36\x7e\x2e\xe5\xdc\x16\x4c\x85\x63\x64\xf3\xe7\x52\xce\x6d\xc1\x76\x9c\x2d\xb5\x14\x69\x28\xf2" +
+	"\x50\x4d\xd9\x20\xb2\x0d\x64\xc3\x43\x9c\xa6\xdb\x01\xb1\x19\x99\xae\xb3\x54\xb4\x15\xbb\xfb\x73\xa9\x96\x15\xfb\x45\x21\x17\xf3" +
+	"\x10\xbd\xd5\x65\x0f\xc8\x62\xe4\x79\xb1\xb4\xeb\x8c\x55\xca\xc5\xd2\x81\x57\x62\xc6\x92\xbf\x1c\xd8\x8a\xbd\x9a\xb9\x68\xec\x65" +
+	"\xc7\x57\xc4\x2e\xa1\x0a\x31\x1c\xcb\xe8\x38\xc1\xfa\x8a\xb4\xe4\x91\x1c\x43\x96\x3f\xa3\xfa\x15\x32\x5c\x05\x41\xa8\x01\x20\xb8" +
+	"\x88\xd6\xa4\x85\x5b\x66\x47\xac\xcf\xcb\xc1\x62\xe9\xc0\xdf\x17\xa2\x5f\xf8\x02\xd2\xb0\x7a\x43\x8a\xb8\x98\x43\xf0\x3e\x97\x61" +
+	"\x20\x8b\x9e\x88\x93\xcf\x48\xb1\x84\x5b\x73\x7f\x91\x41\xd9\x41\xe9\x46\x15\x71\x51\x8e\x5e\xbb\x85\x43\x98\x31\x20\x8b\xbe\x88" +
+	"\x13\xe7\x26\x56\xb2\xe0\xdd\xf0\xeb\x94\xed\x96\x22\x86\xf3\x09\xda\xb6\x73\xbb\x58\xca\xb9\x26\xd8\xae\xda\x1d\x14\xf6\x94\xe9" +
+	"\x39\xda\xb2\x27\x45\x83\xe7\x78\x97\x88\x8b\x39\x84\x6e\x83\x7b\x00\xf4\xe5\xbd\x04\x1c\x3b\xb4\xdf\xa4\x02\x89\xfb\xd4\x28\x3a" +
+	"\x8a\x1c\xa8\x96\x9a\x01\x79\xa0\x10\xab\xdd\x04\xd1\x61\x1e\x16\x4b\xfe\x25\xc1\x41\xa0\x47\x58\xca\xa9\x91\x42\xb8\x58\xda\x4f" +
+	"\x46\xcc\x63\x62\x86\xab\x31\xe8\xb6\x27\x40\xf8\xf6\x5e\x49\x89\xca\x26\x32\x4f\x8b\x25\x8c\xea\xbd\x4a\xdb\xaa\xe8\x4f\x2f\x6d" +
+	"\x0a\xc7\x7b\x04\x99\xdb\xf0\x8c\x18\xcc\x17\x96\xe9\x18\x04\x1d\x2d\xeb\x8f\x17\xa2\x9e\x17\x4b\xd6\x30\xe3\x40\x09\x1f\x41\x2f" +
+	"\x8a\x25\x8c\x20\xbe\x6a\x76\x68\x29\x63\xd2\x0e\x36\x4e\xcc\x4c\xe4\x43\x6e\xb0\x89\x62\xc9\x1a\x66\x8c\xef\x26\x8e\x12\x82\xbd" +
+	"\x54\xeb\xb9\x96\x93\x99\x8c\x89\x78\xa5\x33\xbb\xe6\x67\xa6\x6f\x44\x25\xe8\x75\xb1\x64\x4d\x33\x0e\x84\x10\xeb\x0d\x21\xc7\xad" +
+	"\x2a\xf2\xc8\x5e\x59\xf1\x5b\x42\x1a\xac\x1a\xca\x7e\x76\x61\xde\x15\x4b\xd6\x38\x63\x5f\x06\x81\xde\x53\xde\x3a\x90\x3b\xcd\x98" +
+	"\x45\x26\x09\xd1\x81\xcc\xad\xa7\x4e\xa0\x0f\xc5\x92\x35\xcd\x98\xaf\x3d\xb5\x03\x4d\xa9\xa5\x58\xd5\x63\xc2\x0c\x8a\xf8\x48\x4c" +
+	"\x83\x55\x3c\xde\xd4\x6e\x98\x4f\xc5\x92\x35\xcc\x38\xd0\x41\xa8\xcf\xc5\x92\x0c\x33\x76\xaa\x6b\x26\x38\x4c\x17\x4b\xde\x38\x63" +
+	"\xd3\x7f\x02\xcd\x14\x4b\x66\xa0\x71\x28\x44\x17\xb5\xce\x16\x4b\x34\xd1\xf8\xef\x07\xbe\xf2\xfa\x4f\x64\xe6\x80\xcc\x44\x1e\xe3" +
+	"\xc5\x9a\x07\xf4\x7b\xaf\xb9\x13\xd6\x15\xa2\x58\x0b\xb4\x9e\x6b\xe1\x72\xa4\xb3\x16\x8b\x25\x6f\xaa\x71\xd0\x7c\x4b\xc5\x92\x99" +
+	"\x6a\x1c\xa8\x50\x9c\xe5\x62\x09\x8e\x6a\x46\xf7\x95\xf2\x58\x63\xdb\x27\x5b\x29\x96\x7c\x5b\x96\xe7\xff\xca\xac\xb9\x55\x62\xce" +
+	"\xa4\x4a\xc4\xf1\x65\x19\x59\x2b\x96\x94\x2f\xcb\xf7\x95\x12\x81\xaf\x18\x32\xd5\x78\xbd\x58\xb2\x8c\x59\xbc\x8e\x71\x86\x1a\x53" +
+	"\xa4\x0d\x30\x67\x06\xaa\xc9\x10\xb3\x49\x4c\x16\x22\x9c\xd2\x56\xb1\x64\xbc\x59\x8e\xe3\x24\xb4\x0d\xe2\x4c\x9a\x23\xc1\xc4\x4e" +
+	"\xb1\x04\x57\x35\xe3\xa9\x61\x32\x69\x46\x26\xf8\xed\x82\x80\x93\xc9\xb3\x8a\x09\x31\x71\x70\x20\x09\xc8\xb6\x8f\x48\xdd\x70\x1e" +
+	"\xa9\x58\xca\x71\x66\xb1\x12\x19\x67\x5c\x6e\x2c\xe5\x38\xb3\x3c\x92\x17\x6f\x2c\x7b\x1b\x4b\x39\xce\x2c\x8b\x80\xa8\x34\x96\x5c" +
+	"\x63\x56\x6e\x39\x91\x74\xfa\x1a\x4b\xae\x31\x1b\x64\xd3\xdf\x58\x72\x7d\x59\x23\xa2\x89\x81\xc6\x92\xb6\x65\xb9\xdb\xdc\xb2\x15" +
+	"\xeb\xa2\xc1\xc6\x52\x60\xcb\x06\x91\x86\xb4\x8e\xd5\x70\x41\xe5\x86\x1b\x4b\xda\x95\xe5\x87\xe8\x96\xae\x58\x17\x8d\x34\x96\x6c" +
+	"\x5b\x56\x23\x4e\xe9\x46\x81\xa0\x74\xf9\x2a\x40\xc6\x14\x62\xfa\xcd\x2b\x5e\xb1\x2e\xba\xd9\x58\xb2\x7c\x59\x46\x9c\x94\x6e\x01" +
+	"\xe1\x8c\x3c\x15\x8d\xdc\x6e\x2c\xd5\x1c\x65\x7c\x47\x31\xbb\x55\x46\x19\xe3\x05\xeb\x5d\x42\xd6\xed\x71\xbc\xe6\x4e\x20\xd6\xb9" +
+	"\x47\xd0\x95\x72\x5e\x2c\x9e\x04\x78\x5f\x31\x3b\x39\xb1\xd8\x2a\x7b\xa0\x63\x95\xfd\x58\xda\x71\x83\xcc\xc3\xc6\xd2\xa1\xb7\x92" +
+	"\x16\x19\x77\x66\x68\xac\x5e\xce\x23\xc5\xa8\xb4\xe8\x9d\xbb\x83\x20\xd4\x63\x42\xd6\xa3\x40\xc7\xc9\xeb\x09\x2d\xe8\x98\x86\x28" +
+	"\x2f\xbc\x74\xb0\x27\x00\x3f\x55\x88\x55\x42\x9b\x40\xa8\x67\x44\xb4\xb0\x08\xc6\x62\xb2\x2f\xca\x22\xcf\x09\x39\x56\xce\x89\x23" +
+	"\x03\x01\x5f\x28\x66\x27\x0c\x24\x16\xed\x38\x21\x2d\x56\xf9\x1c\xa3\x17\xe5\x9b\xa0\xbc\x39\x23\xb1\x8b\xed\x8c\x5e\x2a\xc4\xaa" +
+	"\x9e\x4d\x20\xa3\x57\x44\x70\x46\xae\x6f\xcd\x2a\xaf\x1b\x4b\x87\xfe\xd7\x95\x5e\x72\xd9\xdd\xa7\x2d\x93\x07\xdf\x10\xb2\x5b\x0d" +
+	"\x21\x13\xfd\x2d\x18\x3c\x26\x1e\x29\x6a\x35\x1f\xeb\xbc\x03\x73\xa5\x9c\xa3\xc3\x4d\xf3\x9e\x88\x9d\x2a\x04\x05\x9a\x54\x81\xca" +
+	"\x5e\x20\xab\xf3\x3e\x34\x96\x0e\xfd\x4f\x64\xc4\x1b\x14\x4c\x53\xf1\x4a\xa6\x08\xd9\x8d\x64\x9b\x83\x8b\x50\xa0\x8f\x60\xd6\x23" +
+	"\x5f\xc7\x4d\xe9\x53\x63\x5c\xd3\xde\xfb\xdc\x18\xd7\xb6\xf7\xa6\x01\xf5\x78\x8c\xe8\x90\x89\x35\xd3\x18\xd7\x72\xf7\x66\x1b\xe3" +
+	"\x5a\xee\xde\x1c\x90\x9e\xdf\xf2\x45\xc8\xc2\x9a\x27\x62\x20\x27\x8e\xc9\x69\x01\x0c\x72\x72\x03\xb9\x39\x2d\x02\xea\xb9\x5e\x45" +
+	"\x87\x72\x5a\x6a\x8c\x6b\xd8\x7b\xcb\x8d\x71\x4d\x7b\x6f\x85\x98\xe3\x36\x63\xa9\x10\xb2\xda\x18\xd7\xb2\xf7\xd6\x1a\xe3\x9a\xf6" +
+	"\xde\x3a\x31\xc7\x35\x13\xc8\x10\xb3\xa1\x98\x81\x30\x14\xe7\xb4\x49\x44\xf1\xba\x17\xc9\xc9\x69\x8b\x18\x9d\x53\xa0\x42\xc8\x36" +
+	"\xa5\xdd\x63\x15\xcf\x74\x8d\x8e\xb4\x43\xc8\x7c\x64\x95\xc6\xea\x3e\x16\xda\x25\xaa\xc7\x87\x58\x88\x82\x7d\xa1\xf5\xf4\x58\x05" +
+	"\x94\xc7\x09\xe7\x18\x69\xed\x11\x32\x1f\x59\x15\x0c\xfb\xaf\xdc\x04\xa8\xc7\xaa\xa0\xa3\x43\x15\xec\x55\xcc\x40\x4e\x2c\x4e\xac" +
+	"\x42\x08\x27\xe6\xb7\x20\x2f\xba\x8f\xa8\x9e\xeb\x39\xc1\x84\xe9\x6f\x8a\x71\xef\x6b\x2c\xae\x23\x79\xa0\xff\x3e\x62\xed\xd8\x18" +
+	"\x50\x48\x92\x87\x68\xa3\x6f\x10\xc8\x52\xa4\xef\x04\x65\xc4\x38\x5e\xa7\x0e\x16\x86\x80\x2c\x47\xbb\xc6\xdf\x74\xbc\x61\x7c\x60" +
+	"\x0f\x2b\x64\xaf\x0a\x02\xeb\x72\x04\x08\xbc\x56\x13\xc9\xf7\x5a\x47\x9b\xe2\xc2\x54\xc4\x29\x19\xeb\x17\xd6\x19\x2f\x66\x4c\x33" +
+	"\x96\x05\xea\x3a\xc8\x98\xe6\x4b\x0c\x27\x55\xc5\x42\xbe\xd5\x14\xc3\xf0\x8e\xd9\x2c\x0c\xab\x77\x5b\x11\x49\x0e\xc1\x4e\xdf\x1d" +
+	"\x20\x4b\x9e\x88\x13\xe6\xae\x0a\xe3\x9a\xe6\x4e\xed\xee\x29\x62\x2f\x9f\x20\x47\xfc\x7e\x53\x5c\xc3\x79\x7f\xd0\x14\xc3\xec\xf6" +
+	"\x3c\x73\xbb\x70\x0f\x15\x61\x7c\x4b\xb7\x6c\x08\xf3\xa8\x29\xae\x65\xbc\x3f\xc6\x53\x3c\x1d\x87\x4e\xb7\xd8\xa3\x4f\x88\x48\x72" +
+	"\x08\xfd\x74\x9e\x02\x38\x15\xbb\x2e\xb7\xc4\x80\xc3\xfa\x0c\xc4\xe9\xdd\x28\xd4\x30\xe6\xe8\x73\x62\xf6\xaa\x31\xe8\xb8\x17\x40" +
+	"\x4e\x59\x0d\x97\xe3\xb1\x8e\x53\xab\xe8\x84\xa8\x53\xc4\x11\xe6\xd5\x4c\x34\xc5\x35\x3c\xf7\x97\x4d\x71\x4d\xcf\xfd\x55\x53\x5c" +
+	"\xc3\xe3\x7b\xdd\x14\xd7\xf2\xf8\xde\x10\xd2\x17\xe5\x23\x30\x0a\xdf\x36\xc5\xb5\xfd\xbd\x77\x4d\x71\x4d\x7f\xef\x3d\x31\xbe\x8e" +
+	"\x30\x90\x99\xc4\x6a\x28\x96\x93\x92\x13\xea\x03\x98\xcb\x23\x39\x49\x99\x50\x53\xc4\x54\xa2\x2a\x0c\x42\x7d\x6c\x8a\x6b\x39\x7c" +
+	"\x9f\x9a\xe2\x5a\x0e\xdf\x67\x85\xb0\x4a\xc0\x40\x66\xba\x29\xae\x69\xf1\xcd\x34\xc5\x35\x2c\xbe\x59\x45\x38\x2a\x16\x82\x94\xe6" +
+	"\x68\x31\x4d\x15\x2f\x25\x3b\xce\x3c\x21\xc7\x46\xc2\x94\x38\xce\x82\x22\x2a\x39\x19\xb1\xc8\x22\x21\x2b\x91\x55\xbb\xa0\xf5\x96" +
+	"\x88\xb9\x6c\xd5\x4e\x1e\x01\x1a\x0b\x91\x96\x15\x62\xd7\xce\x61\x50\xbb\x15\xca\x7a\x85\xaf\x51\xf6\xdb\x4f\x2f\x68\x95\xa8\xcb" +
+	"\x56\xf5\x4c\x6b\xe9\xb4\xd6\x14\x12\xe8\x30\x83\xbc\xd6\x75\x5e\x15\x3f\x2f\x3b\xd6\x06\x41\x97\x47\x72\x12\xe3\x58\x9b\x0a\xa9" +
+	"\xe4\x25\xc6\x32\x5b\x4d\x71\x2d\xb3\x6f\xbb\x29\xae\x69\xf6\xed\x80\xa9\xef\xad\x26\x43\xcc\x6e\x53\xfc\xaf\xed\xbe\x2f\x4d\x71" +
+	"\x4d\xbb\x6f\x8f\x98\xfa\x0b\x14\x48\x31\xac\xc2\x48\xb9\x19\x6b\xe9\x0e\x97\xc2\x71\x7a\x01\x9c\xb9\xe0\xe6\xe3\x44\xa9\x80\xa8" +
+	"\xbf\x90\x23\xc1\x44\x5f\x73\x5c\xcb\xeb\xeb\x6f\x8e\x6b\x78\x7d\x03\x20\x36\xa2\xde\x7c\x11\xbc\x74\x1d\x6c\x8e\x6b\x58\x7d\x43" +
+	"\xcd\x71\x2d\xab\x6f\x18\xc8\x86\x8f\x48\xcd\xce\xfc\xb9\x30\xa2\x16\xd2\x2d\xeb\x20\x40\x6a\x86\x6c\x46\x55\x36\x17\x1c\xc4\x09" +
+	"\x33\x06\x62\xc3\x23\x4c\xd5\xce\xfc\xb9\x70\x13\x65\x45\xd1\xa4\xb0\x6e\xd5\x1a\xea\xa2\x5b\x40\x36\x23\xab\xdb\x82\xaa\xdd\x06" +
+	"\x82\xaa\xe5\xab\x20\xe3\x3b\xcd\xe8\x92\x8d\xc8\xf4\x9a\x5b\xb8\x86\xba\xe8\x2e\x21\x9b\x91\xd5\x6d\x61\x4a\xf7\xb4\x8e\xd5\x6e" +
+	"\x41\xed\xee\xab\xe5\x98\x7e\xb3\xf2\xd6\xb1\x1e\x00\xe1\x50\x82\x38\x91\x1e\x02\xe1\x40\x9e\x8a\x46\x1e\x35\xc7\xb5\x9c\xbe\xc7" +
+	"\x40\xfe\xb5\xd3\xf7\x44\x21\x9e\x8a\x72\xd0\x58\xe6\x69\x73\x5c\xcb\xeb\x7b\xd6\x1c\xd7\xf0\xfa\x9e\x2b\x62\x38\x9f\x20\xc3\xf0" +
+	"\x05\xd6\x42\x71\x64\x31\xfa\x22\x5a\x41\xc6\x81\x50\x1c\x49\xc9\x0f\x34\xa1\x10\x4f\xc5\x8d\xf4\xb2\x39\xae\x61\xf8\xbd\x6a\x8e" +
+	"\x6b\x1a\x7e\xaf\x15\x73\xd1\x9a\x34\x2e\x8c\x96\x79\xd3\x1c\xd7\xf2\xfb\xde\x36\xc7\x35\xfd\xbe\x77\xc4\x0c\x57\x63\x20\xf3\x9e" +
+	"\x16\xc3\xa1\x9c\x9c\x84\x99\x24\x86\x63\x99\xac\xdc\x60\x1f\x14\x35\x5c\xf0\xf2\x72\xc2\x4d\xa1\xca\xa7\x4c\xdb\x38\xf5\x43\x89" +
+	"\x3f\x02\x38\x6d\x5a\x2f\x2c\xdf\x27\x22\x2c\x09\xa7\x78\xd0\xf8\x8c\x9e\x39\x65\x5a\xc6\x2b\x1e\x90\x69\x20\xa7\x4d\xdf\xe5\xe4" +
+	"\x33\x43\xc8\x70\x15\x84\xda\x6a\x16\x4b\x39\x35\x5c\x08\xd2\x31\xc8\x1c\x90\xd3\x56\xe3\xe5\x44\x9a\x27\xc6\x96\x09\x43\x2d\x34" +
+	"\xc7\x35\x8d\xbe\xc5\xe6\xb8\x86\xd1\xb7\x44\x44\x83\x4d\xd8\x2a\xf8\xb0\x5a\x6e\x8e\x6b\xfa\x7c\x2b\xcd\x71\x2d\x9f\x6f\x95\x90" +
+	"\x06\x8d\x04\x32\x40\xd6\xd4\x62\xae\x79\x29\x39\x91\xd6\x89\x39\xde\x17\xa6\x24\x32\x1b\x84\x34\xf4\x79\x39\xd9\x91\x36\x9b\xe3" +
+	"\xda\x2e\xdf\x56\x73\x5c\xd3\xe5\xdb\x06\x33\x13\xf9\x90\x51\x22\x6a\xa7\x39\xae\xe5\xf4\xed\x36\xc7\xb5\x9c\xbe\x2f\x40\x66\xf4" +
+	"\xf4\xdc\x50\x86\x22\xed\xa9\xf5\x5c\xf3\x12\xb3\x23\x95\x5b\x90\x56\x5f\x4e\x5a\xa2\xd2\xdb\x42\x2a\x3e\xe3\x44\xaa\x80\x69\x30" +
+	"\x15\x34\x7d\x23\x05\xec\x03\x72\xdc\xaa\x0d\x3f\x2a\x53\x99\x7e\x20\x0d\x36\xe2\xc8\x50\xa4\x81\x96\xf8\xdb\xff\xdd\x60\x8a\x17" +
+	"\x36\xc5\x20\x88\xe3\x56\xf1\xdc\x9e\x80\xc8\x10\x90\x86\xbe\x42\xbe\x0a\xc5\x19\xa6\xb5\x98\xd2\x85\x71\x46\x40\x1c\xef\x0b\x13" +
+	"\x32\x22\xa3\x40\x1a\x6c\x24\x88\x33\xd6\x12\xd7\xfd\x67\xfd\x90\xdc\xa4\xea\xba\xa4\xe4\xd5\xdd\x04\x72\xc6\x42\xb4\x1b\x6b\x88" +
+	"\x5b\x20\xea\x07\x0a\xe2\x3c\xe6\x78\xad\xb7\x89\x11\x87\xd3\xb8\xa4\x6c\x09\xde\x01\x70\x26\xf3\xa3\xc8\xbf\xdf\xc5\xbf\xd7\x1b" +
+	"\x77\x33\xf4\x59\xef\xb5\xc4\x75\x7f\xab\x37\xee\x66\x4e\x2e\xf7\x81\x9c\xb1\x10\xb6\x62\x0d\xf2\x00\x48\xfd\x40\x15\x15\xca\xe5" +
+	"\x61\x4b\x0c\x17\x7b\xa8\x60\x2e\xd0\x72\x43\x15\xeb\xa2\x47\xc4\xb0\x47\x1d\xd6\x0d\xe3\x7b\x09\x61\x8f\xba\x5a\xe1\x9e\x28\x2a" +
+	"\x73\x63\x49\xe9\x8a\x75\xd1\xd3\x96\xf8\x5f\xbb\xee\xcf\x5a\xe2\x9a\xae\xfb\xf3\x96\xb8\xae\x83\x53\x12\x1f\xdb\x49\xe9\x45\x4b" +
+	"\x5c\xcb\x75\x1f\x6f\x89\x6b\xba\xee\x13\x78\x90\x5b\x91\x69\x28\xc7\x27\xa5\xc7\xf4\x12\xc8\xb6\x87\x20\x23\x21\x5e\x81\xd8\x8a" +
+	"\xac\x9e\x0b\xdc\xd6\xd7\x0a\x91\x8e\xb2\x6c\x52\xee\xa9\x37\x2d\x71\x0d\xdf\xfd\x6d\x4b\x5c\xcb\x77\x7f\x87\x86\xd9\xf2\x5c\x75" +
+	"\x37\x9f\xf7\x2d\x71\x2d\xe3\x7d\x12\xc8\xbf\x34\xde\x3f\xb4\xc4\xb5\x1c\xbe\xa9\x96\xb8\x96\xc3\xf7\x51\x21\x5a\xc5\x27\x20\xf2" +
+	"\xa9\x25\xae\x61\xf0\x7d\x6e\x89\x6b\x1a\x7c\xd3\x8a\xb1\x55\x6c\x04\x81\x66\x08\x39\xb6\x13\x06\x92\xb5\xcc\x12\xd2\x52\xf6\x23" +
+	"\xd9\x19\xcd\x29\xc6\x96\xf1\x53\x9a\x6f\x89\x6b\x19\x7c\x0b\x2d\x71\x0d\x83\x6f\x51\x11\x3b\xf9\x04\x99\x77\x4b\x2d\x71\x4d\x83" +
+	"\x6f\xb9\x25\xae\x6d\xf0\xad\x28\xc8\x57\x32\x10\x49\xad\x82\xba\xb2\xe3\x41\x4e\x5a\x6b\x40\x28\x2d\x37\x9a\x95\xd7\xba\x42\x7c" +
+	"\x19\x27\xb1\x0d\xe4\x7e\xcc\x2a\xa0\xfb\xa4\xb0\x98\x4d\x20\x2d\xa6\x7e\xc1\x73\xda\x22\x60\x27\x1f\xa0\x28\xdb\x58\xc9\x31\x2b" +
+	"\x69\x8e\x62\x52\xde\x01\xd2\x62\x15\xcf\x74\x15\xd7\x6e\x97\x10\x5b\xc5\x41\x28\xd2\x17\x30\xc7\x76\xc2\x48\x66\x31\x7b\x40\x5a" +
+	"\xac\xc2\x05\x09\x95\xcf\x82\xb0\x45\x82\x8c\x7a\xcf\x26\x7f\x6a\xef\x19\x32\xb3\x88\x8d\xc3\x05\x33\x92\x3e\x62\x2a\x60\x66\xa3" +
+	"\xeb\x3c\xd1\xd8\x5c\x88\x60\x0c\xcb\x3e\x30\x3d\xd7\xab\xc8\x28\x43\xe9\x6c\xf2\xa7\xb7\x51\x8f\x1a\x6a\xec\x9a\x4e\x32\x60\x76" +
+	"\x80\x98\xd9\xe8\x24\x62\x19\xf7\x8a\x67\xcc\x92\x0b\x36\x48\x4c\xcf\xc9\x3c\x1d\x66\x86\x14\x33\x54\xc8\x81\x4c\x62\xc3\x3a\xda" +
+	"\xf5\x30\x9a\x59\xf6\x08\x41\x3d\xd7\xab\x29\x51\x6e\xa3\x67\x93\x3f\xb5\xfe\x3e\x54\xe0\xb3\xcb\x3c\xd8\x45\x06\x4a\x8c\x01\x98" +
+	"\xd1\xb6\x1c\x13\xf4\x0d\x50\x0c\xb7\x9b\x40\x7e\x77\x09\xc7\xd7\xbc\x75\x36\xf9\xd3\xeb\xe8\xf7\x4c\xdc\x3f\xf5\xad\xf7\xca\xa1" +
+	"\x1f\x64\xb2\xf1\x6d\x42\x66\xa2\x93\xc6\xfe\x93\xaf\xbd\x52\x9c\x3b\x04\xfd\xae\x19\x57\x88\x99\xbb\x8a\x19\x32\x56\x63\x90\xd2" +
+	"\x3d\x1d\xcb\xb2\x1a\xf9\x16\x5a\x59\xf2\x7d\x62\x74\x52\x81\x0c\x21\x0f\x90\x76\x8f\x29\x9d\xe9\x1c\xfe\xcb\xf3\x10\xc4\xac\x55" +
+	"\x3b\xab\x01\x59\xe5\x11\x98\x1e\x0f\x71\xfc\xcc\xc7\xb4\x94\x1e\x53\xbd\xb0\xfb\x9e\x10\x32\x6b\x57\x2f\x6c\xbf\xa7\x04\xf5\x58" +
+	"\xd5\x33\x42\xcc\x3c\x53\xcc\x50\x21\x0c\x26\x59\x3d\xd7\xc1\xae\xe7\x04\x93\x45\xbf\x38\x9b\xd4\x34\x6a\xc7\xcf\x26\x85\xf7\xd1" +
+	"\xa5\x72\x64\xb6\x87\xf1\x14\x2e\x1a\x2e\xff\xf5\xc9\xc3\x5f\x4d\x28\x46\x5d\x63\x1a\x22\xb0\xb3\x5e\x12\x82\xdb\x64\xe5\x6b\x1a" +
+	"\x64\xc8\x6d\x14\x9d\x57\x04\x5d\xea\xcd\x89\x25\x1b\x67\x14\xb2\x17\x86\x62\xab\xf6\x8d\x8e\xd4\xeb\x45\x62\xc3\x11\x2a\x6f\xcf" +
+	"\x26\x85\x0f\x92\x94\xb9\x6d\xd6\xc9\xea\x9d\x82\xf4\xe5\xac\x30\x1d\x5d\x06\xc1\xde\x13\xb3\x10\x05\x4a\x6e\x62\x93\x67\x93\xc2" +
+	"\x7f\x5e\x2a\x47\xf2\x35\x8a\x17\xad\x3e\x4c\x4f\x1e\xfe\xea\x03\x11\x5f\xaa\x10\xe4\xa3\x4e\x01\x41\x28\xfe\xba\x66\x0a\xc8\x2a" +
+	"\x1f\x81\x5c\xea\x0d\x55\x38\xf1\x4f\x04\xec\xe5\x03\x14\xe5\xb3\x8a\x62\xee\x78\x0d\x8a\x37\x7d\x36\x29\xfc\x17\x92\x61\xa3\xd5" +
+	"\x94\x85\xd7\x31\x43\xc8\x17\x73\xf8\xd3\x45\x28\xd0\x2c\x98\x85\xc8\xd7\x71\x13\x9a\xa3\x87\x79\x44\x97\x97\x56\x2c\xf7\xc5\x72" +
+	"\x71\xe7\x15\x63\x75\x9f\x83\xe0\x31\x2d\x10\x72\x8a\x65\xf0\x2b\x82\x3d\x4e\x79\x46\x8b\xc4\x1c\xe9\xcd\x09\x45\xa6\x2c\xca\xb7" +
+	"\xa4\x98\xbd\x30\x94\xf8\xb6\xcb\x84\x9c\xb2\xba\xcf\x35\x6e\x21\xb3\x42\x5d\xc3\x59\x89\x71\xeb\x64\xb5\xaa\x18\xab\xfb\x1c\x04" +
+	"\x59\xad\x11\xc2\x59\xb9\xd6\xad\xe8\xac\x9f\x4d\x3c\xeb\x96\xaf\x37\x60\xe3\x6b\xe3\x6c\x62\xac\x5b\x7b\x58\xab\x5c\x6b\xba\x79" +
+	"\x36\x31\xce\xad\x4f\x40\x62\xeb\x6c\xa2\x8d\x5b\xbe\xd4\xd4\x37\x6e\x31\xdf\x17\xd0\xe5\x11\xf3\xb3\xdf\x1d\x40\x4b\x91\x76\x88" +
+	"\xf1\x75\x84\xf9\xfa\xc7\xbf\xec\xdb\x3d\x9b\x78\xc6\xed\x3f\xbf\xf9\xca\x8d\xf4\xe5\x6c\x62\x7c\x5b\x7b\xbd\x26\xd0\xde\xd9\xc4" +
+	"\xd8\xb6\x01\x82\x38\xe5\xd6\x44\xbb\xb6\x3c\xe2\xd7\xbd\xd0\x14\x79\xf7\xb6\x26\x96\x6d\x0b\x19\x36\x30\x65\x42\x61\x45\x21\x2c" +
+	"\x13\x30\x90\xe9\x6b\x4d\xb4\x6d\x8b\x50\xee\x8d\xa6\x32\xb7\xb8\xbf\x35\xb1\x7c\x5b\xbe\xd1\x54\xc6\x1f\xe3\x35\xf9\x80\x42\x1c" +
+	"\x1d\x9b\x41\x5a\x83\xb4\x9e\xa6\x8a\x97\x96\x13\x6a\xa8\x35\xb1\xac\x5b\x7b\xc9\x12\x6a\x58\x21\x95\x9c\xb4\x44\x66\x84\x18\xf4" +
+	"\x9e\xc4\x0a\x9a\x6f\xb4\x35\xb1\xcc\x5b\xfb\x41\xb0\x77\x3b\xa6\x08\xbb\x80\x36\x82\xfa\xdd\xa4\xbc\x57\x78\xee\xb3\xdf\x80\xbc" +
+	"\x9e\x5b\xad\x89\xe5\xdd\xba\x1d\xc8\x79\xdd\x56\x4c\xa0\x24\x10\x84\xee\xe8\xc4\x2a\x5e\x62\x4e\xb4\xbb\xad\x89\xe5\xde\xda\xcb" +
+	"\x96\x60\xf7\x14\x52\xc9\x49\x4d\x64\xee\xb7\x26\x98\xf5\x7b\x4d\xbd\xb8\x77\x6e\x85\x95\xd7\x88\x0f\x88\xc1\x78\x62\x79\xbb\x4f" +
+	"\x10\xcd\xa2\x65\xe8\x21\x41\x37\xf4\x2c\xda\x40\x88\x4c\xd1\x47\xad\xc9\xfe\xff\xa7\x63\x99\x11\xc6\x76\xa8\xc7\x20\xb6\x22\x0f" +
+	"\xa1\x17\x51\xc2\x3c\x01\xa3\x03\x05\x2a\x14\xe7\xa9\x5a\xcb\x8f\x39\x49\xc1\x8d\xa4\x37\x96\xcf\x74\x52\x3f\xe6\x24\x25\xd0\xf3" +
+	"\xd6\xc4\x4c\x42\x76\x75\x4e\x1e\xde\x4f\xb1\x5e\xb4\x26\xd6\x28\x64\x87\x31\x6f\x13\xc7\x09\xc2\x88\x62\x5c\xe2\xe9\x16\x50\xa0" +
+	"\x89\xd6\xc4\x9e\x86\x1c\x4a\x51\xbc\x97\xad\x89\x19\x87\xdc\xf5\xad\x77\x63\x30\x09\xbd\x02\xa2\x83\x09\xa2\x6a\x28\xcc\xeb\xd6" +
+	"\xc4\x9a\x87\x1c\xe8\x50\xa8\x37\xad\x89\x35\x0f\x39\xbf\x8c\x6f\x75\x66\x3f\x86\x99\x09\xf3\xae\x35\xb1\x06\x22\xe7\x97\xf1\x3d" +
+	"\x55\xfa\x0f\xab\x0f\x65\xca\x30\x3f\xf7\x49\x42\x36\xec\x36\x94\x89\xc8\xcc\x7c\x68\x4d\xac\x89\xc8\xbe\x0c\xbd\x93\x9d\x42\xfb" +
+	"\xe8\x40\xec\x98\x3b\x71\x3e\x02\xd8\x88\x5c\xc2\x6d\xc1\x4f\xad\x49\xfe\x44\x64\x0e\xf2\x59\xad\xc3\xea\x40\x75\x79\x96\x3d\x11" +
+	"\x79\x5a\xe7\x63\x75\xa0\x5c\xf9\x25\xd0\x4c\x6b\x62\x26\x22\xbb\x3a\x28\x1d\x62\xcd\xb6\x26\x35\x2d\xa4\x39\x62\x2a\x51\x15\x2b" +
+	"\x0a\xfb\x38\xe6\x81\x1c\xb1\x65\xf4\x25\xb2\x96\x11\xd5\x9a\x1c\xf8\x8f\xd3\xfa\xf2\x56\x9c\xb6\x0e\x03\x2d\x12\x52\x89\xf2\x11" +
+	"\xc4\x59\x02\x71\x44\x8b\x60\xbb\x41\x18\x67\x19\x4b\x39\x3d\xea\x65\x24\x57\xae\x52\x46\x2b\xc4\xf4\x79\x19\x99\x6b\x59\x7f\xfc" +
+	"\xcb\xbe\x55\x20\x47\x46\xc3\x8c\x8c\xca\x5a\x6b\x82\x1b\x78\xd5\x75\xaa\x79\xc5\x6b\x3a\x14\xad\x2b\xa6\xa2\x26\x09\x57\xa9\xde" +
+	"\x06\x41\xfa\x46\xe0\x9c\xf2\x35\x1d\x8a\x36\x5b\x93\x03\xe3\x1c\x2b\xa7\x7c\xb8\xc7\x40\x21\x3a\x54\x7e\xfd\xb6\x89\xd1\x91\x72" +
+	"\x0a\xd8\x74\x28\xda\xa1\xc5\x9c\x1e\xf5\xb2\x1a\x93\xcb\xd2\x71\x97\x81\x62\xfa\xfc\xac\x04\xc2\x5f\xa2\x2f\x04\x1d\x19\x0d\xb3" +
+	"\x12\x9d\x3d\x14\x79\xd9\xf6\xce\xcd\x56\x50\x31\xcf\xcb\x6d\x04\x59\xfd\xe7\x42\xc8\xab\x17\xcc\xaf\xbe\x0e\xbe\x91\x8a\x4c\xa5" +
+	"\x2d\xc1\x5d\xbc\xa6\x01\x65\x37\xa8\x20\x7d\x0a\xa9\x44\xb9\x08\xe2\xf4\xb7\x25\xe6\x06\x62\x7b\x48\xb2\x68\x0c\x60\x25\xcb\x91" +
+	"\xd5\x38\xd6\xd5\xb8\xd2\x3a\x83\x8a\xb2\x3a\xd0\xa3\x50\xc0\x21\x40\xbf\xfa\x4a\x32\x24\x99\x84\x86\xdb\x12\x35\x24\x59\x5f\x3b" +
+	"\x61\xb6\x3c\xef\x3b\x79\x78\x3f\x5d\x49\x3d\x02\x44\x8f\x2e\x16\x04\x6e\x94\x10\xa3\x20\x30\x25\x39\x14\xe1\xe1\xb1\x63\x6d\x89" +
+	"\x1e\x92\xcc\xdf\x4f\x64\x23\xb7\xc8\xdc\x24\x46\x47\x22\x7f\x2d\x0c\x75\x4b\xeb\xfc\x98\xa3\xc3\xb1\x6e\xab\xe5\x94\x0b\xb2\x1e" +
+	"\x0c\xaa\x45\x4e\xe6\x9e\xed\x3b\x6d\x89\x35\x24\x59\x33\x66\x48\x32\x90\xbb\x40\x30\x25\x39\x57\x85\x3e\x20\xef\x01\x99\x33\xd5" +
+	"\x23\x8b\xd3\x54\x0f\x1b\x8f\xee\xb7\x25\x66\x48\x32\x23\x78\x0b\x21\xc4\x03\x10\x73\xd1\x8f\x39\x22\x9c\xd1\x43\xca\x5a\x07\x32" +
+	"\x96\xac\x54\x0f\x81\x1e\xb5\x25\xd6\x8c\x64\xc7\xda\x15\xe6\xb1\xd6\xf9\x31\x47\x87\x63\x3d\x51\xcb\x29\x17\xc2\xf5\x98\xf9\xc7" +
+	"\x4f\xdb\x12\x6b\x46\x72\xeb\xff\xf0\x3c\x6b\x0a\xf6\x0c\xcc\x5c\x74\xb5\x8a\x0e\x15\xf0\x79\x5b\xe2\xce\x48\x0e\xdb\xef\x45\x5b" +
+	"\xe2\x0c\x49\x0e\x5a\x62\x1c\x00\xa6\x24\xaf\x44\x87\x8e\x78\x22\x9c\xd4\x44\x5b\xe2\x4f\x49\x0e\xda\xef\x65\x5b\x62\xc6\x24\xe7" +
+	"\x77\xdf\x2b\x2d\xf3\xa3\xd9\xa4\x10\xb6\xdf\xeb\xb6\x24\x67\x4e\xf2\x10\xef\x12\x83\xce\x9b\xb6\x24\x7f\x4e\x32\x03\x6f\x01\xcc" +
+	"\xeb\xe3\xe9\x81\x06\x95\xee\x5d\x5b\x52\xf7\x21\xda\x8c\x4e\xca\x8f\x68\xfa\xeb\xcc\x16\x17\xbd\xe0\x7a\x4f\xcc\x1f\xca\x1d\x74" +
+	"\xaf\xd1\x94\xe9\xc1\x93\x5a\x27\xcd\xd1\x61\xe6\x43\x5b\x52\xd7\xb1\x19\xfd\xc6\xb3\x60\xcd\x06\x30\x73\x48\x65\x0a\xcc\x1f\xfd" +
+	"\xda\x1f\x64\x48\x2e\x3e\xa5\x58\x1f\xc1\x6c\x46\xfd\x85\x2a\x42\x04\x7d\x52\xd0\xc9\x90\x91\xbc\x3e\x03\xd1\x69\x09\xe2\x64\x35" +
+	"\x0d\x42\x27\xe5\x89\x30\x32\xd3\x96\xd4\x4d\x45\xdb\xd1\x49\x33\xff\xcc\x19\xfe\x4b\x91\x66\x09\xba\x91\xca\x4d\x5b\x84\x7c\x7d" +
+	"\xe9\xe0\x51\x19\x94\x3b\xa7\x75\x14\xe3\xca\x30\x33\xdf\x96\xd4\x75\x6d\xeb\xdb\x5c\xc5\x25\xc4\x72\xe4\x88\xca\x02\x90\x1b\xfd" +
+	"\x05\xbe\x3a\x8c\xef\xed\x90\xa3\x58\x8b\x00\xb6\x23\x97\x10\x0d\x42\x96\x14\x72\xd2\x84\x71\x46\xff\x52\x46\xcb\x60\x54\x42\x42" +
+	"\x38\xf9\xac\x00\xd8\x8e\x5c\xc2\x1d\xfc\xbb\x4a\x3d\xb3\x6d\xf5\x5e\x58\xb9\x35\x62\x6e\x58\xbd\x17\x86\x5a\xd7\x3a\x69\x8e\x0e" +
+	"\x33\x1b\x78\x92\xdb\xa6\xf7\x4c\xd6\xd2\x7a\x9b\x40\x6e\x58\xad\x27\xb5\x93\x39\x9f\x5b\x40\xb6\xad\xce\x73\x65\x88\xd9\x56\xcc" +
+	"\xc9\x9c\x89\xc7\x9c\xd4\x0e\x10\x9d\x93\x20\x4e\x4a\xbb\x20\x74\x46\x9e\x08\x23\x5f\xda\x12\xcb\xed\xa5\xc3\x65\x6c\x51\xf1\xad" +
+	"\xa7\x7b\x84\xc0\x1c\x9d\x8d\xea\x94\xa5\xe6\xdc\x9e\x0a\x73\xb4\xdc\x9e\x78\x76\xaf\x83\x40\xa6\xb7\x3d\xb1\xfc\x5e\xbe\xc8\x15" +
+	"\xbf\xc1\xe9\x9d\x1e\x90\x0a\x21\x38\xd1\x89\x48\xee\x85\xb0\x04\xe1\x35\x64\x5f\x7b\x62\x39\xbe\xa4\xe3\x30\x10\xea\x6f\x4f\x2c" +
+	"\xcb\xd7\x8e\x25\xcb\x19\x20\xa4\xa5\xec\xc7\x72\xd2\x1a\x6c\x4f\x2c\xcf\x57\x62\x39\x79\x0d\xb5\x27\xc6\xf4\x45\x01\xf9\xb6\x2c" +
+	"\xb9\x6f\x77\xb8\x3d\xf1\x3c\x5f\xbe\x2c\x8b\xbd\xd1\x11\x45\xec\xe4\x13\x24\x32\xda\x9e\x28\xcf\x97\xcf\x24\xca\x5d\x59\xe6\x92" +
+	"\xb6\x31\x30\xe4\xf9\x02\xca\xf3\x7c\x4f\x1e\xfe\xea\xa6\x82\x7c\x25\xcb\xb9\x84\xd4\x2d\x50\x57\x76\x3c\xc8\x49\xeb\x36\x10\x4a" +
+	"\xcb\x8d\x66\x27\x76\x47\x31\xbe\x8e\x9b\xda\xdd\xf6\xc4\xb8\xbe\x61\x0b\x02\xb9\xd7\x9e\xb8\xae\x6f\xf0\xa8\xee\x13\xb1\x53\x85" +
+	"\xa0\x05\x3f\xc0\x62\x8e\x59\x89\x73\x1c\x93\xf6\x43\x20\x2d\x56\x01\x4d\xff\x71\xfd\x1e\x11\x62\xab\x38\x08\x45\x7a\x0c\xe6\xd8" +
+	"\x4e\x18\xc9\x2c\xe6\x09\x90\x16\xab\x78\x61\x4a\x4f\x09\xb1\x55\xc2\x9c\x9e\xb5\xa7\x96\xf3\x7b\xf8\x1b\x73\x6d\x88\x31\x48\x9f" +
+	"\x83\x81\xf3\x8b\x4e\x17\x86\x6e\xa9\x27\x68\xff\x4f\x7f\xfd\xea\x45\x7b\x6a\x9c\xdf\x40\x06\xc4\x78\x7b\x6a\x19\xbf\x58\x31\x7f" +
+	"\x53\x90\x5d\x2c\x13\x84\xcc\x46\x27\x29\x12\x2d\x19\x8c\x63\xbc\xbd\x6c\x4f\x2d\xdf\xd7\x91\x61\xe4\x55\x7b\x6a\xdb\xbe\x36\x63" +
+	"\x92\x7a\xad\x63\x5d\x0f\x63\xc9\x92\xdf\xb4\xa7\x96\xe9\x1b\xe8\x20\xab\xb7\xed\x69\xbe\xe5\xcb\x5b\x8d\xde\x01\x80\xe5\x4b\xd7" +
+	"\x16\x6b\x42\xd5\x0e\x08\x34\xde\x03\xf9\xdd\x25\x44\x03\xc0\x64\x7b\x6a\x2c\x5f\x2c\x43\x6e\xf1\xe0\xad\x53\x1f\x88\x80\xe3\x2b" +
+	"\x77\x35\xcb\xf6\x43\x29\xdd\x14\x41\xbf\x6b\xc6\xd5\x61\xe6\x63\x7b\x6a\x39\xbe\xc4\xf8\x19\x7d\xd2\xb1\xae\x5b\xb1\xd8\xf1\xe5" +
+	"\x15\x7f\x26\x46\xe7\x14\xca\x00\x99\x6e\x4f\x73\x1c\xdf\xcb\x87\xbe\x37\x7b\xdc\x66\x80\xcc\x5a\xb5\xb3\x1a\x8f\x65\x66\xc1\xf4" +
+	"\x78\x88\xc8\x80\x98\x6b\x4f\x8d\xe5\x9b\xdb\x77\xf3\x44\xcc\xda\xd5\x0b\xfa\x6e\x81\x98\x1e\xab\x78\x22\xc3\xc8\x62\x7b\x6a\xf9" +
+	"\xbd\x36\x62\x52\x5a\xd2\xa1\xae\x87\xa1\x64\xc1\xcb\xc4\xf4\x5c\x0f\x43\x09\xb2\xd2\x9e\x16\x3a\x96\xd9\xce\x74\xce\x73\x1a\x67" +
+	"\x0a\xcc\xaf\x3b\x11\xff\xf5\xe6\x9f\xe7\xf2\x97\x67\x8d\x00\x4f\x43\x08\x7c\x56\xad\xb7\xa7\x85\xf7\xd1\x72\xf4\x93\x98\x64\xce" +
+	"\x79\xce\xaf\x7f\x3b\xfc\xfd\x06\x21\xbf\xc6\xe2\xb4\xc9\x0f\x73\x3e\xcd\xb9\xa9\x08\xad\xe1\x22\x5a\x64\x4b\xc7\xd1\x6b\x81\x1b" +
+	"\xc7\x63\x94\x65\x2d\xdb\xc4\xe8\x7c\x54\x24\x3f\xa1\x1d\x85\xb0\x4a\xc0\x20\xa5\xdd\xf6\xb4\xd0\xb5\x18\x95\xcd\x99\x4e\xf2\x3c" +
+	"\x55\xe9\xd8\xce\xfc\x02\xe8\xd2\x8e\x39\xd4\xe9\xfa\xa2\xf4\x19\xbd\x47\x4c\xb9\x1a\x03\x99\xf2\xb9\xb4\x30\x19\x2d\x46\x3f\x51" +
+	"\x2c\x36\x69\xed\xea\xf5\x12\x71\x49\x1d\xea\x74\x8c\x5e\x39\xd3\x59\x51\xc8\x4f\x39\x88\x16\xe9\xd3\x61\xca\x51\x10\x47\x96\xd2" +
+	"\x4f\x0c\xa7\xe4\xc8\x98\x94\x06\x14\x54\xae\x06\x21\xa7\xc1\x73\x69\xa1\x63\xd1\xee\x3c\xab\x7e\x5c\xe4\x21\x40\x97\x4c\xeb\x99" +
+	"\xd2\xc8\x1f\x9e\x61\x42\x7c\x19\x61\xa0\x32\x72\x0e\x8f\x73\xd1\xea\x3e\xbf\x7c\xa3\x44\x5c\x32\xcd\x17\x54\x6f\x4c\x11\x56\xf3" +
+	"\xf9\xd5\xbb\xa9\xa3\x94\xa3\x20\x8c\xac\xe4\x16\x31\x3a\x21\x47\xc5\x24\x74\x5b\x31\xe5\x28\x0c\x25\x3a\x77\xce\xa5\xb5\x06\x29" +
+	"\xdf\x25\x64\xb4\x20\x47\x62\x1d\x84\xde\x73\xdd\x03\xd2\x62\xab\xe8\x0d\x5d\x46\xe5\xfe\xb9\x74\xdf\x61\x3d\x49\x19\xef\x3d\xc2" +
+	"\x38\x0f\x88\x18\xcd\x27\x28\xcc\x43\x10\x2d\x96\x06\xef\x4f\x33\x22\x8f\xb0\x92\xa6\x5f\xdc\x74\xe0\xae\xd1\x41\x13\x10\x8f\x89" +
+	"\xb8\xe8\x66\x43\xc4\x4f\x7f\xfd\x9a\xc2\x3c\x01\xd1\xf2\x4b\x90\x8c\x48\x3c\x05\xb0\x6a\x4f\x51\xc6\xb7\x5b\x3e\x59\x4a\xc8\x33" +
+	"\x85\x58\x55\xb3\x91\xef\x57\xa3\x43\x3f\x3c\x07\xb2\xee\xab\x20\x90\xa8\xbc\x40\xc2\xab\x7a\x88\x32\x5f\x4a\xeb\xc4\x19\x57\xc4" +
+	"\x68\x2e\x41\x61\x26\x40\xac\x7b\x1a\xf4\x05\x50\x44\x5e\x62\x25\xab\x91\xc9\x98\x36\x68\xba\x65\x7b\xa5\x18\x53\x37\x8b\x41\xe1" +
+	"\x10\xea\x35\x98\x75\x5f\xc7\x29\xdd\x9b\x73\xe9\xbe\x8f\x98\x6a\x6c\x0d\xa5\x73\x3a\xe1\xf4\xc1\xc2\x5b\xc5\x8c\x6a\x26\xbf\xe5" +
+	"\xde\x11\xd4\x32\x66\x1d\x56\x71\x7a\xee\xf4\xc1\xc2\xfb\x73\xe9\xbe\x7f\x72\x28\xbc\xe6\x71\x54\xe0\xd9\x4e\x12\x31\x5a\x85\xa0" +
+	"\x66\xf8\x00\x44\x87\x21\x0d\xb7\xe7\x4e\x1f\x2c\x4c\xd1\x4a\x9a\x7e\xf1\x32\x92\xea\x9d\x3e\x58\xf8\xa8\x90\x8b\x5e\x42\x52\x3c" +
+	"\x04\xfa\x44\x4c\xcb\x2f\x41\x3e\x2c\xf2\xf9\x5c\xaa\xe6\x27\x1f\xfe\xe6\xab\xbf\x55\x0a\x7f\xf9\x37\xde\xad\x6e\x5e\xaa\x4e\x03" +
+	"\xa9\xb7\x11\x7d\xfe\xd5\x20\x33\x40\x30\xd6\x18\x08\xde\x91\x3b\x2a\x64\x0e\xce\x9e\x4b\xdd\xf9\xc9\x41\x9c\xb9\x73\x69\x38\x3e" +
+	"\xb9\x5f\xee\x79\x43\x9c\xf9\x73\xa9\x3b\x3e\x39\x08\xb3\x40\x2b\xe9\x76\xd3\x91\x59\xb6\xd0\x58\x04\x51\xdf\x1d\x64\x23\xc0\x12" +
+	"\x80\x33\x17\xdc\x5c\x48\x82\xcd\xc7\xe5\x73\xa9\x3d\x3f\x39\x2c\x5b\x43\x5d\xb4\x42\x4c\x7d\x99\xac\x90\x9c\xb2\x35\xd4\x45\xab" +
+	"\x84\xf0\x39\xe7\x2a\x75\x5b\x3b\x97\x5a\xe3\x93\xbb\xbe\x0d\x23\xad\x83\xd0\x81\x08\x70\xeb\xd6\x50\x17\x6d\x9c\x4b\xad\xf3\xd4" +
+	"\xbe\x06\x85\xd9\x54\x6b\xe9\xf6\x32\x92\xca\x35\xd4\x45\x5b\x84\xd4\x77\x07\x09\x31\xb0\x7d\x2e\xf5\x4f\x53\x07\xa5\xdb\x41\x71" +
+	"\x69\xa8\x31\xd7\x1f\x6e\x29\x55\x8e\x5f\xa4\xee\x02\xd9\xf0\x11\x99\x9e\x0c\xe4\x0b\x90\xcd\xc8\x6a\x38\x5b\x85\x5c\xce\xbd\x73" +
+	"\x69\xce\xf4\x64\x3b\x4e\xb9\x23\xcd\x39\x50\x4d\x85\x63\xa4\xb7\x23\xcd\x39\x50\x6d\x87\xa9\x74\xd0\x4a\x4c\x3b\xa9\x37\xb1\x4e" +
+	"\xc7\xf5\x81\xd9\xf0\x19\xa7\xe7\xfa\x81\x6c\x46\xa6\xe9\x2c\x19\xf6\x6d\x07\x3a\x52\x8c\x11\xfe\x85\x4d\xe4\xa3\x93\xd1\xb7\xea" +
+	"\x12\x7b\xfc\x77\xa7\x0f\xc2\x41\x85\x5c\xcc\x41\xf4\x69\xd1\xa1\x8e\xd4\x1a\x9f\xcc\x88\x3a\x35\xc9\x2a\xc3\x1d\xa9\x9e\x9f\xcc" +
+	"\xd7\xea\x2a\x1d\x9c\x5d\x65\x66\x44\x33\x17\xc5\x68\x36\x8c\x0e\x35\xda\x91\x06\xe3\x93\xc3\x60\x63\x58\x10\xe6\x00\xcb\x9a\x79" +
+	"\x1b\x21\xfc\x35\xca\xea\xa6\x42\x86\xf3\x11\x9c\x4c\xbd\xd5\x91\xda\xe3\x93\x35\x82\xaf\x50\x22\x72\xbb\x23\x75\xc7\x27\x87\xd5" +
+	"\xbb\xd3\x91\xba\xf3\x93\xdd\xea\xe1\x8f\xd1\xdd\x8e\xd4\x9d\x9f\x1c\x26\x74\xaf\x23\xc5\x11\xe3\x5f\xf0\x28\xab\x14\xef\xbe\x42" +
+	"\x2e\x12\x12\xd6\x0e\x81\x1e\x00\x59\xf4\x55\xdc\x48\x0f\x3b\xd2\x9c\x11\xca\x4e\xe9\x1e\x29\x64\x38\x1f\xa1\x3f\xe5\x8f\x3b\xd2" +
+	"\x9c\x73\xd5\x76\xe9\x9e\xa0\xba\xa7\xa4\xef\x60\xe9\x9a\xc2\x61\xf7\xda\x53\x02\x2e\x86\x80\xee\x84\x67\xf8\x77\x7d\xd0\x19\x8f" +
+	"\x18\x5b\x07\x4d\x2a\x50\x78\x4e\xbd\x72\xca\x74\x9c\x1c\xf6\x65\xe2\x85\x22\x4c\xbf\x09\xa1\x83\x8c\x77\xa4\xfe\x71\xea\x20\xcc" +
+	"\x04\x16\x72\x6a\xa4\x10\xac\xd4\xec\xc4\x7b\x49\xc8\x70\x15\x04\xcd\xf6\xaa\x23\xb5\x4e\x53\x73\x3a\x28\x98\x88\xbc\xee\x48\x6b" +
+	"\x59\xb4\x6f\x80\xcc\x46\x9e\x47\x4b\x9b\xe4\x85\x79\x0b\x66\x3e\xba\x90\x23\xa3\x4f\xe8\xbf\xeb\x48\xbf\x9d\x8e\x74\x24\xda\xb1" +
+	"\x15\x9a\x64\xef\x89\xe1\x50\x06\x72\x63\x4d\x6a\xa5\x0b\x32\xdd\xc3\xb1\xc9\x70\x34\xf6\x83\x5a\x4e\x25\x34\x69\xb1\x17\x86\x56" +
+	"\x3c\x05\x64\x36\xaa\xb8\x26\x2d\x9f\x0e\x25\xe4\x23\x90\xf9\xa8\x2f\x5f\x05\x71\x3e\x81\x28\x4a\xf1\x64\x2c\x9a\x78\xa2\x9f\x01" +
+	"\x34\x98\xd2\xc9\xc9\x50\x21\xa6\x41\x14\x2f\x84\x12\x3a\x95\x19\xca\xb6\x68\xca\xc6\x53\xde\x44\x61\x96\x08\x1d\xc4\x1c\xfa\x74" +
+	"\xa2\xcc\x11\x53\x34\x25\x13\x15\x1d\x66\x9e\x96\x51\x29\x04\xeb\xa0\x4d\x53\xa4\xb1\x00\xa4\xc1\x2a\x98\x73\xb6\x94\x90\x45\x20" +
+	"\xc5\xbe\x2a\x2a\xa8\xd8\x52\x47\xfa\xed\xe1\xf9\xa8\x1b\xfb\x54\x3d\x3b\x95\x9f\xef\x32\x90\x59\x0f\x31\xe9\x00\x59\x01\x32\x1f" +
+	"\x5d\xc8\x51\x41\x3e\xf8\x1b\xb4\xda\x91\x7e\xfb\x7f\x10\x88\x4f\xc2\x86\xed\xb6\x06\x64\xd6\x47\xdc\x48\xeb\x60\x10\x89\x8f\xc2" +
+	"\xba\xbd\x86\x48\x1b\x6a\x31\x95\x82\xac\x26\x68\xb6\x4d\x20\xb3\x1e\xe2\xf4\xda\x16\x88\xf9\xa8\xcf\x21\x4c\xaf\x21\xce\x76\x47" +
+	"\xaa\x3d\x59\xfc\x74\x26\x63\x8c\xff\xc8\x1b\x0b\xa9\x23\xb5\x3c\x59\x6c\x6c\xe6\x0d\x5c\x72\x60\x73\x57\xcb\xa4\x39\x32\xcc\x7c" +
+	"\xe9\x48\x61\x70\xfe\x26\x27\x36\x95\xc3\x89\x03\xaf\xe4\xb7\xe2\x2d\xde\x5e\x47\x6a\x59\xb2\xcc\x20\x94\x71\x5b\xcb\x9d\xa9\xb6" +
+	"\x64\xab\x08\xd1\x8a\x7a\x15\x75\x32\x07\x92\xc4\x2a\x9d\xa9\x31\x65\x19\x71\xf2\xea\x03\xc1\x69\x79\x2a\xcc\xf4\x77\xa6\xda\x95" +
+	"\x95\xa9\x5e\xce\xd1\x4d\x0a\x35\xd0\x99\x1a\x57\x16\x25\x24\x84\x7c\x38\x7d\x72\x73\xb0\x33\xf5\x4d\x59\x51\xd1\xc8\x50\x67\x0a" +
+	"\x9f\xf3\x37\x7a\x01\x24\x7e\x2a\x16\xf3\xfd\x64\xf4\xed\xbf\xa1\x80\xc3\x9d\x69\xbe\x27\x0b\x00\x07\x44\x47\x3a\xd3\x1c\x4f\x56" +
+	"\x34\x80\x8c\x2a\xe4\xa4\x09\x63\x06\xf6\x72\x3e\x63\x9d\xa9\xe3\xc9\xfa\xd9\xdc\xec\x4c\x5d\x4b\xd6\x48\x68\xe2\x56\x67\xaa\x1d" +
+	"\x59\x69\x99\xb0\x6c\xb7\x3b\x53\xcb\x92\xcd\xad\xdb\x1d\xad\x93\xe6\xe9\x68\xe6\x2e\x1e\xe3\xb6\xd5\x7a\x26\x69\xee\xbc\x7b\x9d" +
+	"\xa9\xe5\xc8\x6a\xc4\x38\xb2\xf5\x7f\x2e\xdc\x07\xb1\x6d\xf7\x9d\xab\x82\xb4\x1f\x28\xc6\x74\x5d\x4e\xe9\x1e\x76\xa6\xc6\x91\x65" +
+	"\xc4\xce\xe8\x11\x00\x4e\xc8\x13\xd1\xc8\xe3\xce\xf4\xd0\xbb\xe8\x72\x22\x7e\xac\x6c\x8b\xe6\x41\x72\x4f\x88\x58\x89\x7e\x12\x3f" +
+	"\x56\x6e\x9c\xc2\x6b\x33\x72\x8e\x34\x92\xb0\x1d\x6b\x08\x2d\xf2\xac\x33\x3d\xd4\x7e\x79\x2f\xe2\xd3\x80\xbc\x1f\x5a\xce\x57\x3c" +
+	"\x07\xb0\x12\x69\x2f\x96\x11\xda\x53\x2e\x56\xec\x0b\xc5\xb8\x2a\x06\xc1\x62\xc7\x81\xa8\x6c\x08\xf0\x93\x99\xc0\xbf\x73\x2e\x42" +
+	"\x90\x06\x27\xf3\x52\x21\xae\x86\x22\xb4\xc8\xab\xce\xf4\xd0\x9b\xe8\x4a\x62\x26\x7c\xb2\x07\x2b\x53\x0b\x5f\x13\xb2\x1a\xfd\x44" +
+	"\xef\xed\xd9\x62\xb5\x6b\xf6\x46\x13\x49\x48\xb0\xc8\xdb\xce\xf4\x50\xeb\x95\x3d\x7d\x11\xeb\xe1\x6f\x0a\xae\x07\x4b\x81\xde\x81" +
+	"\x59\x8d\x7a\x23\x92\x11\x88\xd6\x2b\x1e\xe2\x7b\x05\xed\x55\x85\x48\x6a\x12\x94\xce\x4a\x18\x27\xab\x0f\x20\x38\x29\x57\x86\xd3" +
+	"\x9a\x52\x48\x92\x87\xb0\xcc\x47\x4a\xfd\xb2\x55\xbf\xe0\x39\x7d\x22\x64\xc5\xaa\x5f\xd0\x74\x9f\x35\x92\xe4\x20\x5a\x65\x1a\x8b" +
+	"\xb9\x6c\x15\x50\xda\x0e\x95\x41\x3f\xcc\x80\x58\xb1\xcb\x67\x7a\x8a\xab\x37\xab\x98\xbd\x6a\x0c\x74\xe6\xc0\xe8\x8c\x90\x75\x90" +
+	"\xd0\x3c\x00\xce\x47\x08\xa7\x74\x0b\x0a\x49\xf2\x10\xad\xb2\xd8\x99\xa9\x09\xc9\xda\x75\x36\xee\x95\x5c\xba\xbe\x04\x64\x3e\xba" +
+	"\x2e\xee\xb6\x3f\x20\x19\x8e\x11\x98\x1e\x0f\x71\x7c\xca\x95\xce\xcc\x8c\x47\xb6\x2d\x25\xda\x92\x83\x3f\x56\xab\x9d\x99\x37\x1d" +
+	"\x99\xaf\xb3\x97\x6d\x3d\x6b\x9d\x99\x4c\x47\xf6\x35\x08\x58\xef\xcc\xcc\x70\x64\x9b\x30\xe9\x6c\x74\x66\xde\x6c\x64\xfe\xe6\x21" +
+	"\x6b\xdd\x04\xd2\x73\x3d\x5f\x04\x9f\xc8\x5b\x9d\x99\x35\x17\x19\x76\x3e\x0f\xff\x85\x3f\x86\x0f\xca\x6d\x22\x8a\xca\x42\xa3\xaa" +
+	"\x45\x47\xff\xfc\x35\x7d\xc1\x11\x0f\x6d\x87\x98\xe3\x36\x63\xcb\x20\xd0\x6e\x67\x66\xcd\x45\xc6\x5a\x18\x91\x3f\x89\x5f\x08\x29" +
+	"\xfe\x66\xfc\x3c\x8a\x44\x75\x03\x43\x75\xd9\x23\xe8\xb8\x86\x02\x1d\x62\xca\x5d\x99\x35\x18\xd9\x66\x24\xab\x5e\x42\x8a\xd7\xbd" +
+	"\x58\x4e\x56\x15\x62\x74\x56\xa1\x0c\xb2\xea\xeb\x42\xe2\x3d\xa6\x7c\xa6\x5d\x38\x52\x3f\x21\xf3\xda\x7a\xf5\xdb\x8e\x85\x06\x88" +
+	"\xea\xf1\x21\xc7\xa9\x1c\xec\xca\xac\xc9\xc8\xf6\xe3\xe4\xc1\xc8\x43\x44\xcc\x47\x56\x09\xc3\xd6\x1b\x26\xa8\xc7\xaa\xa0\x2d\x43" +
+	"\x05\x1c\xe9\xca\xac\xb9\xc8\x36\x22\x69\x8d\xea\x50\x56\x05\xe5\x8b\xaf\xac\x78\xac\x2b\xab\x65\xbf\xde\xec\xca\xb4\xfd\xca\x9b" +
+	"\xa7\xc8\xae\xc4\x4f\x6a\xde\xb9\x72\xab\x2b\xcb\x77\x5f\x79\x56\xc1\x6d\x02\x3c\x09\x26\xa0\x70\xa7\x2b\xd3\xe6\x2b\x8f\x54\xf9" +
+	"\xfb\x44\x74\x40\x66\xc2\xc2\xbb\xba\xdb\x95\x19\xf3\x15\x37\xfe\x13\x01\x11\xdc\x55\x4e\x47\xfb\xef\x29\x44\x8b\xb8\x8c\x56\xb9" +
+	"\xaf\x03\xe9\xb5\x38\xee\xab\x5e\xcb\x83\xae\xcc\x32\x5f\x49\xc5\xcf\xe7\xa1\x42\x58\xc4\x67\x90\xd1\xa3\xae\x4c\x7b\xaf\xfa\xbb" +
+	"\x9f\x19\x78\x27\xd3\x0a\x1e\x77\x65\x96\xf5\xca\xcc\xfe\xa3\x7f\x2e\x18\x9f\xf2\x49\x57\x66\x59\xaf\x01\x83\x50\x4f\xbb\x32\x6d" +
+	"\xbd\x5e\x3a\xb8\x1f\x19\xa9\xd7\x44\x56\xed\x9e\x75\x65\x96\xf5\x8a\xe5\x12\x02\x15\x2a\x1e\x02\x3d\xef\xca\x2c\xef\xd5\x65\xb4" +
+	"\xcc\x0b\x1d\xa8\x1c\x05\x91\x64\x31\xe3\x5d\x99\x6d\xbe\x3a\x3a\x26\xab\x89\xae\xcc\x76\x5f\x43\x0a\x79\xbd\x44\xd7\x2c\xda\x9d" +
+	"\x67\xd5\x50\x97\xf9\x55\x57\x96\xe3\xbe\x42\x85\x77\x4c\xbd\x26\xc2\x17\x61\x04\x71\xde\xd0\xd3\x5c\xb4\x9a\x2f\x28\xe0\xdb\xae" +
+	"\xcc\x98\xaf\xf9\xf5\x7b\xa7\x10\xab\xf9\x82\xfa\xbd\xd7\x81\xca\x51\x18\x89\x17\x33\xd9\x95\x59\xf6\xab\x23\x23\x29\x7d\x50\x4c" +
+	"\x39\xca\x89\xc5\x3a\x53\x5d\x59\xcd\x29\xac\x1f\x15\x33\x5c\x65\x98\x2b\xb9\x54\x9f\x88\x59\x0b\xa6\xc2\xc2\x7b\x63\x9d\xcf\x5d" +
+	"\xd9\xbe\x12\x87\xc2\xcb\x89\x60\xb6\xec\x34\x11\xc3\xf2\xf6\xc2\x01\x28\xce\x0c\x88\xb5\xc8\x17\x21\x3b\x91\x55\x66\x69\x2d\x97" +
+	"\x4f\xe4\xa4\x04\x2b\x0b\xc7\x2e\xe7\x14\x72\x31\xcc\x48\xec\xb0\x79\x9d\xd1\x89\x30\x21\x56\x59\x20\xe4\x8a\x5e\x0c\xe9\xf0\xb7" +
+	"\x64\x5e\xcb\xa2\x42\xac\xda\xd9\x04\xbd\x54\x5d\x22\x64\xdd\x2e\x1d\xfb\xb0\xac\xb2\x8c\xac\x39\x0e\x5b\xa8\x76\x98\x15\x02\x4c" +
+	"\xe1\x82\x20\xab\x00\xd6\x23\x4f\xc2\x29\xdb\x1a\xad\xe3\x8a\x55\x36\x72\x58\x21\xc3\x09\xaf\x2b\xc4\x94\xcd\x10\x6c\xc1\x6e\xe8" +
+	"\x6c\x4c\xd9\x1c\x0f\x16\x2a\x9b\x5d\xd9\xbe\x4f\xe8\x39\x72\x23\xab\x4c\x9e\xde\x52\xd0\x70\xa1\x1a\x44\x0f\x69\x9b\x28\xf4\xc3" +
+	"\x91\xcb\x87\xf6\xbb\x5d\xc7\x4a\x3b\x5d\xd9\xbe\x7f\x72\x34\xbc\xd8\x31\x5d\xc5\xc8\x2e\x21\xc3\x85\x2a\x08\x85\xfa\x02\x86\x23" +
+	"\x09\xa3\x2a\xc8\x3a\x7b\xb4\x9c\xcb\x27\x72\xd6\xcc\xc9\x97\xcf\x13\x72\x31\x0f\xe1\xd6\xeb\x25\x66\x2d\x3a\x11\x24\xc5\x2a\x95" +
+	"\xf3\x19\x0e\x8f\x5e\x33\xb7\x08\xf2\x2f\xf0\x1f\x26\xa2\x03\xff\xbe\xff\xc4\x77\xfb\xfa\x08\xd9\x8e\x34\x83\x03\x42\xb2\x29\x5a" +
+	"\xa0\x7e\x82\x6e\x54\x0a\xf2\x32\xdd\xd1\x81\x53\x37\x70\x3e\xdb\x3f\xc1\xa1\xc8\x3f\x93\xdf\xe0\x22\x33\x48\xcc\x76\xe4\x43\x6e" +
+	"\xb0\x21\xa2\x74\x30\x9c\x9f\x72\x85\x10\x6c\x58\xad\xe7\x6a\x98\x97\x1c\x8c\x18\xd1\x79\x5d\xf5\xf2\xe2\x21\xbc\x04\x8d\x12\x74" +
+	"\xa3\x1c\xe6\xc5\xa3\x7c\xc7\xce\x67\xfb\xff\xef\x8d\x6b\x72\xc0\x8a\xff\x9d\x5e\xa5\x62\xb5\x37\x01\x6c\x47\xd7\xe4\x78\x95\xa9" +
+	"\x1e\x23\xb7\x80\xdc\xa8\x14\xf2\x45\xe8\x7d\xec\xed\xf3\xd9\xfe\xff\xb8\x71\x4d\x0e\x84\x99\x94\x59\xe4\x0e\x88\xed\xc8\x43\xdc" +
+	"\x40\x77\xc1\xdc\xa8\xc8\xd9\x34\x57\x85\x02\xdd\xa3\xb5\x5c\x0d\x96\x22\x25\xb9\x0f\x60\x3b\xba\xea\xe6\xe3\x54\xed\x01\x90\x1b" +
+	"\xe5\x30\x1f\x99\x1a\xfd\x90\xea\x7a\xd4\xea\x3b\x58\xe2\x78\x0b\x28\x4f\xf9\x11\x21\x67\xac\xb6\x93\x09\xd6\xc2\x3c\x26\xe6\xa8" +
+	"\xd5\x76\x8e\x0c\x9e\xcf\x13\x6a\x16\x1d\x49\x46\x69\x3b\x91\x9e\x12\xa2\x23\xb9\x13\xb9\x85\x79\x46\x8c\x8e\xc4\x63\xbd\x9d\x40" +
+	"\xcf\xd5\x5a\xac\x96\x63\xe4\xe8\xa7\xe8\x1b\x75\x9c\xf6\x05\x31\x67\xac\x96\x73\xa7\x8c\x13\x34\x4e\xd0\x51\xab\xe7\x1c\x21\x24" +
+	"\x35\x71\x3e\xab\x65\xcb\xbe\x54\xc8\x89\x1c\x24\xfe\x2b\x1d\x07\x7d\x05\xe2\x92\xe7\xca\x62\x4f\x95\x68\xbc\x3e\x9f\x1d\x98\x60" +
+	"\x53\x16\x49\x87\xb6\xe2\x1b\x8d\x9c\x08\x11\x1d\xe6\x2d\x11\x3a\x0e\x59\xeb\x61\xa0\x77\x58\xca\x42\x34\x26\x0e\x98\x58\x86\x66" +
+	"\x43\xd4\x7b\xc5\xf4\x45\xf9\x0c\x3e\xcd\x26\x81\x5c\x1a\x73\x1d\x59\x39\x4c\x4b\x29\x7d\x38\x9f\x1d\xf8\xef\x85\xe8\x17\xde\xb8" +
+	"\x90\x53\xb9\x29\x85\x9c\x90\x9d\x0b\x61\xe9\x3e\x02\xb9\xe4\x8a\x38\x09\x7d\x3a\x9f\x1d\x18\x8f\x74\x9c\xfc\xca\x7d\xd6\xc8\x09" +
+	"\xb9\x3a\x3a\x28\xdd\x34\x21\x2a\x4e\x95\xca\xcd\x60\x25\xa8\x9c\xac\x45\xaa\xc2\x9b\xa2\x66\x15\xd2\x17\x99\x84\x6c\x06\x85\x9b" +
+	"\x03\x72\xc9\x15\x41\x18\xd9\x58\x35\x8f\xca\x2e\x99\x86\x33\x17\x8b\x88\xcf\xb9\xa0\x90\x13\x39\x88\x4e\x67\x11\xc4\xaf\xa6\xe1" +
+	"\x48\x04\xdf\x8c\x45\x63\x89\x7a\x45\xc7\x41\x49\xe8\x07\x95\x94\x0d\x5e\xe9\xb2\x46\x4e\x84\x88\x0e\xb3\x42\x84\x8e\x43\x65\x23" +
+	"\x15\x27\xd0\x2a\x96\xb2\x64\x35\x9c\x59\xad\xea\x15\x84\x5a\x53\x50\x5f\x54\x05\x42\xe5\xd6\xc1\xfc\x3a\x56\x08\x93\x32\x3a\x1b" +
+	"\xe7\x33\x4c\x00\xbe\x7a\x64\x2d\x3a\xf4\x37\x65\xeb\x1d\xc5\x79\x28\xf9\x5f\x7f\xe2\xbb\x7d\x9b\xe7\x33\x6b\x36\xf2\xd5\xbf\xec" +
+	"\xe7\x59\xae\xf4\x7f\x1e\x7e\xdb\xd6\xf9\xcc\x9a\x8d\x0c\xc2\x11\x01\xb2\x7d\x3e\xc3\x50\xe3\x6b\x14\x88\x8e\x1d\xe2\x25\xc6\xd1" +
+	"\x8a\x7c\x90\x55\xa2\xef\x8e\xec\x10\xa4\x47\x16\xab\x2d\x5c\x3c\x36\x16\x1f\x76\xd0\xd9\x3d\x9f\xf9\xc3\x91\x1d\x1d\x30\x5f\xd4" +
+	"\x72\xae\x79\x49\xb9\xb1\xf6\x08\xd2\xb1\xec\xac\x44\xa6\xdc\x9d\x59\xd3\x91\x39\x2b\x27\x52\x6f\x77\x86\xf1\xbf\x57\x65\x4e\x8d" +
+	"\x3f\x1d\xf9\xc4\x77\xfb\x2a\x60\x7e\xef\x2d\xf0\x40\x1c\xd9\x7d\x6f\x76\x1b\xf5\x01\x99\x89\x3c\xc6\x1a\xee\x0b\xa8\xbf\x3b\xf3" +
+	"\x46\x23\xf3\x91\x02\x99\xab\x30\xd0\x9d\x99\xd1\xc8\x28\x1f\x6f\xbf\x97\xd1\x1e\x83\xdd\x99\x3f\x19\xd9\x56\x21\x66\x48\xad\xe6" +
+	"\x9a\x97\x94\x13\x69\x18\xcc\xef\x7d\xee\x82\x9d\x48\x23\x20\x66\x22\x0f\x71\x23\x8d\x82\x69\x30\xd5\x33\x6d\xc3\xc5\x1b\x03\x71" +
+	"\xdc\x14\x86\x1f\x92\x29\xcb\x4d\x10\x0d\x16\xe1\x68\xd0\x52\x6e\x21\x69\x7b\x2c\x72\xd8\x0d\xb7\x81\x1c\xb7\x2a\xe7\x76\x03\x54" +
+	"\xee\x74\x67\xde\x5c\x64\xb7\x1d\xc0\xdc\xa5\xc5\x98\xca\xe5\xf4\xdd\x3d\x20\xc7\xfb\x82\x8c\x8c\xc8\x7d\x10\x0d\x16\x11\xc6\x79" +
+	"\xd0\x9d\xd5\x1a\x8c\xfc\x10\xc8\x51\x0b\x29\xd6\xd1\xc9\x45\x43\x3c\x02\x51\x7f\x5d\x86\x8d\xb9\x22\x64\x4d\x3d\x26\x64\xd0\x8d" +
+	"\x83\x2d\x61\x64\x82\xd1\x29\x8a\x27\x40\x8e\x0e\x06\x71\x0c\xf1\x14\x44\xfd\x6f\x6e\x1c\x11\xc1\x8b\xb5\x67\xdd\x59\xdd\x7f\xe9" +
+	"\xd9\xc8\x64\xd8\xe5\xe4\xf3\x1c\xcc\x51\xcd\xd0\x30\xad\x20\xa1\x17\x40\xea\xaf\x57\x51\xa1\x84\xc6\xbb\xb3\xba\x49\x0c\x47\xe6" +
+	"\xd7\x36\x5e\xa4\x62\x5d\x34\x41\xc8\x51\x0b\x71\x02\x15\xeb\xa2\x97\x44\xd4\x5f\x27\x3f\xb4\x5a\xe9\x5e\x29\x68\xd0\x8d\xd4\xcf" +
+	"\x2f\x88\x71\xa4\xe6\x35\x21\x47\x07\x83\x48\x42\xbc\x21\xa2\xfe\x37\x2f\x92\xa8\xa0\x78\x6f\xbb\xb3\xba\x29\x4e\x29\xaf\x7a\xc5" +
+	"\xba\xe8\x1d\x31\x3a\xa7\x9c\xea\x15\xeb\xa2\xf7\x84\xe8\xa4\xaa\x95\x6f\x12\x8f\x72\xc3\x1a\x7d\x6c\x2e\xc9\x90\xd9\x86\x1f\xc0" +
+	"\xfc\xe1\x21\xf8\x8a\x28\xc4\x14\x88\x8d\xc8\x74\x9e\xab\x42\x0f\xea\xa3\x62\x4c\x5f\x29\x46\xda\x86\x84\x3e\x01\xfa\xc3\x63\x64" +
+	"\x73\x00\x21\x9f\x81\x6c\x44\xa6\xfb\x3c\x1d\x54\x70\x1a\x7d\xb3\xa1\x07\x20\xcb\x5e\x05\x37\xab\x19\x30\x7f\x58\xed\xc7\x5b\x1e" +
+	"\x84\x98\x05\xb1\x11\x99\xf6\x73\x44\x28\xa9\xb9\xee\xcc\xb8\xb4\x98\xf4\x4b\x3f\x7a\xe1\x64\xe1\xdd\xe3\x0f\x6b\x78\x25\x43\x08" +
+	"\xac\x4d\x1e\x92\x2c\x37\x2a\x89\xc1\xd4\x9d\x79\x36\xad\x10\xac\xb2\xd8\x9d\xe5\xfb\xb4\x98\x23\x08\x60\x09\xc0\x0a\xdf\x20\x2b" +
+	"\x26\xec\xb0\xbe\x19\x0e\x6f\x99\x97\x15\xe2\x8a\x08\x41\x8b\x5d\xe9\xce\x1c\x9b\x36\xc8\x66\x15\x00\x27\x23\x08\x89\x70\x36\x6b" +
+	"\xdd\x99\xeb\xd3\x1a\x82\x55\xd6\xbb\x33\xcb\xa8\x75\x26\x24\x53\x24\x98\x91\x1b\xc4\xc0\xd4\xd4\xee\x06\x21\x76\xd9\x36\x35\x91" +
+	"\x84\x04\x8b\x6c\x75\x67\x96\x53\x5b\xfa\xc6\x8c\x2c\x36\xf6\xea\x36\x98\x55\x58\x8d\xfa\x04\x1b\x31\xb4\x60\x19\xf2\xbb\xa3\x98" +
+	"\xbd\x6a\x0c\xb9\xb0\xbb\xdd\x99\xf1\x69\x99\x71\x72\xfa\x02\x42\xa7\xe4\xaa\x70\x4e\x7b\x8a\x48\x72\x08\x16\x29\xff\x23\xb3\x5c" +
+	"\xda\xfc\xa6\xeb\x25\x66\xc5\x14\x2f\xe8\xb9\x8a\x26\x92\x90\x60\x91\xbe\x7f\x64\x96\x4b\x5b\xfa\xe6\xa8\xeb\xd2\x02\xe9\x07\xb2" +
+	"\x62\xd5\xce\xb4\x14\x97\x6e\x40\x21\x7b\x55\x10\xea\xaa\xc1\x7f\x64\xc6\xa5\x45\xa0\x20\xa1\x21\x10\x3a\x1f\x21\x9c\xca\x0d\x2b" +
+	"\x22\xc9\x21\x4e\x7c\xf7\xf5\x0f\x6b\xd1\xa1\xef\xff\xff\x00\xe2\x11\xbb\xf5\x4b\x8a\x04\x00"
@@ -0,0 +1,52 @@
+package m3point
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPointPackReference(t *testing.T) {
+	assert.Nil(t, VerifyPointPackReference())
+
+	ppd := GetPointPackData(nil)
+	assert.Equal(t, GetReferencePointPackData(), ppd)
+	assert.Equal(t, 50, len(ppd.allConnections))
+	assert.Equal(t, 200, len(ppd.allTrioDetails))
+	assert.Equal(t, 52, len(ppd.GetAllGrowthContexts()))
+	assert.Equal(t, TotalNumberOfCubes, len(ppd.cubeIdsPerKey))
+	assert.Equal(t, TotalNumberOfCubes+1, len(ppd.pathBuilders))
+
+	// The growth contexts of the reference route back to it
+	for _, growthCtx := range ppd.GetAllGrowthContexts() {
+		for offset := 0; offset < growthCtx.GetGrowthType().GetMaxOffset(); offset++ {
+			pnb := ppd.GetPathNodeBuilder(growthCtx, offset, Origin)
+			assert.NotNil(t, pnb, "no builder for %s offset %d", growthCtx.String(), offset)
+		}
+	}
+}
+
+func TestPointPackReferenceRoundTrip(t *testing.T) {
+	ppd := GetReferencePointPackData()
+	var buf bytes.Buffer
+	assert.Nil(t, ppd.WriteReference(&buf))
+
+	// The gzip bytes depend on the compressor, only the decoded rows are compared
+	readPpd := new(PointPackData)
+	assert.Nil(t, readPpd.initFromReference(buf.String()))
+	assert.Equal(t, ppd.getAllReferenceRows(), readPpd.getAllReferenceRows())
+
+	assert.NotNil(t, readPpd.initFromReference(gzipped(t, "QSMXX")))
+	assert.NotNil(t, readPpd.initFromReference(gzipped(t, PointPackRefMagic+"\x04")))
+	assert.NotNil(t, readPpd.initFromReference("not gzipped"))
+}
+
+func gzipped(t *testing.T, s string) string {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(s))
+	assert.Nil(t, err)
+	assert.Nil(t, gz.Close())
+	return buf.String()
+}
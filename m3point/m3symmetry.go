@@ -0,0 +1,320 @@
+package m3point
+
+import (
+	"sort"
+	"strings"
+)
+
+/***************************************************************/
+// Type declaration
+/***************************************************************/
+
+// An element of the cube symmetry group: a permutation of the axes with a sign on each.
+// Coordinate i of the result is signs[i] times the coordinate perm[i] of the point.
+type CubeSymmetry struct {
+	perm  [3]int
+	signs [3]CInt
+}
+
+/***************************************************************/
+// Global fields declaration
+/***************************************************************/
+
+const (
+	NbCubeSymmetries = 48
+	NbCubeRotations  = 24
+)
+
+var IdentitySymmetry = CubeSymmetry{[3]int{0, 1, 2}, [3]CInt{1, 1, 1}}
+
+// All the 48 elements, the identity first then the 23 other rotations, then the 24 reflections
+var AllCubeSymmetries [NbCubeSymmetries]CubeSymmetry
+
+var allUnitDirections = [6]UnitDirection{PlusX, MinusX, PlusY, MinusY, PlusZ, MinusZ}
+
+/***************************************************************/
+// Init Functions
+/***************************************************************/
+
+func init() {
+	initCubeSymmetries()
+}
+
+func initCubeSymmetries() {
+	perms := [6][3]int{{0, 1, 2}, {1, 2, 0}, {2, 0, 1}, {0, 2, 1}, {2, 1, 0}, {1, 0, 2}}
+	rotIdx := 0
+	reflIdx := NbCubeRotations
+	for _, perm := range perms {
+		for signIdx := 0; signIdx < 8; signIdx++ {
+			s := CubeSymmetry{perm, [3]CInt{1, 1, 1}}
+			for i := 0; i < 3; i++ {
+				if signIdx&(1<<uint(i)) != 0 {
+					s.signs[i] = -1
+				}
+			}
+			if s.IsRotation() {
+				AllCubeSymmetries[rotIdx] = s
+				rotIdx++
+			} else {
+				AllCubeSymmetries[reflIdx] = s
+				reflIdx++
+			}
+		}
+	}
+}
+
+/***************************************************************/
+// CubeSymmetry Functions
+/***************************************************************/
+
+func (s CubeSymmetry) String() string {
+	var sb strings.Builder
+	axes := "XYZ"
+	for i := 0; i < 3; i++ {
+		if s.signs[i] < 0 {
+			sb.WriteString("-")
+		} else {
+			sb.WriteString("+")
+		}
+		sb.WriteByte(axes[s.perm[i]])
+	}
+	return sb.String()
+}
+
+// The index in AllCubeSymmetries
+func (s CubeSymmetry) GetIndex() int {
+	for i, o := range AllCubeSymmetries {
+		if o == s {
+			return i
+		}
+	}
+	Log.Fatalf("cube symmetry %s is not in the list of all symmetries", s.String())
+	return -1
+}
+
+// True for the 24 rotations, false for the reflections
+func (s CubeSymmetry) IsRotation() bool {
+	det := s.signs[0] * s.signs[1] * s.signs[2]
+	// Odd permutations are the ones swapping only 2 axes
+	if s.perm[0] == 0 || s.perm[1] == 1 || s.perm[2] == 2 {
+		if s.perm != IdentitySymmetry.perm {
+			det = -det
+		}
+	}
+	return det > 0
+}
+
+func (s CubeSymmetry) ApplyToPoint(p Point) Point {
+	return Point{s.signs[0] * p[s.perm[0]], s.signs[1] * p[s.perm[1]], s.signs[2] * p[s.perm[2]]}
+}
+
+func (s CubeSymmetry) ApplyToDirection(ud UnitDirection) UnitDirection {
+	p := s.ApplyToPoint(ud.GetFirstPoint())
+	for _, res := range allUnitDirections {
+		if res.GetFirstPoint() == p {
+			return res
+		}
+	}
+	Log.Fatalf("cube symmetry %s on direction %d gave %v", s.String(), ud, p)
+	return ud
+}
+
+// The symmetry doing first o then s
+func (s CubeSymmetry) Compose(o CubeSymmetry) CubeSymmetry {
+	res := CubeSymmetry{}
+	for i := 0; i < 3; i++ {
+		res.perm[i] = o.perm[s.perm[i]]
+		res.signs[i] = s.signs[i] * o.signs[s.perm[i]]
+	}
+	return res
+}
+
+func (s CubeSymmetry) Inverse() CubeSymmetry {
+	res := CubeSymmetry{}
+	for i := 0; i < 3; i++ {
+		res.perm[s.perm[i]] = i
+		res.signs[s.perm[i]] = s.signs[i]
+	}
+	return res
+}
+
+/***************************************************************/
+// PointPackData Symmetry Functions
+/***************************************************************/
+
+func (ppd *PointPackData) ApplyToConnection(s CubeSymmetry, cd *ConnectionDetails) *ConnectionDetails {
+	return ppd.getConnDetailsByVector(s.ApplyToPoint(cd.Vector))
+}
+
+// The trio with all the connections transformed, or nil if the transformed connections are not a known trio
+func (ppd *PointPackData) ApplyToTrio(s CubeSymmetry, td *TrioDetails) *TrioDetails {
+	var connIds [3]ConnectionId
+	for i, cd := range td.conns {
+		connIds[i] = ppd.ApplyToConnection(s, cd).GetId()
+	}
+	for _, res := range ppd.allTrioDetails {
+		if res.HasConnections(connIds[0], connIds[1], connIds[2]) {
+			return res
+		}
+	}
+	return nil
+}
+
+// The cube with the trio of each position moved to the transformed position and transformed.
+// Returns false if one of the transformed trios is not known.
+func (ppd *PointPackData) ApplyToCube(s CubeSymmetry, cube CubeOfTrioIndex) (CubeOfTrioIndex, bool) {
+	res := CubeOfTrioIndex{}
+	ok := true
+	applyTrio := func(trIdx TrioIndex) TrioIndex {
+		td := ppd.ApplyToTrio(s, ppd.GetTrioDetails(trIdx))
+		if td == nil {
+			ok = false
+			return NilTrioIndex
+		}
+		return td.GetId()
+	}
+	res.center = applyTrio(cube.center)
+	for _, ud := range allUnitDirections {
+		res.centerFaces[s.ApplyToDirection(ud)] = applyTrio(cube.centerFaces[ud])
+		for _, ud2 := range allUnitDirections {
+			if ud2 > ud && ud2 != ud.GetOpposite() {
+				newIdx := GetMiddleEdgeIndex(s.ApplyToDirection(ud), s.ApplyToDirection(ud2))
+				res.middleEdges[newIdx] = applyTrio(cube.GetMiddleEdgeTrio(ud, ud2))
+			}
+		}
+	}
+	return res, ok
+}
+
+/***************************************************************/
+// Orbits and Stabilisers Functions
+/***************************************************************/
+
+// All the connections reachable by symmetry, ordered by id
+func (ppd *PointPackData) GetConnectionOrbit(cd *ConnectionDetails) []*ConnectionDetails {
+	found := make(map[ConnectionId]*ConnectionDetails)
+	for _, s := range AllCubeSymmetries {
+		other := ppd.ApplyToConnection(s, cd)
+		found[other.GetId()] = other
+	}
+	res := make([]*ConnectionDetails, 0, len(found))
+	for _, other := range found {
+		res = append(res, other)
+	}
+	sort.Sort(ByConnId(res))
+	return res
+}
+
+// The first connection of the orbit ordered by id
+func (ppd *PointPackData) GetCanonicalConnection(cd *ConnectionDetails) *ConnectionDetails {
+	return ppd.GetConnectionOrbit(cd)[0]
+}
+
+// All the trios reachable by symmetry, ordered by index
+func (ppd *PointPackData) GetTrioOrbit(trIdx TrioIndex) []TrioIndex {
+	td := ppd.GetTrioDetails(trIdx)
+	found := make(map[TrioIndex]bool)
+	for _, s := range AllCubeSymmetries {
+		other := ppd.ApplyToTrio(s, td)
+		if other != nil {
+			found[other.GetId()] = true
+		}
+	}
+	res := make([]TrioIndex, 0, len(found))
+	for other := range found {
+		res = append(res, other)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+// The smallest trio index of the orbit
+func (ppd *PointPackData) GetCanonicalTrio(trIdx TrioIndex) TrioIndex {
+	return ppd.GetTrioOrbit(trIdx)[0]
+}
+
+// All the distinct trio orbits ordered by canonical trio index
+func (ppd *PointPackData) GetAllTrioOrbits() [][]TrioIndex {
+	ppd.checkTrioInitialized()
+	res := make([][]TrioIndex, 0)
+	done := make(map[TrioIndex]bool)
+	for _, td := range ppd.allTrioDetails {
+		if done[td.GetId()] {
+			continue
+		}
+		orbit := ppd.GetTrioOrbit(td.GetId())
+		for _, trIdx := range orbit {
+			done[trIdx] = true
+		}
+		res = append(res, orbit)
+	}
+	return res
+}
+
+// All the symmetries leaving the trio unchanged
+func (ppd *PointPackData) GetTrioStabiliser(trIdx TrioIndex) []CubeSymmetry {
+	td := ppd.GetTrioDetails(trIdx)
+	res := make([]CubeSymmetry, 0)
+	for _, s := range AllCubeSymmetries {
+		if ppd.ApplyToTrio(s, td) == td {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// The smallest cube of all the transformed ones, using the order of the cubes ids
+func (ppd *PointPackData) GetCanonicalCube(cube CubeOfTrioIndex) CubeOfTrioIndex {
+	res := cube
+	for _, s := range AllCubeSymmetries {
+		other, ok := ppd.ApplyToCube(s, cube)
+		if ok && other.isLess(res) {
+			res = other
+		}
+	}
+	return res
+}
+
+// All the symmetries transforming the first cube into the second one
+func (ppd *PointPackData) GetSymmetriesBetweenCubes(c1, c2 CubeOfTrioIndex) []CubeSymmetry {
+	res := make([]CubeSymmetry, 0)
+	for _, s := range AllCubeSymmetries {
+		other, ok := ppd.ApplyToCube(s, c1)
+		if ok && other == c2 {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// All the symmetries transforming every cube of the first growth context into a cube of the second one,
+// proving both growth contexts generate the same structure up to this symmetry.
+func (ppd *PointPackData) GetSymmetriesBetweenGrowthContexts(ctx1, ctx2 GrowthContext) []CubeSymmetry {
+	cubes1 := ppd.getCubeList(ctx1).allCubes
+	cubes2 := make(map[CubeOfTrioIndex]bool)
+	for _, cube := range ppd.getCubeList(ctx2).allCubes {
+		cubes2[cube] = true
+	}
+	res := make([]CubeSymmetry, 0)
+	if len(cubes1) != len(cubes2) {
+		return res
+	}
+	for _, s := range AllCubeSymmetries {
+		allIn := true
+		for _, cube := range cubes1 {
+			other, ok := ppd.ApplyToCube(s, cube)
+			if !ok || !cubes2[other] {
+				allIn = false
+				break
+			}
+		}
+		if allIn {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func (ppd *PointPackData) AreEquivalentGrowthContexts(ctx1, ctx2 GrowthContext) bool {
+	return len(ppd.GetSymmetriesBetweenGrowthContexts(ctx1, ctx2)) > 0
+}
@@ -0,0 +1,96 @@
+package m3point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCubeSymmetryGroup(t *testing.T) {
+	assert.Equal(t, IdentitySymmetry, AllCubeSymmetries[0])
+	nbRotations := 0
+	all := make(map[CubeSymmetry]bool)
+	for i, s := range AllCubeSymmetries {
+		all[s] = true
+		assert.Equal(t, i, s.GetIndex())
+		assert.Equal(t, i < NbCubeRotations, s.IsRotation(), "wrong rotation for %s", s.String())
+		if s.IsRotation() {
+			nbRotations++
+		}
+		assert.Equal(t, IdentitySymmetry, s.Compose(s.Inverse()), "wrong inverse for %s", s.String())
+		p := Point{1, 2, 3}
+		assert.Equal(t, p.DistanceSquared(), s.ApplyToPoint(p).DistanceSquared())
+		for _, o := range AllCubeSymmetries {
+			so := s.Compose(o)
+			assert.Equal(t, s.ApplyToPoint(o.ApplyToPoint(p)), so.ApplyToPoint(p))
+			assert.Equal(t, s.IsRotation() == o.IsRotation(), so.IsRotation())
+		}
+	}
+	assert.Equal(t, NbCubeSymmetries, len(all))
+	assert.Equal(t, NbCubeRotations, nbRotations)
+
+	// The existing rotations are in the group
+	p := Point{1, 2, 3}
+	for _, rotated := range []Point{p.RotPlusX(), p.RotNegX(), p.RotPlusY(), p.RotNegY(), p.RotPlusZ(), p.RotNegZ()} {
+		found := false
+		for _, s := range AllCubeSymmetries[:NbCubeRotations] {
+			found = found || s.ApplyToPoint(p) == rotated
+		}
+		assert.True(t, found, "rotation to %v not found", rotated)
+	}
+	assert.Equal(t, "-Y+X+Z", AllCubeSymmetries[0].Compose(CubeSymmetry{[3]int{1, 0, 2}, [3]CInt{-1, 1, 1}}).String())
+}
+
+func TestConnectionAndTrioOrbits(t *testing.T) {
+	ppd := GetReferencePointPackData()
+
+	// One orbit per connection distance
+	canonical := make(map[ConnectionId]int)
+	for _, cd := range ppd.allConnections {
+		orbit := ppd.GetConnectionOrbit(cd)
+		canonical[ppd.GetCanonicalConnection(cd).GetId()] = len(orbit)
+		for _, other := range orbit {
+			assert.Equal(t, cd.ConnDS, other.ConnDS)
+		}
+	}
+	assert.Equal(t, 4, len(canonical))
+
+	orbits := ppd.GetAllTrioOrbits()
+	assert.Equal(t, 8, len(orbits))
+	nbTrios := 0
+	for _, orbit := range orbits {
+		nbTrios += len(orbit)
+		for _, trIdx := range orbit {
+			assert.Equal(t, orbit[0], ppd.GetCanonicalTrio(trIdx))
+			// Orbit stabiliser theorem, all transformed trios exist
+			assert.Equal(t, NbCubeSymmetries, len(orbit)*len(ppd.GetTrioStabiliser(trIdx)), "wrong stabiliser for %s", trIdx.String())
+		}
+	}
+	assert.Equal(t, len(ppd.allTrioDetails), nbTrios)
+	// The base trios are all the same
+	assert.Equal(t, []TrioIndex{0, 1, 2, 3, 4, 5, 6, 7}, orbits[0])
+	assert.Equal(t, 6, len(ppd.GetTrioStabiliser(0)))
+}
+
+func TestCubeSymmetries(t *testing.T) {
+	ppd := GetReferencePointPackData()
+	for cubeKey := range ppd.cubeIdsPerKey {
+		cube := cubeKey.cube
+		canonicalCube := ppd.GetCanonicalCube(cube)
+		assert.False(t, cube.isLess(canonicalCube))
+		assert.True(t, len(ppd.GetSymmetriesBetweenCubes(cube, canonicalCube)) > 0)
+		assert.Equal(t, []CubeSymmetry{IdentitySymmetry}, ppd.GetSymmetriesBetweenCubes(cube, cube)[:1])
+		s := AllCubeSymmetries[7]
+		transformed, ok := ppd.ApplyToCube(s, cube)
+		assert.True(t, ok)
+		back, ok := ppd.ApplyToCube(s.Inverse(), transformed)
+		assert.True(t, ok)
+		assert.Equal(t, cube, back)
+		assert.Equal(t, canonicalCube, ppd.GetCanonicalCube(transformed))
+	}
+
+	// All the simple growth contexts of one base trio are the same up to symmetry
+	for index := 1; index < 8; index++ {
+		assert.True(t, ppd.AreEquivalentGrowthContexts(ppd.GetGrowthContextByTypeAndIndex(1, 0), ppd.GetGrowthContextByTypeAndIndex(1, index)))
+	}
+	assert.False(t, ppd.AreEquivalentGrowthContexts(ppd.GetGrowthContextByTypeAndIndex(1, 0), ppd.GetGrowthContextByTypeAndIndex(8, 0)))
+}
@@ -105,6 +105,27 @@ func (cube CubeOfTrioIndex) GetMiddleEdgeTrio(ud1 UnitDirection, ud2 UnitDirecti
 	return cube.middleEdges[GetMiddleEdgeIndex(ud1, ud2)]
 }
 
+// Order by center, then center faces, then middle edges trio indexes
+func (cube CubeOfTrioIndex) isLess(c2 CubeOfTrioIndex) bool {
+	centerDiff := int(cube.center) - int(c2.center)
+	if centerDiff != 0 {
+		return centerDiff < 0
+	}
+	for cfIdx := 0; cfIdx < len(cube.centerFaces); cfIdx++ {
+		cfDiff := int(cube.centerFaces[cfIdx]) - int(c2.centerFaces[cfIdx])
+		if cfDiff != 0 {
+			return cfDiff < 0
+		}
+	}
+	for meIdx := 0; meIdx < len(cube.middleEdges); meIdx++ {
+		meDiff := int(cube.middleEdges[meIdx]) - int(c2.middleEdges[meIdx])
+		if meDiff != 0 {
+			return meDiff < 0
+		}
+	}
+	return false
+}
+
 /***************************************************************/
 // CubeListBuilder Functions
 /***************************************************************/
@@ -114,38 +135,9 @@ func (ppd *PointPackData) calculateAllContextCubes() map[CubeKeyId]int {
 	cubeIdx := 1
 	for _, growthCtx := range ppd.GetAllGrowthContexts() {
 		cl := CubeListBuilder{growthCtx, nil,}
-		switch growthCtx.GetGrowthType() {
-		case 1:
-			cl.populate(1)
-		case 3:
-			cl.populate(6)
-		case 2:
-			cl.populate(1)
-		case 4:
-			cl.populate(4)
-		case 8:
-			cl.populate(8)
-		}
+		cl.populateAll()
 		sort.Slice(cl.allCubes, func(i, j int) bool {
-			c1 := cl.allCubes[i]
-			c2 := cl.allCubes[j]
-			centerDiff := int(c1.center) - int(c2.center)
-			if centerDiff != 0 {
-				return centerDiff < 0
-			}
-			for cfIdx:=0; cfIdx < len(c1.centerFaces); cfIdx++ {
-				cfDiff := int(c1.centerFaces[cfIdx]) - int(c2.centerFaces[cfIdx])
-				if cfDiff != 0 {
-					return cfDiff < 0
-				}
-			}
-			for meIdx:=0; meIdx < len(c1.middleEdges); meIdx++ {
-				meDiff := int(c1.middleEdges[meIdx]) - int(c2.middleEdges[meIdx])
-				if meDiff != 0 {
-					return meDiff < 0
-				}
-			}
-			return false
+			return cl.allCubes[i].isLess(cl.allCubes[j])
 		})
 		for _, cube := range cl.allCubes {
 			key := CubeKeyId{growthCtx.GetId(), cube}
@@ -159,6 +151,22 @@ func (ppd *PointPackData) calculateAllContextCubes() map[CubeKeyId]int {
 	return res
 }
 
+// Populate far enough to get all the distinct cubes of the growth type
+func (cl *CubeListBuilder) populateAll() {
+	switch cl.growthCtx.GetGrowthType() {
+	case 1:
+		cl.populate(1)
+	case 3:
+		cl.populate(6)
+	case 2:
+		cl.populate(1)
+	case 4:
+		cl.populate(4)
+	case 8:
+		cl.populate(8)
+	}
+}
+
 func (cl *CubeListBuilder) populate(max CInt) {
 	allCubesMap := make(map[CubeOfTrioIndex]int)
 	// For center populate for all offsets
@@ -88,7 +88,10 @@ func (ppd *PointPackData) saveAllContextCubes() (int, error) {
 		return 0, err
 	}
 	if toFill {
-		cubeKeys := ppd.calculateAllContextCubes()
+		cubeKeys := ppd.cubeIdsPerKey
+		if !ppd.cubesLoaded {
+			cubeKeys = ppd.calculateAllContextCubes()
+		}
 		if Log.IsDebug() {
 			Log.Debugf("Populating table %s with %d elements", te.TableDef.Name, len(cubeKeys))
 		}
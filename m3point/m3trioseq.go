@@ -0,0 +1,423 @@
+package m3point
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3util"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/***************************************************************/
+// Type declaration
+/***************************************************************/
+
+// A cyclic sequence of base trios. Like the permutation growth contexts, the main point with div by three d
+// uses the base trio at index (d + offset) modulo the length.
+type TrioSequence []TrioIndex
+
+type TrioSequenceOptions struct {
+	MinLength, MaxLength int
+	// Use each base trio at most once, like AllMod4Permutations and AllMod8Permutations
+	DistinctTrios bool
+	// Number of growth steps from origin to score the sphericity, no scoring if 0
+	GrowthSteps int
+}
+
+// One class of equivalent sequences, represented by its canonical sequence
+type TrioSequenceResult struct {
+	Sequence TrioSequence
+	// Number of different sequences, by rotation and symmetry, equivalent to this one
+	NbEquivalent int
+	// Number of points reached and on the last growth step
+	NbPoints, NbLastPoints int
+	// One minus the relative standard deviation of the distance to origin of the last step points
+	Sphericity float64
+}
+
+// A growth context using a trio sequence, only used to calculate cubes and path builders on a detached environment
+type sequenceGrowthContext struct {
+	BaseGrowthContext
+	sequence TrioSequence
+}
+
+/***************************************************************/
+// Global fields declaration
+/***************************************************************/
+
+const (
+	MinTrioSequenceLength = 2
+	MaxTrioSequenceLength = 12
+)
+
+// The symmetries keeping all valid next trio valid, with their action on the 8 base trios
+var seqSymmetriesOnce sync.Once
+var seqSymmetries [][8]TrioIndex
+
+/***************************************************************/
+// TrioSequence Functions
+/***************************************************************/
+
+func (seq TrioSequence) String() string {
+	var sb strings.Builder
+	for i, trIdx := range seq {
+		if i > 0 {
+			sb.WriteString("-")
+		}
+		sb.WriteString(fmt.Sprintf("%d", trIdx))
+	}
+	return sb.String()
+}
+
+func isValidNextTrio(t1, t2 TrioIndex) bool {
+	if t1 > t2 {
+		t1, t2 = t2, t1
+	}
+	for _, validTrio := range validNextTrio {
+		if validTrio[0] == t1 && validTrio[1] == t2 {
+			return true
+		}
+	}
+	return false
+}
+
+// All base trios and each one followed by a valid next trio, the last one by the first one
+func (seq TrioSequence) IsValid() bool {
+	if len(seq) < MinTrioSequenceLength {
+		return false
+	}
+	for i, trIdx := range seq {
+		if !trIdx.IsBaseTrio() || !isValidNextTrio(trIdx, seq[(i+1)%len(seq)]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Not the repetition of a shorter sequence
+func (seq TrioSequence) IsPrimitive() bool {
+	for period := 1; period < len(seq); period++ {
+		if len(seq)%period != 0 {
+			continue
+		}
+		repeated := true
+		for i := period; i < len(seq) && repeated; i++ {
+			repeated = seq[i] == seq[i-period]
+		}
+		if repeated {
+			return false
+		}
+	}
+	return true
+}
+
+func (seq TrioSequence) isLess(o TrioSequence) bool {
+	for i := range seq {
+		if seq[i] != o[i] {
+			return seq[i] < o[i]
+		}
+	}
+	return false
+}
+
+// The sequence starting at index start, with the symmetry applied to all trios
+func (seq TrioSequence) transform(start int, sym [8]TrioIndex) TrioSequence {
+	res := make(TrioSequence, len(seq))
+	for i := range seq {
+		res[i] = sym[seq[(start+i)%len(seq)]]
+	}
+	return res
+}
+
+// The smallest of all the rotations and symmetry images of the sequence, with the number of different ones
+func (seq TrioSequence) GetCanonical() (TrioSequence, int) {
+	all := make(map[string]bool)
+	res := seq.transform(0, getSequenceSymmetries()[0])
+	for _, sym := range getSequenceSymmetries() {
+		for start := range seq {
+			other := seq.transform(start, sym)
+			all[other.String()] = true
+			if other.isLess(res) {
+				res = other
+			}
+		}
+	}
+	return res, len(all)
+}
+
+func (seq TrioSequence) isCanonical() bool {
+	for _, sym := range getSequenceSymmetries() {
+		for start := range seq {
+			for i := range seq {
+				other := sym[seq[(start+i)%len(seq)]]
+				if other != seq[i] {
+					if other < seq[i] {
+						return false
+					}
+					break
+				}
+			}
+		}
+	}
+	return true
+}
+
+func getSequenceSymmetries() [][8]TrioIndex {
+	seqSymmetriesOnce.Do(func() {
+		ppd := GetReferencePointPackData()
+		for _, s := range AllCubeSymmetries {
+			sym := [8]TrioIndex{}
+			for trIdx := TrioIndex(0); trIdx < 8; trIdx++ {
+				sym[trIdx] = ppd.ApplyToTrio(s, ppd.GetTrioDetails(trIdx)).GetId()
+			}
+			keepValid := true
+			for _, validTrio := range validNextTrio {
+				keepValid = keepValid && isValidNextTrio(sym[validTrio[0]], sym[validTrio[1]])
+			}
+			if keepValid {
+				seqSymmetries = append(seqSymmetries, sym)
+			}
+		}
+	})
+	return seqSymmetries
+}
+
+/***************************************************************/
+// Search Functions
+/***************************************************************/
+
+func ParseTrioSequenceOptions(args []string) (TrioSequenceOptions, error) {
+	opts := TrioSequenceOptions{}
+	fs := flag.NewFlagSet("trioseq", flag.ContinueOnError)
+	fs.IntVar(&opts.MinLength, "min", MinTrioSequenceLength, "minimum length of the sequences")
+	fs.IntVar(&opts.MaxLength, "max", 8, "maximum length of the sequences")
+	fs.BoolVar(&opts.DistinctTrios, "distinct", false, "use each base trio at most once")
+	fs.IntVar(&opts.GrowthSteps, "steps", 0, "number of growth steps to score the sphericity, no scoring if 0")
+	err := fs.Parse(args)
+	if err != nil {
+		return opts, err
+	}
+	if opts.MinLength < MinTrioSequenceLength || opts.MaxLength > MaxTrioSequenceLength || opts.MinLength > opts.MaxLength || opts.GrowthSteps < 0 {
+		return opts, fmt.Errorf("lengths %d to %d should be in [%d,%d] and steps %d positive",
+			opts.MinLength, opts.MaxLength, MinTrioSequenceLength, MaxTrioSequenceLength, opts.GrowthSteps)
+	}
+	return opts, nil
+}
+
+// All the valid primitive cyclic sequences, one per class of rotation and symmetry, ordered by length then sequence.
+// Since a valid next trio always changes between the base and prime sides, only even lengths have sequences.
+func SearchTrioSequences(opts TrioSequenceOptions) []*TrioSequenceResult {
+	res := make([]*TrioSequenceResult, 0)
+	for length := opts.MinLength; length <= opts.MaxLength; length++ {
+		found := make(map[string]*TrioSequenceResult)
+		current := make(TrioSequence, length)
+		// All base trios are equivalent by symmetry, so all classes have a sequence starting with 0
+		current[0] = 0
+		fillTrioSequence(current, 1, opts.DistinctTrios, found)
+		lengthRes := make([]*TrioSequenceResult, 0, len(found))
+		for _, r := range found {
+			lengthRes = append(lengthRes, r)
+		}
+		sort.Slice(lengthRes, func(i, j int) bool { return lengthRes[i].Sequence.isLess(lengthRes[j].Sequence) })
+		res = append(res, lengthRes...)
+	}
+	if opts.GrowthSteps > 0 {
+		for _, r := range res {
+			r.scoreGrowth(opts.GrowthSteps)
+		}
+	}
+	return res
+}
+
+func fillTrioSequence(current TrioSequence, pos int, distinct bool, found map[string]*TrioSequenceResult) {
+	if pos == len(current) {
+		if !isValidNextTrio(current[pos-1], current[0]) || !current.IsPrimitive() {
+			return
+		}
+		// Only keep the canonical one of each class, the other ones are found from it
+		if !current.isCanonical() {
+			return
+		}
+		canonical, nbEquivalent := current.GetCanonical()
+		found[canonical.String()] = &TrioSequenceResult{Sequence: canonical, NbEquivalent: nbEquivalent}
+		return
+	}
+	for next := TrioIndex(0); next < 8; next++ {
+		if !isValidNextTrio(current[pos-1], next) {
+			continue
+		}
+		if distinct {
+			used := false
+			for _, trIdx := range current[:pos] {
+				used = used || trIdx == next
+			}
+			if used {
+				continue
+			}
+		}
+		current[pos] = next
+		fillTrioSequence(current, pos+1, distinct, found)
+	}
+}
+
+/***************************************************************/
+// Growth Score Functions
+/***************************************************************/
+
+func (ctx *sequenceGrowthContext) String() string {
+	return fmt.Sprintf("SeqCtx-%s", ctx.sequence.String())
+}
+
+func (ctx *sequenceGrowthContext) GetBaseTrioIndex(divByThree uint64, offset int) TrioIndex {
+	return ctx.sequence[(divByThree+uint64(offset))%uint64(len(ctx.sequence))]
+}
+
+// A point pack data on a detached environment with the reference connections and trios, and only the
+// sequence growth context. Its cubes and path builders are added when reached.
+func makeSequencePointPackData(seq TrioSequence) (*PointPackData, *sequenceGrowthContext) {
+	refPpd := GetReferencePointPackData()
+	env := new(m3db.QsmEnvironment)
+	ppd := GetPointPackData(env)
+	ppd.allConnections, ppd.allConnectionsByVector = refPpd.allConnections, refPpd.allConnectionsByVector
+	ppd.connectionsLoaded = true
+	ppd.allTrioDetails = refPpd.allTrioDetails
+	ppd.trioDetailsLoaded = true
+	growthCtx := &sequenceGrowthContext{BaseGrowthContext{env, 0, GrowthType(len(seq)), 0}, seq}
+	ppd.allGrowthContexts = []GrowthContext{growthCtx}
+	ppd.growthContextsLoaded = true
+	ppd.cubeIdsPerKey = make(map[CubeKeyId]int)
+	ppd.cubesLoaded = true
+	ppd.pathBuilders = []*RootPathNodeBuilder{nil}
+	ppd.pathBuildersLoaded = true
+	return ppd, growthCtx
+}
+
+// Add the cube and its path builder at this main point if not already there
+func (ppd *PointPackData) ensureSequenceCube(growthCtx GrowthContext, mainPoint Point) *RootPathNodeBuilder {
+	key := CubeKeyId{growthCtx.GetId(), createTrioCube(growthCtx, 0, mainPoint)}
+	cubeId, ok := ppd.cubeIdsPerKey[key]
+	if ok {
+		return ppd.pathBuilders[cubeId]
+	}
+	cubeId = len(ppd.pathBuilders)
+	ppd.cubeIdsPerKey[key] = cubeId
+	root := RootPathNodeBuilder{}
+	root.ctx = &PathBuilderContext{growthCtx, cubeId}
+	root.populate()
+	ppd.pathBuilders = append(ppd.pathBuilders, &root)
+	return &root
+}
+
+// The connections to follow from a node using this path node builder
+func getOutgoingConnections(pnb PathNodeBuilder) []ConnectionId {
+	switch b := pnb.(type) {
+	case *RootPathNodeBuilder:
+		return []ConnectionId{b.pathLinks[0].connId, b.pathLinks[1].connId, b.pathLinks[2].connId}
+	case *IntermediatePathNodeBuilder:
+		return []ConnectionId{b.pathLinks[0].connId, b.pathLinks[1].connId}
+	case *LastIntermediatePathNodeBuilder:
+		return []ConnectionId{b.nextMainConnId, b.nextInterConnId}
+	}
+	Log.Fatalf("path node builder %s of unknown type", pnb.String())
+	return nil
+}
+
+// Grow from origin using the path builders of the sequence, each point only reached once
+func (r *TrioSequenceResult) scoreGrowth(nbSteps int) {
+	type growthNode struct {
+		p   Point
+		pnb PathNodeBuilder
+	}
+	ppd, growthCtx := makeSequencePointPackData(r.Sequence)
+	reached := map[Point]bool{Origin: true}
+	current := []growthNode{{Origin, ppd.ensureSequenceCube(growthCtx, Origin)}}
+	for step := 0; step < nbSteps; step++ {
+		next := make([]growthNode, 0, 2*len(current))
+		for _, node := range current {
+			if _, ok := node.pnb.(*LastIntermediatePathNodeBuilder); ok {
+				ppd.ensureSequenceCube(growthCtx, node.p.GetNearMainPoint())
+			}
+			for _, connId := range getOutgoingConnections(node.pnb) {
+				np := node.p.Add(ppd.GetConnDetailsById(connId).Vector)
+				if reached[np] {
+					continue
+				}
+				reached[np] = true
+				npnb, _ := node.pnb.GetNextPathNodeBuilder(node.p, connId, 0)
+				next = append(next, growthNode{np, npnb})
+			}
+		}
+		current = next
+	}
+	r.NbPoints = len(reached)
+	r.NbLastPoints = len(current)
+	if len(current) == 0 {
+		r.Sphericity = 0.0
+		return
+	}
+	sum, sumSquare := 0.0, 0.0
+	for _, node := range current {
+		d := math.Sqrt(float64(node.p.DistanceSquared()))
+		sum += d
+		sumSquare += d * d
+	}
+	mean := sum / float64(len(current))
+	stdDev := math.Sqrt(math.Max(0.0, sumSquare/float64(len(current))-mean*mean))
+	r.Sphericity = 1.0 - stdDev/mean
+}
+
+/***************************************************************/
+// Export Functions
+/***************************************************************/
+
+func GetTrioSequencesTableCsv(results []*TrioSequenceResult) [][]string {
+	res := make([][]string, 0, len(results)+1)
+	res = append(res, []string{"length", "sequence", "nbEquivalent", "nbPoints", "nbLastPoints", "sphericity"})
+	for _, r := range results {
+		res = append(res, []string{
+			fmt.Sprintf("%d", len(r.Sequence)),
+			r.Sequence.String(),
+			fmt.Sprintf("%d", r.NbEquivalent),
+			fmt.Sprintf("%d", r.NbPoints),
+			fmt.Sprintf("%d", r.NbLastPoints),
+			fmt.Sprintf("%.4f", r.Sphericity),
+		})
+	}
+	return res
+}
+
+// Search all the sequences and write them in text and CSV files in the generated docs dir
+func GenerateTrioSequencesFiles(args []string) error {
+	opts, err := ParseTrioSequenceOptions(args)
+	if err != nil {
+		return err
+	}
+	results := SearchTrioSequences(opts)
+	genDoc := m3util.GetGenDocDir()
+	txtFile := m3util.CreateFile(genDoc, "AllTrioSequences.txt")
+	csvFile := m3util.CreateFile(genDoc, "AllTrioSequences.csv")
+	defer m3util.CloseFile(txtFile)
+	defer m3util.CloseFile(csvFile)
+
+	m3util.WriteAll(csv.NewWriter(csvFile), GetTrioSequencesTableCsv(results))
+	m3util.WriteNextString(txtFile, fmt.Sprintf("Valid cyclic trio sequences of length %d to %d distinct=%v growth steps=%d\n",
+		opts.MinLength, opts.MaxLength, opts.DistinctTrios, opts.GrowthSteps))
+	length := 0
+	for i, r := range results {
+		if len(r.Sequence) != length {
+			length = len(r.Sequence)
+			m3util.WriteNextString(txtFile, fmt.Sprintf("\nLength %d\n", length))
+		}
+		line := fmt.Sprintf("%4d: %v x%d", i, []TrioIndex(r.Sequence), r.NbEquivalent)
+		if opts.GrowthSteps > 0 {
+			line += fmt.Sprintf(" points=%d last=%d sphericity=%.4f", r.NbPoints, r.NbLastPoints, r.Sphericity)
+		}
+		m3util.WriteNextString(txtFile, line+"\n")
+	}
+	Log.Infof("Generated %d trio sequences in %s", len(results), genDoc)
+	return nil
+}
@@ -0,0 +1,111 @@
+package m3point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTrioSequenceBasics(t *testing.T) {
+	assert.True(t, TrioSequence{0, 5, 2, 7}.IsValid())
+	assert.False(t, TrioSequence{0, 4}.IsValid())
+	assert.False(t, TrioSequence{0, 5, 1}.IsValid())
+	assert.False(t, TrioSequence{0}.IsValid())
+	assert.True(t, TrioSequence{0, 5, 2, 7}.IsPrimitive())
+	assert.False(t, TrioSequence{0, 5, 0, 5}.IsPrimitive())
+	assert.Equal(t, "0-5-2-7", TrioSequence{0, 5, 2, 7}.String())
+
+	for _, perm := range AllMod4Permutations {
+		assert.True(t, TrioSequence(perm[:]).IsValid())
+	}
+	for _, perm := range AllMod8Permutations {
+		assert.True(t, TrioSequence(perm[:]).IsValid())
+	}
+
+	// Rotation and symmetry give the same canonical sequence
+	seq := TrioSequence{2, 7, 0, 5}
+	canonical, nbEquivalent := seq.GetCanonical()
+	assert.Equal(t, TrioSequence{0, 5, 2, 7}, canonical)
+	assert.Equal(t, 48, nbEquivalent)
+	assert.Equal(t, TrioSequence{2, 7, 0, 5}, seq)
+	assert.True(t, canonical.isCanonical())
+	assert.False(t, seq.isCanonical())
+}
+
+func TestSearchTrioSequences(t *testing.T) {
+	results := SearchTrioSequences(TrioSequenceOptions{MinLength: 2, MaxLength: 8})
+	nbClasses := make(map[int]int)
+	nbSequences := make(map[int]int)
+	for _, r := range results {
+		assert.True(t, r.Sequence.IsValid(), "invalid %s", r.Sequence.String())
+		assert.True(t, r.Sequence.IsPrimitive(), "not primitive %s", r.Sequence.String())
+		nbClasses[len(r.Sequence)]++
+		nbSequences[len(r.Sequence)] += r.NbEquivalent
+	}
+	assert.Equal(t, map[int]int{2: 1, 4: 2, 6: 8, 8: 38}, nbClasses)
+	// All the primitive cycles are in one of the classes
+	for length := 2; length <= 6; length++ {
+		assert.Equal(t, countPrimitiveSequences(make(TrioSequence, length), 0), nbSequences[length], "wrong count for %d", length)
+	}
+
+	// The hand picked permutations are a single class each
+	distinct := SearchTrioSequences(TrioSequenceOptions{MinLength: 4, MaxLength: 8, DistinctTrios: true})
+	classes := make(map[string]bool)
+	for _, perm := range AllMod4Permutations {
+		canonical, _ := TrioSequence(perm[:]).GetCanonical()
+		classes[canonical.String()] = true
+	}
+	for _, perm := range AllMod8Permutations {
+		canonical, _ := TrioSequence(perm[:]).GetCanonical()
+		classes[canonical.String()] = true
+	}
+	assert.Equal(t, 2, len(classes))
+	for _, r := range distinct {
+		if len(r.Sequence) == 4 || len(r.Sequence) == 8 {
+			assert.True(t, classes[r.Sequence.String()], "missing %s", r.Sequence.String())
+		}
+	}
+}
+
+func TestTrioSequenceGrowthScore(t *testing.T) {
+	results := SearchTrioSequences(TrioSequenceOptions{MinLength: 2, MaxLength: 4, GrowthSteps: 12})
+	assert.Equal(t, 3, len(results))
+	for _, r := range results {
+		assert.True(t, r.NbPoints > r.NbLastPoints && r.NbLastPoints > 0, "no growth for %s", r.Sequence.String())
+		assert.True(t, r.Sphericity > 0.5 && r.Sphericity <= 1.0, "wrong sphericity %f for %s", r.Sphericity, r.Sequence.String())
+	}
+	table := GetTrioSequencesTableCsv(results)
+	assert.Equal(t, 4, len(table))
+	assert.Equal(t, "sequence", table[0][1])
+	assert.Equal(t, "0-5", table[1][1])
+
+	// Same growth than the existing context using the same sequence
+	ppd := GetReferencePointPackData()
+	growthCtx := ppd.GetGrowthContextByTypeAndIndex(2, 1)
+	seq := TrioSequence{growthCtx.GetBaseTrioIndex(0, 0), growthCtx.GetBaseTrioIndex(1, 0)}
+	seqPpd, seqCtx := makeSequencePointPackData(seq)
+	for _, mainPoint := range []Point{Origin, {3, 0, 0}, {3, -3, 6}} {
+		rpnb := seqPpd.ensureSequenceCube(seqCtx, mainPoint)
+		assert.Equal(t, ppd.GetPathNodeBuilder(growthCtx, 0, mainPoint).GetTrioIndex(), rpnb.GetTrioIndex())
+	}
+
+	_, err := ParseTrioSequenceOptions([]string{"-max", "13"})
+	assert.NotNil(t, err)
+	opts, err := ParseTrioSequenceOptions([]string{"-min", "4", "-distinct", "-steps", "5"})
+	assert.Nil(t, err)
+	assert.Equal(t, TrioSequenceOptions{4, 8, true, 5}, opts)
+}
+
+func countPrimitiveSequences(current TrioSequence, pos int) int {
+	if pos == len(current) {
+		if current.IsValid() && current.IsPrimitive() {
+			return 1
+		}
+		return 0
+	}
+	res := 0
+	for trIdx := TrioIndex(0); trIdx < 8; trIdx++ {
+		current[pos] = trIdx
+		res += countPrimitiveSequences(current, pos+1)
+	}
+	return res
+}
@@ -0,0 +1,415 @@
+package m3point
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"strings"
+)
+
+// The result of one structural check: how many elements were checked and all the failures found
+type VerifyCheck struct {
+	Name      string
+	NbChecked int
+	Failures  []string
+}
+
+// All the structural checks of the point pack data of an environment
+type VerifyReport struct {
+	EnvId  m3db.QsmEnvID
+	Checks []*VerifyCheck
+}
+
+// How far from origin main points are used to verify the path builders closure
+const verifyClosureMax = CInt(2)
+
+/***************************************************************/
+// Verify Functions
+/***************************************************************/
+
+// Run all the structural checks on the point pack data of the environment, without exiting on the first failure.
+// For a nil environment the embedded reference data is verified, and compared to the calculated one instead of the DB.
+func VerifyPointPackData(env *m3db.QsmEnvironment) *VerifyReport {
+	var ppd *PointPackData
+	if env == nil {
+		ppd = GetReferencePointPackData()
+	} else {
+		InitializeDBEnv(env, false)
+		ppd = GetPointPackData(env)
+	}
+	report := ppd.verifyStructure()
+	report.Checks = append(report.Checks, ppd.verifySameAsCalculated())
+	return report
+}
+
+// All the checks not comparing to the calculated data
+func (ppd *PointPackData) verifyStructure() *VerifyReport {
+	report := &VerifyReport{EnvId: ppd.GetId()}
+	report.Checks = append(report.Checks,
+		ppd.verifyConnections(),
+		ppd.verifyTrios(),
+		verifyValidNextTrio(),
+		ppd.verifyCubes(),
+		ppd.verifyPathBuilders(),
+	)
+	return report
+}
+
+func logVerifyFailures(failures []string) {
+	for _, failure := range failures {
+		Log.Error(failure)
+	}
+}
+
+/***************************************************************/
+// VerifyReport Functions
+/***************************************************************/
+
+func (check *VerifyCheck) addFailure(format string, args ...interface{}) {
+	check.Failures = append(check.Failures, fmt.Sprintf(format, args...))
+}
+
+func (check *VerifyCheck) IsOk() bool {
+	return len(check.Failures) == 0
+}
+
+func (report *VerifyReport) IsOk() bool {
+	return report.GetNbFailures() == 0
+}
+
+func (report *VerifyReport) GetNbFailures() int {
+	res := 0
+	for _, check := range report.Checks {
+		res += len(check.Failures)
+	}
+	return res
+}
+
+// Nil if all checks passed, otherwise an error with the number of failures
+func (report *VerifyReport) GetError() error {
+	nbFailures := report.GetNbFailures()
+	if nbFailures == 0 {
+		return nil
+	}
+	return m3db.MakeQsmErrorf("verification of environment %d failed with %d failures", report.EnvId, nbFailures)
+}
+
+func (report *VerifyReport) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Verification of environment %d\n", report.EnvId))
+	for _, check := range report.Checks {
+		status := "OK"
+		if !check.IsOk() {
+			status = "FAILED"
+		}
+		sb.WriteString(fmt.Sprintf("%-8s %-24s checked %6d failures %d\n", status, check.Name, check.NbChecked, len(check.Failures)))
+		for _, failure := range check.Failures {
+			sb.WriteString(fmt.Sprintf("\t%s\n", failure))
+		}
+	}
+	return sb.String()
+}
+
+/***************************************************************/
+// Checks Functions
+/***************************************************************/
+
+// Each connection has its negation with the opposite id and vector, and is found by id and vector
+func (ppd *PointPackData) verifyConnections() *VerifyCheck {
+	check := &VerifyCheck{Name: "connections"}
+	ppd.checkConnInitialized()
+	if len(ppd.allConnections) != len(ppd.allConnectionsByVector) {
+		check.addFailure("%d connections but %d per vector", len(ppd.allConnections), len(ppd.allConnectionsByVector))
+	}
+	for _, cd := range ppd.allConnections {
+		check.NbChecked++
+		if !cd.IsValid() {
+			check.addFailure("connection %v has nil id", cd.Vector)
+			continue
+		}
+		if ppd.GetConnDetailsById(cd.GetId()) != cd {
+			check.addFailure("connection %s is not found by its id", cd.String())
+		}
+		if ppd.allConnectionsByVector[cd.Vector] != cd {
+			check.addFailure("connection %s is not found by its vector %v", cd.String(), cd.Vector)
+		}
+		if cd.ConnDS != cd.Vector.DistanceSquared() {
+			check.addFailure("connection %s has DS %d but vector %v has %d", cd.String(), cd.ConnDS, cd.Vector, cd.Vector.DistanceSquared())
+		}
+		negCd, ok := ppd.allConnectionsByVector[cd.Vector.Neg()]
+		if !ok {
+			check.addFailure("connection %s has no negation for %v", cd.String(), cd.Vector.Neg())
+			continue
+		}
+		if negCd.GetId() != cd.GetNegId() {
+			check.addFailure("connection %s negation vector %v has id %s", cd.String(), negCd.Vector, negCd.String())
+		}
+		if negCd.ConnDS != cd.ConnDS {
+			check.addFailure("connection %s and its negation %s have different DS %d %d", cd.String(), negCd.String(), cd.ConnDS, negCd.ConnDS)
+		}
+	}
+	return check
+}
+
+// Each trio has 3 different ordered connections, the 8 first ones being the base trios, and is unique
+func (ppd *PointPackData) verifyTrios() *VerifyCheck {
+	check := &VerifyCheck{Name: "trios"}
+	ppd.checkTrioInitialized()
+	allConns := make(map[[3]ConnectionId]TrioIndex, len(ppd.allTrioDetails))
+	for i, td := range ppd.allTrioDetails {
+		check.NbChecked++
+		if td.GetId() != TrioIndex(i) {
+			check.addFailure("trio %s is at index %d", td.String(), i)
+		}
+		connIds := [3]ConnectionId{}
+		allBase := true
+		for j, cd := range td.conns {
+			if cd == nil || !cd.IsValid() {
+				check.addFailure("trio %s has no connection %d", td.GetId(), j)
+				continue
+			}
+			connIds[j] = cd.GetId()
+			allBase = allBase && cd.IsBaseConnection()
+		}
+		for j := 0; j < 2; j++ {
+			if connIds[j].GetPosConnectionId() > connIds[j+1].GetPosConnectionId() {
+				check.addFailure("trio %s connections %v not ordered", td.GetId(), connIds)
+			}
+			for k := j + 1; k < 3; k++ {
+				if connIds[j] == connIds[k] {
+					check.addFailure("trio %s connections %v are not all different", td.GetId(), connIds)
+				}
+			}
+		}
+		if td.IsBaseTrio() != allBase {
+			check.addFailure("trio %s is base %v but has all base connections %v", td.GetId(), td.IsBaseTrio(), allBase)
+		}
+		if td.IsBaseTrio() {
+			sum := Origin
+			for _, cd := range td.conns {
+				if cd != nil {
+					sum = sum.Add(cd.Vector)
+				}
+			}
+			if sum != Origin {
+				check.addFailure("base trio %s connections sum is %v", td.GetId(), sum)
+			}
+		}
+		other, ok := allConns[connIds]
+		if ok {
+			check.addFailure("trio %s has the same connections %v than %s", td.GetId(), connIds, other)
+		}
+		allConns[connIds] = td.GetId()
+	}
+	return check
+}
+
+// The valid next trio pairs are never prime, and the permutations only go through valid next trio
+func verifyValidNextTrio() *VerifyCheck {
+	check := &VerifyCheck{Name: "valid next trio"}
+	validPairs := make(map[[2]TrioIndex]bool, len(validNextTrio))
+	idxCount := make(map[TrioIndex]int, 8)
+	for i, nextTrio := range validNextTrio {
+		check.NbChecked++
+		if nextTrio[0] >= 4 || nextTrio[1] < 4 || !nextTrio[1].IsBaseTrio() {
+			check.addFailure("valid next trio %d %v is not a base trio and a prime side base trio", i, nextTrio)
+			continue
+		}
+		if isPrime(nextTrio[0], nextTrio[1]) {
+			check.addFailure("valid next trio %d %v is prime", i, nextTrio)
+		}
+		if validPairs[nextTrio] {
+			check.addFailure("valid next trio %d %v is duplicated", i, nextTrio)
+		}
+		validPairs[nextTrio] = true
+		idxCount[nextTrio[0]]++
+		idxCount[nextTrio[1]]++
+	}
+	for trIdx := TrioIndex(0); trIdx < 8; trIdx++ {
+		if idxCount[trIdx] != 3 {
+			check.addFailure("base trio %s used %d times in valid next trio instead of 3", trIdx, idxCount[trIdx])
+		}
+	}
+	isValidPair := func(t1, t2 TrioIndex) bool {
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		return validPairs[[2]TrioIndex{t1, t2}]
+	}
+	for i, perm := range AllMod4Permutations {
+		check.NbChecked++
+		for j := range perm {
+			if !isValidPair(perm[j], perm[(j+1)%len(perm)]) {
+				check.addFailure("mod4 permutation %d %v index %d is not a valid next trio", i, perm, j)
+			}
+		}
+	}
+	for i, perm := range AllMod8Permutations {
+		check.NbChecked++
+		used := make(map[TrioIndex]bool, len(perm))
+		for j := range perm {
+			used[perm[j]] = true
+			if !isValidPair(perm[j], perm[(j+1)%len(perm)]) {
+				check.addFailure("mod8 permutation %d %v index %d is not a valid next trio", i, perm, j)
+			}
+		}
+		if len(used) != 8 {
+			check.addFailure("mod8 permutation %d %v does not use all 8 base trios", i, perm)
+		}
+	}
+	return check
+}
+
+// Each growth context has the calculated cubes, the cube ids going from 1 to TotalNumberOfCubes
+func (ppd *PointPackData) verifyCubes() *VerifyCheck {
+	check := &VerifyCheck{Name: "cubes"}
+	ppd.checkCubesInitialized()
+	if len(ppd.cubeIdsPerKey) != TotalNumberOfCubes {
+		check.addFailure("%d cubes instead of %d", len(ppd.cubeIdsPerKey), TotalNumberOfCubes)
+	}
+	usedIds := make(map[int]bool, len(ppd.cubeIdsPerKey))
+	for _, cubeId := range ppd.cubeIdsPerKey {
+		if cubeId < 1 || cubeId > TotalNumberOfCubes || usedIds[cubeId] {
+			check.addFailure("cube id %d is out of range or duplicated", cubeId)
+		}
+		usedIds[cubeId] = true
+	}
+	for _, growthCtx := range ppd.GetAllGrowthContexts() {
+		check.NbChecked++
+		calculated := CubeListBuilder{growthCtx, nil}
+		calculated.populateAll()
+		existing := ppd.getCubeList(growthCtx)
+		if len(calculated.allCubes) != len(existing.allCubes) {
+			check.addFailure("%s has %d cubes but %d calculated", growthCtx.String(), len(existing.allCubes), len(calculated.allCubes))
+		}
+		for _, cube := range calculated.allCubes {
+			_, ok := ppd.cubeIdsPerKey[CubeKeyId{growthCtx.GetId(), cube}]
+			if !ok {
+				check.addFailure("%s calculated cube %s does not exists", growthCtx.String(), cube.String())
+			}
+		}
+	}
+	return check
+}
+
+// Each cube has a path builder with correct links, and following the last intermediate nodes from main points
+// around origin always gets to a next main point builder going back to the same last intermediate point.
+func (ppd *PointPackData) verifyPathBuilders() *VerifyCheck {
+	check := &VerifyCheck{Name: "path builders closure"}
+	ppd.checkPathBuildersInitialized()
+	if len(ppd.pathBuilders) != TotalNumberOfCubes+1 {
+		check.addFailure("%d path builders instead of %d", len(ppd.pathBuilders)-1, TotalNumberOfCubes)
+		return check
+	}
+	for cubeKey, cubeId := range ppd.cubeIdsPerKey {
+		check.NbChecked++
+		rpnb := ppd.pathBuilders[cubeId]
+		if rpnb == nil {
+			check.addFailure("cube %d has no path builder", cubeId)
+			continue
+		}
+		if rpnb.ctx.cubeId != cubeId || rpnb.ctx.growthCtx.GetId() != cubeKey.trCtxId || rpnb.trIdx != cubeKey.cube.center {
+			check.addFailure("%s does not match cube %d of context %d center %s", rpnb.String(), cubeId, cubeKey.trCtxId, cubeKey.cube.center)
+		}
+		check.Failures = append(check.Failures, rpnb.checkLinks()...)
+		for _, pl := range rpnb.pathLinks {
+			ipnb, ok := pl.pathNode.(*IntermediatePathNodeBuilder)
+			if !ok {
+				check.addFailure("%s link %s is not an intermediate node", rpnb.String(), pl.connId)
+				continue
+			}
+			check.Failures = append(check.Failures, ipnb.checkLinks()...)
+			for _, ipl := range ipnb.pathLinks {
+				lipnb, ok := ipl.pathNode.(*LastIntermediatePathNodeBuilder)
+				if !ok {
+					check.addFailure("%s link %s is not a last intermediate node", ipnb.String(), ipl.connId)
+					continue
+				}
+				check.Failures = append(check.Failures, lipnb.checkLinks()...)
+			}
+		}
+	}
+	if !check.IsOk() {
+		// Closure needs all the links to be correct
+		return check
+	}
+	for _, growthCtx := range ppd.GetAllGrowthContexts() {
+		maxOffset := growthCtx.GetGrowthType().GetMaxOffset()
+		for offset := 0; offset < maxOffset; offset++ {
+			for x := -verifyClosureMax; x <= verifyClosureMax; x++ {
+				for y := -verifyClosureMax; y <= verifyClosureMax; y++ {
+					for z := -verifyClosureMax; z <= verifyClosureMax; z++ {
+						ppd.verifyClosureAt(check, growthCtx, offset, Point{x, y, z}.Mul(THREE))
+					}
+				}
+			}
+		}
+	}
+	return check
+}
+
+func (ppd *PointPackData) getRootBuilderAt(growthCtx GrowthContext, offset int, mainPoint Point) *RootPathNodeBuilder {
+	cubeId, ok := ppd.cubeIdsPerKey[CubeKeyId{growthCtx.GetId(), createTrioCube(growthCtx, offset, mainPoint)}]
+	if !ok {
+		return nil
+	}
+	return ppd.pathBuilders[cubeId]
+}
+
+func (ppd *PointPackData) verifyClosureAt(check *VerifyCheck, growthCtx GrowthContext, offset int, mainPoint Point) {
+	check.NbChecked++
+	rpnb := ppd.getRootBuilderAt(growthCtx, offset, mainPoint)
+	if rpnb == nil {
+		check.addFailure("%s offset %d has no path builder at %v", growthCtx.String(), offset, mainPoint)
+		return
+	}
+	for _, pl := range rpnb.pathLinks {
+		ip := mainPoint.Add(ppd.GetConnDetailsById(pl.connId).Vector)
+		ipnb := pl.pathNode.(*IntermediatePathNodeBuilder)
+		for _, ipl := range ipnb.pathLinks {
+			lip := ip.Add(ppd.GetConnDetailsById(ipl.connId).Vector)
+			lipnb := ipl.pathNode.(*LastIntermediatePathNodeBuilder)
+			nextMainPoint := lip.Add(ppd.GetConnDetailsById(lipnb.nextMainConnId).Vector)
+			if !nextMainPoint.IsMainPoint() {
+				check.addFailure("%s at %v next main connection %s goes to %v not a main point", lipnb.String(), lip, lipnb.nextMainConnId, nextMainPoint)
+				continue
+			}
+			nextRpnb := ppd.getRootBuilderAt(growthCtx, offset, nextMainPoint)
+			if nextRpnb == nil {
+				check.addFailure("%s offset %d has no path builder at next main point %v", growthCtx.String(), offset, nextMainPoint)
+				continue
+			}
+			var backIpnb *IntermediatePathNodeBuilder
+			for _, npl := range nextRpnb.pathLinks {
+				if npl.connId == lipnb.nextMainConnId.GetNegId() {
+					backIpnb = npl.pathNode.(*IntermediatePathNodeBuilder)
+				}
+			}
+			if backIpnb == nil {
+				check.addFailure("%s at %v has no way back from %s with %s", nextRpnb.String(), nextMainPoint, lipnb.String(), lipnb.nextMainConnId.GetNegId())
+				continue
+			}
+			foundInter := false
+			for _, bpl := range backIpnb.pathLinks {
+				foundInter = foundInter || bpl.connId == lipnb.nextInterConnId
+			}
+			if !foundInter {
+				check.addFailure("%s at %v next intermediate connection %s is not a link of %s", lipnb.String(), lip, lipnb.nextInterConnId, backIpnb.String())
+			}
+		}
+	}
+}
+
+// The point pack data is the same as the one calculated
+func (ppd *PointPackData) verifySameAsCalculated() *VerifyCheck {
+	check := &VerifyCheck{Name: "db as calculated"}
+	if ppd.env == nil {
+		check.Name = "reference as calculated"
+	}
+	current := ppd.getAllReferenceRows()
+	calculated := calculatePointPackData().getAllReferenceRows()
+	for i, name := range pointPackRefTables {
+		check.NbChecked += len(calculated[i])
+		check.Failures = append(check.Failures, diffReferenceRows(name, current[i], calculated[i])...)
+	}
+	return check
+}
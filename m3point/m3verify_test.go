@@ -0,0 +1,47 @@
+package m3point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestVerifyReference(t *testing.T) {
+	Log.SetInfo()
+	report := VerifyPointPackData(nil)
+	assert.True(t, report.IsOk(), report.String())
+	assert.Nil(t, report.GetError())
+	assert.Equal(t, 6, len(report.Checks))
+	for _, check := range report.Checks {
+		assert.True(t, check.NbChecked > 0, "nothing checked for %s", check.Name)
+	}
+}
+
+func TestVerifyFindsAllFailures(t *testing.T) {
+	Log.SetInfo()
+	ppd := calculatePointPackData()
+	report := ppd.verifyStructure()
+	assert.True(t, report.IsOk(), report.String())
+
+	// Break one last intermediate node and a cube
+	lipnb := ppd.pathBuilders[1].pathLinks[0].pathNode.(*IntermediatePathNodeBuilder).pathLinks[0].pathNode.(*LastIntermediatePathNodeBuilder)
+	lipnb.nextInterConnId = lipnb.nextMainConnId
+	for cubeKey, cubeId := range ppd.cubeIdsPerKey {
+		if cubeId == TotalNumberOfCubes {
+			delete(ppd.cubeIdsPerKey, cubeKey)
+		}
+	}
+
+	report = ppd.verifyStructure()
+	assert.False(t, report.IsOk())
+	assert.NotNil(t, report.GetError())
+	failedChecks := make(map[string]int)
+	for _, check := range report.Checks {
+		failedChecks[check.Name] = len(check.Failures)
+	}
+	assert.Equal(t, 0, failedChecks["connections"])
+	assert.Equal(t, 0, failedChecks["trios"])
+	assert.Equal(t, 0, failedChecks["valid next trio"])
+	assert.True(t, failedChecks["cubes"] >= 2, report.String())
+	assert.True(t, failedChecks["path builders closure"] >= 1, report.String())
+	assert.Equal(t, report.GetNbFailures(), failedChecks["cubes"]+failedChecks["path builders closure"])
+}
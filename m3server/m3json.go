@@ -0,0 +1,301 @@
+package m3server
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3gl"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"strings"
+)
+
+type ErrorJson struct {
+	Error string `json:"error"`
+}
+
+type CreateSpaceRequest struct {
+	Max                     m3point.CInt `json:"max"`
+	MaxConnections          int          `json:"maxConnections"`
+	EventOutgrowthThreshold *int         `json:"eventOutgrowthThreshold"`
+}
+
+type CreateEventRequest struct {
+	GrowthType   m3point.GrowthType `json:"growthType"`
+	GrowthIndex  int                `json:"growthIndex"`
+	GrowthOffset int                `json:"growthOffset"`
+	Point        m3point.Point      `json:"point"`
+	Color        string             `json:"color"`
+}
+
+type ForwardRequest struct {
+	Steps int `json:"steps"`
+}
+
+type SpaceSettingsRequest struct {
+	EventOutgrowthThreshold *int `json:"eventOutgrowthThreshold"`
+}
+
+type SpaceJson struct {
+	Id                          int                 `json:"id"`
+	CurrentTime                 m3space.DistAndTime `json:"currentTime"`
+	Max                         m3point.CInt        `json:"max"`
+	MaxConnections              int                 `json:"maxConnections"`
+	EventOutgrowthThreshold     m3space.DistAndTime `json:"eventOutgrowthThreshold"`
+	EventOutgrowthOldThreshold  m3space.DistAndTime `json:"eventOutgrowthOldThreshold"`
+	EventOutgrowthDeadThreshold m3space.DistAndTime `json:"eventOutgrowthDeadThreshold"`
+	NbEvents                    int                 `json:"nbEvents"`
+	NbNodes                     int                 `json:"nbNodes"`
+	NbActiveNodes               int                 `json:"nbActiveNodes"`
+	NbActiveLinks               int                 `json:"nbActiveLinks"`
+}
+
+type EventJson struct {
+	Id            m3space.EventID     `json:"id"`
+	Color         string              `json:"color"`
+	GrowthType    m3point.GrowthType  `json:"growthType"`
+	GrowthIndex   int                 `json:"growthIndex"`
+	GrowthOffset  int                 `json:"growthOffset"`
+	Point         m3point.Point       `json:"point"`
+	Created       m3space.DistAndTime `json:"created"`
+	PathContextId int                 `json:"pathContextId"`
+}
+
+type NodeEventJson struct {
+	EventId      m3space.EventID     `json:"eventId"`
+	PathNodeId   int64               `json:"pathNodeId"`
+	D            int                 `json:"d"`
+	TrioIndex    m3point.TrioIndex   `json:"trioIndex"`
+	AccessedTime m3space.DistAndTime `json:"accessedTime"`
+}
+
+type NodeJson struct {
+	Point     m3point.Point   `json:"point"`
+	State     string          `json:"state"`
+	IsRoot    bool            `json:"isRoot"`
+	ColorMask uint8           `json:"colorMask"`
+	Events    []NodeEventJson `json:"events"`
+}
+
+type LinkJson struct {
+	Src    m3point.Point        `json:"src"`
+	Dst    m3point.Point        `json:"dst"`
+	ConnId m3point.ConnectionId `json:"connId"`
+}
+
+type MeetingPointsJson struct {
+	EventIds m3space.ThreeIds `json:"eventIds"`
+	Points   []m3point.Point  `json:"points"`
+}
+
+type ForwardResultJson struct {
+	Time          m3space.DistAndTime `json:"time"`
+	NbActiveNodes int                 `json:"nbActiveNodes"`
+	NbActiveLinks int                 `json:"nbActiveLinks"`
+	MeetingPoints []MeetingPointsJson `json:"meetingPoints"`
+}
+
+// Same options as the m3gl.SpaceDrawingFilter, passed as query parameters
+type DrawingFilterJson struct {
+	DisplayEmptyNodes                 bool  `json:"displayEmptyNodes"`
+	DisplayEmptyConnections           bool  `json:"displayEmptyConnections"`
+	EventColorMask                    uint8 `json:"eventColorMask"`
+	EventOutgrowthManyColorsThreshold uint8 `json:"eventOutgrowthManyColorsThreshold"`
+}
+
+type DrawingObjectJson struct {
+	Type       m3gl.ObjectType `json:"type"`
+	Offset     int32           `json:"offset"`
+	NbVertices int32           `json:"nbVertices"`
+}
+
+// The default m3gl camera for the space size, and how it moves with the mouse
+type CameraJson struct {
+	FovAngle      float64    `json:"fovAngle"`
+	EyeDist       float64    `json:"eyeDist"`
+	EyeDistMin    float64    `json:"eyeDistMin"`
+	EyeDistMax    float64    `json:"eyeDistMax"`
+	TopCornerDist float64    `json:"topCornerDist"`
+	Yaw           float64    `json:"yaw"`
+	Pitch         float64    `json:"pitch"`
+	Target        [3]float32 `json:"target"`
+	OrbitPerPixel float64    `json:"orbitPerPixel"`
+	ZoomPerStep   float64    `json:"zoomPerStep"`
+}
+
+// The triangles of all the object types drawn at the origin, in one PPPNNN vertices buffer
+type DrawingObjectsJson struct {
+	Max              m3point.CInt        `json:"max"`
+	FloatPerVertices int                 `json:"floatPerVertices"`
+	Camera           CameraJson          `json:"camera"`
+	Objects          []DrawingObjectJson `json:"objects"`
+	Vertices         []float32           `json:"vertices"`
+}
+
+// One object to draw at pos, with the color and dimmer for each blinker value
+type DrawingElementJson struct {
+	Type    m3gl.ObjectType        `json:"type"`
+	Pos     m3point.Point          `json:"pos"`
+	Colors  [NbBlinkValues]int32   `json:"colors"`
+	Dimmers [NbBlinkValues]float32 `json:"dimmers"`
+}
+
+type DrawingJson struct {
+	Time                    m3space.DistAndTime  `json:"time"`
+	Max                     m3point.CInt         `json:"max"`
+	EventOutgrowthThreshold m3space.DistAndTime  `json:"eventOutgrowthThreshold"`
+	Filter                  DrawingFilterJson    `json:"filter"`
+	NbElements              int                  `json:"nbElements"`
+	Elements                []DrawingElementJson `json:"elements"`
+}
+
+/***************************************************************/
+// Conversion Functions
+/***************************************************************/
+
+func getColorName(k m3space.EventColor) string {
+	switch k {
+	case m3space.RedEvent:
+		return "red"
+	case m3space.GreenEvent:
+		return "green"
+	case m3space.BlueEvent:
+		return "blue"
+	case m3space.YellowEvent:
+		return "yellow"
+	}
+	return fmt.Sprintf("%d", k)
+}
+
+func parseColor(s string) (m3space.EventColor, error) {
+	for _, k := range m3space.AllColors {
+		if strings.EqualFold(s, getColorName(k)) || s == fmt.Sprintf("%d", k) {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("event color %q unknown, should be one of red, green, blue, yellow", s)
+}
+
+func getNodeStateName(space *m3space.Space, n m3space.Node) string {
+	if n.IsEmpty() {
+		return "empty"
+	}
+	if n.IsActive(space) {
+		return "active"
+	}
+	if n.IsDead(space) {
+		return "dead"
+	}
+	if n.IsOld(space) {
+		return "old"
+	}
+	return "inactive"
+}
+
+func makeSpaceJson(id int, space *m3space.Space) SpaceJson {
+	return SpaceJson{
+		Id:                          id,
+		CurrentTime:                 space.GetCurrentTime(),
+		Max:                         space.Max,
+		MaxConnections:              space.MaxConnections,
+		EventOutgrowthThreshold:     space.EventOutgrowthThreshold,
+		EventOutgrowthOldThreshold:  space.EventOutgrowthOldThreshold,
+		EventOutgrowthDeadThreshold: space.EventOutgrowthDeadThreshold,
+		NbEvents:                    space.GetNbEvents(),
+		NbNodes:                     space.GetNbNodes(),
+		NbActiveNodes:               space.GetNbActiveNodes(),
+		NbActiveLinks:               space.GetNbActiveLinks(),
+	}
+}
+
+func makeEventJson(evt *m3space.Event) EventJson {
+	pathCtx := evt.GetPathContext()
+	return EventJson{
+		Id:            evt.GetId(),
+		Color:         getColorName(evt.GetColor()),
+		GrowthType:    pathCtx.GetGrowthType(),
+		GrowthIndex:   pathCtx.GetGrowthIndex(),
+		GrowthOffset:  pathCtx.GetGrowthOffset(),
+		Point:         *evt.GetNode().GetPoint(),
+		Created:       evt.GetCreated(),
+		PathContextId: pathCtx.GetId(),
+	}
+}
+
+func makeNodeJson(space *m3space.Space, n m3space.Node) NodeJson {
+	res := NodeJson{
+		Point:     *n.GetPoint(),
+		State:     getNodeStateName(space, n),
+		IsRoot:    n.HasRoot(space),
+		ColorMask: n.GetColorMask(space),
+		Events:    make([]NodeEventJson, 0, n.GetNbEvents()),
+	}
+	for _, evt := range space.GetEvents() {
+		pn := n.GetPathNode(evt.GetId())
+		if pn == nil {
+			continue
+		}
+		res.Events = append(res.Events, NodeEventJson{
+			EventId:      evt.GetId(),
+			PathNodeId:   pn.GetId(),
+			D:            pn.D(),
+			TrioIndex:    pn.GetTrioIndex(),
+			AccessedTime: m3space.DistAndTime(pn.D()) + evt.GetCreated(),
+		})
+	}
+	return res
+}
+
+func makeCameraJson(c m3gl.Camera) CameraJson {
+	return CameraJson{
+		FovAngle:      c.FovAngle.Val,
+		EyeDist:       c.EyeDist.Val,
+		EyeDistMin:    c.EyeDist.Min,
+		EyeDistMax:    c.EyeDist.Max,
+		TopCornerDist: c.TopCornerDist,
+		Yaw:           c.Yaw,
+		Pitch:         c.Pitch,
+		Target:        c.Target,
+		OrbitPerPixel: m3gl.OrbitPerPixel,
+		ZoomPerStep:   m3gl.ZoomPerStep,
+	}
+}
+
+func makeLinkJson(ppd *m3point.PointPackData, nl m3space.NodeLink) LinkJson {
+	src := nl.GetSrc()
+	return LinkJson{
+		Src:    src,
+		Dst:    src.Add(ppd.GetConnDetailsById(nl.GetConnId()).Vector),
+		ConnId: nl.GetConnId(),
+	}
+}
+
+func makeMeetingPointsJson(res *m3space.ForwardResult) []MeetingPointsJson {
+	pointsPerThreeIds := res.GetPointsPerThreeIds()
+	allIds := make([]m3space.ThreeIds, 0, len(pointsPerThreeIds))
+	for tIds := range pointsPerThreeIds {
+		allIds = append(allIds, tIds)
+	}
+	m3space.SortThreeIds(allIds)
+	meetings := make([]MeetingPointsJson, len(allIds))
+	for i, tIds := range allIds {
+		meetings[i] = MeetingPointsJson{tIds, pointsPerThreeIds[tIds]}
+	}
+	return meetings
+}
+
+func makeForwardResultJson(time m3space.DistAndTime, nbActiveNodes, nbActiveLinks int, res *m3space.ForwardResult) ForwardResultJson {
+	return ForwardResultJson{
+		Time:          time,
+		NbActiveNodes: nbActiveNodes,
+		NbActiveLinks: nbActiveLinks,
+		MeetingPoints: makeMeetingPointsJson(res),
+	}
+}
+
+func makeDrawingElementJson(e m3gl.SpaceDrawingElement) DrawingElementJson {
+	res := DrawingElementJson{Type: e.Key(), Pos: *e.Pos()}
+	for i := 0; i < NbBlinkValues; i++ {
+		res.Colors[i] = e.Color(float64(i))
+		res.Dimmers[i] = e.Dimmer(float64(i))
+	}
+	return res
+}
@@ -0,0 +1,530 @@
+package m3server
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/freddy33/qsm-go/m3util"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var Log = m3util.NewLogger("m3server", m3util.INFO)
+
+const (
+	DefaultAddress = "localhost:8063"
+	// Protection against stepping for ever in one request
+	MaxStepsPerRequest = 100
+	MaxStepsPerRun     = 1000
+	// Only the latest forward results are kept per space
+	MaxStoredResults = 100
+	DefaultSpaceMax  = m3point.CInt(9 * m3point.THREE)
+)
+
+// One space driven by the HTTP API. All the accesses to the space go through the mutex.
+type SpaceHolder struct {
+	mutex sync.Mutex
+	id    int
+	space *m3space.Space
+	// The latest forward results ordered by time, at most MaxStoredResults
+	results []ForwardResultJson
+	hub     *StreamHub
+	running bool
+	// Set when the space is deleted, a background run stops at the next step
+	stopped bool
+}
+
+type Server struct {
+	env    *m3db.QsmEnvironment
+	mutex  sync.RWMutex
+	lastId int
+	spaces map[int]*SpaceHolder
+}
+
+func MakeServer(env *m3db.QsmEnvironment) *Server {
+	srv := new(Server)
+	srv.env = env
+	srv.lastId = 0
+	srv.spaces = make(map[int]*SpaceHolder)
+	return srv
+}
+
+// Initialize the DB of the environment and serve the HTTP API until failure
+func Serve(env *m3db.QsmEnvironment, addr string) error {
+	m3path.InitializeDBEnv(env)
+	srv := MakeServer(env)
+	Log.Infof("QSM server listening on http://%s", addr)
+	return http.ListenAndServe(addr, srv)
+}
+
+/***************************************************************/
+// Server Functions
+/***************************************************************/
+
+func (srv *Server) getSpaceHolder(idStr string) *SpaceHolder {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return nil
+	}
+	srv.mutex.RLock()
+	defer srv.mutex.RUnlock()
+	return srv.spaces[id]
+}
+
+func (srv *Server) addSpace(space *m3space.Space) *SpaceHolder {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	srv.lastId++
+	sh := SpaceHolder{id: srv.lastId, space: space, results: make([]ForwardResultJson, 0, MaxStoredResults)}
+	sh.hub = MakeStreamHub()
+	space.AddListener(makeDeltaCollector(sh.hub))
+	srv.spaces[sh.id] = &sh
+	return &sh
+}
+
+func (srv *Server) removeSpace(id int) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+	sh, ok := srv.spaces[id]
+	if ok {
+		sh.mutex.Lock()
+		sh.stopped = true
+		sh.mutex.Unlock()
+		sh.hub.Close()
+		delete(srv.spaces, id)
+	}
+}
+
+func (srv *Server) getAllSpaceHolders() []*SpaceHolder {
+	srv.mutex.RLock()
+	defer srv.mutex.RUnlock()
+	res := make([]*SpaceHolder, 0, len(srv.spaces))
+	for _, sh := range srv.spaces {
+		res = append(res, sh)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].id < res[j].id
+	})
+	return res
+}
+
+// Routes:
+//
+//	/ or /viewer                     GET the browser 3D viewer
+//	/spaces                          GET list, POST create
+//	/spaces/{id}                     GET, DELETE
+//	/spaces/{id}/events              GET list, POST create
+//	/spaces/{id}/settings            POST change the event outgrowth threshold
+//	/spaces/{id}/forward             POST step time
+//	/spaces/{id}/run                 POST step time in the background
+//	/spaces/{id}/stream              GET WebSocket of the forward time deltas
+//	/spaces/{id}/nodes?point=x,y,z   GET node at point
+//	/spaces/{id}/nodes/active        GET active nodes
+//	/spaces/{id}/links/active        GET active links
+//	/spaces/{id}/results             GET the last 100 forward results
+//	/spaces/{id}/results/{time}      GET forward result at time if in the last 100, or latest
+//	/spaces/{id}/drawing?filter      GET the filtered drawing elements
+//	/spaces/{id}/drawing/objects     GET the triangles of each drawing object type
+func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) == 1 && (parts[0] == "" || parts[0] == "viewer") {
+		srv.serveViewer(w, r)
+		return
+	}
+	if parts[0] != "spaces" {
+		writeError(w, http.StatusNotFound, "unknown path %s", r.URL.Path)
+		return
+	}
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			srv.listSpaces(w)
+		case http.MethodPost:
+			srv.createSpace(w, r)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+		return
+	}
+	sh := srv.getSpaceHolder(parts[1])
+	if sh == nil {
+		writeError(w, http.StatusNotFound, "space %s not found", parts[1])
+		return
+	}
+	route := strings.Join(parts[2:], "/")
+	switch {
+	case route == "":
+		switch r.Method {
+		case http.MethodGet:
+			sh.getSpace(w)
+		case http.MethodDelete:
+			srv.removeSpace(sh.id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case route == "events":
+		switch r.Method {
+		case http.MethodGet:
+			sh.listEvents(w)
+		case http.MethodPost:
+			sh.createEvent(w, r)
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case route == "settings":
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		sh.updateSettings(w, r)
+	case route == "forward":
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		sh.forward(w, r)
+	case route == "run":
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		sh.run(w, r)
+	case route == "stream" && r.Method == http.MethodGet:
+		sh.stream(w, r)
+	case route == "nodes" && r.Method == http.MethodGet:
+		sh.getNode(w, r)
+	case route == "nodes/active" && r.Method == http.MethodGet:
+		sh.listActiveNodes(w)
+	case route == "links/active" && r.Method == http.MethodGet:
+		sh.listActiveLinks(w)
+	case route == "results" && r.Method == http.MethodGet:
+		sh.listResults(w)
+	case len(parts) == 4 && parts[2] == "results" && r.Method == http.MethodGet:
+		sh.getResult(w, parts[3])
+	case route == "drawing" && r.Method == http.MethodGet:
+		sh.getDrawing(w, r)
+	case route == "drawing/objects" && r.Method == http.MethodGet:
+		sh.getDrawingObjects(w)
+	default:
+		writeError(w, http.StatusNotFound, "unknown path %s for %s", r.URL.Path, r.Method)
+	}
+}
+
+func (srv *Server) listSpaces(w http.ResponseWriter) {
+	all := srv.getAllSpaceHolders()
+	res := make([]SpaceJson, len(all))
+	for i, sh := range all {
+		sh.mutex.Lock()
+		res[i] = makeSpaceJson(sh.id, sh.space)
+		sh.mutex.Unlock()
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (srv *Server) createSpace(w http.ResponseWriter, r *http.Request) {
+	req := CreateSpaceRequest{}
+	if !readJson(w, r, &req) {
+		return
+	}
+	if req.Max < 0 || req.MaxConnections < 0 {
+		writeError(w, http.StatusBadRequest, "max %d and max connections %d cannot be negative", req.Max, req.MaxConnections)
+		return
+	}
+	if req.EventOutgrowthThreshold != nil && *req.EventOutgrowthThreshold < 0 {
+		writeError(w, http.StatusBadRequest, "event outgrowth threshold %d cannot be negative", *req.EventOutgrowthThreshold)
+		return
+	}
+	if req.Max == 0 {
+		req.Max = DefaultSpaceMax
+	}
+	space := m3space.MakeSpace(srv.env, req.Max)
+	if req.MaxConnections > 0 {
+		space.MaxConnections = req.MaxConnections
+	}
+	if req.EventOutgrowthThreshold != nil {
+		space.SetEventOutgrowthThreshold(m3space.DistAndTime(*req.EventOutgrowthThreshold))
+	}
+	sh := srv.addSpace(&space)
+	Log.Infof("created space %d with max %d", sh.id, req.Max)
+	writeJson(w, http.StatusCreated, makeSpaceJson(sh.id, sh.space))
+}
+
+/***************************************************************/
+// SpaceHolder Functions
+/***************************************************************/
+
+func (sh *SpaceHolder) getSpace(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	writeJson(w, http.StatusOK, makeSpaceJson(sh.id, sh.space))
+}
+
+func (sh *SpaceHolder) listEvents(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	events := sh.space.GetEvents()
+	res := make([]EventJson, len(events))
+	for i, evt := range events {
+		res[i] = makeEventJson(evt)
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func validateEventRequest(req *CreateEventRequest) (m3space.EventColor, error) {
+	validType := false
+	for _, t := range m3point.GetAllContextTypes() {
+		if t == req.GrowthType {
+			validType = true
+		}
+	}
+	if !validType {
+		return 0, fmt.Errorf("growth type %d invalid, should be one of %v", req.GrowthType, m3point.GetAllContextTypes())
+	}
+	if req.GrowthIndex < 0 || req.GrowthIndex >= req.GrowthType.GetNbIndexes() {
+		return 0, fmt.Errorf("growth index %d invalid for type %d, should be in [0,%d[", req.GrowthIndex, req.GrowthType, req.GrowthType.GetNbIndexes())
+	}
+	if req.GrowthOffset < 0 || req.GrowthOffset >= req.GrowthType.GetMaxOffset() {
+		return 0, fmt.Errorf("growth offset %d invalid for type %d, should be in [0,%d[", req.GrowthOffset, req.GrowthType, req.GrowthType.GetMaxOffset())
+	}
+	if !req.Point.IsMainPoint() {
+		return 0, fmt.Errorf("event point %v is not a main point", req.Point)
+	}
+	return parseColor(req.Color)
+}
+
+func (sh *SpaceHolder) createEvent(w http.ResponseWriter, r *http.Request) {
+	req := CreateEventRequest{}
+	if !readJson(w, r, &req) {
+		return
+	}
+	color, err := validateEventRequest(&req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%v", err)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	space := sh.space
+	if !space.CanCreateEvent() {
+		writeError(w, http.StatusConflict, "space %d reached its maximum number of events", sh.id)
+		return
+	}
+	if evt := space.GetEventAt(req.Point); evt != nil {
+		writeError(w, http.StatusConflict, "event %d already exists at point %v", evt.GetId(), req.Point)
+		return
+	}
+	evt := space.CreateEvent(req.GrowthType, req.GrowthIndex, req.GrowthOffset, req.Point, color)
+	writeJson(w, http.StatusCreated, makeEventJson(evt))
+}
+
+func (sh *SpaceHolder) forward(w http.ResponseWriter, r *http.Request) {
+	req := ForwardRequest{Steps: 1}
+	if r.ContentLength != 0 && !readJson(w, r, &req) {
+		return
+	}
+	if req.Steps <= 0 || req.Steps > MaxStepsPerRequest {
+		writeError(w, http.StatusBadRequest, "number of steps %d should be in [1,%d]", req.Steps, MaxStepsPerRequest)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	if sh.space.GetNbEvents() == 0 {
+		writeError(w, http.StatusConflict, "space %d has no events", sh.id)
+		return
+	}
+	res := make([]ForwardResultJson, req.Steps)
+	for i := 0; i < req.Steps; i++ {
+		res[i] = sh.forwardOneStep()
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+// Step time in a go routine, the clients follow it with the stream
+func (sh *SpaceHolder) run(w http.ResponseWriter, r *http.Request) {
+	req := ForwardRequest{}
+	if !readJson(w, r, &req) {
+		return
+	}
+	if req.Steps <= 0 || req.Steps > MaxStepsPerRun {
+		writeError(w, http.StatusBadRequest, "number of steps %d should be in [1,%d]", req.Steps, MaxStepsPerRun)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	if sh.space.GetNbEvents() == 0 {
+		writeError(w, http.StatusConflict, "space %d has no events", sh.id)
+		return
+	}
+	if sh.running {
+		writeError(w, http.StatusConflict, "space %d is already running", sh.id)
+		return
+	}
+	sh.running = true
+	go func() {
+		for i := 0; i < req.Steps; i++ {
+			sh.mutex.Lock()
+			if sh.stopped {
+				sh.mutex.Unlock()
+				Log.Infof("space %d deleted while running, stopped after %d steps", sh.id, i)
+				return
+			}
+			sh.forwardOneStep()
+			sh.mutex.Unlock()
+		}
+		sh.mutex.Lock()
+		sh.running = false
+		sh.mutex.Unlock()
+		Log.Infof("space %d finished running %d steps", sh.id, req.Steps)
+	}()
+	writeJson(w, http.StatusAccepted, makeSpaceJson(sh.id, sh.space))
+}
+
+func (sh *SpaceHolder) stream(w http.ResponseWriter, r *http.Request) {
+	// Locking the space while subscribing guarantees the snapshot is followed by the next delta
+	sh.mutex.Lock()
+	sub := sh.hub.Subscribe(w, r, makeSnapshotMessage(sh.space))
+	sh.mutex.Unlock()
+	if sub != nil {
+		sh.hub.Serve(sub)
+	}
+}
+
+// Should be called with the mutex locked
+func (sh *SpaceHolder) forwardOneStep() ForwardResultJson {
+	space := sh.space
+	fr := space.ForwardTime()
+	res := makeForwardResultJson(space.GetCurrentTime(), space.GetNbActiveNodes(), space.GetNbActiveLinks(), fr)
+	if len(sh.results) == MaxStoredResults {
+		copy(sh.results, sh.results[1:])
+		sh.results = sh.results[:len(sh.results)-1]
+	}
+	sh.results = append(sh.results, res)
+	return res
+}
+
+func parsePoint(s string) (m3point.Point, error) {
+	res := m3point.Point{}
+	coords := strings.Split(s, ",")
+	if len(coords) != 3 {
+		return res, fmt.Errorf("point %q should be 3 coordinates x,y,z", s)
+	}
+	for i, c := range coords {
+		v, err := strconv.ParseInt(strings.TrimSpace(c), 10, 32)
+		if err != nil {
+			return res, fmt.Errorf("point %q has invalid coordinate %q", s, c)
+		}
+		res[i] = m3point.CInt(v)
+	}
+	return res, nil
+}
+
+func (sh *SpaceHolder) getNode(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePoint(r.URL.Query().Get("point"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%v", err)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	n := sh.space.GetNode(p)
+	if n == nil {
+		writeError(w, http.StatusNotFound, "no node at %v in space %d", p, sh.id)
+		return
+	}
+	writeJson(w, http.StatusOK, makeNodeJson(sh.space, n))
+}
+
+func (sh *SpaceHolder) listActiveNodes(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	nodes := sh.space.GetActiveNodes()
+	res := make([]NodeJson, len(nodes))
+	for i, n := range nodes {
+		res[i] = makeNodeJson(sh.space, n)
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (sh *SpaceHolder) listActiveLinks(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	ppd := sh.space.GetPointPackData()
+	links := sh.space.GetActiveLinks()
+	res := make([]LinkJson, len(links))
+	for i, nl := range links {
+		res[i] = makeLinkJson(ppd, nl)
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (sh *SpaceHolder) listResults(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	res := make([]ForwardResultJson, len(sh.results))
+	copy(res, sh.results)
+	writeJson(w, http.StatusOK, res)
+}
+
+func (sh *SpaceHolder) getResult(w http.ResponseWriter, timeStr string) {
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	time := sh.space.GetCurrentTime()
+	if timeStr != "latest" {
+		t, err := strconv.Atoi(timeStr)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "time %q is not a number or latest", timeStr)
+			return
+		}
+		time = m3space.DistAndTime(t)
+	}
+	for _, res := range sh.results {
+		if res.Time == time {
+			writeJson(w, http.StatusOK, res)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "no forward result at time %d in space %d", time, sh.id)
+}
+
+/***************************************************************/
+// JSON utils Functions
+/***************************************************************/
+
+func readJson(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: %v", err)
+		return false
+	}
+	return true
+}
+
+func writeJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		Log.Errorf("could not write JSON response due to %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if Log.IsDebug() {
+		Log.Debugf("HTTP error %d: %s", status, msg)
+	}
+	writeJson(w, status, ErrorJson{msg})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "method %s not allowed on %s", r.Method, r.URL.Path)
+}
@@ -0,0 +1,227 @@
+package m3server
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+var envMutex sync.Mutex
+var serverEnv *m3db.QsmEnvironment
+
+func getServerTestEnv() *m3db.QsmEnvironment {
+	envMutex.Lock()
+	defer envMutex.Unlock()
+	if serverEnv != nil {
+		return serverEnv
+	}
+	m3db.SetToTestMode()
+	serverEnv = m3path.GetFullTestDb(m3db.ServerTestEnv)
+	m3point.InitializeDBEnv(serverEnv, true)
+	return serverEnv
+}
+
+func doRequest(t *testing.T, ts *httptest.Server, method, path string, body interface{}, expectedStatus int, res interface{}) {
+	var reqBody bytes.Buffer
+	if body != nil {
+		assert.Nil(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req, err := http.NewRequest(method, ts.URL+path, &reqBody)
+	assert.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, expectedStatus, resp.StatusCode, "wrong status for %s %s", method, path)
+	if res != nil {
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(res), "could not decode response of %s %s", method, path)
+	}
+}
+
+func TestParsePointAndColor(t *testing.T) {
+	p, err := parsePoint("3, -6,9")
+	assert.Nil(t, err)
+	assert.Equal(t, m3point.Point{3, -6, 9}, p)
+	_, err = parsePoint("3,6")
+	assert.NotNil(t, err)
+	_, err = parsePoint("3,a,6")
+	assert.NotNil(t, err)
+
+	for _, k := range m3space.AllColors {
+		parsed, err := parseColor(getColorName(k))
+		assert.Nil(t, err)
+		assert.Equal(t, k, parsed)
+	}
+	parsed, err := parseColor("4")
+	assert.Nil(t, err)
+	assert.Equal(t, m3space.BlueEvent, parsed)
+	_, err = parseColor("purple")
+	assert.NotNil(t, err)
+}
+
+func TestServerErrorsWithoutSpace(t *testing.T) {
+	// None of these requests need the DB
+	ts := httptest.NewServer(MakeServer(nil))
+	defer ts.Close()
+
+	errRes := ErrorJson{}
+	doRequest(t, ts, http.MethodGet, "/unknown", nil, http.StatusNotFound, &errRes)
+	assert.NotEmpty(t, errRes.Error)
+	doRequest(t, ts, http.MethodGet, "/spaces/1", nil, http.StatusNotFound, &errRes)
+	doRequest(t, ts, http.MethodGet, "/spaces/abc/events", nil, http.StatusNotFound, &errRes)
+	doRequest(t, ts, http.MethodPut, "/spaces", nil, http.StatusMethodNotAllowed, &errRes)
+	doRequest(t, ts, http.MethodPost, "/spaces", map[string]int{"unknownField": 1}, http.StatusBadRequest, &errRes)
+	doRequest(t, ts, http.MethodPost, "/spaces", CreateSpaceRequest{Max: -3}, http.StatusBadRequest, &errRes)
+
+	var spaces []SpaceJson
+	doRequest(t, ts, http.MethodGet, "/spaces", nil, http.StatusOK, &spaces)
+	assert.Equal(t, 0, len(spaces))
+}
+
+func TestValidateEventRequest(t *testing.T) {
+	valid := CreateEventRequest{GrowthType: 8, GrowthIndex: 3, GrowthOffset: 4, Point: m3point.Point{3, 0, -3}, Color: "green"}
+	k, err := validateEventRequest(&valid)
+	assert.Nil(t, err)
+	assert.Equal(t, m3space.GreenEvent, k)
+
+	invalids := []CreateEventRequest{
+		{GrowthType: 5, Color: "red"},
+		{GrowthType: 2, GrowthIndex: 12, Color: "red"},
+		{GrowthType: 4, GrowthOffset: 4, Color: "red"},
+		{GrowthType: 8, Point: m3point.Point{1, 0, 0}, Color: "red"},
+		{GrowthType: 8, Color: "black"},
+	}
+	for _, req := range invalids {
+		_, err := validateEventRequest(&req)
+		assert.NotNil(t, err, "request %v should be invalid", req)
+	}
+}
+
+func TestServerFullSpaceRun(t *testing.T) {
+	Log.SetWarn()
+	m3space.Log.SetWarn()
+	m3space.LogStat.SetWarn()
+	m3path.Log.SetWarn()
+
+	ts := httptest.NewServer(MakeServer(getServerTestEnv()))
+	defer ts.Close()
+
+	threshold := 1
+	spaceJson := SpaceJson{}
+	doRequest(t, ts, http.MethodPost, "/spaces", CreateSpaceRequest{Max: 3 * 9, EventOutgrowthThreshold: &threshold}, http.StatusCreated, &spaceJson)
+	assert.Equal(t, 1, spaceJson.Id)
+	assert.Equal(t, m3space.DistAndTime(0), spaceJson.CurrentTime)
+	assert.Equal(t, 3, spaceJson.MaxConnections)
+	assert.Equal(t, m3space.DistAndTime(1), spaceJson.EventOutgrowthThreshold)
+
+	errRes := ErrorJson{}
+	doRequest(t, ts, http.MethodPost, "/spaces/1/forward", nil, http.StatusConflict, &errRes)
+
+	evtJson := EventJson{}
+	doRequest(t, ts, http.MethodPost, "/spaces/1/events",
+		CreateEventRequest{GrowthType: 8, GrowthIndex: 0, GrowthOffset: 0, Point: m3point.Origin, Color: "red"},
+		http.StatusCreated, &evtJson)
+	assert.Equal(t, m3space.EventID(1), evtJson.Id)
+	assert.Equal(t, "red", evtJson.Color)
+	assert.Equal(t, m3point.GrowthType(8), evtJson.GrowthType)
+	assert.True(t, evtJson.PathContextId > 0)
+
+	doRequest(t, ts, http.MethodPost, "/spaces/1/events",
+		CreateEventRequest{GrowthType: 8, Point: m3point.Origin, Color: "green"},
+		http.StatusConflict, &errRes)
+
+	var events []EventJson
+	doRequest(t, ts, http.MethodGet, "/spaces/1/events", nil, http.StatusOK, &events)
+	assert.Equal(t, 1, len(events))
+
+	nodeJson := NodeJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1/nodes?point=0,0,0", nil, http.StatusOK, &nodeJson)
+	assert.True(t, nodeJson.IsRoot)
+	assert.Equal(t, "active", nodeJson.State)
+	assert.Equal(t, uint8(m3space.RedEvent), nodeJson.ColorMask)
+	assert.Equal(t, 1, len(nodeJson.Events))
+	assert.Equal(t, 0, nodeJson.Events[0].D)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/nodes?point=30,0,0", nil, http.StatusNotFound, &errRes)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/nodes?point=30", nil, http.StatusBadRequest, &errRes)
+
+	var results []ForwardResultJson
+	doRequest(t, ts, http.MethodPost, "/spaces/1/forward", ForwardRequest{Steps: 3}, http.StatusOK, &results)
+	assert.Equal(t, 3, len(results))
+	for i, fr := range results {
+		assert.Equal(t, m3space.DistAndTime(i+1), fr.Time)
+		assert.True(t, fr.NbActiveNodes > 0)
+		assert.Equal(t, 0, len(fr.MeetingPoints))
+	}
+	doRequest(t, ts, http.MethodPost, "/spaces/1/forward", ForwardRequest{Steps: MaxStepsPerRequest + 1}, http.StatusBadRequest, &errRes)
+	// Empty body is one step
+	doRequest(t, ts, http.MethodPost, "/spaces/1/forward", nil, http.StatusOK, &results)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, m3space.DistAndTime(4), results[0].Time)
+
+	doRequest(t, ts, http.MethodGet, "/spaces/1", nil, http.StatusOK, &spaceJson)
+	assert.Equal(t, m3space.DistAndTime(4), spaceJson.CurrentTime)
+
+	var activeNodes []NodeJson
+	doRequest(t, ts, http.MethodGet, "/spaces/1/nodes/active", nil, http.StatusOK, &activeNodes)
+	assert.Equal(t, spaceJson.NbActiveNodes, len(activeNodes))
+	for _, n := range activeNodes {
+		assert.Equal(t, "active", n.State)
+	}
+
+	var activeLinks []LinkJson
+	doRequest(t, ts, http.MethodGet, "/spaces/1/links/active", nil, http.StatusOK, &activeLinks)
+	assert.Equal(t, spaceJson.NbActiveLinks, len(activeLinks))
+	for _, l := range activeLinks {
+		assert.True(t, m3point.MakeVector(l.Src, l.Dst).IsConnectionVector())
+	}
+
+	doRequest(t, ts, http.MethodGet, "/spaces/1/results", nil, http.StatusOK, &results)
+	assert.Equal(t, 4, len(results))
+	frJson := ForwardResultJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1/results/2", nil, http.StatusOK, &frJson)
+	assert.Equal(t, m3space.DistAndTime(2), frJson.Time)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/results/latest", nil, http.StatusOK, &frJson)
+	assert.Equal(t, m3space.DistAndTime(4), frJson.Time)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/results/12", nil, http.StatusNotFound, &errRes)
+
+	doRequest(t, ts, http.MethodDelete, "/spaces/1", nil, http.StatusNoContent, nil)
+	doRequest(t, ts, http.MethodGet, "/spaces/1", nil, http.StatusNotFound, &errRes)
+}
+
+func TestServerDeleteStopsRun(t *testing.T) {
+	Log.SetWarn()
+	m3space.Log.SetWarn()
+	m3space.LogStat.SetWarn()
+
+	srv := MakeServer(getServerTestEnv())
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	threshold := 1
+	doRequest(t, ts, http.MethodPost, "/spaces", CreateSpaceRequest{EventOutgrowthThreshold: &threshold}, http.StatusCreated, nil)
+	doRequest(t, ts, http.MethodPost, "/spaces/1/events",
+		CreateEventRequest{GrowthType: 8, Point: m3point.Origin, Color: "red"}, http.StatusCreated, nil)
+	sh := srv.getSpaceHolder("1")
+	doRequest(t, ts, http.MethodPost, "/spaces/1/run", ForwardRequest{Steps: MaxStepsPerRun}, http.StatusAccepted, nil)
+	doRequest(t, ts, http.MethodDelete, "/spaces/1", nil, http.StatusNoContent, nil)
+
+	sh.mutex.Lock()
+	stoppedAt := sh.space.GetCurrentTime()
+	assert.True(t, sh.stopped)
+	assert.True(t, len(sh.results) <= MaxStoredResults)
+	sh.mutex.Unlock()
+	time.Sleep(100 * time.Millisecond)
+	sh.mutex.Lock()
+	assert.Equal(t, stoppedAt, sh.space.GetCurrentTime())
+	sh.mutex.Unlock()
+}
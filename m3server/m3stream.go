@@ -0,0 +1,399 @@
+package m3server
+
+import (
+	"encoding/json"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// Number of messages waiting for a client before the publisher blocks
+	DefaultStreamBufferSize = 16
+	// How long the publisher waits for a slow client before dropping it
+	DefaultSlowClientTimeout = 5 * time.Second
+	// Number of messages waiting to be published before the slow clients are dropped
+	DefaultStreamQueueSize = 64
+)
+
+const (
+	SnapshotMessage = "snapshot"
+	DeltaMessage    = "delta"
+)
+
+// Sent once when connecting with the full active state, then one delta per forward time.
+// A new snapshot replacing the whole state is sent when deltas were dropped because the stream was too slow.
+type StreamMessageJson struct {
+	Type           string              `json:"type"`
+	Time           m3space.DistAndTime `json:"time"`
+	NewActiveNodes []NodeJson          `json:"newActiveNodes"`
+	NewActiveLinks []LinkJson          `json:"newActiveLinks"`
+	InactiveNodes  []m3point.Point     `json:"inactiveNodes"`
+	InactiveLinks  []LinkJson          `json:"inactiveLinks"`
+	OldNodes       []m3point.Point     `json:"oldNodes"`
+	DeadNodes      []m3point.Point     `json:"deadNodes"`
+	MeetingPoints  []MeetingPointsJson `json:"meetingPoints"`
+}
+
+type linkKey struct {
+	src    m3point.Point
+	connId m3point.ConnectionId
+}
+
+// Publish the active changes of each forward time of the space
+type deltaCollector struct {
+	m3space.BaseSpaceListener
+	hub *StreamHub
+	// The root nodes already published when their event was created
+	announcedNodes map[m3point.Point]bool
+	// The same link can be active from two events, it is published when the first one is added and the last removed
+	linkCounts map[linkKey]int
+	oldNodes   []m3point.Point
+	deadNodes  []m3point.Point
+	meetings   map[m3space.ThreeIds][]m3point.Point
+	// Set when a message could not be queued, the next step publishes a snapshot instead of a delta
+	needsSnapshot bool
+}
+
+type StreamSubscriber struct {
+	ws       *WsConn
+	messages chan []byte
+	done     chan struct{}
+	once     sync.Once
+}
+
+// A message to publish, or a new subscriber to register once all the previous messages are published
+type streamItem struct {
+	data []byte
+	sub  *StreamSubscriber
+}
+
+// Broadcast JSON messages to all the connected WebSocket clients.
+// Publishing blocks while a client buffer is full until the slow client timeout is reached and the client is dropped.
+// The space listener uses PublishAsync so only the hub goroutine waits for slow clients, never the space.
+type StreamHub struct {
+	mutex             sync.Mutex
+	subscribers       map[*StreamSubscriber]bool
+	BufferSize        int
+	SlowClientTimeout time.Duration
+	queue             chan streamItem
+	closed            chan struct{}
+	closeOnce         sync.Once
+}
+
+func MakeStreamHub() *StreamHub {
+	hub := new(StreamHub)
+	hub.subscribers = make(map[*StreamSubscriber]bool)
+	hub.BufferSize = DefaultStreamBufferSize
+	hub.SlowClientTimeout = DefaultSlowClientTimeout
+	hub.queue = make(chan streamItem, DefaultStreamQueueSize)
+	hub.closed = make(chan struct{})
+	go hub.publishLoop()
+	return hub
+}
+
+/***************************************************************/
+// StreamHub Functions
+/***************************************************************/
+
+func (hub *StreamHub) NbSubscribers() int {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	return len(hub.subscribers)
+}
+
+func (hub *StreamHub) getSubscribers() []*StreamSubscriber {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	res := make([]*StreamSubscriber, 0, len(hub.subscribers))
+	for sub := range hub.subscribers {
+		res = append(res, sub)
+	}
+	return res
+}
+
+func (hub *StreamHub) unsubscribe(sub *StreamSubscriber) {
+	hub.mutex.Lock()
+	delete(hub.subscribers, sub)
+	hub.mutex.Unlock()
+	sub.close()
+}
+
+// Upgrade the request to a WebSocket and register the new client with the initial message if not nil.
+// Nothing published after Subscribe returns is missed by the client. Returns nil if the upgrade failed.
+func (hub *StreamHub) Subscribe(w http.ResponseWriter, r *http.Request, initial interface{}) *StreamSubscriber {
+	ws, err := upgradeWebSocket(w, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "websocket upgrade failed: %v", err)
+		return nil
+	}
+	bufferSize := hub.BufferSize
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	sub := &StreamSubscriber{ws: ws, messages: make(chan []byte, bufferSize), done: make(chan struct{})}
+	if initial != nil {
+		data, err := json.Marshal(initial)
+		if err != nil {
+			Log.Errorf("could not marshal initial stream message due to %v", err)
+		} else {
+			sub.messages <- data
+		}
+	}
+	// Registered after the messages already queued, which the initial message includes
+	if !hub.enqueue(streamItem{sub: sub}) {
+		sub.close()
+	}
+	return sub
+}
+
+// Stream to the client until it leaves
+func (hub *StreamHub) Serve(sub *StreamSubscriber) {
+	go hub.writeLoop(sub)
+	err := sub.ws.readLoop()
+	if err != nil && Log.IsDebug() {
+		Log.Debugf("stream client left with %v", err)
+	}
+	hub.unsubscribe(sub)
+}
+
+func (hub *StreamHub) writeLoop(sub *StreamSubscriber) {
+	for {
+		select {
+		case <-sub.done:
+			return
+		case data := <-sub.messages:
+			err := sub.ws.WriteMessage(wsTextFrame, data)
+			if err != nil {
+				select {
+				case <-sub.done:
+					// Already dropped, the connection was closed under the write
+				default:
+					Log.Warnf("dropping stream client after write error %v", err)
+					hub.unsubscribe(sub)
+				}
+				return
+			}
+		}
+	}
+}
+
+// Marshal once and send to all clients, waiting for the slow ones
+func (hub *StreamHub) Publish(v interface{}) {
+	subs := hub.getSubscribers()
+	if len(subs) == 0 {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		Log.Errorf("could not marshal stream message due to %v", err)
+		return
+	}
+	hub.sendToAll(subs, data)
+}
+
+// Marshal now and queue the message for the hub goroutine, without waiting for the clients.
+// When the queue is full the clients not reading are dropped right away,
+// and if it is still full the message is dropped and false returned.
+func (hub *StreamHub) PublishAsync(v interface{}) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		Log.Errorf("could not marshal stream message due to %v", err)
+		return false
+	}
+	return hub.enqueue(streamItem{data: data})
+}
+
+// Stop the hub goroutine and drop all the clients
+func (hub *StreamHub) Close() {
+	hub.closeOnce.Do(func() {
+		close(hub.closed)
+	})
+	for _, sub := range hub.getSubscribers() {
+		hub.unsubscribe(sub)
+	}
+}
+
+func (hub *StreamHub) enqueue(item streamItem) bool {
+	select {
+	case hub.queue <- item:
+		return true
+	case <-hub.closed:
+		return false
+	default:
+	}
+	hub.dropSlowSubscribers()
+	// Never wait for the hub goroutine, the caller may hold the space lock
+	select {
+	case hub.queue <- item:
+		return true
+	default:
+		Log.Warnf("dropping stream message with %d messages queued", len(hub.queue))
+		return false
+	}
+}
+
+func (hub *StreamHub) dropSlowSubscribers() {
+	for _, sub := range hub.getSubscribers() {
+		if len(sub.messages) == cap(sub.messages) {
+			Log.Warnf("dropping stream client not reading with %d messages queued", len(hub.queue))
+			hub.unsubscribe(sub)
+		}
+	}
+}
+
+func (hub *StreamHub) publishLoop() {
+	for {
+		select {
+		case <-hub.closed:
+			return
+		case item := <-hub.queue:
+			if item.sub != nil {
+				hub.mutex.Lock()
+				hub.subscribers[item.sub] = true
+				hub.mutex.Unlock()
+			} else {
+				subs := hub.getSubscribers()
+				if len(subs) > 0 {
+					hub.sendToAll(subs, item.data)
+				}
+			}
+		}
+	}
+}
+
+func (hub *StreamHub) sendToAll(subs []*StreamSubscriber, data []byte) {
+	for _, sub := range subs {
+		select {
+		case sub.messages <- data:
+		case <-sub.done:
+		case <-time.After(hub.SlowClientTimeout):
+			Log.Warnf("dropping stream client not reading for %v", hub.SlowClientTimeout)
+			hub.unsubscribe(sub)
+		}
+	}
+}
+
+/***************************************************************/
+// StreamSubscriber Functions
+/***************************************************************/
+
+func (sub *StreamSubscriber) close() {
+	sub.once.Do(func() {
+		close(sub.done)
+		sub.ws.Close()
+	})
+}
+
+/***************************************************************/
+// deltaCollector Functions
+/***************************************************************/
+
+func makeDeltaCollector(hub *StreamHub) *deltaCollector {
+	dc := new(deltaCollector)
+	dc.hub = hub
+	dc.announcedNodes = make(map[m3point.Point]bool)
+	dc.linkCounts = make(map[linkKey]int)
+	dc.meetings = make(map[m3space.ThreeIds][]m3point.Point)
+	return dc
+}
+
+func (dc *deltaCollector) NodeOld(space *m3space.Space, node m3space.Node) {
+	dc.oldNodes = append(dc.oldNodes, *node.GetPoint())
+}
+
+func (dc *deltaCollector) NodeDead(space *m3space.Space, node m3space.Node) {
+	dc.deadNodes = append(dc.deadNodes, *node.GetPoint())
+}
+
+func (dc *deltaCollector) MeetingPointFound(space *m3space.Space, tIds m3space.ThreeIds, p m3point.Point) {
+	dc.meetings[tIds] = append(dc.meetings[tIds], p)
+}
+
+func (dc *deltaCollector) EventCreated(space *m3space.Space, evt *m3space.Event) {
+	// The root node is active right away
+	n := evt.GetNode()
+	dc.announcedNodes[*n.GetPoint()] = true
+	dc.publish(StreamMessageJson{
+		Type:           DeltaMessage,
+		Time:           space.GetCurrentTime(),
+		NewActiveNodes: []NodeJson{makeNodeJson(space, n)},
+	})
+}
+
+// Only the nodes and links changed by this step are visited, unless a snapshot is needed
+func (dc *deltaCollector) StepFinished(space *m3space.Space, time m3space.DistAndTime, res *m3space.ForwardResult) {
+	msg := StreamMessageJson{Type: DeltaMessage, Time: time, OldNodes: dc.oldNodes, DeadNodes: dc.deadNodes}
+
+	changes := res.GetActiveChanges()
+	for _, n := range changes.ActivatedNodes {
+		if !dc.announcedNodes[*n.GetPoint()] {
+			msg.NewActiveNodes = append(msg.NewActiveNodes, makeNodeJson(space, n))
+		}
+	}
+	for _, n := range changes.DeactivatedNodes {
+		msg.InactiveNodes = append(msg.InactiveNodes, *n.GetPoint())
+	}
+
+	ppd := space.GetPointPackData()
+	for _, nl := range changes.AddedLinks {
+		key := linkKey{nl.GetSrc(), nl.GetConnId()}
+		dc.linkCounts[key]++
+		if dc.linkCounts[key] == 1 {
+			msg.NewActiveLinks = append(msg.NewActiveLinks, makeLinkJson(ppd, nl))
+		}
+	}
+	for _, nl := range changes.RemovedLinks {
+		key := linkKey{nl.GetSrc(), nl.GetConnId()}
+		dc.linkCounts[key]--
+		if dc.linkCounts[key] <= 0 {
+			delete(dc.linkCounts, key)
+			msg.InactiveLinks = append(msg.InactiveLinks, makeLinkJson(ppd, nl))
+		}
+	}
+
+	allIds := make([]m3space.ThreeIds, 0, len(dc.meetings))
+	for tIds := range dc.meetings {
+		allIds = append(allIds, tIds)
+	}
+	m3space.SortThreeIds(allIds)
+	for _, tIds := range allIds {
+		msg.MeetingPoints = append(msg.MeetingPoints, MeetingPointsJson{tIds, dc.meetings[tIds]})
+	}
+
+	dc.announcedNodes = make(map[m3point.Point]bool)
+	dc.oldNodes = nil
+	dc.deadNodes = nil
+	dc.meetings = make(map[m3space.ThreeIds][]m3point.Point)
+
+	if dc.needsSnapshot {
+		// The dropped deltas are lost, so the clients get the whole active state again
+		snapshot := makeSnapshotMessage(space)
+		snapshot.OldNodes = msg.OldNodes
+		snapshot.DeadNodes = msg.DeadNodes
+		snapshot.MeetingPoints = msg.MeetingPoints
+		dc.needsSnapshot = !dc.hub.PublishAsync(snapshot)
+	} else {
+		dc.publish(msg)
+	}
+}
+
+func (dc *deltaCollector) publish(msg StreamMessageJson) {
+	if !dc.hub.PublishAsync(msg) {
+		dc.needsSnapshot = true
+	}
+}
+
+func makeSnapshotMessage(space *m3space.Space) StreamMessageJson {
+	msg := StreamMessageJson{Type: SnapshotMessage, Time: space.GetCurrentTime()}
+	for _, n := range space.GetActiveNodes() {
+		msg.NewActiveNodes = append(msg.NewActiveNodes, makeNodeJson(space, n))
+	}
+	ppd := space.GetPointPackData()
+	for _, nl := range space.GetActiveLinks() {
+		msg.NewActiveLinks = append(msg.NewActiveLinks, makeLinkJson(ppd, nl))
+	}
+	return msg
+}
@@ -0,0 +1,282 @@
+package m3server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type wsTestClient struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func dialWebSocket(t *testing.T, serverUrl string, path string) *wsTestClient {
+	host := strings.TrimPrefix(serverUrl, "http://")
+	conn, err := net.Dial("tcp", host)
+	if !assert.Nil(t, err) {
+		return nil
+	}
+	key := "dGhlIHNhbXBsZSBub25jZQ=="
+	_, err = fmt.Fprintf(conn, "GET %s HTTP/1.1\r\nHost: %s\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: %s\r\nSec-WebSocket-Version: 13\r\n\r\n", path, host, key)
+	assert.Nil(t, err)
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if !assert.Nil(t, err) {
+		return nil
+	}
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", resp.Header.Get("Sec-WebSocket-Accept"))
+	return &wsTestClient{conn, reader}
+}
+
+func (c *wsTestClient) readMessage(t *testing.T, v interface{}) {
+	assert.Nil(t, c.conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	opcode, payload, err := readFrame(c.reader, 1<<30)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(wsTextFrame), opcode)
+	assert.Nil(t, json.Unmarshal(payload, v))
+}
+
+func (c *wsTestClient) writeMasked(t *testing.T, opcode byte, payload []byte) {
+	mask := [4]byte{1, 2, 3, 4}
+	frame := appendFrameHeader(nil, opcode, len(payload), true)
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	_, err := c.conn.Write(frame)
+	assert.Nil(t, err)
+}
+
+func waitForSubscribers(hub *StreamHub, expected int) bool {
+	for i := 0; i < 200; i++ {
+		if hub.NbSubscribers() == expected {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestWebSocketFrames(t *testing.T) {
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", computeAcceptKey("dGhlIHNhbXBsZSBub25jZQ=="))
+
+	for _, size := range []int{0, 5, 125, 126, 300, 0xFFFF, 70000} {
+		payload := bytes.Repeat([]byte{'q'}, size)
+		for _, masked := range []bool{false, true} {
+			frame := appendFrameHeader(nil, wsTextFrame, size, masked)
+			if masked {
+				mask := []byte{9, 8, 7, 6}
+				frame = append(frame, mask...)
+				for i, b := range payload {
+					frame = append(frame, b^mask[i%4])
+				}
+			} else {
+				frame = append(frame, payload...)
+			}
+			opcode, read, err := readFrame(bytes.NewReader(frame), 1<<20)
+			assert.Nil(t, err)
+			assert.Equal(t, byte(wsTextFrame), opcode)
+			assert.Equal(t, payload, read, "failed for size %d masked %v", size, masked)
+		}
+	}
+
+	_, _, err := readFrame(bytes.NewReader(appendFrameHeader(nil, wsTextFrame, 1000, false)), 100)
+	assert.NotNil(t, err)
+}
+
+func TestStreamHubPublishAndClose(t *testing.T) {
+	hub := MakeStreamHub()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := hub.Subscribe(w, r, StreamMessageJson{Type: SnapshotMessage, Time: 3})
+		if sub != nil {
+			hub.Serve(sub)
+		}
+	}))
+	defer ts.Close()
+
+	// Not a websocket request
+	resp, err := http.Get(ts.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	client := dialWebSocket(t, ts.URL, "/")
+	if client == nil {
+		return
+	}
+	defer client.conn.Close()
+	assert.True(t, waitForSubscribers(hub, 1))
+
+	msg := StreamMessageJson{}
+	client.readMessage(t, &msg)
+	assert.Equal(t, SnapshotMessage, msg.Type)
+	assert.Equal(t, m3space.DistAndTime(3), msg.Time)
+
+	hub.Publish(StreamMessageJson{Type: DeltaMessage, Time: 4, DeadNodes: []m3point.Point{{3, 0, 0}}})
+	client.readMessage(t, &msg)
+	assert.Equal(t, DeltaMessage, msg.Type)
+	assert.Equal(t, m3space.DistAndTime(4), msg.Time)
+	assert.Equal(t, []m3point.Point{{3, 0, 0}}, msg.DeadNodes)
+
+	// Ping is answered by pong with same payload
+	client.writeMasked(t, wsPingFrame, []byte("qsm"))
+	opcode, payload, err := readFrame(client.reader, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(wsPongFrame), opcode)
+	assert.Equal(t, "qsm", string(payload))
+
+	// Close is echoed and the client removed
+	client.writeMasked(t, wsCloseFrame, []byte{0x03, 0xE8})
+	opcode, _, err = readFrame(client.reader, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(wsCloseFrame), opcode)
+	assert.True(t, waitForSubscribers(hub, 0))
+}
+
+func TestStreamHubDropsSlowClient(t *testing.T) {
+	hub := MakeStreamHub()
+	hub.BufferSize = 1
+	hub.SlowClientTimeout = 50 * time.Millisecond
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := hub.Subscribe(w, r, nil)
+		if sub != nil {
+			hub.Serve(sub)
+		}
+	}))
+	defer ts.Close()
+
+	client := dialWebSocket(t, ts.URL, "/")
+	if client == nil {
+		return
+	}
+	defer client.conn.Close()
+	assert.True(t, waitForSubscribers(hub, 1))
+
+	// The client never reads, so after the socket buffers are full the publisher waits then drops it
+	big := ErrorJson{strings.Repeat("x", 1<<20)}
+	start := time.Now()
+	for i := 0; i < 100 && hub.NbSubscribers() > 0; i++ {
+		hub.Publish(big)
+	}
+	assert.Equal(t, 0, hub.NbSubscribers())
+	assert.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestStreamHubPublishAsyncNeverWaits(t *testing.T) {
+	hub := MakeStreamHub()
+	defer hub.Close()
+	hub.BufferSize = 1
+	// Long enough that waiting once for the slow client would fail the test
+	hub.SlowClientTimeout = time.Minute
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub := hub.Subscribe(w, r, nil)
+		if sub != nil {
+			hub.Serve(sub)
+		}
+	}))
+	defer ts.Close()
+
+	client := dialWebSocket(t, ts.URL, "/")
+	if client == nil {
+		return
+	}
+	defer client.conn.Close()
+	assert.True(t, waitForSubscribers(hub, 1))
+
+	// The client never reads, once the queue is full it is dropped instead of blocking the caller
+	big := ErrorJson{strings.Repeat("x", 1<<20)}
+	start := time.Now()
+	for i := 0; i < 4*DefaultStreamQueueSize; i++ {
+		hub.PublishAsync(big)
+	}
+	assert.True(t, waitForSubscribers(hub, 0))
+	assert.True(t, time.Since(start) < 10*time.Second)
+}
+
+func TestStreamHubEnqueueNeverBlocks(t *testing.T) {
+	// No hub goroutine reading the queue, so it stays full
+	hub := &StreamHub{subscribers: make(map[*StreamSubscriber]bool), queue: make(chan streamItem, 1), closed: make(chan struct{})}
+	assert.True(t, hub.PublishAsync(ErrorJson{"first"}))
+	done := make(chan bool)
+	go func() {
+		done <- hub.PublishAsync(ErrorJson{"second"})
+	}()
+	select {
+	case queued := <-done:
+		assert.False(t, queued)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "publish blocked on a full queue")
+	}
+	assert.Equal(t, 1, len(hub.queue))
+}
+
+func TestServerStreamDeltas(t *testing.T) {
+	Log.SetWarn()
+	m3space.Log.SetWarn()
+	m3space.LogStat.SetWarn()
+
+	srv := MakeServer(getServerTestEnv())
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	threshold := 1
+	doRequest(t, ts, http.MethodPost, "/spaces", CreateSpaceRequest{EventOutgrowthThreshold: &threshold}, http.StatusCreated, nil)
+	doRequest(t, ts, http.MethodPost, "/spaces/1/events",
+		CreateEventRequest{GrowthType: 8, Point: m3point.Origin, Color: "blue"}, http.StatusCreated, nil)
+
+	client := dialWebSocket(t, ts.URL, "/spaces/1/stream")
+	if client == nil {
+		return
+	}
+	defer client.conn.Close()
+
+	msg := StreamMessageJson{}
+	client.readMessage(t, &msg)
+	assert.Equal(t, SnapshotMessage, msg.Type)
+	assert.Equal(t, m3space.DistAndTime(0), msg.Time)
+	assert.Equal(t, 1, len(msg.NewActiveNodes))
+	assert.True(t, msg.NewActiveNodes[0].IsRoot)
+
+	nbSteps := 12
+	doRequest(t, ts, http.MethodPost, "/spaces/1/run", ForwardRequest{Steps: nbSteps}, http.StatusAccepted, nil)
+	active := map[m3point.Point]bool{m3point.Origin: true}
+	nbOld := 0
+	nbDead := 0
+	for i := 1; i <= nbSteps; i++ {
+		msg = StreamMessageJson{}
+		client.readMessage(t, &msg)
+		assert.Equal(t, DeltaMessage, msg.Type)
+		assert.Equal(t, m3space.DistAndTime(i), msg.Time)
+		for _, n := range msg.NewActiveNodes {
+			assert.False(t, active[n.Point], "node %v already active at %d", n.Point, i)
+			active[n.Point] = true
+		}
+		for _, p := range msg.InactiveNodes {
+			assert.True(t, active[p], "node %v was not active at %d", p, i)
+			delete(active, p)
+		}
+		nbOld += len(msg.OldNodes)
+		nbDead += len(msg.DeadNodes)
+		assert.Equal(t, 0, len(msg.MeetingPoints))
+	}
+	assert.True(t, nbOld > 0)
+	assert.True(t, nbDead > 0)
+
+	spaceJson := SpaceJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1", nil, http.StatusOK, &spaceJson)
+	assert.Equal(t, m3space.DistAndTime(nbSteps), spaceJson.CurrentTime)
+	assert.Equal(t, spaceJson.NbActiveNodes, len(active))
+}
@@ -0,0 +1,157 @@
+package m3server
+
+import (
+	"fmt"
+	"github.com/freddy33/qsm-go/m3gl"
+	"github.com/freddy33/qsm-go/m3space"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+const (
+	// The m3gl blinker cycles through the 4 event colors
+	NbBlinkValues = 4
+	// Highest many colors threshold of the m3gl filter
+	MaxManyColorsThreshold = 4
+)
+
+func (srv *Server) serveViewer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err := io.WriteString(w, viewerHtml)
+	if err != nil {
+		Log.Warnf("could not send viewer page due to %v", err)
+	}
+}
+
+// Read the filter query parameters, missing ones get the same default as the OpenGL world
+func parseDrawingFilter(query url.Values) (DrawingFilterJson, error) {
+	res := DrawingFilterJson{EventColorMask: uint8(0xFF)}
+	var err error
+	if v := query.Get("emptyNodes"); v != "" {
+		res.DisplayEmptyNodes, err = strconv.ParseBool(v)
+		if err != nil {
+			return res, fmt.Errorf("emptyNodes %q is not a boolean", v)
+		}
+	}
+	if v := query.Get("emptyConnections"); v != "" {
+		res.DisplayEmptyConnections, err = strconv.ParseBool(v)
+		if err != nil {
+			return res, fmt.Errorf("emptyConnections %q is not a boolean", v)
+		}
+	}
+	if v := query.Get("colorMask"); v != "" {
+		mask, err := strconv.ParseUint(v, 0, 8)
+		if err != nil {
+			return res, fmt.Errorf("colorMask %q should be in [0,255]", v)
+		}
+		res.EventColorMask = uint8(mask)
+	}
+	if v := query.Get("manyColors"); v != "" {
+		many, err := strconv.ParseUint(v, 10, 8)
+		if err != nil || many > MaxManyColorsThreshold {
+			return res, fmt.Errorf("manyColors %q should be in [0,%d]", v, MaxManyColorsThreshold)
+		}
+		res.EventOutgrowthManyColorsThreshold = uint8(many)
+	}
+	return res, nil
+}
+
+/***************************************************************/
+// SpaceHolder Drawing Functions
+/***************************************************************/
+
+func (sh *SpaceHolder) updateSettings(w http.ResponseWriter, r *http.Request) {
+	req := SpaceSettingsRequest{}
+	if !readJson(w, r, &req) {
+		return
+	}
+	if req.EventOutgrowthThreshold != nil && *req.EventOutgrowthThreshold < 0 {
+		writeError(w, http.StatusBadRequest, "event outgrowth threshold %d cannot be negative", *req.EventOutgrowthThreshold)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	if req.EventOutgrowthThreshold != nil {
+		sh.space.SetEventOutgrowthThreshold(m3space.DistAndTime(*req.EventOutgrowthThreshold))
+	}
+	writeJson(w, http.StatusOK, makeSpaceJson(sh.id, sh.space))
+}
+
+func (sh *SpaceHolder) getDrawingObjects(w http.ResponseWriter) {
+	sh.mutex.Lock()
+	max := sh.space.Max
+	ppd := sh.space.GetPointPackData()
+	sh.mutex.Unlock()
+
+	meshes := m3gl.MakeMeshes(ppd, max)
+	camera := m3gl.MakeCamera(800, 600)
+	camera.SetMax(max)
+	res := DrawingObjectsJson{
+		Max:              max,
+		FloatPerVertices: m3gl.FloatPerVertices,
+		Camera:           makeCameraJson(camera),
+		Objects:          make([]DrawingObjectJson, 0, len(meshes)),
+	}
+	nbVertices := 0
+	for ot, mesh := range meshes {
+		res.Objects = append(res.Objects, DrawingObjectJson{ot, 0, int32(mesh.NumberOfVertices())})
+		nbVertices += mesh.NumberOfVertices()
+	}
+	sort.Slice(res.Objects, func(i, j int) bool {
+		return res.Objects[i].Type < res.Objects[j].Type
+	})
+	// All the meshes one after the other in one PPPNNN buffer
+	res.Vertices = make([]float32, nbVertices*m3gl.FloatPerVertices)
+	offset := int32(0)
+	for i, obj := range res.Objects {
+		res.Objects[i].Offset = offset
+		meshes[obj.Type].FillInterleaved(res.Vertices[int(offset)*m3gl.FloatPerVertices:])
+		offset += obj.NbVertices
+	}
+	writeJson(w, http.StatusOK, res)
+}
+
+func (sh *SpaceHolder) getDrawing(w http.ResponseWriter, r *http.Request) {
+	filterJson, err := parseDrawingFilter(r.URL.Query())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%v", err)
+		return
+	}
+	sh.mutex.Lock()
+	defer sh.mutex.Unlock()
+	space := sh.space
+	elements := m3gl.MakeDrawingElements(space, space.Max)
+	if elements == nil {
+		writeError(w, http.StatusInternalServerError, "space %d active nodes and links are not consistent", sh.id)
+		return
+	}
+	filter := m3gl.SpaceDrawingFilter{
+		DisplayEmptyNodes:                 filterJson.DisplayEmptyNodes,
+		DisplayEmptyConnections:           filterJson.DisplayEmptyConnections,
+		EventColorMask:                    filterJson.EventColorMask,
+		EventOutgrowthManyColorsThreshold: filterJson.EventOutgrowthManyColorsThreshold,
+		Space:                             space,
+	}
+	res := DrawingJson{
+		Time:                    space.GetCurrentTime(),
+		Max:                     space.Max,
+		EventOutgrowthThreshold: space.EventOutgrowthThreshold,
+		Filter:                  filterJson,
+		NbElements:              len(elements),
+		Elements:                make([]DrawingElementJson, 0, len(elements)),
+	}
+	for _, e := range elements {
+		if e.Display(filter) {
+			res.Elements = append(res.Elements, makeDrawingElementJson(e))
+		}
+	}
+	writeJson(w, http.StatusOK, res)
+}
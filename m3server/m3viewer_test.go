@@ -0,0 +1,129 @@
+package m3server
+
+import (
+	"github.com/freddy33/qsm-go/m3gl"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3space"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseDrawingFilter(t *testing.T) {
+	filter, err := parseDrawingFilter(url.Values{})
+	assert.Nil(t, err)
+	assert.Equal(t, DrawingFilterJson{false, false, uint8(0xFF), 0}, filter)
+
+	query, _ := url.ParseQuery("emptyNodes=true&emptyConnections=1&colorMask=5&manyColors=2")
+	filter, err = parseDrawingFilter(query)
+	assert.Nil(t, err)
+	assert.Equal(t, DrawingFilterJson{true, true, uint8(5), 2}, filter)
+
+	for _, invalid := range []string{"emptyNodes=yes", "emptyConnections=2", "colorMask=256", "colorMask=-1", "manyColors=5", "manyColors=a"} {
+		query, _ = url.ParseQuery(invalid)
+		_, err = parseDrawingFilter(query)
+		assert.NotNil(t, err, "query %s should be invalid", invalid)
+	}
+}
+
+func TestServeViewer(t *testing.T) {
+	ts := httptest.NewServer(MakeServer(nil))
+	defer ts.Close()
+
+	for _, path := range []string{"/", "/viewer"} {
+		resp, err := http.Get(ts.URL + path)
+		if !assert.Nil(t, err) {
+			return
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.True(t, strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html"))
+		assert.True(t, strings.Contains(string(body), "<canvas"))
+	}
+	errRes := ErrorJson{}
+	doRequest(t, ts, http.MethodPost, "/viewer", nil, http.StatusMethodNotAllowed, &errRes)
+}
+
+func TestServerDrawing(t *testing.T) {
+	Log.SetWarn()
+	m3space.Log.SetWarn()
+	m3space.LogStat.SetWarn()
+	m3gl.Log.SetWarn()
+
+	ts := httptest.NewServer(MakeServer(getServerTestEnv()))
+	defer ts.Close()
+
+	threshold := 1
+	doRequest(t, ts, http.MethodPost, "/spaces", CreateSpaceRequest{EventOutgrowthThreshold: &threshold}, http.StatusCreated, nil)
+
+	objects := DrawingObjectsJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing/objects", nil, http.StatusOK, &objects)
+	assert.Equal(t, DefaultSpaceMax, objects.Max)
+	assert.Equal(t, m3gl.FloatPerVertices, objects.FloatPerVertices)
+	assert.Equal(t, float64(DefaultSpaceMax), objects.Camera.EyeDistMin)
+	assert.InDelta(t, 1.5*objects.Camera.TopCornerDist, objects.Camera.EyeDist, 1e-9)
+	assert.Equal(t, m3gl.OrbitPerPixel, objects.Camera.OrbitPerPixel)
+	ppd := m3point.GetPointPackData(getServerTestEnv())
+	assert.Equal(t, 3+2+2*int(ppd.GetMaxConnId()), len(objects.Objects))
+	for _, obj := range objects.Objects {
+		assert.True(t, obj.NbVertices > 0)
+		assert.True(t, int(obj.Offset+obj.NbVertices)*objects.FloatPerVertices <= len(objects.Vertices))
+	}
+
+	// Only the axes before any event
+	drawing := DrawingJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing", nil, http.StatusOK, &drawing)
+	assert.Equal(t, 6, drawing.NbElements)
+	assert.Equal(t, 6, len(drawing.Elements))
+	for _, e := range drawing.Elements {
+		assert.True(t, e.Type.IsAxe())
+	}
+
+	doRequest(t, ts, http.MethodPost, "/spaces/1/events",
+		CreateEventRequest{GrowthType: 8, Point: m3point.Origin, Color: "green"}, http.StatusCreated, nil)
+	doRequest(t, ts, http.MethodPost, "/spaces/1/forward", ForwardRequest{Steps: 3}, http.StatusOK, nil)
+
+	spaceJson := SpaceJson{}
+	doRequest(t, ts, http.MethodGet, "/spaces/1", nil, http.StatusOK, &spaceJson)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing?emptyNodes=true&emptyConnections=true", nil, http.StatusOK, &drawing)
+	assert.Equal(t, m3space.DistAndTime(3), drawing.Time)
+	assert.Equal(t, 6+spaceJson.NbActiveNodes+spaceJson.NbActiveLinks, drawing.NbElements)
+	assert.Equal(t, drawing.NbElements, len(drawing.Elements))
+	nbNodes := 0
+	for _, e := range drawing.Elements {
+		if e.Type.IsNode() {
+			nbNodes++
+			if e.Type == m3gl.NodeActive {
+				assert.Equal(t, int32(m3space.GreenEvent), e.Colors[0])
+			}
+		}
+		if e.Type.IsConnection() {
+			assert.True(t, e.Type.GetConnectionId() != 0)
+		}
+	}
+	assert.Equal(t, spaceJson.NbActiveNodes, nbNodes)
+
+	// Masking green keeps only the axes and the root node if still active
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing?emptyNodes=false&emptyConnections=false&colorMask=13", nil, http.StatusOK, &drawing)
+	assert.True(t, len(drawing.Elements) >= 6 && len(drawing.Elements) <= 7)
+	for _, e := range drawing.Elements[6:] {
+		assert.Equal(t, m3gl.NodeActive, e.Type)
+		assert.Equal(t, m3point.Origin, e.Pos)
+	}
+
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing?manyColors=9", nil, http.StatusBadRequest, nil)
+
+	threshold = 3
+	doRequest(t, ts, http.MethodPost, "/spaces/1/settings", SpaceSettingsRequest{&threshold}, http.StatusOK, &spaceJson)
+	assert.Equal(t, m3space.DistAndTime(3), spaceJson.EventOutgrowthThreshold)
+	doRequest(t, ts, http.MethodGet, "/spaces/1/drawing", nil, http.StatusOK, &drawing)
+	assert.Equal(t, m3space.DistAndTime(3), drawing.EventOutgrowthThreshold)
+	threshold = -1
+	doRequest(t, ts, http.MethodPost, "/spaces/1/settings", SpaceSettingsRequest{&threshold}, http.StatusBadRequest, nil)
+}
@@ -0,0 +1,458 @@
+package m3server
+
+// The browser 3D viewer, a single page using WebGL with no external dependencies.
+// Same objects, colors and lighting as the playgl OpenGL shaders.
+var viewerHtml = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>QSM Viewer</title>
+<style>
+  body { margin: 0; background: #000; color: #ddd; font: 13px sans-serif; overflow: hidden; }
+  #view { position: absolute; top: 0; left: 0; width: 100%; height: 100%; display: block; }
+  #panel { position: absolute; top: 8px; left: 8px; width: 250px; padding: 8px; background: rgba(40,40,40,0.85); border-radius: 4px; }
+  #panel fieldset { border: 1px solid #555; margin: 6px 0; padding: 4px 6px; }
+  #panel input[type=number] { width: 48px; }
+  #panel label { display: inline-block; margin-right: 6px; }
+  #status { margin-top: 6px; min-height: 2em; }
+</style>
+</head>
+<body>
+<canvas id="view"></canvas>
+<div id="panel">
+  <fieldset><legend>Space</legend>
+    <select id="spaces"></select>
+    <button id="newSpace">New</button>
+    <button id="refresh">Refresh</button><br>
+    <button id="step">Step</button>
+    <label><input type="checkbox" id="play">Play</label>
+    <label><input type="checkbox" id="rotate">Rotate</label>
+    <button id="resetView">Reset view</button><br>
+    <label>Outgrowth threshold <input type="number" id="threshold" min="0" value="0"></label>
+  </fieldset>
+  <fieldset><legend>Filter</legend>
+    <label><input type="checkbox" id="emptyNodes">Empty nodes</label>
+    <label><input type="checkbox" id="emptyConnections">Empty connections</label><br>
+    <label><input type="checkbox" class="color" value="1" checked>Red</label>
+    <label><input type="checkbox" class="color" value="2" checked>Green</label>
+    <label><input type="checkbox" class="color" value="4" checked>Blue</label>
+    <label><input type="checkbox" class="color" value="8" checked>Yellow</label><br>
+    <label>Many colors <input type="number" id="manyColors" min="0" max="4" value="0"></label>
+  </fieldset>
+  <fieldset><legend>Event</legend>
+    <label>Type <select id="growthType"><option>1</option><option>2</option><option>3</option><option>4</option><option selected>8</option></select></label>
+    <label>Index <input type="number" id="growthIndex" min="0" value="0"></label>
+    <label>Offset <input type="number" id="growthOffset" min="0" value="0"></label><br>
+    <label>Point <input type="number" id="px" value="0" step="3"><input type="number" id="py" value="0" step="3"><input type="number" id="pz" value="0" step="3"></label><br>
+    <select id="color"><option>red</option><option>green</option><option>blue</option><option>yellow</option></select>
+    <button id="createEvent">Create</button>
+  </fieldset>
+  <div id="status"></div>
+</div>
+<script>
+"use strict";
+
+var vertexShader =
+  "attribute vec3 vert;\n" +
+  "attribute vec3 norm;\n" +
+  "uniform mat4 projection;\n" +
+  "uniform mat4 camera;\n" +
+  "uniform mat4 model;\n" +
+  "uniform vec3 obj_color;\n" +
+  "varying vec3 s_normal;\n" +
+  "varying vec3 s_obj_color;\n" +
+  "void main() {\n" +
+  "  s_normal = vec3(model * vec4(norm, 0.0));\n" +
+  "  gl_Position = projection * camera * model * vec4(vert, 1.0);\n" +
+  "  s_obj_color = obj_color;\n" +
+  "}\n";
+
+var fragmentShader =
+  "precision mediump float;\n" +
+  "uniform vec3 light_direction;\n" +
+  "uniform vec3 light_color;\n" +
+  "varying vec3 s_normal;\n" +
+  "varying vec3 s_obj_color;\n" +
+  "void main() {\n" +
+  "  vec3 ambient = 0.15 * light_color;\n" +
+  "  float diff = max(dot(s_normal, light_direction), 0.0);\n" +
+  "  gl_FragColor = vec4((ambient + diff * light_color) * s_obj_color, 1.0);\n" +
+  "}\n";
+
+var gl, prog, loc = {};
+var state = {
+  spaceId: 0, objects: null, objMap: null, drawing: null, ws: null,
+  angle: 0, cam: null, lastNow: 0,
+  reloadPending: false, playTimer: null, dragButton: -1, lastX: 0, lastY: 0
+};
+
+function $(id) { return document.getElementById(id); }
+
+function showStatus(msg) { $("status").textContent = msg; }
+
+function showError(e) { showStatus("Error: " + e.message); }
+
+function api(method, path, body) {
+  var opts = {method: method, headers: {}};
+  if (body !== undefined) {
+    opts.body = JSON.stringify(body);
+    opts.headers["Content-Type"] = "application/json";
+  }
+  return fetch(path, opts).then(function (resp) {
+    if (resp.status === 204) {
+      return null;
+    }
+    return resp.json().then(function (data) {
+      if (!resp.ok) {
+        throw new Error(data.error || resp.statusText);
+      }
+      return data;
+    });
+  });
+}
+
+/* Matrices are column major like mgl32 */
+
+function perspective(fovDeg, aspect, near, far) {
+  var f = 1.0 / Math.tan(fovDeg * Math.PI / 360.0);
+  return [f / aspect, 0, 0, 0, 0, f, 0, 0, 0, 0, (far + near) / (near - far), -1, 0, 0, 2 * far * near / (near - far), 0];
+}
+
+function normalize(v) {
+  var l = Math.sqrt(v[0] * v[0] + v[1] * v[1] + v[2] * v[2]);
+  return [v[0] / l, v[1] / l, v[2] / l];
+}
+
+function cross(a, b) {
+  return [a[1] * b[2] - a[2] * b[1], a[2] * b[0] - a[0] * b[2], a[0] * b[1] - a[1] * b[0]];
+}
+
+function dot(a, b) { return a[0] * b[0] + a[1] * b[1] + a[2] * b[2]; }
+
+function lookAt(eye, center, up) {
+  var f = normalize([center[0] - eye[0], center[1] - eye[1], center[2] - eye[2]]);
+  var s = normalize(cross(f, up));
+  var u = cross(s, f);
+  return [s[0], u[0], -f[0], 0, s[1], u[1], -f[1], 0, s[2], u[2], -f[2], 0, -dot(s, eye), -dot(u, eye), dot(f, eye), 1];
+}
+
+/* Rotation around Z of angle then translation to pos */
+function modelMatrix(angle, pos) {
+  var c = Math.cos(angle), s = Math.sin(angle);
+  return [c, s, 0, 0, -s, c, 0, 0, 0, 0, 1, 0, c * pos[0] - s * pos[1], s * pos[0] + c * pos[1], pos[2], 1];
+}
+
+function objColor(color, dimmer) {
+  var rgb;
+  switch (color) {
+    case 0: rgb = [0.25, 0.25, 0.25]; break;
+    case 1: rgb = [1, 0, 0]; break;
+    case 2: rgb = [0, 1, 0]; break;
+    case 4: rgb = [0, 0, 1]; break;
+    case 8: rgb = [1, 1, 0]; break;
+    default: rgb = [1, 1, 1];
+  }
+  return [rgb[0] * dimmer, rgb[1] * dimmer, rgb[2] * dimmer];
+}
+
+function compileShader(type, source) {
+  var shader = gl.createShader(type);
+  gl.shaderSource(shader, source);
+  gl.compileShader(shader);
+  if (!gl.getShaderParameter(shader, gl.COMPILE_STATUS)) {
+    throw new Error("shader compile failed: " + gl.getShaderInfoLog(shader));
+  }
+  return shader;
+}
+
+function initGl() {
+  gl = $("view").getContext("webgl");
+  if (!gl) {
+    throw new Error("WebGL not available");
+  }
+  prog = gl.createProgram();
+  gl.attachShader(prog, compileShader(gl.VERTEX_SHADER, vertexShader));
+  gl.attachShader(prog, compileShader(gl.FRAGMENT_SHADER, fragmentShader));
+  gl.linkProgram(prog);
+  if (!gl.getProgramParameter(prog, gl.LINK_STATUS)) {
+    throw new Error("program link failed: " + gl.getProgramInfoLog(prog));
+  }
+  ["projection", "camera", "model", "obj_color", "light_direction", "light_color"].forEach(function (name) {
+    loc[name] = gl.getUniformLocation(prog, name);
+  });
+  loc.vert = gl.getAttribLocation(prog, "vert");
+  loc.norm = gl.getAttribLocation(prog, "norm");
+  gl.bindBuffer(gl.ARRAY_BUFFER, gl.createBuffer());
+  gl.enable(gl.DEPTH_TEST);
+  gl.depthFunc(gl.LESS);
+  gl.clearColor(0, 0, 0, 1);
+}
+
+function setObjects(objects) {
+  var stride = objects.floatPerVertices * 4;
+  gl.bufferData(gl.ARRAY_BUFFER, new Float32Array(objects.vertices), gl.STATIC_DRAW);
+  gl.enableVertexAttribArray(loc.vert);
+  gl.vertexAttribPointer(loc.vert, 3, gl.FLOAT, false, stride, 0);
+  gl.enableVertexAttribArray(loc.norm);
+  gl.vertexAttribPointer(loc.norm, 3, gl.FLOAT, true, stride, 12);
+  state.objMap = {};
+  objects.objects.forEach(function (o) { state.objMap[o.type] = o; });
+  state.objects = objects;
+  // Keep the current view while the space size does not change
+  if (!state.cam || state.cam.topCornerDist !== objects.camera.topCornerDist) {
+    resetView();
+  }
+}
+
+// Same orbiting camera as m3gl, the eye distance being the coordinate of the default (d,d,d) eye
+function resetView() {
+  state.cam = Object.assign({}, state.objects.camera);
+  state.cam.target = state.cam.target.slice();
+  state.angle = 0;
+}
+
+function camEye(c) {
+  var d = c.eyeDist * Math.sqrt(3);
+  return [c.target[0] + d * Math.cos(c.pitch) * Math.cos(c.yaw),
+    c.target[1] + d * Math.cos(c.pitch) * Math.sin(c.yaw),
+    c.target[2] + d * Math.sin(c.pitch)];
+}
+
+function orbit(dx, dy) {
+  var c = state.cam, maxPitch = Math.PI / 2 - 0.01;
+  c.yaw = (c.yaw - dx * c.orbitPerPixel) % (2 * Math.PI);
+  c.pitch = Math.min(Math.max(c.pitch + dy * c.orbitPerPixel, -maxPitch), maxPitch);
+}
+
+function pan(dx, dy) {
+  var c = state.cam, eye = camEye(c);
+  var forward = normalize([c.target[0] - eye[0], c.target[1] - eye[1], c.target[2] - eye[2]]);
+  var right = normalize(cross(forward, [0, 0, 1]));
+  var up = cross(right, forward);
+  var scale = 2 * c.eyeDist * Math.sqrt(3) * Math.tan(c.fovAngle * Math.PI / 360) / $("view").height;
+  for (var i = 0; i < 3; i++) {
+    c.target[i] += (-right[i] * dx + up[i] * dy) * scale;
+  }
+}
+
+function zoom(steps) {
+  var c = state.cam;
+  c.eyeDist = Math.min(Math.max(c.eyeDist * Math.pow(c.zoomPerStep, steps), c.eyeDistMin), c.eyeDistMax);
+}
+
+function draw(now) {
+  var canvas = $("view");
+  if (canvas.width !== canvas.clientWidth || canvas.height !== canvas.clientHeight) {
+    canvas.width = canvas.clientWidth;
+    canvas.height = canvas.clientHeight;
+  }
+  gl.viewport(0, 0, canvas.width, canvas.height);
+  gl.clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT);
+  if (state.drawing && state.objMap) {
+    if ($("rotate").checked) {
+      state.angle += (now - state.lastNow) * 0.0003;
+    }
+    var blink = Math.floor(now / 500) % 4;
+    var c = state.cam, eye = camEye(c);
+    var far = Math.sqrt(dot(eye, eye)) + Math.sqrt(dot(c.target, c.target)) + c.topCornerDist;
+    gl.useProgram(prog);
+    gl.uniformMatrix4fv(loc.projection, false, perspective(c.fovAngle, canvas.width / canvas.height, 1.0, far));
+    gl.uniformMatrix4fv(loc.camera, false, lookAt(eye, c.target, [0, 0, 1]));
+    gl.uniform3fv(loc.light_direction, normalize([-1, 1, 1]));
+    gl.uniform3fv(loc.light_color, [1, 1, 1]);
+    state.drawing.elements.forEach(function (e) {
+      var obj = state.objMap[e.type];
+      if (!obj) {
+        return;
+      }
+      gl.uniformMatrix4fv(loc.model, false, modelMatrix(state.angle, e.pos));
+      gl.uniform3fv(loc.obj_color, objColor(e.colors[blink], e.dimmers[blink]));
+      gl.drawArrays(gl.TRIANGLES, obj.offset, obj.nbVertices);
+    });
+  }
+  state.lastNow = now;
+  requestAnimationFrame(draw);
+}
+
+function filterQuery() {
+  var mask = 0;
+  Array.prototype.forEach.call(document.querySelectorAll(".color"), function (c) {
+    if (c.checked) {
+      mask |= parseInt(c.value, 10);
+    }
+  });
+  return "emptyNodes=" + $("emptyNodes").checked +
+    "&emptyConnections=" + $("emptyConnections").checked +
+    "&colorMask=" + mask +
+    "&manyColors=" + (parseInt($("manyColors").value, 10) || 0);
+}
+
+function spacePath(suffix) { return "/spaces/" + state.spaceId + suffix; }
+
+function loadDrawing() {
+  if (!state.spaceId) {
+    state.drawing = null;
+    showStatus("No space, create one");
+    return Promise.resolve();
+  }
+  return api("GET", spacePath("/drawing?" + filterQuery())).then(function (drawing) {
+    var next = Promise.resolve();
+    if (!state.objects || state.objects.max !== drawing.max) {
+      next = api("GET", spacePath("/drawing/objects")).then(setObjects);
+    }
+    return next.then(function () {
+      state.drawing = drawing;
+      $("threshold").value = drawing.eventOutgrowthThreshold;
+      showStatus("Space " + state.spaceId + " time " + drawing.time + ", drawing " +
+        drawing.elements.length + " of " + drawing.nbElements + " elements");
+    });
+  }).catch(showError);
+}
+
+function scheduleReload() {
+  if (state.reloadPending) {
+    return;
+  }
+  state.reloadPending = true;
+  setTimeout(function () {
+    state.reloadPending = false;
+    loadDrawing();
+  }, 100);
+}
+
+function openStream() {
+  if (state.ws) {
+    state.ws.onmessage = null;
+    state.ws.close();
+    state.ws = null;
+  }
+  if (!state.spaceId || !window.WebSocket) {
+    return;
+  }
+  var scheme = location.protocol === "https:" ? "wss://" : "ws://";
+  state.ws = new WebSocket(scheme + location.host + spacePath("/stream"));
+  state.ws.onmessage = scheduleReload;
+}
+
+function selectSpace(id) {
+  state.spaceId = id;
+  state.objects = null;
+  state.objMap = null;
+  state.drawing = null;
+  openStream();
+  return loadDrawing();
+}
+
+function loadSpaces(selectId) {
+  return api("GET", "/spaces").then(function (spaces) {
+    var select = $("spaces");
+    select.innerHTML = "";
+    spaces.forEach(function (s) {
+      var opt = document.createElement("option");
+      opt.value = s.id;
+      opt.textContent = "Space " + s.id + " max " + s.max + " (" + s.nbEvents + " events)";
+      select.appendChild(opt);
+    });
+    var id = selectId || state.spaceId;
+    if (!spaces.some(function (s) { return s.id === id; })) {
+      id = spaces.length > 0 ? spaces[0].id : 0;
+    }
+    select.value = id;
+    if (id !== state.spaceId) {
+      return selectSpace(id);
+    }
+    return loadDrawing();
+  }).catch(showError);
+}
+
+function step() {
+  return api("POST", spacePath("/forward"), {steps: 1}).then(scheduleReload);
+}
+
+function setPlay(on) {
+  if (state.playTimer) {
+    clearInterval(state.playTimer);
+    state.playTimer = null;
+  }
+  if (on) {
+    state.playTimer = setInterval(function () {
+      step().catch(function (e) {
+        $("play").checked = false;
+        setPlay(false);
+        showError(e);
+      });
+    }, 500);
+  }
+}
+
+function bindUi() {
+  $("spaces").onchange = function () { selectSpace(parseInt($("spaces").value, 10)); };
+  $("refresh").onclick = function () { loadSpaces(); };
+  $("newSpace").onclick = function () {
+    api("POST", "/spaces", {eventOutgrowthThreshold: 1}).then(function (s) { return loadSpaces(s.id); }).catch(showError);
+  };
+  $("step").onclick = function () { step().catch(showError); };
+  $("play").onchange = function () { setPlay($("play").checked); };
+  $("threshold").onchange = function () {
+    api("POST", spacePath("/settings"), {eventOutgrowthThreshold: parseInt($("threshold").value, 10) || 0})
+      .then(loadDrawing).catch(showError);
+  };
+  ["emptyNodes", "emptyConnections", "manyColors"].forEach(function (id) { $(id).onchange = loadDrawing; });
+  Array.prototype.forEach.call(document.querySelectorAll(".color"), function (c) { c.onchange = loadDrawing; });
+  $("createEvent").onclick = function () {
+    api("POST", spacePath("/events"), {
+      growthType: parseInt($("growthType").value, 10),
+      growthIndex: parseInt($("growthIndex").value, 10) || 0,
+      growthOffset: parseInt($("growthOffset").value, 10) || 0,
+      point: [parseInt($("px").value, 10) || 0, parseInt($("py").value, 10) || 0, parseInt($("pz").value, 10) || 0],
+      color: $("color").value
+    }).then(function () { return loadSpaces(); }).catch(showError);
+  };
+
+  var canvas = $("view");
+  // Left drag orbits, right or shift drag pans, and the wheel zooms
+  canvas.oncontextmenu = function (e) { e.preventDefault(); };
+  canvas.onmousedown = function (e) {
+    state.dragButton = (e.button === 2 || e.shiftKey) ? 2 : 0;
+    state.lastX = e.clientX;
+    state.lastY = e.clientY;
+  };
+  window.onmouseup = function () { state.dragButton = -1; };
+  window.onmousemove = function (e) {
+    if (state.dragButton < 0 || !state.cam) {
+      return;
+    }
+    var dx = e.clientX - state.lastX, dy = e.clientY - state.lastY;
+    state.lastX = e.clientX;
+    state.lastY = e.clientY;
+    if (state.dragButton === 0) {
+      orbit(dx, dy);
+    } else {
+      pan(dx, dy);
+    }
+  };
+  canvas.onwheel = function (e) {
+    e.preventDefault();
+    if (state.cam) {
+      zoom(e.deltaY < 0 ? 1 : -1);
+    }
+  };
+  $("resetView").onclick = function () {
+    if (state.objects) {
+      resetView();
+    }
+  };
+}
+
+try {
+  initGl();
+  bindUi();
+  loadSpaces();
+  requestAnimationFrame(draw);
+} catch (e) {
+  showError(e);
+}
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,211 @@
+package m3server
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Minimal server side of the WebSocket protocol (RFC 6455) since only net/http is used.
+// Fragmented messages are not assembled, the server only needs to send text messages and answer control frames.
+
+const (
+	websocketGuid = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	// Client frames are only control frames or ignored messages
+	wsMaxReadPayload = 64 * 1024
+	wsWriteTimeout   = 10 * time.Second
+)
+
+const (
+	wsContinuationFrame = 0x0
+	wsTextFrame         = 0x1
+	wsBinaryFrame       = 0x2
+	wsCloseFrame        = 0x8
+	wsPingFrame         = 0x9
+	wsPongFrame         = 0xA
+)
+
+type WsConn struct {
+	conn       net.Conn
+	rw         *bufio.ReadWriter
+	writeMutex sync.Mutex
+}
+
+func computeAcceptKey(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + websocketGuid))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func headerContainsToken(r *http.Request, name, token string) bool {
+	for _, v := range r.Header[name] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Validate the handshake and take over the connection of the request
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (*WsConn, error) {
+	if r.Method != http.MethodGet {
+		return nil, fmt.Errorf("websocket handshake needs GET not %s", r.Method)
+	}
+	if !headerContainsToken(r, "Connection", "upgrade") || !headerContainsToken(r, "Upgrade", "websocket") {
+		return nil, fmt.Errorf("missing websocket upgrade headers")
+	}
+	if r.Header.Get("Sec-Websocket-Version") != "13" {
+		return nil, fmt.Errorf("websocket version %q not supported", r.Header.Get("Sec-Websocket-Version"))
+	}
+	key := r.Header.Get("Sec-Websocket-Key")
+	if key == "" {
+		return nil, fmt.Errorf("missing websocket key")
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, fmt.Errorf("connection does not support hijacking")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	_, err = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + computeAcceptKey(key) + "\r\n\r\n")
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &WsConn{conn: conn, rw: rw}, nil
+}
+
+/***************************************************************/
+// WsConn Functions
+/***************************************************************/
+
+func appendFrameHeader(buf []byte, opcode byte, length int, masked bool) []byte {
+	maskBit := byte(0)
+	if masked {
+		maskBit = 0x80
+	}
+	buf = append(buf, 0x80|opcode)
+	switch {
+	case length < 126:
+		buf = append(buf, maskBit|byte(length))
+	case length <= 0xFFFF:
+		buf = append(buf, maskBit|126, 0, 0)
+		binary.BigEndian.PutUint16(buf[len(buf)-2:], uint16(length))
+	default:
+		buf = append(buf, maskBit|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(buf[len(buf)-8:], uint64(length))
+	}
+	return buf
+}
+
+// Write one unfragmented, unmasked frame
+func (wc *WsConn) WriteMessage(opcode byte, payload []byte) error {
+	wc.writeMutex.Lock()
+	defer wc.writeMutex.Unlock()
+	header := appendFrameHeader(make([]byte, 0, 10), opcode, len(payload), false)
+	err := wc.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if err != nil {
+		return err
+	}
+	_, err = wc.rw.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = wc.rw.Write(payload)
+	if err != nil {
+		return err
+	}
+	return wc.rw.Flush()
+}
+
+// Read one frame, unmasking the payload if needed
+func (wc *WsConn) ReadFrame() (byte, []byte, error) {
+	return readFrame(wc.rw.Reader, wsMaxReadPayload)
+}
+
+func readFrame(r io.Reader, maxPayload uint64) (byte, []byte, error) {
+	var head [2]byte
+	_, err := io.ReadFull(r, head[:])
+	if err != nil {
+		return 0, nil, err
+	}
+	opcode := head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+	length := uint64(head[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > maxPayload {
+		return 0, nil, fmt.Errorf("websocket frame of %d bytes too big", length)
+	}
+	var mask [4]byte
+	if masked {
+		if _, err = io.ReadFull(r, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return opcode, payload, nil
+}
+
+// Read the client frames until closed, answering the control frames
+func (wc *WsConn) readLoop() error {
+	for {
+		opcode, payload, err := wc.ReadFrame()
+		if err != nil {
+			return err
+		}
+		switch opcode {
+		case wsCloseFrame:
+			// Echo the status code and stop
+			wc.WriteMessage(wsCloseFrame, payload)
+			return nil
+		case wsPingFrame:
+			err = wc.WriteMessage(wsPongFrame, payload)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (wc *WsConn) Close() error {
+	return wc.conn.Close()
+}
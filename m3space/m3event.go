@@ -103,11 +103,14 @@ func (space *Space) CreateEvent(ctxType m3point.GrowthType, idx int, offset int,
 	ctx := m3path.MakePathContextDBFromGrowthContext(space.env, ppd.GetGrowthContextByTypeAndIndex(ctxType, idx), offset)
 	e := Event{pnm.id, space, pnm,nil, space.currentTime, k, ctx}
 	space.events[pnm.id] = &e
+	ctx.SetAbsoluteTrioField(space.AbsoluteTrioField)
 	ctx.InitRootNode(p)
 	// TODO: Remove PathNodeMap need. Use DB
 	pnm.AddPathNode(ctx.GetRootPathNode())
 	e.node = space.GetNode(p)
 	space.activeNodes.addNode(e.node)
+	ctx.AddListener(&eventPathListener{evt: &e})
+	space.fireEventCreated(&e)
 	return &e
 }
 
@@ -131,6 +134,26 @@ func getIndexAndOffsetForColor(k EventColor) (int, int) {
 	return -1, -1
 }
 
+func (evt *Event) GetId() EventID {
+	return evt.id
+}
+
+func (evt *Event) GetColor() EventColor {
+	return evt.color
+}
+
+func (evt *Event) GetCreated() DistAndTime {
+	return evt.created
+}
+
+func (evt *Event) GetNode() Node {
+	return evt.node
+}
+
+func (evt *Event) GetPathContext() m3path.PathContext {
+	return evt.pathContext
+}
+
 func (evt *Event) LatestDistance() DistAndTime {
 	// DistAndTime and time are the same...
 	return DistAndTime(evt.space.currentTime - evt.created)
@@ -15,13 +15,43 @@ var NilThreeIds = ThreeIds{NilEvent, NilEvent, NilEvent}
 
 type ForwardResult struct {
 	pointsPerThreeIds map[ThreeIds][]m3point.Point
+	changes           *ActiveChanges
+}
+
+// The changes of the active nodes and links done by one forward time.
+// The active links are the from links of the active nodes, so they change only with these nodes.
+type ActiveChanges struct {
+	// Nodes active now and not at the previous time
+	ActivatedNodes NodeList
+	// Nodes not active anymore, they are now old or dead
+	DeactivatedNodes NodeList
+	// Nodes still active with some events that just became active or inactive, changing colors and links
+	ChangedNodes NodeList
+	// Links active now and not at the previous time, and the reverse
+	AddedLinks   NodeLinkList
+	RemovedLinks NodeLinkList
+	// False if the threshold changed, so the colors of the nodes not in the changes may have changed too
+	Complete bool
 }
 
 func MakeForwardResult() *ForwardResult {
-	res := ForwardResult{make(map[ThreeIds][]m3point.Point, 16)}
+	res := ForwardResult{make(map[ThreeIds][]m3point.Point, 16), nil}
 	return &res
 }
 
+// All the main points reached at the same time by three events, per sorted ids of these three events
+func (fr *ForwardResult) GetPointsPerThreeIds() map[ThreeIds][]m3point.Point {
+	return fr.pointsPerThreeIds
+}
+
+func (fr *ForwardResult) GetActiveChanges() *ActiveChanges {
+	return fr.changes
+}
+
+func (ac *ActiveChanges) GetNbChanges() int {
+	return len(ac.ActivatedNodes) + len(ac.DeactivatedNodes) + len(ac.ChangedNodes) + len(ac.AddedLinks) + len(ac.RemovedLinks)
+}
+
 func (fr *ForwardResult) addPoint(tIds []ThreeIds, p m3point.Point) {
 	for _, tid := range tIds {
 		pList, ok := fr.pointsPerThreeIds[tid]
@@ -52,6 +82,7 @@ func (space *Space) ForwardTime() *ForwardResult {
 	}
 	LogStat.Infof("%4d: %d: %d: %d: %d: %d",
 		space.currentTime, space.GetNbEvents(), len(space.activeNodes), len(space.activeLinks), nbLatest, expectedLatestNodes)
+	space.fireStepStarted()
 
 	wg := sync.WaitGroup{}
 	for _, evt := range space.events {
@@ -82,12 +113,121 @@ func (space *Space) ForwardTime() *ForwardResult {
 	for _, n := range space.activeNodes {
 		space.populateActiveNodesAndLinks(n, res, &newActiveNodes, &newActiveLinks)
 	}
+	space.accessedNodes[space.currentTime] = space.latestNodes
+	res.changes = space.makeActiveChanges(newActiveNodes)
+	oldNodes, deadNodes := space.updateOldAndDeadNodes()
+	meetings := space.updateMeetingPoints(res)
+	if space.HasListeners() {
+		space.fireNodeChanges(res.changes.ActivatedNodes, oldNodes, deadNodes, meetings)
+	}
 	space.activeNodes = newActiveNodes
 	space.activeLinks = newActiveLinks
+	space.activeThreshold = space.EventOutgrowthThreshold
+	space.fireStepFinished(res)
 
 	return res
 }
 
+// Compare the state of the nodes between the previous and the current time.
+// The events of a node change state when accessed now, or when accessed at the threshold distance from now,
+// so only the nodes accessed at these times are compared. After a threshold change all the active nodes are.
+func (space *Space) makeActiveChanges(newActiveNodes NodeList) *ActiveChanges {
+	res := ActiveChanges{Complete: true}
+	now := space.currentTime
+	threshold := space.EventOutgrowthThreshold
+	previousThreshold := threshold
+	if space.activeThreshold >= 0 && space.activeThreshold != threshold {
+		previousThreshold = space.activeThreshold
+		res.Complete = false
+	}
+	candidates := make(NodeList, 0, len(space.latestNodes))
+	seen := make(map[Node]bool, len(space.latestNodes))
+	allFound := res.Complete
+	if allFound {
+		for _, t := range []DistAndTime{now, now - threshold, now - threshold - 1} {
+			if t <= 0 {
+				// Only roots are accessed at creation time, and they stay active
+				continue
+			}
+			nodes, ok := space.accessedNodes[t]
+			if !ok {
+				allFound = false
+				break
+			}
+			candidates = candidates.addNew(seen, nodes)
+		}
+	}
+	if !allFound {
+		candidates = candidates.addNew(seen, space.activeNodes)
+		candidates = candidates.addNew(seen, newActiveNodes)
+	}
+	for _, n := range candidates {
+		wasActive := n.isActiveAt(space, now-1, previousThreshold)
+		isActive := n.isActiveAt(space, now, threshold)
+		if !wasActive && !isActive {
+			continue
+		}
+		if !wasActive {
+			res.ActivatedNodes = append(res.ActivatedNodes, n)
+		} else if !isActive {
+			res.DeactivatedNodes = append(res.DeactivatedNodes, n)
+		} else {
+			res.ChangedNodes = append(res.ChangedNodes, n)
+		}
+		previousLinks := n.getActiveLinksAt(space, now-1, previousThreshold)
+		links := n.getActiveLinksAt(space, now, threshold)
+		res.AddedLinks = append(res.AddedLinks, links.missingIn(previousLinks)...)
+		res.RemovedLinks = append(res.RemovedLinks, previousLinks.missingIn(links)...)
+	}
+	return &res
+}
+
+// Find the nodes accessed long enough ago to be old or dead now, and count the dead ones.
+// Each time of the accessed nodes is checked once for old nodes, then once for dead nodes, then dropped.
+func (space *Space) updateOldAndDeadNodes() (NodeList, NodeList) {
+	var oldNodes, deadNodes NodeList
+	for ; space.oldCheckedTime < space.currentTime-space.EventOutgrowthOldThreshold; space.oldCheckedTime++ {
+		t := space.oldCheckedTime + 1
+		for _, n := range space.accessedNodes[t] {
+			// If accessed after t, the node is checked at a later time
+			if n.GetLastAccessed(space) == t && n.IsOld(space) && !n.IsActive(space) {
+				oldNodes = append(oldNodes, n)
+			}
+		}
+	}
+	for ; space.deadCheckedTime < space.currentTime-space.EventOutgrowthDeadThreshold; space.deadCheckedTime++ {
+		t := space.deadCheckedTime + 1
+		for _, n := range space.accessedNodes[t] {
+			if n.GetLastAccessed(space) == t && n.IsDead(space) && !n.IsActive(space) {
+				deadNodes = append(deadNodes, n)
+			}
+		}
+	}
+	// Keep the times still needed for old nodes and changes
+	for t := range space.accessedNodes {
+		if t <= space.deadCheckedTime {
+			delete(space.accessedNodes, t)
+		}
+	}
+	space.nbDeadNodes += len(deadNodes)
+	return oldNodes, deadNodes
+}
+
+// The meeting points of the forward result never found before
+func (space *Space) updateMeetingPoints(res *ForwardResult) []meetingPointKey {
+	var newMeetings []meetingPointKey
+	for tIds, points := range res.pointsPerThreeIds {
+		for _, p := range points {
+			key := meetingPointKey{tIds, p}
+			if !space.meetingPoints[key] {
+				space.meetingPoints[key] = true
+				newMeetings = append(newMeetings, key)
+			}
+		}
+	}
+	return newMeetings
+}
+
 func (space *Space) populateActiveNodesAndLinks(n Node, res *ForwardResult, nodes *NodeList, links *NodeLinkList) {
 	nbActive := n.GetNbActiveEvents(space)
 	point := n.GetPoint()
@@ -112,6 +252,17 @@ func SortEventIDs(ids *[]EventID) {
 	})
 }
 
+func SortThreeIds(tIdsList []ThreeIds) {
+	sort.Slice(tIdsList, func(i, j int) bool {
+		for k := 0; k < 3; k++ {
+			if tIdsList[i][k] != tIdsList[j][k] {
+				return tIdsList[i][k] < tIdsList[j][k]
+			}
+		}
+		return false
+	})
+}
+
 func MakeThreeIds(ids []EventID) []ThreeIds {
 	SortEventIDs(&ids)
 	if len(ids) == 3 {
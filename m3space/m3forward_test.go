@@ -148,3 +148,58 @@ func TestSpaceRunPySize2(t *testing.T) {
 func runSpaceTest(pSize m3point.CInt) {
 	runSpacePyramidWithParams(getSpaceTestEnv(), pSize, [4]m3point.GrowthType{8, 8, 8, 8}, [4]int{0, 4, 8, 10}, [4]int{0, 0, 0, 4})
 }
+
+func TestActiveChanges(t *testing.T) {
+	Log.SetWarn()
+	LogStat.SetWarn()
+
+	space := MakeSpace(getSpaceTestEnv(), 3*9)
+	space.SetEventOutgrowthThreshold(DistAndTime(2))
+	space.CreateEvent(8, 1, 0, m3point.Origin, RedEvent)
+	space.CreateEvent(8, 2, 0, m3point.Point{3, 0, 3}, GreenEvent)
+
+	active := make(map[Node]bool)
+	for _, n := range space.GetActiveNodes() {
+		active[n] = true
+	}
+	links := make(map[BaseNodeLink]int)
+	for i := 0; i < 10; i++ {
+		if i == 6 {
+			space.SetEventOutgrowthThreshold(DistAndTime(1))
+		}
+		changes := space.ForwardTime().GetActiveChanges()
+		assert.Equal(t, i != 6, changes.Complete, "at %d", space.GetCurrentTime())
+		for _, n := range changes.ActivatedNodes {
+			assert.False(t, active[n], "at %d", space.GetCurrentTime())
+			active[n] = true
+		}
+		for _, n := range changes.DeactivatedNodes {
+			assert.True(t, active[n], "at %d", space.GetCurrentTime())
+			delete(active, n)
+		}
+		for _, n := range changes.ChangedNodes {
+			assert.True(t, active[n], "at %d", space.GetCurrentTime())
+		}
+		for _, nl := range changes.AddedLinks {
+			links[BaseNodeLink{nl.GetConnId(), nl.GetSrc()}]++
+		}
+		for _, nl := range changes.RemovedLinks {
+			key := BaseNodeLink{nl.GetConnId(), nl.GetSrc()}
+			assert.True(t, links[key] > 0, "at %d", space.GetCurrentTime())
+			links[key]--
+			if links[key] == 0 {
+				delete(links, key)
+			}
+		}
+
+		assert.Equal(t, space.GetNbActiveNodes(), len(active), "at %d", space.GetCurrentTime())
+		for _, n := range space.GetActiveNodes() {
+			assert.True(t, active[n], "at %d", space.GetCurrentTime())
+		}
+		expectedLinks := make(map[BaseNodeLink]int)
+		for _, nl := range space.GetActiveLinks() {
+			expectedLinks[BaseNodeLink{nl.GetConnId(), nl.GetSrc()}]++
+		}
+		assert.Equal(t, expectedLinks, links, "at %d", space.GetCurrentTime())
+	}
+}
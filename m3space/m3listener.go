@@ -0,0 +1,144 @@
+package m3space
+
+import (
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+)
+
+// Receive all the changes happening in space while time moves forward.
+// Path node conflicts are forwarded from the path contexts of the events and may come from concurrent go routines.
+type SpaceListener interface {
+	StepStarted(space *Space, time DistAndTime)
+	StepFinished(space *Space, time DistAndTime, res *ForwardResult)
+	EventCreated(space *Space, evt *Event)
+	NodeActivated(space *Space, node Node)
+	NodeOld(space *Space, node Node)
+	NodeDead(space *Space, node Node)
+	MeetingPointFound(space *Space, tIds ThreeIds, p m3point.Point)
+	PathNodeConflict(space *Space, evt *Event, kind m3path.PathNodeConflictKind, p m3point.Point)
+}
+
+// Empty implementation to embed when only some callbacks are needed
+type BaseSpaceListener struct{}
+
+type meetingPointKey struct {
+	tIds ThreeIds
+	p    m3point.Point
+}
+
+// Forward the path context events of one event to the space listeners
+type eventPathListener struct {
+	m3path.BasePathContextListener
+	evt *Event
+}
+
+/***************************************************************/
+// BaseSpaceListener Functions
+/***************************************************************/
+
+func (l *BaseSpaceListener) StepStarted(space *Space, time DistAndTime) {
+}
+
+func (l *BaseSpaceListener) StepFinished(space *Space, time DistAndTime, res *ForwardResult) {
+}
+
+func (l *BaseSpaceListener) EventCreated(space *Space, evt *Event) {
+}
+
+func (l *BaseSpaceListener) NodeActivated(space *Space, node Node) {
+}
+
+func (l *BaseSpaceListener) NodeOld(space *Space, node Node) {
+}
+
+func (l *BaseSpaceListener) NodeDead(space *Space, node Node) {
+}
+
+func (l *BaseSpaceListener) MeetingPointFound(space *Space, tIds ThreeIds, p m3point.Point) {
+}
+
+func (l *BaseSpaceListener) PathNodeConflict(space *Space, evt *Event, kind m3path.PathNodeConflictKind, p m3point.Point) {
+}
+
+/***************************************************************/
+// eventPathListener Functions
+/***************************************************************/
+
+func (epl *eventPathListener) PathNodeConflict(pathCtx m3path.PathContext, kind m3path.PathNodeConflictKind, p m3point.Point, d int) {
+	space := epl.evt.space
+	for _, l := range space.listeners {
+		l.PathNodeConflict(space, epl.evt, kind, p)
+	}
+}
+
+/***************************************************************/
+// Space Listeners Functions
+/***************************************************************/
+
+func (space *Space) AddListener(l SpaceListener) {
+	if l == nil {
+		return
+	}
+	for _, el := range space.listeners {
+		if el == l {
+			return
+		}
+	}
+	space.listeners = append(space.listeners, l)
+}
+
+func (space *Space) RemoveListener(l SpaceListener) {
+	for i, el := range space.listeners {
+		if el == l {
+			space.listeners = append(space.listeners[:i], space.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+func (space *Space) HasListeners() bool {
+	return len(space.listeners) > 0
+}
+
+func (space *Space) fireStepStarted() {
+	for _, l := range space.listeners {
+		l.StepStarted(space, space.currentTime)
+	}
+}
+
+func (space *Space) fireStepFinished(res *ForwardResult) {
+	for _, l := range space.listeners {
+		l.StepFinished(space, space.currentTime, res)
+	}
+}
+
+func (space *Space) fireEventCreated(evt *Event) {
+	for _, l := range space.listeners {
+		l.EventCreated(space, evt)
+	}
+}
+
+// Send the node changes of this forward time to the listeners.
+// Called at the end of forward time when the new active nodes are not yet assigned.
+func (space *Space) fireNodeChanges(activatedNodes, oldNodes, deadNodes NodeList, meetings []meetingPointKey) {
+	for _, n := range activatedNodes {
+		for _, l := range space.listeners {
+			l.NodeActivated(space, n)
+		}
+	}
+	for _, n := range oldNodes {
+		for _, l := range space.listeners {
+			l.NodeOld(space, n)
+		}
+	}
+	for _, n := range deadNodes {
+		for _, l := range space.listeners {
+			l.NodeDead(space, n)
+		}
+	}
+	for _, key := range meetings {
+		for _, l := range space.listeners {
+			l.MeetingPointFound(space, key.tIds, key.p)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package m3space
+
+import (
+	"github.com/freddy33/qsm-go/m3path"
+	"github.com/freddy33/qsm-go/m3point"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type countingListener struct {
+	BaseSpaceListener
+	mutex         sync.Mutex
+	nbStarted     int
+	nbFinished    int
+	nbEvents      int
+	nbActivated   int
+	nbOld         int
+	nbDead        int
+	nbMeetings    int
+	nbConflicts   int
+	lastFinishedT DistAndTime
+}
+
+func (cl *countingListener) StepStarted(space *Space, time DistAndTime) {
+	cl.nbStarted++
+}
+
+func (cl *countingListener) StepFinished(space *Space, time DistAndTime, res *ForwardResult) {
+	cl.nbFinished++
+	cl.lastFinishedT = time
+}
+
+func (cl *countingListener) EventCreated(space *Space, evt *Event) {
+	cl.nbEvents++
+}
+
+func (cl *countingListener) NodeActivated(space *Space, node Node) {
+	cl.nbActivated++
+}
+
+func (cl *countingListener) NodeOld(space *Space, node Node) {
+	cl.nbOld++
+}
+
+func (cl *countingListener) NodeDead(space *Space, node Node) {
+	cl.nbDead++
+}
+
+func (cl *countingListener) MeetingPointFound(space *Space, tIds ThreeIds, p m3point.Point) {
+	cl.nbMeetings++
+}
+
+func (cl *countingListener) PathNodeConflict(space *Space, evt *Event, kind m3path.PathNodeConflictKind, p m3point.Point) {
+	cl.mutex.Lock()
+	cl.nbConflicts++
+	cl.mutex.Unlock()
+}
+
+func TestSpaceListenerSingleEvent(t *testing.T) {
+	Log.SetWarn()
+	LogStat.SetWarn()
+
+	env := getSpaceTestEnv()
+
+	space := MakeSpace(env, 3*9)
+	space.MaxConnections = 3
+	space.SetEventOutgrowthThreshold(DistAndTime(1))
+
+	listener := new(countingListener)
+	space.AddListener(listener)
+	// Adding twice is ignored
+	space.AddListener(listener)
+
+	space.CreateEvent(8, 0, 0, m3point.Origin, RedEvent)
+	assert.Equal(t, 1, listener.nbEvents)
+
+	nbSteps := 12
+	for i := 0; i < nbSteps; i++ {
+		space.ForwardTime()
+		assert.Equal(t, i+1, listener.nbStarted)
+		assert.Equal(t, i+1, listener.nbFinished)
+		assert.Equal(t, DistAndTime(i+1), listener.lastFinishedT)
+	}
+
+	assert.True(t, listener.nbActivated > 0)
+	assert.True(t, listener.nbOld > 0)
+	assert.True(t, listener.nbDead > 0)
+	assert.True(t, listener.nbOld >= listener.nbDead)
+	assert.Equal(t, space.nbDeadNodes, listener.nbDead)
+	// Single event never meets
+	assert.Equal(t, 0, listener.nbMeetings)
+
+	space.RemoveListener(listener)
+	assert.False(t, space.HasListeners())
+	space.ForwardTime()
+	assert.Equal(t, nbSteps, listener.nbFinished)
+
+	// The dead nodes are counted the same without listeners
+	other := MakeSpace(env, 3*9)
+	other.MaxConnections = 3
+	other.SetEventOutgrowthThreshold(DistAndTime(1))
+	other.CreateEvent(8, 0, 0, m3point.Origin, RedEvent)
+	for i := 0; i < nbSteps; i++ {
+		other.ForwardTime()
+	}
+	assert.Equal(t, listener.nbDead, other.GetNbDeadNodes())
+}
+
+func TestSpaceListenerPyramidMeetings(t *testing.T) {
+	Log.SetWarn()
+	LogStat.SetWarn()
+
+	env := getSpaceTestEnv()
+
+	space := MakeSpace(env, 3*30)
+	space.MaxConnections = 3
+	space.SetEventOutgrowthThreshold(DistAndTime(0))
+
+	listener := new(countingListener)
+	space.AddListener(listener)
+	createPyramidWithParams(&space, 2, [4]m3point.GrowthType{8, 8, 8, 8}, [4]int{0, 4, 8, 10}, [4]int{0, 0, 0, 4})
+	assert.Equal(t, 4, listener.nbEvents)
+
+	nbMeetingPoints := 0
+	for i := 0; i < 12; i++ {
+		res := space.ForwardTime()
+		for _, points := range res.pointsPerThreeIds {
+			nbMeetingPoints += len(points)
+		}
+	}
+	// Meeting points are only fired the first time they are found
+	assert.True(t, listener.nbMeetings <= nbMeetingPoints)
+	assert.True(t, listener.nbMeetings > 0)
+}
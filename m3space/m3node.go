@@ -95,6 +95,17 @@ func (nl *NodeList) addNode(newNode Node) {
 	*nl = append(*nl, newNode)
 }
 
+// Append the nodes not seen yet, and mark them as seen
+func (nl NodeList) addNew(seen map[Node]bool, nodes NodeList) NodeList {
+	for _, n := range nodes {
+		if !seen[n] {
+			seen[n] = true
+			nl = append(nl, n)
+		}
+	}
+	return nl
+}
+
 /***************************************************************/
 // NodeLinkList Functions
 /***************************************************************/
@@ -105,6 +116,26 @@ func (pll *NodeLinkList) addAll(links NodeLinkList) {
 	}
 }
 
+// The links of this list not in the other one, a link present twice here needs to be twice in other
+func (pll NodeLinkList) missingIn(other NodeLinkList) NodeLinkList {
+	var res NodeLinkList
+	used := make([]bool, len(other))
+	for _, pl := range pll {
+		found := false
+		for i, ol := range other {
+			if !used[i] && ol.GetConnId() == pl.GetConnId() && ol.GetSrc() == pl.GetSrc() {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, pl)
+		}
+	}
+	return res
+}
+
 /***************************************************************/
 // BaseNode Functions
 /***************************************************************/
@@ -172,7 +203,12 @@ func (bn *BaseNode) GetActiveEventIds(space *Space) []EventID {
 }
 
 func (bn *BaseNode) GetActiveLinks(space *Space) NodeLinkList {
-	if space.EventOutgrowthThreshold <= DistAndTime(0) {
+	return bn.getActiveLinksAt(space, space.currentTime, space.EventOutgrowthThreshold)
+}
+
+// The from links of the node events active at the given time and the next one with the given threshold
+func (bn *BaseNode) getActiveLinksAt(space *Space, time DistAndTime, threshold DistAndTime) NodeLinkList {
+	if threshold <= DistAndTime(0) {
 		// No chance of active links with activity at 0
 		return NodeLinkList(nil)
 	}
@@ -180,8 +216,13 @@ func (bn *BaseNode) GetActiveLinks(space *Space) NodeLinkList {
 	nel := bn.head
 	for nel != nil {
 		// Need to be active on the next round also to have from link activated
-		if nel.cur.IsActiveNext(space) {
+		if nel.cur.isActiveNextAt(space, time, threshold) {
 			pn := nel.cur.GetPathNode()
+			if pn == nil {
+				Log.Errorf("active node event %d of %v has no path node", nel.cur.evtId, bn.p)
+				nel = nel.next
+				continue
+			}
 			td := pn.GetTrioDetails()
 			for i := 0; i < m3path.NbConnections; i++ {
 				if pn.IsFrom(i) {
@@ -333,6 +374,18 @@ func (bn *BaseNode) IsActive(space *Space) bool {
 	return false
 }
 
+// Node was active at the given time with the given threshold if any node events it has was
+func (bn *BaseNode) isActiveAt(space *Space, time DistAndTime, threshold DistAndTime) bool {
+	nel := bn.head
+	for nel != nil {
+		if nel.cur.isActiveAt(space, time, threshold) {
+			return true
+		}
+		nel = nel.next
+	}
+	return false
+}
+
 // Node is old if all node events it has are old. Empty node are dead and so also old
 func (bn *BaseNode) IsOld(space *Space) bool {
 	if bn.IsEmpty() {
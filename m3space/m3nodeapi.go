@@ -42,6 +42,8 @@ type Node interface {
 	GetStateString(space *Space) string
 
 	addPathNode(id EventID, pn m3path.PathNode, space *Space)
+	isActiveAt(space *Space, time DistAndTime, threshold DistAndTime) bool
+	getActiveLinksAt(space *Space, time DistAndTime, threshold DistAndTime) NodeLinkList
 }
 
 type NodeEvent interface {
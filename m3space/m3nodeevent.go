@@ -70,6 +70,30 @@ func (ne *BaseNodeEvent) IsActiveNext(space *Space) bool {
 	return ne.GetDistFromCurrent(space) < space.EventOutgrowthThreshold
 }
 
+// Return true if path node was active at the given time with the given threshold
+func (ne *BaseNodeEvent) isActiveAt(space *Space, time DistAndTime, threshold DistAndTime) bool {
+	if ne.accessedTime > time {
+		return false
+	}
+	evt := space.GetEvent(ne.evtId)
+	if ne.IsRoot(evt) {
+		return true
+	}
+	return time-ne.accessedTime <= threshold
+}
+
+// Return true if path node was active at the given time and the next one with the given threshold
+func (ne *BaseNodeEvent) isActiveNextAt(space *Space, time DistAndTime, threshold DistAndTime) bool {
+	if ne.accessedTime > time {
+		return false
+	}
+	evt := space.GetEvent(ne.evtId)
+	if ne.IsRoot(evt) {
+		return false
+	}
+	return time-ne.accessedTime < threshold
+}
+
 // Return true if path node is old. Dead node are also old
 func (ne *BaseNodeEvent) IsOld(space *Space) bool {
 	return ne.GetDistFromCurrent(space) >= space.EventOutgrowthOldThreshold
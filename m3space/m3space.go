@@ -37,6 +37,17 @@ type Space struct {
 	activeLinks NodeLinkList
 
 	nbDeadNodes int
+	// The latest nodes of each time, to find the nodes changing state at the next times
+	accessedNodes map[DistAndTime]NodeList
+	// The accessed nodes up to these times were checked for becoming old and dead
+	oldCheckedTime  DistAndTime
+	deadCheckedTime DistAndTime
+	// The threshold the active nodes were computed with, negative before the first forward time
+	activeThreshold DistAndTime
+
+	// Registered listeners, and the meeting points already found
+	listeners     []SpaceListener
+	meetingPoints map[meetingPointKey]bool
 
 	// Max absolute coordinate in all nodes
 	Max m3point.CInt
@@ -50,6 +61,8 @@ type Space struct {
 	EventOutgrowthOldThreshold DistAndTime
 	// DistAndTime from latest above which to consider event outgrowth dead
 	EventOutgrowthDeadThreshold DistAndTime
+	// New events grow in the trio field of their growth context at their real center, not translated from origin
+	AbsoluteTrioField bool
 }
 
 func MakeSpace(env *m3db.QsmEnvironment, max m3point.CInt) Space {
@@ -64,6 +77,12 @@ func MakeSpace(env *m3db.QsmEnvironment, max m3point.CInt) Space {
 	space.activeLinks = make([]NodeLink, 0, 500)
 
 	space.nbDeadNodes = 0
+	space.accessedNodes = make(map[DistAndTime]NodeList)
+	space.oldCheckedTime = 0
+	space.deadCheckedTime = 0
+	space.activeThreshold = -1
+	space.listeners = nil
+	space.meetingPoints = make(map[meetingPointKey]bool)
 	space.Max = max
 	space.MaxConnections = 3
 	space.blockOnSameEvent = 3
@@ -102,6 +121,11 @@ func (space *Space) GetNbNodes() int {
 	return space.nbNodes
 }
 
+// The number of nodes dead since the space creation
+func (space *Space) GetNbDeadNodes() int {
+	return space.nbDeadNodes
+}
+
 func (space *Space) GetNbActiveLinks() int {
 	return len(space.activeLinks)
 }
@@ -120,6 +144,40 @@ func (space *Space) GetEvent(id EventID) *Event {
 	return space.events[id]
 }
 
+// Event ids are indexes in the events slice
+func (space *Space) CanCreateEvent() bool {
+	return int(space.lastIdCounter) < space.maxEvents
+}
+
+// The list of all created events ordered by id
+func (space *Space) GetEvents() []*Event {
+	res := make([]*Event, 0, len(space.events))
+	for _, evt := range space.events {
+		if evt != nil {
+			res = append(res, evt)
+		}
+	}
+	return res
+}
+
+// The event created at this point, nil if none
+func (space *Space) GetEventAt(p m3point.Point) *Event {
+	for _, evt := range space.GetEvents() {
+		if *evt.GetNode().GetPoint() == p {
+			return evt
+		}
+	}
+	return nil
+}
+
+func (space *Space) GetActiveNodes() NodeList {
+	return space.activeNodes
+}
+
+func (space *Space) GetActiveLinks() NodeLinkList {
+	return space.activeLinks
+}
+
 func (space *Space) VisitAll(visitor SpaceVisitor, onlyActive bool) {
 	if onlyActive {
 		for _, n := range space.activeNodes {
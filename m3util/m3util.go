@@ -104,6 +104,10 @@ func GetGenDocDir() string {
 	return getOrCreateBuildSubDir("gendoc")
 }
 
+func GetExportDir() string {
+	return getOrCreateBuildSubDir("export")
+}
+
 func ExitOnError(err error) {
 	if err != nil {
 		log.Fatal(err)
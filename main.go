@@ -3,25 +3,32 @@ package main
 import (
 	"fmt"
 	"github.com/freddy33/qsm-go/m3db"
+	"github.com/freddy33/qsm-go/m3gl"
 	"github.com/freddy33/qsm-go/m3path"
 	"github.com/freddy33/qsm-go/m3point"
+	"github.com/freddy33/qsm-go/m3server"
 	"github.com/freddy33/qsm-go/m3util"
 	"github.com/freddy33/qsm-go/playgl"
 	"os"
+	"strconv"
 )
 
 func main() {
 	c := "play"
+	// The arguments of the command, without the verbose flag that can be anywhere after it
+	var args []string
 	if len(os.Args) > 1 {
 		c = os.Args[1]
-		if len(os.Args) > 2 {
-			if os.Args[2] == "-v" {
+		for _, arg := range os.Args[2:] {
+			if arg == "-v" {
 				m3util.Log.SetDebug()
 				m3db.Log.SetDebug()
 				m3point.Log.SetDebug()
 				m3path.Log.SetDebug()
 				//m3space.Log.SetDebug()
 				//m3gl.Log.SetDebug()
+			} else {
+				args = append(args, arg)
 			}
 		}
 	}
@@ -34,11 +41,45 @@ func main() {
 	case "gentxt":
 		m3point.GenerateTextFilesEnv(m3db.GetDefaultEnvironment())
 	case "filldb":
-		m3point.FillDbEnv(m3db.GetDefaultEnvironment())
+		if len(args) > 0 && args[0] == "-ref" {
+			m3point.FillDbEnvFromReference(m3db.GetDefaultEnvironment())
+		} else {
+			m3point.FillDbEnv(m3db.GetDefaultEnvironment())
+		}
+	case "verify":
+		env := m3db.GetDefaultEnvironment()
+		if len(args) > 0 && args[0] == "-ref" {
+			env = nil
+		}
+		report := m3point.VerifyPointPackData(env)
+		fmt.Print(report.String())
+		m3util.ExitOnError(report.GetError())
+	case "trioseq":
+		m3util.ExitOnError(m3point.GenerateTrioSequencesFiles(args))
+	case "pbexport":
+		m3util.ExitOnError(m3point.ExportPathBuilders(m3db.GetDefaultEnvironment(), args))
+	case "genpointref":
+		m3point.GeneratePointPackReference()
 	case "refilldb":
 		m3point.ReFillDbEnv(m3db.GetDefaultEnvironment())
+	case "export":
+		nbSteps := 12
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[len(args)-1])
+			m3util.ExitOnError(err)
+			nbSteps = n
+		}
+		m3gl.ExportDefaultWorld(m3db.GetDefaultEnvironment(), nbSteps)
+	case "record":
+		m3util.ExitOnError(m3gl.RecordDefaultWorld(m3db.GetDefaultEnvironment(), args))
 	case "perf":
 		m3path.RunInsertRandomPoints()
+	case "serve":
+		addr := m3server.DefaultAddress
+		if len(args) > 0 {
+			addr = args[len(args)-1]
+		}
+		m3util.ExitOnError(m3server.Serve(m3db.GetDefaultEnvironment(), addr))
 	default:
 		fmt.Println("The param", c, "unknown")
 	}
@@ -5,12 +5,11 @@ import (
 	"github.com/freddy33/qsm-go/m3db"
 	"github.com/freddy33/qsm-go/m3gl"
 	"github.com/freddy33/qsm-go/m3path"
-	"github.com/freddy33/qsm-go/m3point"
 	"github.com/freddy33/qsm-go/m3space"
 	"github.com/freddy33/qsm-go/m3util"
 	"github.com/go-gl/gl/v4.1-core/gl"
 	"github.com/go-gl/glfw/v3.3/glfw"
-	"github.com/go-gl/mathgl/mgl32"
+	"math"
 	"runtime"
 	"strings"
 )
@@ -23,6 +22,28 @@ const windowHeight = 600
 // TODO: Is there another way than global?
 var world m3gl.DisplayWorld
 
+// Under this many pixels of move between press and release a left click picks instead of orbiting
+const clickMaxMove = 3.0
+
+type mouseDrag struct {
+	button         glfw.MouseButton
+	active         bool
+	pressX, pressY float64
+	lastX, lastY   float64
+}
+
+var drag mouseDrag
+
+// The control and event windows drawn over the canvas
+var ui m3gl.Ui
+var eventState m3gl.EventWindowState
+
+// The left button was pressed over a UI window, the release goes to the UI too
+var uiHasMouse bool
+
+// The OpenGL backend of the world scene
+var renderer *glRenderer
+
 func Play() {
 	runtime.LockOSThread()
 	if err := glfw.Init(); err != nil {
@@ -48,92 +69,40 @@ func Play() {
 	Log.Info("Renderer:", gl.GoStr(gl.GetString(gl.RENDERER)))
 	Log.Info("OpenGL version supported:", gl.GoStr(gl.GetString(gl.VERSION)))
 
-	// ******************************************************************
-	//    HERE CHANGE THE SIZE
-	// ******************************************************************
-	max := int64(9 * m3point.THREE)
 	env := m3db.GetDefaultEnvironment()
 	m3path.InitializeDBEnv(env)
-	world = m3gl.MakeWorld(env, max, glfw.GetTime())
-	//world.WorldSpace.CreateSingleEventCenter()
-	world.WorldSpace.EventOutgrowthThreshold = m3space.DistAndTime(1)
-	world.WorldSpace.EventOutgrowthOldThreshold = m3space.DistAndTime(50)
-	world.WorldSpace.MaxConnections = 3
-	world.WorldSpace.CreateEvent(8, 1, 0, m3point.Origin, m3space.RedEvent)
-	world.CreateDrawingElements()
-
-	// Configure the vertex and fragment shaders
-	prog, err := newProgram(vertexShaderFull, fragmentShader)
+	world = m3gl.MakeDefaultWorld(env, glfw.GetTime())
+
+	win.SetKeyCallback(onKey)
+	win.SetMouseButtonCallback(onMouseButton)
+	win.SetCursorPosCallback(onCursorPos)
+	win.SetScrollCallback(onScroll)
+
+	renderer, err = makeGlRenderer()
 	if err != nil {
 		Log.Fatal(err)
 	}
+	world.SetRendererMeshes(renderer)
 
-	win.SetKeyCallback(onKey)
-
-	projectionUniform := gl.GetUniformLocation(prog, gl.Str("projection\x00"))
-	cameraUniform := gl.GetUniformLocation(prog, gl.Str("camera\x00"))
-	modelUniform := gl.GetUniformLocation(prog, gl.Str("model\x00"))
-	colorUniform := gl.GetUniformLocation(prog, gl.Str("obj_color\x00"))
-	colorDimmerUniform := gl.GetUniformLocation(prog, gl.Str("obj_dimmer\x00"))
-	lightDirectionUniform := gl.GetUniformLocation(prog, gl.Str("light_direction\x00"))
-	lightColorUniform := gl.GetUniformLocation(prog, gl.Str("light_color\x00"))
-	gl.BindFragDataLocation(prog, 0, gl.Str("out_color\x00"))
-
-	// Configure the vertex data
-	var vao uint32
-	gl.GenVertexArrays(1, &vao)
-	gl.BindVertexArray(vao)
-
-	var vbo uint32
-	gl.GenBuffers(1, &vbo)
-	gl.BindBuffer(gl.ARRAY_BUFFER, vbo)
-	Log.Info("Nb vertices", world.NbVertices, ", total size", len(world.OpenGLBuffer))
-	gl.BufferData(gl.ARRAY_BUFFER, world.NbVertices*m3gl.FloatPerVertices*m3gl.FloatSize, gl.Ptr(world.OpenGLBuffer), gl.STATIC_DRAW)
-
-	vertAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("vert\x00")))
-	gl.EnableVertexAttribArray(vertAttrib)
-	gl.VertexAttribPointer(vertAttrib, 3, gl.FLOAT, false, m3gl.FloatPerVertices*m3gl.FloatSize, gl.PtrOffset(0))
-
-	normAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("norm\x00")))
-	gl.EnableVertexAttribArray(normAttrib)
-	gl.VertexAttribPointer(normAttrib, 3, gl.FLOAT, true, m3gl.FloatPerVertices*m3gl.FloatSize, gl.PtrOffset(3*m3gl.FloatSize))
-
-	// Configure global settings
-	gl.Enable(gl.DEPTH_TEST)
-	gl.DepthFunc(gl.LESS)
-	gl.ClearColor(0.0, 0.0, 0.0, 1.0)
+	ui = m3gl.MakeUi(m3gl.MakeUiFontAtlas())
+	eventState = m3gl.MakeEventWindowState()
+	uiRender, err := makeUiRenderer(ui.Atlas)
+	if err != nil {
+		Log.Fatal(err)
+	}
 
 	for !win.ShouldClose() {
-		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
 		// In Retina display retrieving the window size give half of what is needed. Using framebuffer size fix the issue.
-		world.Width, world.Height = win.GetFramebufferSize()
-		gl.Viewport(0, 0, int32(world.Width), int32(world.Height))
+		world.Camera.SetSize(win.GetFramebufferSize())
 		world.Tick(glfw.GetTime())
+		for i := world.NextAutoPlayTicks(glfw.GetTime()); i > 0; i-- {
+			forwardTime()
+		}
 
-		gl.UseProgram(prog)
-
-		gl.UniformMatrix4fv(projectionUniform, 1, false, &(world.Projection[0]))
-		gl.UniformMatrix4fv(cameraUniform, 1, false, &(world.Camera[0]))
-		gl.UniformMatrix4fv(modelUniform, 1, false, &(world.Model[0]))
-		gl.Uniform3f(lightDirectionUniform, world.LightDirection[0], world.LightDirection[1], world.LightDirection[2])
-		gl.Uniform3f(lightColorUniform, world.LightColor[0], world.LightColor[1], world.LightColor[2])
-		gl.Uniform1i(colorUniform, 0)
-		gl.Uniform1f(colorDimmerUniform, 1.0)
-		gl.BindVertexArray(vao)
-
-		for _, obj := range world.Elements {
-			if obj != nil && obj.Display(world.Filter) {
-				toDraw := world.DrawingElementsMap[obj.Key()]
-				world.Model = mgl32.HomogRotate3D(float32(world.Angle.Value), mgl32.Vec3{0, 0, 1})
-				world.Model = world.Model.Mul4(mgl32.Translate3D(float32(obj.Pos().X()), float32(obj.Pos().Y()), float32(obj.Pos().Z())))
-				gl.UniformMatrix4fv(modelUniform, 1, false, &(world.Model[0]))
-
-				gl.Uniform1i(colorUniform, obj.Color(world.Blinker.Value))
-				gl.Uniform1f(colorDimmerUniform, obj.Dimmer(world.Blinker.Value))
+		world.DrawFrame(renderer)
 
-				gl.DrawArrays(gl.TRIANGLES, toDraw.OpenGLOffset, toDraw.NbVertices)
-			}
-		}
+		drawUi(win)
+		uiRender.draw(&ui)
 
 		win.SwapBuffers()
 		glfw.PollEvents()
@@ -155,14 +124,50 @@ func onKey(win *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mod
 		case glfw.KeyS:
 			world.Angle.Enabled = !world.Angle.Enabled
 			displaySettings = false
+		case glfw.KeyR:
+			world.ResetView()
+
+		case glfw.KeyE:
+			export()
+			displaySettings = false
 
 		case glfw.KeyRight:
-			world.WorldSpace.ForwardTime()
-			if world.CheckMax() {
-				gl.BufferData(gl.ARRAY_BUFFER, world.NbVertices*m3gl.FloatPerVertices*4, gl.Ptr(world.OpenGLBuffer), gl.STATIC_DRAW)
-			}
-			world.CreateDrawingElements()
+			forwardTime()
+			displaySettings = false
+		case glfw.KeyLeft:
+			world.BackwardTime()
+			displaySettings = false
+		case glfw.KeyPageUp:
+			world.JumpToTime(world.GetDisplayTime() + m3gl.JumpTicks)
+			timeChanged()
+			displaySettings = false
+		case glfw.KeyPageDown:
+			world.JumpToTime(world.GetDisplayTime() - m3gl.JumpTicks)
 			displaySettings = false
+		case glfw.KeyHome:
+			world.JumpToTime(0)
+			displaySettings = false
+		case glfw.KeyEnd:
+			world.JumpToLatest()
+			displaySettings = false
+		case glfw.KeySpace:
+			world.AutoPlay.Enabled = !world.AutoPlay.Enabled
+			displaySettings = false
+		case glfw.KeyG:
+			world.Filter.Region.SelectNextPlane()
+		case glfw.KeyH:
+			world.Filter.Region.ToggleSelectedPlane()
+		case glfw.KeyComma:
+			world.Filter.Region.MoveSelectedPlane(-1, world.Max)
+		case glfw.KeyPeriod:
+			world.Filter.Region.MoveSelectedPlane(1, world.Max)
+		case glfw.KeySlash:
+			world.Filter.Region.FlipSelectedPlane()
+
+		case glfw.KeyMinus:
+			world.PlayRate.Decrease()
+		case glfw.KeyEqual:
+			world.PlayRate.Increase()
 
 		case glfw.KeyN:
 			world.Filter.DisplayEmptyNodes = !world.Filter.DisplayEmptyNodes
@@ -189,16 +194,16 @@ func onKey(win *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mod
 			world.Filter.ColorMaskSwitch(m3space.YellowEvent)
 
 		case glfw.KeyZ:
-			world.FovAngle.Decrease()
+			world.Camera.FovAngle.Decrease()
 			reCalc = true
 		case glfw.KeyX:
-			world.FovAngle.Increase()
+			world.Camera.FovAngle.Increase()
 			reCalc = true
 		case glfw.KeyQ:
-			world.EyeDist.Increase()
+			world.Camera.EyeDist.Increase()
 			reCalc = true
 		case glfw.KeyW:
-			world.EyeDist.Decrease()
+			world.Camera.EyeDist.Decrease()
 			reCalc = true
 
 		case glfw.KeyB:
@@ -229,12 +234,133 @@ func onKey(win *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mod
 	}
 }
 
+// Declare the UI windows of this frame and apply what they ask for
+func drawUi(win *glfw.Window) {
+	ui.BeginFrame(world.Camera.Width, world.Camera.Height)
+	actions := world.DrawControlWindow(&ui, 10, 10)
+	world.DrawEventWindow(&ui, &eventState, float32(world.Camera.Width-m3gl.EventWindowWidth-10), 10)
+	world.DrawRegionWindow(&ui, float32(world.Camera.Width-m3gl.RegionWindowWidth-10), ui.GetLastWindowBottom()+10)
+	ui.EndFrame()
+
+	if actions.Quit {
+		win.SetShouldClose(true)
+	}
+	if actions.Export {
+		export()
+	}
+	if actions.TimeChanged {
+		timeChanged()
+	}
+	if actions.ReFill {
+		world.CreateMeshes()
+		world.SetRendererMeshes(renderer)
+	}
+}
+
+func forwardTime() {
+	world.ForwardTime()
+	timeChanged()
+}
+
+// The space may have grown while moving in time
+func timeChanged() {
+	if world.CheckMax() {
+		world.UpdateRendererAxes(renderer)
+		world.CreateDrawingElements()
+	}
+}
+
+func export() {
+	files := world.MakeExportScene().WriteAllFormats(fmt.Sprintf("play-%d", world.WorldSpace.GetCurrentTime()))
+	Log.Infof("exported current space to %v", files)
+}
+
+// The cursor is in window coordinates, the camera size is the framebuffer one
+func getFramebufferCursorPos(win *glfw.Window, x, y float64) (float64, float64) {
+	winWidth, winHeight := win.GetSize()
+	if winWidth == 0 || winHeight == 0 {
+		return x, y
+	}
+	return x * float64(world.Camera.Width) / float64(winWidth), y * float64(world.Camera.Height) / float64(winHeight)
+}
+
+// Left drag orbits, right drag pans, and a left click without move picks
+func onMouseButton(win *glfw.Window, button glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+	if button != glfw.MouseButtonLeft && button != glfw.MouseButtonRight {
+		return
+	}
+	cursorX, cursorY := win.GetCursorPos()
+	x, y := getFramebufferCursorPos(win, cursorX, cursorY)
+	ui.SetMouse(x, y)
+	if button == glfw.MouseButtonLeft && (uiHasMouse || (action == glfw.Press && ui.WantsMouse())) {
+		uiHasMouse = action == glfw.Press
+		ui.SetMouseDown(uiHasMouse)
+		return
+	}
+	if action == glfw.Press {
+		if ui.WantsMouse() {
+			return
+		}
+		drag = mouseDrag{button, true, x, y, x, y}
+		return
+	}
+	if action != glfw.Release || !drag.active || drag.button != button {
+		return
+	}
+	drag.active = false
+	if button == glfw.MouseButtonLeft && math.Abs(x-drag.pressX) <= clickMaxMove && math.Abs(y-drag.pressY) <= clickMaxMove {
+		pick(x, y)
+	}
+}
+
+func onCursorPos(win *glfw.Window, xpos, ypos float64) {
+	x, y := getFramebufferCursorPos(win, xpos, ypos)
+	ui.SetMouse(x, y)
+	if !drag.active {
+		return
+	}
+	dx := x - drag.lastX
+	dy := y - drag.lastY
+	drag.lastX, drag.lastY = x, y
+	if drag.button == glfw.MouseButtonLeft {
+		world.Camera.Orbit(dx, dy)
+	} else {
+		world.Camera.Pan(dx, dy)
+	}
+}
+
+func onScroll(win *glfw.Window, xoff, yoff float64) {
+	if ui.WantsMouse() {
+		return
+	}
+	world.Camera.Zoom(yoff)
+}
+
+// Print the info of the node or connection under the framebuffer position
+func pick(x, y float64) {
+	picked, ok := world.Pick(x, y)
+	if !ok {
+		world.ClearHighlight()
+		fmt.Println("Nothing under the cursor at", x, y, "ray", world.GetPickingRay(x, y))
+		return
+	}
+	fmt.Println("========= Picked Element =========")
+	fmt.Print(world.GetElementInfo(picked.Element))
+	if ne, ok := picked.Element.(*m3gl.NodeDrawingElement); ok {
+		paths, err := world.HighlightNode(ne.GetNode())
+		fmt.Print(paths)
+		if err != nil {
+			Log.Errorf("could not highlight all the paths to %v due to %v", ne.Pos(), err)
+		}
+	}
+}
+
 func recalc(fill bool) {
 	world.DisplaySettings()
-	world.SetMatrices()
+	world.Camera.SetMatrices()
 	if fill {
-		world.CreateDrawingElementsMap()
-		gl.BufferData(gl.ARRAY_BUFFER, world.NbVertices*m3gl.FloatPerVertices*4, gl.Ptr(world.OpenGLBuffer), gl.STATIC_DRAW)
+		world.CreateMeshes()
+		world.SetRendererMeshes(renderer)
 	}
 }
 
@@ -244,11 +370,12 @@ var vertexShaderFull = `
 uniform mat4 projection;
 uniform mat4 camera;
 uniform mat4 model;
-uniform int obj_color;
-uniform float obj_dimmer;
 
 in vec3 vert;
 in vec3 norm;
+in vec3 inst_pos;
+in float inst_color;
+in float inst_dimmer;
 
 out vec3 s_normal;
 out vec3 s_obj_color;
@@ -257,7 +384,10 @@ void main() {
 // Be careful last val of vec4 is zero since no translation on normal vector
 	s_normal = vec3(model * vec4(norm, 0));
 
-    gl_Position = projection * camera * model * vec4(vert, 1);
+    gl_Position = projection * camera * model * vec4(vert + inst_pos, 1);
+
+	int obj_color = int(inst_color + 0.5);
+	float obj_dimmer = inst_dimmer;
 
 	if (obj_color == 0) {
 		s_obj_color = vec3(0.25,0.25,0.25) * obj_dimmer;
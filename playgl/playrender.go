@@ -0,0 +1,210 @@
+package playgl
+
+import (
+	"github.com/freddy33/qsm-go/m3gl"
+	"github.com/go-gl/gl/v4.1-core/gl"
+	"sort"
+)
+
+// The OpenGL m3gl.Renderer: the meshes in a static buffer and the instances streamed each frame
+type glRenderer struct {
+	prog        uint32
+	vao         uint32
+	worldVbo    uint32
+	instanceVbo uint32
+
+	// The PPPNNN vertices of all the meshes, and where each object type is in it
+	meshes             map[m3gl.ObjectType]*m3gl.Mesh
+	openGLBuffer       []float32
+	drawingElementsMap map[m3gl.ObjectType]OpenGLDrawingElement
+
+	projectionUniform     int32
+	cameraUniform         int32
+	modelUniform          int32
+	lightDirectionUniform int32
+	lightColorUniform     int32
+
+	instAttribs instanceAttribs
+}
+
+// The locations of the per instance attributes of the shader program
+type instanceAttribs struct {
+	pos, color, dimmer uint32
+}
+
+// The vertices of one object type in the OpenGL buffer
+type OpenGLDrawingElement struct {
+	k            m3gl.ObjectType
+	OpenGLOffset int32
+	NbVertices   int32
+}
+
+// Write the meshes in the buffer, at the offset of the object type if already there
+type TriangleFiller struct {
+	objMap         map[m3gl.ObjectType]OpenGLDrawingElement
+	verticesOffset int32
+	buffer         *[]float32
+}
+
+func makeGlRenderer() (*glRenderer, error) {
+	// Configure the vertex and fragment shaders
+	prog, err := newProgram(vertexShaderFull, fragmentShader)
+	if err != nil {
+		return nil, err
+	}
+	r := &glRenderer{prog: prog}
+	r.projectionUniform = gl.GetUniformLocation(prog, gl.Str("projection\x00"))
+	r.cameraUniform = gl.GetUniformLocation(prog, gl.Str("camera\x00"))
+	r.modelUniform = gl.GetUniformLocation(prog, gl.Str("model\x00"))
+	r.lightDirectionUniform = gl.GetUniformLocation(prog, gl.Str("light_direction\x00"))
+	r.lightColorUniform = gl.GetUniformLocation(prog, gl.Str("light_color\x00"))
+	gl.BindFragDataLocation(prog, 0, gl.Str("out_color\x00"))
+
+	// Configure the vertex data
+	gl.GenVertexArrays(1, &r.vao)
+	gl.BindVertexArray(r.vao)
+
+	gl.GenBuffers(1, &r.worldVbo)
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.worldVbo)
+
+	vertAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("vert\x00")))
+	gl.EnableVertexAttribArray(vertAttrib)
+	gl.VertexAttribPointer(vertAttrib, 3, gl.FLOAT, false, m3gl.FloatPerVertices*m3gl.FloatSize, gl.PtrOffset(0))
+
+	normAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("norm\x00")))
+	gl.EnableVertexAttribArray(normAttrib)
+	gl.VertexAttribPointer(normAttrib, 3, gl.FLOAT, true, m3gl.FloatPerVertices*m3gl.FloatSize, gl.PtrOffset(3*m3gl.FloatSize))
+
+	// The per instance attributes, streamed each frame
+	gl.GenBuffers(1, &r.instanceVbo)
+	r.instAttribs = makeInstanceAttribs(prog)
+
+	// Configure global settings
+	gl.Enable(gl.DEPTH_TEST)
+	gl.DepthFunc(gl.LESS)
+	gl.ClearColor(0.0, 0.0, 0.0, 1.0)
+	return r, nil
+}
+
+func (r *glRenderer) SetMeshes(meshes map[m3gl.ObjectType]*m3gl.Mesh) {
+	r.meshes = make(map[m3gl.ObjectType]*m3gl.Mesh, len(meshes))
+	nbVertices := 0
+	for k, mesh := range meshes {
+		r.meshes[k] = mesh
+		nbVertices += mesh.NumberOfVertices()
+	}
+	r.openGLBuffer = make([]float32, nbVertices*m3gl.FloatPerVertices)
+	r.drawingElementsMap = make(map[m3gl.ObjectType]OpenGLDrawingElement, len(meshes))
+	triangleFiller := TriangleFiller{r.drawingElementsMap, 0, &r.openGLBuffer}
+	// Sorted for the axes to be first in the buffer
+	for _, k := range sortedObjectTypes(meshes) {
+		triangleFiller.fill(k, meshes[k])
+	}
+	Log.Info("Nb vertices", nbVertices, ", total size", len(r.openGLBuffer))
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.worldVbo)
+	gl.BufferData(gl.ARRAY_BUFFER, len(r.openGLBuffer)*m3gl.FloatSize, gl.Ptr(r.openGLBuffer), gl.STATIC_DRAW)
+}
+
+func (r *glRenderer) UpdateMeshes(meshes map[m3gl.ObjectType]*m3gl.Mesh) {
+	for k, mesh := range meshes {
+		wo, ok := r.drawingElementsMap[k]
+		if !ok || int(wo.NbVertices) != mesh.NumberOfVertices() {
+			// Does not fit in place, fill the whole buffer again
+			all := r.meshes
+			for uk, um := range meshes {
+				all[uk] = um
+			}
+			r.SetMeshes(all)
+			return
+		}
+	}
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.worldVbo)
+	triangleFiller := TriangleFiller{r.drawingElementsMap, 0, &r.openGLBuffer}
+	for k, mesh := range meshes {
+		r.meshes[k] = mesh
+		wo := triangleFiller.fill(k, mesh)
+		start := int(wo.OpenGLOffset) * m3gl.FloatPerVertices
+		size := int(wo.NbVertices) * m3gl.FloatPerVertices
+		gl.BufferSubData(gl.ARRAY_BUFFER, start*m3gl.FloatSize, size*m3gl.FloatSize, gl.Ptr(r.openGLBuffer[start:start+size]))
+	}
+}
+
+func (r *glRenderer) DrawFrame(frame *m3gl.SceneFrame) {
+	gl.Viewport(0, 0, int32(frame.Width), int32(frame.Height))
+	gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
+
+	gl.UseProgram(r.prog)
+	gl.UniformMatrix4fv(r.projectionUniform, 1, false, &(frame.Projection[0]))
+	gl.UniformMatrix4fv(r.cameraUniform, 1, false, &(frame.View[0]))
+	gl.UniformMatrix4fv(r.modelUniform, 1, false, &(frame.Model[0]))
+	gl.Uniform3f(r.lightDirectionUniform, frame.LightDirection[0], frame.LightDirection[1], frame.LightDirection[2])
+	gl.Uniform3f(r.lightColorUniform, frame.LightColor[0], frame.LightColor[1], frame.LightColor[2])
+	gl.BindVertexArray(r.vao)
+
+	instances := frame.Instances
+	if len(instances.Data) == 0 {
+		return
+	}
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.instanceVbo)
+	gl.BufferData(gl.ARRAY_BUFFER, len(instances.Data)*m3gl.FloatSize, gl.Ptr(instances.Data), gl.STREAM_DRAW)
+	for _, d := range instances.Draws {
+		wo, ok := r.drawingElementsMap[d.Key]
+		if !ok {
+			continue
+		}
+		r.instAttribs.point(d.FirstInstance)
+		gl.DrawArraysInstanced(gl.TRIANGLES, wo.OpenGLOffset, wo.NbVertices, d.NbInstances)
+	}
+}
+
+func makeInstanceAttribs(prog uint32) instanceAttribs {
+	res := instanceAttribs{
+		uint32(gl.GetAttribLocation(prog, gl.Str("inst_pos\x00"))),
+		uint32(gl.GetAttribLocation(prog, gl.Str("inst_color\x00"))),
+		uint32(gl.GetAttribLocation(prog, gl.Str("inst_dimmer\x00"))),
+	}
+	for _, attrib := range []uint32{res.pos, res.color, res.dimmer} {
+		gl.EnableVertexAttribArray(attrib)
+		gl.VertexAttribDivisor(attrib, 1)
+	}
+	return res
+}
+
+// Point the instance attributes at the first instance of the draw, the instance buffer being bound.
+// OpenGL 4.1 has no base instance for instanced draws.
+func (ia instanceAttribs) point(firstInstance int32) {
+	stride := int32(m3gl.FloatPerInstance * m3gl.FloatSize)
+	offset := int(firstInstance) * m3gl.FloatPerInstance * m3gl.FloatSize
+	gl.VertexAttribPointer(ia.pos, 3, gl.FLOAT, false, stride, gl.PtrOffset(offset))
+	gl.VertexAttribPointer(ia.color, 1, gl.FLOAT, false, stride, gl.PtrOffset(offset+3*m3gl.FloatSize))
+	gl.VertexAttribPointer(ia.dimmer, 1, gl.FLOAT, false, stride, gl.PtrOffset(offset+4*m3gl.FloatSize))
+}
+
+func sortedObjectTypes(meshes map[m3gl.ObjectType]*m3gl.Mesh) []m3gl.ObjectType {
+	res := make([]m3gl.ObjectType, 0, len(meshes))
+	for k := range meshes {
+		res = append(res, k)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+/***************************************************************/
+// TriangleFiller Functions
+/***************************************************************/
+
+// Write the mesh at the end of the buffer, or in place if the object type is already there
+func (t *TriangleFiller) fill(k m3gl.ObjectType, mesh *m3gl.Mesh) OpenGLDrawingElement {
+	wo, ok := t.objMap[k]
+	if !ok {
+		wo = OpenGLDrawingElement{
+			k,
+			t.verticesOffset,
+			int32(mesh.NumberOfVertices()),
+		}
+		t.objMap[k] = wo
+		t.verticesOffset += wo.NbVertices
+	}
+	mesh.FillInterleaved((*t.buffer)[int(wo.OpenGLOffset)*m3gl.FloatPerVertices:])
+	return wo
+}
@@ -0,0 +1,109 @@
+package playgl
+
+import (
+	"github.com/freddy33/qsm-go/m3gl"
+	"github.com/go-gl/gl/v4.1-core/gl"
+)
+
+// The OpenGL objects to draw the m3gl immediate mode UI over the 3D canvas
+type uiRenderer struct {
+	prog          uint32
+	vao           uint32
+	vbo           uint32
+	texture       uint32
+	screenUniform int32
+}
+
+func makeUiRenderer(atlas *m3gl.UiFontAtlas) (*uiRenderer, error) {
+	prog, err := newProgram(uiVertexShader, uiFragmentShader)
+	if err != nil {
+		return nil, err
+	}
+	r := &uiRenderer{prog: prog}
+	r.screenUniform = gl.GetUniformLocation(prog, gl.Str("screen\x00"))
+	gl.BindFragDataLocation(prog, 0, gl.Str("out_color\x00"))
+
+	gl.GenVertexArrays(1, &r.vao)
+	gl.BindVertexArray(r.vao)
+	gl.GenBuffers(1, &r.vbo)
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.vbo)
+	stride := int32(m3gl.UiFloatPerVertices * m3gl.FloatSize)
+	posAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("pos\x00")))
+	gl.EnableVertexAttribArray(posAttrib)
+	gl.VertexAttribPointer(posAttrib, 2, gl.FLOAT, false, stride, gl.PtrOffset(0))
+	uvAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("uv\x00")))
+	gl.EnableVertexAttribArray(uvAttrib)
+	gl.VertexAttribPointer(uvAttrib, 2, gl.FLOAT, false, stride, gl.PtrOffset(2*m3gl.FloatSize))
+	colorAttrib := uint32(gl.GetAttribLocation(prog, gl.Str("color\x00")))
+	gl.EnableVertexAttribArray(colorAttrib)
+	gl.VertexAttribPointer(colorAttrib, 4, gl.FLOAT, false, stride, gl.PtrOffset(4*m3gl.FloatSize))
+
+	// The font atlas is one byte per pixel
+	gl.GenTextures(1, &r.texture)
+	gl.BindTexture(gl.TEXTURE_2D, r.texture)
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+	b := atlas.Image.Bounds()
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.R8, int32(b.Dx()), int32(b.Dy()), 0, gl.RED, gl.UNSIGNED_BYTE, gl.Ptr(atlas.Image.Pix))
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.NEAREST)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	return r, nil
+}
+
+// Draw the triangles of the UI frame on top of everything, with alpha blending
+func (r *uiRenderer) draw(ui *m3gl.Ui) {
+	if len(ui.Vertices) == 0 {
+		return
+	}
+	gl.Disable(gl.DEPTH_TEST)
+	gl.Enable(gl.BLEND)
+	gl.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
+
+	gl.UseProgram(r.prog)
+	gl.Uniform2f(r.screenUniform, float32(ui.Width), float32(ui.Height))
+	gl.ActiveTexture(gl.TEXTURE0)
+	gl.BindTexture(gl.TEXTURE_2D, r.texture)
+	gl.BindVertexArray(r.vao)
+	gl.BindBuffer(gl.ARRAY_BUFFER, r.vbo)
+	gl.BufferData(gl.ARRAY_BUFFER, len(ui.Vertices)*m3gl.FloatSize, gl.Ptr(ui.Vertices), gl.STREAM_DRAW)
+	gl.DrawArrays(gl.TRIANGLES, 0, int32(len(ui.Vertices)/m3gl.UiFloatPerVertices))
+
+	gl.Disable(gl.BLEND)
+	gl.Enable(gl.DEPTH_TEST)
+}
+
+var uiVertexShader = `
+#version 330
+
+uniform vec2 screen;
+
+in vec2 pos;
+in vec2 uv;
+in vec4 color;
+
+out vec2 s_uv;
+out vec4 s_color;
+
+void main() {
+	s_uv = uv;
+	s_color = color;
+	// Pixels from the top left corner to normalized device coordinates
+	gl_Position = vec4(2.0 * pos.x / screen.x - 1.0, 1.0 - 2.0 * pos.y / screen.y, 0.0, 1.0);
+}
+` + "\x00"
+
+var uiFragmentShader = `
+#version 330
+
+uniform sampler2D atlas;
+
+in vec2 s_uv;
+in vec4 s_color;
+
+out vec4 out_color;
+
+void main() {
+	out_color = vec4(s_color.rgb, s_color.a * texture(atlas, s_uv).r);
+}
+` + "\x00"
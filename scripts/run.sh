@@ -1,7 +1,7 @@
 #!/usr/bin/env bash
 
 usage() {
-    echo "Usage qsm run [refilldb, filldb, gentxt, play]"
+    echo "Usage qsm run [refilldb, filldb [-ref], genpointref, verify [-ref], trioseq [-min n] [-max n] [-distinct] [-steps n], pbexport [-cube n|-ctx n] [-format dot|json] [-ref], gentxt, play, export [steps], record [-ticks n] [-format gif|png] [-orbit] ...]"
     exit 1
 }
 
@@ -9,7 +9,7 @@ if [[ -z "$1" ]]; then
     usage
 fi
 
-if [ "$1" != "play" ] && [ "$1" == "gentxt" ] && [ "$1" != "filldb" ] && [ "$1" != "refilldb" ]; then
+if [ "$1" != "play" ] && [ "$1" != "gentxt" ] && [ "$1" != "filldb" ] && [ "$1" != "genpointref" ] && [ "$1" != "verify" ] && [ "$1" != "trioseq" ] && [ "$1" != "pbexport" ] && [ "$1" != "refilldb" ] && [ "$1" != "export" ] && [ "$1" != "record" ]; then
     echo "ERROR: Run command $1 unknown"
     usage
 fi
@@ -0,0 +1,4 @@
+#!/usr/bin/env bash
+
+# Usage qsm serve [-v] [host:port]
+go build && ./qsm-go serve $@